@@ -5,8 +5,8 @@ import (
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // sweeperAwsClients is a shared cache of regional AWSClient
@@ -15,7 +15,7 @@ var sweeperAwsClients map[string]interface{}
 
 func TestMain(m *testing.M) {
 	sweeperAwsClients = make(map[string]interface{})
-	resource.TestMain(m)
+	sweep.TestMain(m)
 }
 
 // sharedClientForRegion returns a common AWSClient setup needed for the sweeper
@@ -37,6 +37,13 @@ func sharedClientForRegion(region string) (interface{}, error) {
 		}
 	}
 
+	// Sweepers share a single cassette, which is never stopped.
+	if testAccRecorder != nil {
+		if err := testAccRecorder.Start("Sweepers"); err != nil {
+			return nil, err
+		}
+	}
+
 	conf := &Config{
 		MaxRetries: 5,
 		Region:     region,
@@ -1,13 +1,18 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
@@ -188,12 +193,29 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	AssumeRoleWithWebIdentityConfig *AssumeRoleWithWebIdentityConfig
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
-	Endpoints        map[string]string
-	IgnoreTagsConfig *keyvaluetags.IgnoreConfig
-	Insecure         bool
+	AuditLogPath string
+
+	DefaultTagsConfig *keyvaluetags.DefaultConfig
+	EndpointURL       string
+	Endpoints         map[string]string
+	IgnoreTagsConfig  *keyvaluetags.IgnoreConfig
+	Insecure          bool
+
+	CustomCABundle string
+	HTTPProxy      string
+	HTTPSProxy     string
+	NoProxy        string
+	TLSMinVersion  string
+
+	ReadOnly                  bool
+	ReadOnlyAllowedOperations []string
+
+	RetryConfig *RetryConfig
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -206,171 +228,35 @@ type Config struct {
 }
 
 type AWSClient struct {
-	accessanalyzerconn                  *accessanalyzer.AccessAnalyzer
-	accountid                           string
-	acmconn                             *acm.ACM
-	acmpcaconn                          *acmpca.ACMPCA
-	amplifyconn                         *amplify.Amplify
-	apigatewayconn                      *apigateway.APIGateway
-	apigatewayv2conn                    *apigatewayv2.ApiGatewayV2
-	appautoscalingconn                  *applicationautoscaling.ApplicationAutoScaling
-	applicationinsightsconn             *applicationinsights.ApplicationInsights
-	appmeshconn                         *appmesh.AppMesh
-	appstreamconn                       *appstream.AppStream
-	appsyncconn                         *appsync.AppSync
-	athenaconn                          *athena.Athena
-	autoscalingconn                     *autoscaling.AutoScaling
-	autoscalingplansconn                *autoscalingplans.AutoScalingPlans
-	backupconn                          *backup.Backup
-	batchconn                           *batch.Batch
-	budgetconn                          *budgets.Budgets
-	cfconn                              *cloudformation.CloudFormation
-	cloud9conn                          *cloud9.Cloud9
-	cloudfrontconn                      *cloudfront.CloudFront
-	cloudhsmv2conn                      *cloudhsmv2.CloudHSMV2
-	cloudsearchconn                     *cloudsearch.CloudSearch
-	cloudtrailconn                      *cloudtrail.CloudTrail
-	cloudwatchconn                      *cloudwatch.CloudWatch
-	cloudwatcheventsconn                *cloudwatchevents.CloudWatchEvents
-	cloudwatchlogsconn                  *cloudwatchlogs.CloudWatchLogs
-	codeartifactconn                    *codeartifact.CodeArtifact
-	codebuildconn                       *codebuild.CodeBuild
-	codecommitconn                      *codecommit.CodeCommit
-	codedeployconn                      *codedeploy.CodeDeploy
-	codepipelineconn                    *codepipeline.CodePipeline
-	codestarconnectionsconn             *codestarconnections.CodeStarConnections
-	codestarnotificationsconn           *codestarnotifications.CodeStarNotifications
-	cognitoconn                         *cognitoidentity.CognitoIdentity
-	cognitoidpconn                      *cognitoidentityprovider.CognitoIdentityProvider
-	configconn                          *configservice.ConfigService
-	connectconn                         *connect.Connect
-	costandusagereportconn              *costandusagereportservice.CostandUsageReportService
-	dataexchangeconn                    *dataexchange.DataExchange
-	datapipelineconn                    *datapipeline.DataPipeline
-	datasyncconn                        *datasync.DataSync
-	daxconn                             *dax.DAX
-	devicefarmconn                      *devicefarm.DeviceFarm
-	dlmconn                             *dlm.DLM
-	dmsconn                             *databasemigrationservice.DatabaseMigrationService
-	dnsSuffix                           string
-	docdbconn                           *docdb.DocDB
-	dsconn                              *directoryservice.DirectoryService
-	dxconn                              *directconnect.DirectConnect
-	dynamodbconn                        *dynamodb.DynamoDB
-	ec2conn                             *ec2.EC2
-	ecrconn                             *ecr.ECR
-	ecrpublicconn                       *ecrpublic.ECRPublic
-	ecsconn                             *ecs.ECS
-	efsconn                             *efs.EFS
-	eksconn                             *eks.EKS
-	elasticacheconn                     *elasticache.ElastiCache
-	elasticbeanstalkconn                *elasticbeanstalk.ElasticBeanstalk
-	elastictranscoderconn               *elastictranscoder.ElasticTranscoder
-	elbconn                             *elb.ELB
-	elbv2conn                           *elbv2.ELBV2
-	emrconn                             *emr.EMR
-	emrcontainersconn                   *emrcontainers.EMRContainers
-	esconn                              *elasticsearch.ElasticsearchService
-	firehoseconn                        *firehose.Firehose
-	fmsconn                             *fms.FMS
-	forecastconn                        *forecastservice.ForecastService
-	fsxconn                             *fsx.FSx
-	gameliftconn                        *gamelift.GameLift
-	glacierconn                         *glacier.Glacier
-	globalacceleratorconn               *globalaccelerator.GlobalAccelerator
-	glueconn                            *glue.Glue
-	guarddutyconn                       *guardduty.GuardDuty
-	greengrassconn                      *greengrass.Greengrass
-	iamconn                             *iam.IAM
-	identitystoreconn                   *identitystore.IdentityStore
-	IgnoreTagsConfig                    *keyvaluetags.IgnoreConfig
-	imagebuilderconn                    *imagebuilder.Imagebuilder
-	inspectorconn                       *inspector.Inspector
-	iotconn                             *iot.IoT
-	iotanalyticsconn                    *iotanalytics.IoTAnalytics
-	ioteventsconn                       *iotevents.IoTEvents
-	kafkaconn                           *kafka.Kafka
-	kinesisanalyticsconn                *kinesisanalytics.KinesisAnalytics
-	kinesisanalyticsv2conn              *kinesisanalyticsv2.KinesisAnalyticsV2
-	kinesisconn                         *kinesis.Kinesis
-	kinesisvideoconn                    *kinesisvideo.KinesisVideo
-	kmsconn                             *kms.KMS
-	lakeformationconn                   *lakeformation.LakeFormation
-	lambdaconn                          *lambda.Lambda
-	lexmodelconn                        *lexmodelbuildingservice.LexModelBuildingService
-	licensemanagerconn                  *licensemanager.LicenseManager
-	lightsailconn                       *lightsail.Lightsail
-	macieconn                           *macie.Macie
-	macie2conn                          *macie2.Macie2
-	managedblockchainconn               *managedblockchain.ManagedBlockchain
-	marketplacecatalogconn              *marketplacecatalog.MarketplaceCatalog
-	mediaconnectconn                    *mediaconnect.MediaConnect
-	mediaconvertconn                    *mediaconvert.MediaConvert
-	mediaconvertaccountconn             *mediaconvert.MediaConvert
-	medialiveconn                       *medialive.MediaLive
-	mediapackageconn                    *mediapackage.MediaPackage
-	mediastoreconn                      *mediastore.MediaStore
-	mediastoredataconn                  *mediastoredata.MediaStoreData
-	mqconn                              *mq.MQ
-	mwaaconn                            *mwaa.MWAA
-	neptuneconn                         *neptune.Neptune
-	networkfirewallconn                 *networkfirewall.NetworkFirewall
-	networkmanagerconn                  *networkmanager.NetworkManager
-	opsworksconn                        *opsworks.OpsWorks
-	organizationsconn                   *organizations.Organizations
-	outpostsconn                        *outposts.Outposts
-	partition                           string
-	personalizeconn                     *personalize.Personalize
-	prometheusserviceconn               *prometheusservice.PrometheusService
-	pinpointconn                        *pinpoint.Pinpoint
-	pricingconn                         *pricing.Pricing
-	qldbconn                            *qldb.QLDB
-	quicksightconn                      *quicksight.QuickSight
-	r53conn                             *route53.Route53
-	ramconn                             *ram.RAM
-	rdsconn                             *rds.RDS
-	redshiftconn                        *redshift.Redshift
-	region                              string
-	resourcegroupsconn                  *resourcegroups.ResourceGroups
-	resourcegroupstaggingapiconn        *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
-	reverseDnsPrefix                    string
-	route53domainsconn                  *route53domains.Route53Domains
-	route53resolverconn                 *route53resolver.Route53Resolver
-	s3conn                              *s3.S3
-	s3connUriCleaningDisabled           *s3.S3
-	s3controlconn                       *s3control.S3Control
-	s3outpostsconn                      *s3outposts.S3Outposts
-	sagemakerconn                       *sagemaker.SageMaker
-	scconn                              *servicecatalog.ServiceCatalog
-	sdconn                              *servicediscovery.ServiceDiscovery
-	secretsmanagerconn                  *secretsmanager.SecretsManager
-	securityhubconn                     *securityhub.SecurityHub
-	serverlessapplicationrepositoryconn *serverlessapplicationrepository.ServerlessApplicationRepository
-	servicequotasconn                   *servicequotas.ServiceQuotas
-	sesconn                             *ses.SES
-	sfnconn                             *sfn.SFN
-	shieldconn                          *shield.Shield
-	signerconn                          *signer.Signer
-	simpledbconn                        *simpledb.SimpleDB
-	snsconn                             *sns.SNS
-	sqsconn                             *sqs.SQS
-	ssmconn                             *ssm.SSM
-	ssoadminconn                        *ssoadmin.SSOAdmin
-	storagegatewayconn                  *storagegateway.StorageGateway
-	stsconn                             *sts.STS
-	supportedplatforms                  []string
-	swfconn                             *swf.SWF
-	syntheticsconn                      *synthetics.Synthetics
-	terraformVersion                    string
-	timestreamwriteconn                 *timestreamwrite.TimestreamWrite
-	transferconn                        *transfer.Transfer
-	wafconn                             *waf.WAF
-	wafregionalconn                     *wafregional.WAFRegional
-	wafv2conn                           *wafv2.WAFV2
-	worklinkconn                        *worklink.WorkLink
-	workmailconn                        *workmail.WorkMail
-	workspacesconn                      *workspaces.WorkSpaces
-	xrayconn                            *xray.XRay
+	accountid               string
+	DefaultTagsConfig       *keyvaluetags.DefaultConfig
+	dnsSuffix               string
+	endpointURL             string
+	endpoints               map[string]string
+	IgnoreTagsConfig        *keyvaluetags.IgnoreConfig
+	mediaconvertaccountconn *mediaconvert.MediaConvert
+	partition               string
+	region                  string
+	reverseDnsPrefix        string
+	retryConfig             *RetryConfig
+	s3ForcePathStyle        bool
+	session                 *session.Session
+	supportedplatforms      []string
+	terraformVersion        string
+
+	// conns holds service clients created on first use by their accessor
+	// methods, keyed by accessor name. See conn().
+	conns     map[string]interface{}
+	connsLock sync.Mutex
+
+	// resourceType is the Terraform resource type this AWSClient makes
+	// requests on behalf of, if any. See resourceClient().
+	resourceType string
+
+	// resourceClients holds AWSClients for other regions and resource types,
+	// created on first use by resourceClient().
+	resourceClients     map[string]*AWSClient
+	resourceClientsLock sync.Mutex
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -411,7 +297,7 @@ func (c *Config) Client() (interface{}, error) {
 		CallerName:                  "Terraform AWS Provider",
 		CredsFilename:               c.CredsFilename,
 		DebugLogging:                logging.IsDebugOrHigher(),
-		IamEndpoint:                 c.Endpoints["iam"],
+		IamEndpoint:                 c.endpoint("iam"),
 		Insecure:                    c.Insecure,
 		MaxRetries:                  c.MaxRetries,
 		Profile:                     c.Profile,
@@ -420,7 +306,7 @@ func (c *Config) Client() (interface{}, error) {
 		SkipCredsValidation:         c.SkipCredsValidation,
 		SkipMetadataApiCheck:        c.SkipMetadataApiCheck,
 		SkipRequestingAccountId:     c.SkipRequestingAccountId,
-		StsEndpoint:                 c.Endpoints["sts"],
+		StsEndpoint:                 c.endpoint("sts"),
 		Token:                       c.Token,
 		UserAgentProducts: []*awsbase.UserAgentProduct{
 			{Name: "APN", Version: "1.0"},
@@ -430,11 +316,86 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
+	var creds *credentials.Credentials
+	var httpClient *http.Client
+
+	if c.hasHTTPClientConfig() {
+		var err error
+		httpClient, err = c.httpClient()
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
+	// awsbase supports neither web identity credentials nor a custom HTTP
+	// client. In those cases the provider resolves and validates the
+	// credentials itself, and awsbase is given the current credentials only
+	// to create the session. The session is switched to the refreshing
+	// credentials below.
+	if c.AssumeRoleWithWebIdentityConfig != nil || httpClient != nil {
+		var err error
+		creds, err = c.credentials(awsbaseConfig, httpClient)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		value, err := creds.Get()
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		awsbaseConfig.AccessKey = value.AccessKeyID
+		awsbaseConfig.AssumeRoleARN = ""
+		awsbaseConfig.SecretKey = value.SecretAccessKey
+		awsbaseConfig.SkipCredsValidation = true
+		awsbaseConfig.SkipRequestingAccountId = true
+		awsbaseConfig.Token = value.SessionToken
+	}
+
 	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	if creds != nil {
+		sess.Config.Credentials = creds
+
+		if httpClient != nil {
+			sess.Config.HTTPClient = httpClient
+		}
+
+		accountID, partition, err = c.accountIDAndPartition(sess)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
+	if c.RetryConfig != nil && c.RetryConfig.MaxConcurrentRequests > 0 {
+		sess.Handlers.Send.AfterEachFn = request.HandlerListStopOnError
+		newConcurrencyLimiter(c.RetryConfig.MaxConcurrentRequests).configure(&sess.Handlers)
+	}
+
+	if c.ReadOnly {
+		// Every service client is created from a copy of this session, so this
+		// covers all service clients. Operations are blocked before they are sent.
+		sess.Handlers.Send.AfterEachFn = request.HandlerListStopOnError
+		sess.Handlers.Send.PushFrontNamed(readOnlyHandler(c.ReadOnlyAllowedOperations))
+	}
+
+	if c.AuditLogPath != "" {
+		auditLogger, err := newAuditLogger(c.AuditLogPath)
+
+		if err != nil {
+			return nil, err
+		}
+
+		sess.Handlers.Complete.PushBackNamed(auditLogger.handler())
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -449,362 +410,1316 @@ func (c *Config) Client() (interface{}, error) {
 	}
 
 	client := &AWSClient{
-		accessanalyzerconn:                  accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["accessanalyzer"])})),
-		accountid:                           accountID,
-		acmconn:                             acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acm"])})),
-		acmpcaconn:                          acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acmpca"])})),
-		amplifyconn:                         amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["amplify"])})),
-		apigatewayconn:                      apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
-		apigatewayv2conn:                    apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
-		appautoscalingconn:                  applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["applicationautoscaling"])})),
-		applicationinsightsconn:             applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["applicationinsights"])})),
-		appmeshconn:                         appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appmesh"])})),
-		appstreamconn:                       appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appstream"])})),
-		appsyncconn:                         appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appsync"])})),
-		athenaconn:                          athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["athena"])})),
-		autoscalingconn:                     autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["autoscaling"])})),
-		autoscalingplansconn:                autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["autoscalingplans"])})),
-		backupconn:                          backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["backup"])})),
-		batchconn:                           batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["batch"])})),
-		budgetconn:                          budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["budgets"])})),
-		cfconn:                              cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudformation"])})),
-		cloud9conn:                          cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloud9"])})),
-		cloudfrontconn:                      cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudfront"])})),
-		cloudhsmv2conn:                      cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudhsm"])})),
-		cloudsearchconn:                     cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudsearch"])})),
-		cloudtrailconn:                      cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudtrail"])})),
-		cloudwatchconn:                      cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatch"])})),
-		cloudwatcheventsconn:                cloudwatchevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatchevents"])})),
-		cloudwatchlogsconn:                  cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatchlogs"])})),
-		codeartifactconn:                    codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codeartifact"])})),
-		codebuildconn:                       codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codebuild"])})),
-		codecommitconn:                      codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codecommit"])})),
-		codedeployconn:                      codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codedeploy"])})),
-		codepipelineconn:                    codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codepipeline"])})),
-		codestarconnectionsconn:             codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codestarconnections"])})),
-		codestarnotificationsconn:           codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codestarnotifications"])})),
-		cognitoconn:                         cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidentity"])})),
-		cognitoidpconn:                      cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidp"])})),
-		configconn:                          configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["configservice"])})),
-		connectconn:                         connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["connect"])})),
-		costandusagereportconn:              costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cur"])})),
-		dataexchangeconn:                    dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dataexchange"])})),
-		datapipelineconn:                    datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])})),
-		datasyncconn:                        datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datasync"])})),
-		daxconn:                             dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dax"])})),
-		devicefarmconn:                      devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["devicefarm"])})),
-		dlmconn:                             dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])})),
-		dmsconn:                             databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dms"])})),
-		dnsSuffix:                           dnsSuffix,
-		docdbconn:                           docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["docdb"])})),
-		dsconn:                              directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ds"])})),
-		dxconn:                              directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["directconnect"])})),
-		dynamodbconn:                        dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dynamodb"])})),
-		ec2conn:                             ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ec2"])})),
-		ecrconn:                             ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecr"])})),
-		ecrpublicconn:                       ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecrpublic"])})),
-		ecsconn:                             ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecs"])})),
-		efsconn:                             efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["efs"])})),
-		eksconn:                             eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["eks"])})),
-		elasticacheconn:                     elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elasticache"])})),
-		elasticbeanstalkconn:                elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elasticbeanstalk"])})),
-		elastictranscoderconn:               elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elastictranscoder"])})),
-		elbconn:                             elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elb"])})),
-		elbv2conn:                           elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elb"])})),
-		emrconn:                             emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["emr"])})),
-		emrcontainersconn:                   emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["emrcontainers"])})),
-		esconn:                              elasticsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["es"])})),
-		firehoseconn:                        firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["firehose"])})),
-		fmsconn:                             fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fms"])})),
-		forecastconn:                        forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["forecast"])})),
-		fsxconn:                             fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fsx"])})),
-		gameliftconn:                        gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["gamelift"])})),
-		glacierconn:                         glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["glacier"])})),
-		glueconn:                            glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["glue"])})),
-		guarddutyconn:                       guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["guardduty"])})),
-		greengrassconn:                      greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["greengrass"])})),
-		iamconn:                             iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iam"])})),
-		identitystoreconn:                   identitystore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["identitystore"])})),
-		IgnoreTagsConfig:                    c.IgnoreTagsConfig,
-		imagebuilderconn:                    imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["imagebuilder"])})),
-		inspectorconn:                       inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["inspector"])})),
-		iotconn:                             iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iot"])})),
-		iotanalyticsconn:                    iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iotanalytics"])})),
-		ioteventsconn:                       iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iotevents"])})),
-		kafkaconn:                           kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kafka"])})),
-		kinesisanalyticsconn:                kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisanalytics"])})),
-		kinesisanalyticsv2conn:              kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisanalyticsv2"])})),
-		kinesisconn:                         kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesis"])})),
-		kinesisvideoconn:                    kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisvideo"])})),
-		kmsconn:                             kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kms"])})),
-		lakeformationconn:                   lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lakeformation"])})),
-		lambdaconn:                          lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lambda"])})),
-		lexmodelconn:                        lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lexmodels"])})),
-		licensemanagerconn:                  licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["licensemanager"])})),
-		lightsailconn:                       lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lightsail"])})),
-		macieconn:                           macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["macie"])})),
-		macie2conn:                          macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["macie2"])})),
-		managedblockchainconn:               managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["managedblockchain"])})),
-		marketplacecatalogconn:              marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["marketplacecatalog"])})),
-		mediaconnectconn:                    mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediaconnect"])})),
-		mediaconvertconn:                    mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediaconvert"])})),
-		medialiveconn:                       medialive.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["medialive"])})),
-		mediapackageconn:                    mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediapackage"])})),
-		mediastoreconn:                      mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediastore"])})),
-		mediastoredataconn:                  mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediastoredata"])})),
-		mqconn:                              mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mq"])})),
-		mwaaconn:                            mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mwaa"])})),
-		neptuneconn:                         neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["neptune"])})),
-		networkfirewallconn:                 networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["networkfirewall"])})),
-		networkmanagerconn:                  networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["networkmanager"])})),
-		opsworksconn:                        opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["opsworks"])})),
-		organizationsconn:                   organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["organizations"])})),
-		outpostsconn:                        outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["outposts"])})),
-		partition:                           partition,
-		personalizeconn:                     personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["personalize"])})),
-		prometheusserviceconn:               prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["prometheusservice"])})),
-		pinpointconn:                        pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pinpoint"])})),
-		pricingconn:                         pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pricing"])})),
-		qldbconn:                            qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["qldb"])})),
-		quicksightconn:                      quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["quicksight"])})),
-		ramconn:                             ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ram"])})),
-		rdsconn:                             rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["rds"])})),
-		redshiftconn:                        redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["redshift"])})),
-		region:                              c.Region,
-		resourcegroupsconn:                  resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroups"])})),
-		resourcegroupstaggingapiconn:        resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroupstaggingapi"])})),
-		reverseDnsPrefix:                    ReverseDns(dnsSuffix),
-		route53domainsconn:                  route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53domains"])})),
-		route53resolverconn:                 route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53resolver"])})),
-		s3controlconn:                       s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3control"])})),
-		s3outpostsconn:                      s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3outposts"])})),
-		sagemakerconn:                       sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sagemaker"])})),
-		scconn:                              servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicecatalog"])})),
-		sdconn:                              servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicediscovery"])})),
-		secretsmanagerconn:                  secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["secretsmanager"])})),
-		securityhubconn:                     securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["securityhub"])})),
-		serverlessapplicationrepositoryconn: serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["serverlessrepo"])})),
-		servicequotasconn:                   servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicequotas"])})),
-		sesconn:                             ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ses"])})),
-		sfnconn:                             sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["stepfunctions"])})),
-		signerconn:                          signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["signer"])})),
-		simpledbconn:                        simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sdb"])})),
-		snsconn:                             sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sns"])})),
-		sqsconn:                             sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sqs"])})),
-		ssmconn:                             ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ssm"])})),
-		ssoadminconn:                        ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ssoadmin"])})),
-		storagegatewayconn:                  storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["storagegateway"])})),
-		stsconn:                             sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])})),
-		swfconn:                             swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["swf"])})),
-		syntheticsconn:                      synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["synthetics"])})),
-		terraformVersion:                    c.terraformVersion,
-		timestreamwriteconn:                 timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["timestreamwrite"])})),
-		transferconn:                        transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["transfer"])})),
-		wafconn:                             waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["waf"])})),
-		wafregionalconn:                     wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["wafregional"])})),
-		wafv2conn:                           wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["wafv2"])})),
-		worklinkconn:                        worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["worklink"])})),
-		workmailconn:                        workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["workmail"])})),
-		workspacesconn:                      workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["workspaces"])})),
-		xrayconn:                            xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["xray"])})),
+		accountid:         accountID,
+		DefaultTagsConfig: c.DefaultTagsConfig,
+		dnsSuffix:         dnsSuffix,
+		endpointURL:       c.EndpointURL,
+		endpoints:         c.Endpoints,
+		IgnoreTagsConfig:  c.IgnoreTagsConfig,
+		partition:         partition,
+		region:            c.Region,
+		reverseDnsPrefix:  ReverseDns(dnsSuffix),
+		retryConfig:       c.RetryConfig,
+		s3ForcePathStyle:  c.S3ForcePathStyle,
+		session:           sess,
+		terraformVersion:  c.terraformVersion,
+	}
+
+	if !c.SkipGetEC2Platforms {
+		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn())
+		if err != nil {
+			// We intentionally fail *silently* because there's a chance
+			// user just doesn't have ec2:DescribeAccountAttributes permissions
+			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
+		} else {
+			client.supportedplatforms = supportedPlatforms
+		}
+	}
+
+	return client, nil
+}
+
+// endpoint returns the custom endpoint configured for the given endpoints key,
+// falling back to EndpointURL.
+func (c *Config) endpoint(endpointKey string) string {
+	if v := c.Endpoints[endpointKey]; v != "" {
+		return v
 	}
 
-	// "Global" services that require customizations
-	globalAcceleratorConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints["globalaccelerator"]),
+	return c.EndpointURL
+}
+
+// conn returns the service client cached under key, calling newConn to create
+// it the first time it is requested. Service clients are created lazily so
+// that configurations only pay for the services they actually use.
+func (client *AWSClient) conn(key string, newConn func() interface{}) interface{} {
+	client.connsLock.Lock()
+	defer client.connsLock.Unlock()
+
+	if conn, ok := client.conns[key]; ok {
+		return conn
 	}
-	route53Config := &aws.Config{
-		Endpoint: aws.String(c.Endpoints["route53"]),
+
+	if client.conns == nil {
+		client.conns = make(map[string]interface{})
 	}
-	shieldConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints["shield"]),
+
+	conn := newConn()
+	client.conns[key] = conn
+
+	return conn
+}
+
+// endpoint returns the custom endpoint configured for the given endpoints key,
+// falling back to the provider base endpoint URL. An empty string means the
+// AWS SDK default endpoint.
+func (client *AWSClient) endpoint(endpointKey string) string {
+	if v := client.endpoints[endpointKey]; v != "" {
+		return v
 	}
 
-	// Services that require multiple client configurations
-	s3Config := &aws.Config{
-		Endpoint:         aws.String(c.Endpoints["s3"]),
-		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
+	return client.endpointURL
+}
+
+// endpointSession returns a copy of the provider session using the custom
+// endpoint, if any, configured for the given endpoints key.
+func (client *AWSClient) endpointSession(endpointKey string) *session.Session {
+	return client.serviceSession(endpointKey, &aws.Config{Endpoint: aws.String(client.endpoint(endpointKey))})
+}
+
+// serviceSession returns a copy of the provider session merged with config
+// for the service with the given endpoints key, applying any retry and
+// rate limit configuration for the service.
+func (client *AWSClient) serviceSession(endpointKey string, config *aws.Config) *session.Session {
+	if client.retryConfig == nil {
+		return client.session.Copy(config)
 	}
 
-	client.s3conn = s3.New(sess.Copy(s3Config))
-
-	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
-	client.s3connUriCleaningDisabled = s3.New(sess.Copy(s3Config))
-
-	// Force "global" services to correct regions
-	switch partition {
-	case endpoints.AwsPartitionID:
-		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
-		shieldConfig.Region = aws.String(endpoints.UsEast1RegionID)
-	case endpoints.AwsCnPartitionID:
-		// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
-		// This can likely be removed in the future.
-		if aws.StringValue(route53Config.Endpoint) == "" {
-			route53Config.Endpoint = aws.String("https://api.route53.cn")
-		}
-		route53Config.Region = aws.String(endpoints.CnNorthwest1RegionID)
-	case endpoints.AwsUsGovPartitionID:
-		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
+	return client.retryConfig.session(client.session, endpointKey, config)
+}
+
+// regionalClient returns an AWSClient that operates in the given region of the
+// provider partition. An empty region or the client region returns the client itself.
+func (client *AWSClient) regionalClient(region string) (*AWSClient, error) {
+	return client.resourceClient(client.resourceType, region)
+}
+
+// resourceClient returns an AWSClient that operates in the given region of the
+// provider partition on behalf of the given Terraform resource type. Requests
+// made by its service clients carry the resource type in their context, see
+// requestResourceType(). Clients are cached per region and resource type, so
+// service clients are still only created for the combinations actually used.
+// An empty region means the provider region.
+func (client *AWSClient) resourceClient(resourceType, region string) (*AWSClient, error) {
+	if region == "" {
+		region = client.region
 	}
 
-	client.globalacceleratorconn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.r53conn = route53.New(sess.Copy(route53Config))
-	client.shieldconn = shield.New(sess.Copy(shieldConfig))
+	if region == client.region && resourceType == client.resourceType {
+		return client, nil
+	}
 
-	client.apigatewayconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Many operations can return an error such as:
-		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
-		// Handle them all globally for the service client.
-		if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && client.partition != "" && p.ID() != client.partition {
+		return nil, fmt.Errorf("region (%s) is in partition (%s), expected partition (%s)", region, p.ID(), client.partition)
+	}
 
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.appautoscalingconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	client.resourceClientsLock.Lock()
+	defer client.resourceClientsLock.Unlock()
+
+	key := resourceType + "@" + region
+
+	if resourceClient, ok := client.resourceClients[key]; ok {
+		return resourceClient, nil
+	}
+
+	if client.resourceClients == nil {
+		client.resourceClients = make(map[string]*AWSClient)
+	}
+
+	sess := client.session.Copy(&aws.Config{Region: aws.String(region)})
+
+	if resourceType != "" {
+		sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+			Name: "terraform.ResourceTypeHandler",
+			Fn: func(r *request.Request) {
+				r.SetContext(context.WithValue(r.Context(), resourceTypeContextKey{}, resourceType))
+			},
+		})
+	}
+
+	resourceClient := &AWSClient{
+		accountid:          client.accountid,
+		DefaultTagsConfig:  client.DefaultTagsConfig,
+		dnsSuffix:          client.dnsSuffix,
+		endpointURL:        client.endpointURL,
+		endpoints:          client.endpoints,
+		IgnoreTagsConfig:   client.IgnoreTagsConfig,
+		partition:          client.partition,
+		region:             region,
+		resourceType:       resourceType,
+		retryConfig:        client.retryConfig,
+		reverseDnsPrefix:   client.reverseDnsPrefix,
+		s3ForcePathStyle:   client.s3ForcePathStyle,
+		session:            sess,
+		supportedplatforms: client.supportedplatforms,
+		terraformVersion:   client.terraformVersion,
+	}
+	client.resourceClients[key] = resourceClient
+
+	return resourceClient, nil
+}
+
+type resourceTypeContextKey struct{}
+
+// requestResourceType returns the Terraform resource type a request was made
+// on behalf of, or an empty string if it is not known.
+func requestResourceType(r *request.Request) string {
+	v, _ := r.Context().Value(resourceTypeContextKey{}).(string)
+
+	return v
+}
+
+func (client *AWSClient) accessanalyzerconn() *accessanalyzer.AccessAnalyzer {
+	return client.conn("accessanalyzerconn", func() interface{} {
+		return accessanalyzer.New(client.endpointSession("accessanalyzer"))
+	}).(*accessanalyzer.AccessAnalyzer)
+}
+
+func (client *AWSClient) acmconn() *acm.ACM {
+	return client.conn("acmconn", func() interface{} {
+		return acm.New(client.endpointSession("acm"))
+	}).(*acm.ACM)
+}
+
+func (client *AWSClient) acmpcaconn() *acmpca.ACMPCA {
+	return client.conn("acmpcaconn", func() interface{} {
+		return acmpca.New(client.endpointSession("acmpca"))
+	}).(*acmpca.ACMPCA)
+}
 
-	client.appsyncconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateGraphqlApi" {
-			if isAWSErr(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
+func (client *AWSClient) amplifyconn() *amplify.Amplify {
+	return client.conn("amplifyconn", func() interface{} {
+		return amplify.New(client.endpointSession("amplify"))
+	}).(*amplify.Amplify)
+}
+
+func (client *AWSClient) apigatewayconn() *apigateway.APIGateway {
+	return client.conn("apigatewayconn", func() interface{} {
+		conn := apigateway.New(client.endpointSession("apigateway"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Many operations can return an error such as:
+			//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+			// Handle them all globally for the service client.
+			if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
 				r.Retryable = aws.Bool(true)
 			}
-		}
-	})
-
-	client.configconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling Config Organization Rules API actions immediately
-		// after Organization creation, the API can randomly return the
-		// OrganizationAccessDeniedException error for a few minutes, even
-		// after succeeding a few requests.
-		switch r.Operation.Name {
-		case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
-			if !isAWSErr(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
+		})
+
+		return conn
+	}).(*apigateway.APIGateway)
+}
+
+func (client *AWSClient) apigatewayv2conn() *apigatewayv2.ApiGatewayV2 {
+	return client.conn("apigatewayv2conn", func() interface{} {
+		return apigatewayv2.New(client.endpointSession("apigateway"))
+	}).(*apigatewayv2.ApiGatewayV2)
+}
+
+func (client *AWSClient) appautoscalingconn() *applicationautoscaling.ApplicationAutoScaling {
+	return client.conn("appautoscalingconn", func() interface{} {
+		conn := applicationautoscaling.New(client.endpointSession("applicationautoscaling"))
+
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
 				return
 			}
-
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
+			if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
 				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
 			}
-		}
-	})
+		})
 
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.dynamodbconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
-		}
-		if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+		return conn
+	}).(*applicationautoscaling.ApplicationAutoScaling)
+}
 
-	client.ec2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateClientVpnEndpoint" {
-			if isAWSErr(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
-				r.Retryable = aws.Bool(true)
+func (client *AWSClient) applicationinsightsconn() *applicationinsights.ApplicationInsights {
+	return client.conn("applicationinsightsconn", func() interface{} {
+		return applicationinsights.New(client.endpointSession("applicationinsights"))
+	}).(*applicationinsights.ApplicationInsights)
+}
+
+func (client *AWSClient) appmeshconn() *appmesh.AppMesh {
+	return client.conn("appmeshconn", func() interface{} {
+		return appmesh.New(client.endpointSession("appmesh"))
+	}).(*appmesh.AppMesh)
+}
+
+func (client *AWSClient) appstreamconn() *appstream.AppStream {
+	return client.conn("appstreamconn", func() interface{} {
+		return appstream.New(client.endpointSession("appstream"))
+	}).(*appstream.AppStream)
+}
+
+func (client *AWSClient) appsyncconn() *appsync.AppSync {
+	return client.conn("appsyncconn", func() interface{} {
+		conn := appsync.New(client.endpointSession("appsync"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateGraphqlApi" {
+				if isAWSErr(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
+					r.Retryable = aws.Bool(true)
+				}
 			}
-		}
+		})
 
-		if r.Operation.Name == "CreateVpnConnection" {
-			if isAWSErr(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
+		return conn
+	}).(*appsync.AppSync)
+}
+
+func (client *AWSClient) athenaconn() *athena.Athena {
+	return client.conn("athenaconn", func() interface{} {
+		return athena.New(client.endpointSession("athena"))
+	}).(*athena.Athena)
+}
+
+func (client *AWSClient) autoscalingconn() *autoscaling.AutoScaling {
+	return client.conn("autoscalingconn", func() interface{} {
+		return autoscaling.New(client.endpointSession("autoscaling"))
+	}).(*autoscaling.AutoScaling)
+}
+
+func (client *AWSClient) autoscalingplansconn() *autoscalingplans.AutoScalingPlans {
+	return client.conn("autoscalingplansconn", func() interface{} {
+		return autoscalingplans.New(client.endpointSession("autoscalingplans"))
+	}).(*autoscalingplans.AutoScalingPlans)
+}
+
+func (client *AWSClient) backupconn() *backup.Backup {
+	return client.conn("backupconn", func() interface{} {
+		return backup.New(client.endpointSession("backup"))
+	}).(*backup.Backup)
+}
+
+func (client *AWSClient) batchconn() *batch.Batch {
+	return client.conn("batchconn", func() interface{} {
+		return batch.New(client.endpointSession("batch"))
+	}).(*batch.Batch)
+}
+
+func (client *AWSClient) budgetconn() *budgets.Budgets {
+	return client.conn("budgetconn", func() interface{} {
+		return budgets.New(client.endpointSession("budgets"))
+	}).(*budgets.Budgets)
+}
+
+func (client *AWSClient) cfconn() *cloudformation.CloudFormation {
+	return client.conn("cfconn", func() interface{} {
+		return cloudformation.New(client.endpointSession("cloudformation"))
+	}).(*cloudformation.CloudFormation)
+}
+
+func (client *AWSClient) cloud9conn() *cloud9.Cloud9 {
+	return client.conn("cloud9conn", func() interface{} {
+		return cloud9.New(client.endpointSession("cloud9"))
+	}).(*cloud9.Cloud9)
+}
+
+func (client *AWSClient) cloudfrontconn() *cloudfront.CloudFront {
+	return client.conn("cloudfrontconn", func() interface{} {
+		return cloudfront.New(client.endpointSession("cloudfront"))
+	}).(*cloudfront.CloudFront)
+}
+
+func (client *AWSClient) cloudhsmv2conn() *cloudhsmv2.CloudHSMV2 {
+	return client.conn("cloudhsmv2conn", func() interface{} {
+		return cloudhsmv2.New(client.endpointSession("cloudhsm"))
+	}).(*cloudhsmv2.CloudHSMV2)
+}
+
+func (client *AWSClient) cloudsearchconn() *cloudsearch.CloudSearch {
+	return client.conn("cloudsearchconn", func() interface{} {
+		return cloudsearch.New(client.endpointSession("cloudsearch"))
+	}).(*cloudsearch.CloudSearch)
+}
+
+func (client *AWSClient) cloudtrailconn() *cloudtrail.CloudTrail {
+	return client.conn("cloudtrailconn", func() interface{} {
+		return cloudtrail.New(client.endpointSession("cloudtrail"))
+	}).(*cloudtrail.CloudTrail)
+}
+
+func (client *AWSClient) cloudwatchconn() *cloudwatch.CloudWatch {
+	return client.conn("cloudwatchconn", func() interface{} {
+		return cloudwatch.New(client.endpointSession("cloudwatch"))
+	}).(*cloudwatch.CloudWatch)
+}
+
+func (client *AWSClient) cloudwatcheventsconn() *cloudwatchevents.CloudWatchEvents {
+	return client.conn("cloudwatcheventsconn", func() interface{} {
+		return cloudwatchevents.New(client.endpointSession("cloudwatchevents"))
+	}).(*cloudwatchevents.CloudWatchEvents)
+}
+
+func (client *AWSClient) cloudwatchlogsconn() *cloudwatchlogs.CloudWatchLogs {
+	return client.conn("cloudwatchlogsconn", func() interface{} {
+		return cloudwatchlogs.New(client.endpointSession("cloudwatchlogs"))
+	}).(*cloudwatchlogs.CloudWatchLogs)
+}
+
+func (client *AWSClient) codeartifactconn() *codeartifact.CodeArtifact {
+	return client.conn("codeartifactconn", func() interface{} {
+		return codeartifact.New(client.endpointSession("codeartifact"))
+	}).(*codeartifact.CodeArtifact)
+}
+
+func (client *AWSClient) codebuildconn() *codebuild.CodeBuild {
+	return client.conn("codebuildconn", func() interface{} {
+		return codebuild.New(client.endpointSession("codebuild"))
+	}).(*codebuild.CodeBuild)
+}
+
+func (client *AWSClient) codecommitconn() *codecommit.CodeCommit {
+	return client.conn("codecommitconn", func() interface{} {
+		return codecommit.New(client.endpointSession("codecommit"))
+	}).(*codecommit.CodeCommit)
+}
+
+func (client *AWSClient) codedeployconn() *codedeploy.CodeDeploy {
+	return client.conn("codedeployconn", func() interface{} {
+		return codedeploy.New(client.endpointSession("codedeploy"))
+	}).(*codedeploy.CodeDeploy)
+}
+
+func (client *AWSClient) codepipelineconn() *codepipeline.CodePipeline {
+	return client.conn("codepipelineconn", func() interface{} {
+		return codepipeline.New(client.endpointSession("codepipeline"))
+	}).(*codepipeline.CodePipeline)
+}
+
+func (client *AWSClient) codestarconnectionsconn() *codestarconnections.CodeStarConnections {
+	return client.conn("codestarconnectionsconn", func() interface{} {
+		return codestarconnections.New(client.endpointSession("codestarconnections"))
+	}).(*codestarconnections.CodeStarConnections)
+}
+
+func (client *AWSClient) codestarnotificationsconn() *codestarnotifications.CodeStarNotifications {
+	return client.conn("codestarnotificationsconn", func() interface{} {
+		return codestarnotifications.New(client.endpointSession("codestarnotifications"))
+	}).(*codestarnotifications.CodeStarNotifications)
+}
+
+func (client *AWSClient) cognitoconn() *cognitoidentity.CognitoIdentity {
+	return client.conn("cognitoconn", func() interface{} {
+		return cognitoidentity.New(client.endpointSession("cognitoidentity"))
+	}).(*cognitoidentity.CognitoIdentity)
+}
+
+func (client *AWSClient) cognitoidpconn() *cognitoidentityprovider.CognitoIdentityProvider {
+	return client.conn("cognitoidpconn", func() interface{} {
+		return cognitoidentityprovider.New(client.endpointSession("cognitoidp"))
+	}).(*cognitoidentityprovider.CognitoIdentityProvider)
+}
+
+func (client *AWSClient) configconn() *configservice.ConfigService {
+	return client.conn("configconn", func() interface{} {
+		conn := configservice.New(client.endpointSession("configservice"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// When calling Config Organization Rules API actions immediately
+			// after Organization creation, the API can randomly return the
+			// OrganizationAccessDeniedException error for a few minutes, even
+			// after succeeding a few requests.
+			switch r.Operation.Name {
+			case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
+				if !isAWSErr(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
+					return
+				}
+
+				// We only want to retry briefly as the default max retry count would
+				// excessively retry when the error could be legitimate.
+				// We currently depend on the DefaultRetryer exponential backoff here.
+				// ~10 retries gives a fair backoff of a few seconds.
+				if r.RetryCount < 9 {
+					r.Retryable = aws.Bool(true)
+				} else {
+					r.Retryable = aws.Bool(false)
+				}
 			}
-		}
+		})
+
+		return conn
+	}).(*configservice.ConfigService)
+}
 
-		if r.Operation.Name == "CreateVpnGateway" {
-			if isAWSErr(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
+func (client *AWSClient) connectconn() *connect.Connect {
+	return client.conn("connectconn", func() interface{} {
+		return connect.New(client.endpointSession("connect"))
+	}).(*connect.Connect)
+}
+
+func (client *AWSClient) costandusagereportconn() *costandusagereportservice.CostandUsageReportService {
+	return client.conn("costandusagereportconn", func() interface{} {
+		return costandusagereportservice.New(client.endpointSession("cur"))
+	}).(*costandusagereportservice.CostandUsageReportService)
+}
+
+func (client *AWSClient) dataexchangeconn() *dataexchange.DataExchange {
+	return client.conn("dataexchangeconn", func() interface{} {
+		return dataexchange.New(client.endpointSession("dataexchange"))
+	}).(*dataexchange.DataExchange)
+}
+
+func (client *AWSClient) datapipelineconn() *datapipeline.DataPipeline {
+	return client.conn("datapipelineconn", func() interface{} {
+		return datapipeline.New(client.endpointSession("datapipeline"))
+	}).(*datapipeline.DataPipeline)
+}
+
+func (client *AWSClient) datasyncconn() *datasync.DataSync {
+	return client.conn("datasyncconn", func() interface{} {
+		return datasync.New(client.endpointSession("datasync"))
+	}).(*datasync.DataSync)
+}
+
+func (client *AWSClient) daxconn() *dax.DAX {
+	return client.conn("daxconn", func() interface{} {
+		return dax.New(client.endpointSession("dax"))
+	}).(*dax.DAX)
+}
+
+func (client *AWSClient) devicefarmconn() *devicefarm.DeviceFarm {
+	return client.conn("devicefarmconn", func() interface{} {
+		return devicefarm.New(client.endpointSession("devicefarm"))
+	}).(*devicefarm.DeviceFarm)
+}
+
+func (client *AWSClient) dlmconn() *dlm.DLM {
+	return client.conn("dlmconn", func() interface{} {
+		return dlm.New(client.endpointSession("dlm"))
+	}).(*dlm.DLM)
+}
+
+func (client *AWSClient) dmsconn() *databasemigrationservice.DatabaseMigrationService {
+	return client.conn("dmsconn", func() interface{} {
+		return databasemigrationservice.New(client.endpointSession("dms"))
+	}).(*databasemigrationservice.DatabaseMigrationService)
+}
+
+func (client *AWSClient) docdbconn() *docdb.DocDB {
+	return client.conn("docdbconn", func() interface{} {
+		return docdb.New(client.endpointSession("docdb"))
+	}).(*docdb.DocDB)
+}
+
+func (client *AWSClient) dsconn() *directoryservice.DirectoryService {
+	return client.conn("dsconn", func() interface{} {
+		return directoryservice.New(client.endpointSession("ds"))
+	}).(*directoryservice.DirectoryService)
+}
+
+func (client *AWSClient) dxconn() *directconnect.DirectConnect {
+	return client.conn("dxconn", func() interface{} {
+		return directconnect.New(client.endpointSession("directconnect"))
+	}).(*directconnect.DirectConnect)
+}
+
+func (client *AWSClient) dynamodbconn() *dynamodb.DynamoDB {
+	return client.conn("dynamodbconn", func() interface{} {
+		conn := dynamodb.New(client.endpointSession("dynamodb"))
+
+		// See https://github.com/aws/aws-sdk-go/pull/1276
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
+				return
+			}
+			if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
 				r.Retryable = aws.Bool(true)
 			}
-		}
+		})
 
-		if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
-			if isAWSErr(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
-				r.Retryable = aws.Bool(true)
+		return conn
+	}).(*dynamodb.DynamoDB)
+}
+
+func (client *AWSClient) ec2conn() *ec2.EC2 {
+	return client.conn("ec2conn", func() interface{} {
+		conn := ec2.New(client.endpointSession("ec2"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateClientVpnEndpoint" {
+				if isAWSErr(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "CreateVpnConnection" {
+				if isAWSErr(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "CreateVpnGateway" {
+				if isAWSErr(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
+				if isAWSErr(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
+					r.Retryable = aws.Bool(true)
+				}
 			}
+		})
+
+		return conn
+	}).(*ec2.EC2)
+}
+
+func (client *AWSClient) ecrconn() *ecr.ECR {
+	return client.conn("ecrconn", func() interface{} {
+		return ecr.New(client.endpointSession("ecr"))
+	}).(*ecr.ECR)
+}
+
+func (client *AWSClient) ecrpublicconn() *ecrpublic.ECRPublic {
+	return client.conn("ecrpublicconn", func() interface{} {
+		return ecrpublic.New(client.endpointSession("ecrpublic"))
+	}).(*ecrpublic.ECRPublic)
+}
+
+func (client *AWSClient) ecsconn() *ecs.ECS {
+	return client.conn("ecsconn", func() interface{} {
+		return ecs.New(client.endpointSession("ecs"))
+	}).(*ecs.ECS)
+}
+
+func (client *AWSClient) efsconn() *efs.EFS {
+	return client.conn("efsconn", func() interface{} {
+		return efs.New(client.endpointSession("efs"))
+	}).(*efs.EFS)
+}
+
+func (client *AWSClient) eksconn() *eks.EKS {
+	return client.conn("eksconn", func() interface{} {
+		return eks.New(client.endpointSession("eks"))
+	}).(*eks.EKS)
+}
+
+func (client *AWSClient) elasticacheconn() *elasticache.ElastiCache {
+	return client.conn("elasticacheconn", func() interface{} {
+		return elasticache.New(client.endpointSession("elasticache"))
+	}).(*elasticache.ElastiCache)
+}
+
+func (client *AWSClient) elasticbeanstalkconn() *elasticbeanstalk.ElasticBeanstalk {
+	return client.conn("elasticbeanstalkconn", func() interface{} {
+		return elasticbeanstalk.New(client.endpointSession("elasticbeanstalk"))
+	}).(*elasticbeanstalk.ElasticBeanstalk)
+}
+
+func (client *AWSClient) elastictranscoderconn() *elastictranscoder.ElasticTranscoder {
+	return client.conn("elastictranscoderconn", func() interface{} {
+		return elastictranscoder.New(client.endpointSession("elastictranscoder"))
+	}).(*elastictranscoder.ElasticTranscoder)
+}
+
+func (client *AWSClient) elbconn() *elb.ELB {
+	return client.conn("elbconn", func() interface{} {
+		return elb.New(client.endpointSession("elb"))
+	}).(*elb.ELB)
+}
+
+func (client *AWSClient) elbv2conn() *elbv2.ELBV2 {
+	return client.conn("elbv2conn", func() interface{} {
+		return elbv2.New(client.endpointSession("elb"))
+	}).(*elbv2.ELBV2)
+}
+
+func (client *AWSClient) emrconn() *emr.EMR {
+	return client.conn("emrconn", func() interface{} {
+		return emr.New(client.endpointSession("emr"))
+	}).(*emr.EMR)
+}
+
+func (client *AWSClient) emrcontainersconn() *emrcontainers.EMRContainers {
+	return client.conn("emrcontainersconn", func() interface{} {
+		return emrcontainers.New(client.endpointSession("emrcontainers"))
+	}).(*emrcontainers.EMRContainers)
+}
+
+func (client *AWSClient) esconn() *elasticsearch.ElasticsearchService {
+	return client.conn("esconn", func() interface{} {
+		return elasticsearch.New(client.endpointSession("es"))
+	}).(*elasticsearch.ElasticsearchService)
+}
+
+func (client *AWSClient) firehoseconn() *firehose.Firehose {
+	return client.conn("firehoseconn", func() interface{} {
+		return firehose.New(client.endpointSession("firehose"))
+	}).(*firehose.Firehose)
+}
+
+func (client *AWSClient) fmsconn() *fms.FMS {
+	return client.conn("fmsconn", func() interface{} {
+		return fms.New(client.endpointSession("fms"))
+	}).(*fms.FMS)
+}
+
+func (client *AWSClient) forecastconn() *forecastservice.ForecastService {
+	return client.conn("forecastconn", func() interface{} {
+		return forecastservice.New(client.endpointSession("forecast"))
+	}).(*forecastservice.ForecastService)
+}
+
+func (client *AWSClient) fsxconn() *fsx.FSx {
+	return client.conn("fsxconn", func() interface{} {
+		return fsx.New(client.endpointSession("fsx"))
+	}).(*fsx.FSx)
+}
+
+func (client *AWSClient) gameliftconn() *gamelift.GameLift {
+	return client.conn("gameliftconn", func() interface{} {
+		return gamelift.New(client.endpointSession("gamelift"))
+	}).(*gamelift.GameLift)
+}
+
+func (client *AWSClient) glacierconn() *glacier.Glacier {
+	return client.conn("glacierconn", func() interface{} {
+		return glacier.New(client.endpointSession("glacier"))
+	}).(*glacier.Glacier)
+}
+
+func (client *AWSClient) globalacceleratorconn() *globalaccelerator.GlobalAccelerator {
+	return client.conn("globalacceleratorconn", func() interface{} {
+		config := &aws.Config{
+			Endpoint: aws.String(client.endpoint("globalaccelerator")),
 		}
-	})
 
-	client.kafkaconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
-			r.Retryable = aws.Bool(true)
+		// Force "global" service to correct region
+		if client.partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsWest2RegionID)
 		}
-	})
 
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
+		return globalaccelerator.New(client.serviceSession("globalaccelerator", config))
+	}).(*globalaccelerator.GlobalAccelerator)
+}
+
+func (client *AWSClient) glueconn() *glue.Glue {
+	return client.conn("glueconn", func() interface{} {
+		return glue.New(client.endpointSession("glue"))
+	}).(*glue.Glue)
+}
+
+func (client *AWSClient) guarddutyconn() *guardduty.GuardDuty {
+	return client.conn("guarddutyconn", func() interface{} {
+		return guardduty.New(client.endpointSession("guardduty"))
+	}).(*guardduty.GuardDuty)
+}
+
+func (client *AWSClient) greengrassconn() *greengrass.Greengrass {
+	return client.conn("greengrassconn", func() interface{} {
+		return greengrass.New(client.endpointSession("greengrass"))
+	}).(*greengrass.Greengrass)
+}
+
+func (client *AWSClient) iamconn() *iam.IAM {
+	return client.conn("iamconn", func() interface{} {
+		return iam.New(client.endpointSession("iam"))
+	}).(*iam.IAM)
+}
+
+func (client *AWSClient) identitystoreconn() *identitystore.IdentityStore {
+	return client.conn("identitystoreconn", func() interface{} {
+		return identitystore.New(client.endpointSession("identitystore"))
+	}).(*identitystore.IdentityStore)
+}
+
+func (client *AWSClient) imagebuilderconn() *imagebuilder.Imagebuilder {
+	return client.conn("imagebuilderconn", func() interface{} {
+		return imagebuilder.New(client.endpointSession("imagebuilder"))
+	}).(*imagebuilder.Imagebuilder)
+}
+
+func (client *AWSClient) inspectorconn() *inspector.Inspector {
+	return client.conn("inspectorconn", func() interface{} {
+		return inspector.New(client.endpointSession("inspector"))
+	}).(*inspector.Inspector)
+}
+
+func (client *AWSClient) iotconn() *iot.IoT {
+	return client.conn("iotconn", func() interface{} {
+		return iot.New(client.endpointSession("iot"))
+	}).(*iot.IoT)
+}
+
+func (client *AWSClient) iotanalyticsconn() *iotanalytics.IoTAnalytics {
+	return client.conn("iotanalyticsconn", func() interface{} {
+		return iotanalytics.New(client.endpointSession("iotanalytics"))
+	}).(*iotanalytics.IoTAnalytics)
+}
+
+func (client *AWSClient) ioteventsconn() *iotevents.IoTEvents {
+	return client.conn("ioteventsconn", func() interface{} {
+		return iotevents.New(client.endpointSession("iotevents"))
+	}).(*iotevents.IoTEvents)
+}
+
+func (client *AWSClient) kafkaconn() *kafka.Kafka {
+	return client.conn("kafkaconn", func() interface{} {
+		conn := kafka.New(client.endpointSession("kafka"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if isAWSErr(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
 				r.Retryable = aws.Bool(true)
 			}
-		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
+		})
+
+		return conn
+	}).(*kafka.Kafka)
+}
+
+func (client *AWSClient) kinesisanalyticsconn() *kinesisanalytics.KinesisAnalytics {
+	return client.conn("kinesisanalyticsconn", func() interface{} {
+		return kinesisanalytics.New(client.endpointSession("kinesisanalytics"))
+	}).(*kinesisanalytics.KinesisAnalytics)
+}
+
+func (client *AWSClient) kinesisanalyticsv2conn() *kinesisanalyticsv2.KinesisAnalyticsV2 {
+	return client.conn("kinesisanalyticsv2conn", func() interface{} {
+		return kinesisanalyticsv2.New(client.endpointSession("kinesisanalyticsv2"))
+	}).(*kinesisanalyticsv2.KinesisAnalyticsV2)
+}
+
+func (client *AWSClient) kinesisconn() *kinesis.Kinesis {
+	return client.conn("kinesisconn", func() interface{} {
+		conn := kinesis.New(client.endpointSession("kinesis"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateStream" {
+				if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+			if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
+				if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*kinesis.Kinesis)
+}
+
+func (client *AWSClient) kinesisvideoconn() *kinesisvideo.KinesisVideo {
+	return client.conn("kinesisvideoconn", func() interface{} {
+		return kinesisvideo.New(client.endpointSession("kinesisvideo"))
+	}).(*kinesisvideo.KinesisVideo)
+}
+
+func (client *AWSClient) kmsconn() *kms.KMS {
+	return client.conn("kmsconn", func() interface{} {
+		return kms.New(client.endpointSession("kms"))
+	}).(*kms.KMS)
+}
+
+func (client *AWSClient) lakeformationconn() *lakeformation.LakeFormation {
+	return client.conn("lakeformationconn", func() interface{} {
+		return lakeformation.New(client.endpointSession("lakeformation"))
+	}).(*lakeformation.LakeFormation)
+}
+
+func (client *AWSClient) lambdaconn() *lambda.Lambda {
+	return client.conn("lambdaconn", func() interface{} {
+		return lambda.New(client.endpointSession("lambda"))
+	}).(*lambda.Lambda)
+}
+
+func (client *AWSClient) lexmodelconn() *lexmodelbuildingservice.LexModelBuildingService {
+	return client.conn("lexmodelconn", func() interface{} {
+		return lexmodelbuildingservice.New(client.endpointSession("lexmodels"))
+	}).(*lexmodelbuildingservice.LexModelBuildingService)
+}
+
+func (client *AWSClient) licensemanagerconn() *licensemanager.LicenseManager {
+	return client.conn("licensemanagerconn", func() interface{} {
+		return licensemanager.New(client.endpointSession("licensemanager"))
+	}).(*licensemanager.LicenseManager)
+}
+
+func (client *AWSClient) lightsailconn() *lightsail.Lightsail {
+	return client.conn("lightsailconn", func() interface{} {
+		return lightsail.New(client.endpointSession("lightsail"))
+	}).(*lightsail.Lightsail)
+}
+
+func (client *AWSClient) macieconn() *macie.Macie {
+	return client.conn("macieconn", func() interface{} {
+		return macie.New(client.endpointSession("macie"))
+	}).(*macie.Macie)
+}
+
+func (client *AWSClient) macie2conn() *macie2.Macie2 {
+	return client.conn("macie2conn", func() interface{} {
+		return macie2.New(client.endpointSession("macie2"))
+	}).(*macie2.Macie2)
+}
+
+func (client *AWSClient) managedblockchainconn() *managedblockchain.ManagedBlockchain {
+	return client.conn("managedblockchainconn", func() interface{} {
+		return managedblockchain.New(client.endpointSession("managedblockchain"))
+	}).(*managedblockchain.ManagedBlockchain)
+}
+
+func (client *AWSClient) marketplacecatalogconn() *marketplacecatalog.MarketplaceCatalog {
+	return client.conn("marketplacecatalogconn", func() interface{} {
+		return marketplacecatalog.New(client.endpointSession("marketplacecatalog"))
+	}).(*marketplacecatalog.MarketplaceCatalog)
+}
+
+func (client *AWSClient) mediaconnectconn() *mediaconnect.MediaConnect {
+	return client.conn("mediaconnectconn", func() interface{} {
+		return mediaconnect.New(client.endpointSession("mediaconnect"))
+	}).(*mediaconnect.MediaConnect)
+}
+
+func (client *AWSClient) mediaconvertconn() *mediaconvert.MediaConvert {
+	return client.conn("mediaconvertconn", func() interface{} {
+		return mediaconvert.New(client.endpointSession("mediaconvert"))
+	}).(*mediaconvert.MediaConvert)
+}
+
+func (client *AWSClient) medialiveconn() *medialive.MediaLive {
+	return client.conn("medialiveconn", func() interface{} {
+		return medialive.New(client.endpointSession("medialive"))
+	}).(*medialive.MediaLive)
+}
+
+func (client *AWSClient) mediapackageconn() *mediapackage.MediaPackage {
+	return client.conn("mediapackageconn", func() interface{} {
+		return mediapackage.New(client.endpointSession("mediapackage"))
+	}).(*mediapackage.MediaPackage)
+}
+
+func (client *AWSClient) mediastoreconn() *mediastore.MediaStore {
+	return client.conn("mediastoreconn", func() interface{} {
+		return mediastore.New(client.endpointSession("mediastore"))
+	}).(*mediastore.MediaStore)
+}
+
+func (client *AWSClient) mediastoredataconn() *mediastoredata.MediaStoreData {
+	return client.conn("mediastoredataconn", func() interface{} {
+		return mediastoredata.New(client.endpointSession("mediastoredata"))
+	}).(*mediastoredata.MediaStoreData)
+}
+
+func (client *AWSClient) mqconn() *mq.MQ {
+	return client.conn("mqconn", func() interface{} {
+		return mq.New(client.endpointSession("mq"))
+	}).(*mq.MQ)
+}
+
+func (client *AWSClient) mwaaconn() *mwaa.MWAA {
+	return client.conn("mwaaconn", func() interface{} {
+		return mwaa.New(client.endpointSession("mwaa"))
+	}).(*mwaa.MWAA)
+}
+
+func (client *AWSClient) neptuneconn() *neptune.Neptune {
+	return client.conn("neptuneconn", func() interface{} {
+		return neptune.New(client.endpointSession("neptune"))
+	}).(*neptune.Neptune)
+}
+
+func (client *AWSClient) networkfirewallconn() *networkfirewall.NetworkFirewall {
+	return client.conn("networkfirewallconn", func() interface{} {
+		return networkfirewall.New(client.endpointSession("networkfirewall"))
+	}).(*networkfirewall.NetworkFirewall)
+}
+
+func (client *AWSClient) networkmanagerconn() *networkmanager.NetworkManager {
+	return client.conn("networkmanagerconn", func() interface{} {
+		return networkmanager.New(client.endpointSession("networkmanager"))
+	}).(*networkmanager.NetworkManager)
+}
+
+func (client *AWSClient) opsworksconn() *opsworks.OpsWorks {
+	return client.conn("opsworksconn", func() interface{} {
+		return opsworks.New(client.endpointSession("opsworks"))
+	}).(*opsworks.OpsWorks)
+}
+
+func (client *AWSClient) organizationsconn() *organizations.Organizations {
+	return client.conn("organizationsconn", func() interface{} {
+		conn := organizations.New(client.endpointSession("organizations"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Retry on the following error:
+			// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+			if isAWSErr(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
 				r.Retryable = aws.Bool(true)
 			}
-		}
-	})
+		})
 
-	client.organizationsconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Retry on the following error:
-		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
-		if isAWSErr(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
-			r.Retryable = aws.Bool(true)
+		return conn
+	}).(*organizations.Organizations)
+}
+
+func (client *AWSClient) outpostsconn() *outposts.Outposts {
+	return client.conn("outpostsconn", func() interface{} {
+		return outposts.New(client.endpointSession("outposts"))
+	}).(*outposts.Outposts)
+}
+
+func (client *AWSClient) personalizeconn() *personalize.Personalize {
+	return client.conn("personalizeconn", func() interface{} {
+		return personalize.New(client.endpointSession("personalize"))
+	}).(*personalize.Personalize)
+}
+
+func (client *AWSClient) prometheusserviceconn() *prometheusservice.PrometheusService {
+	return client.conn("prometheusserviceconn", func() interface{} {
+		return prometheusservice.New(client.endpointSession("prometheusservice"))
+	}).(*prometheusservice.PrometheusService)
+}
+
+func (client *AWSClient) pinpointconn() *pinpoint.Pinpoint {
+	return client.conn("pinpointconn", func() interface{} {
+		return pinpoint.New(client.endpointSession("pinpoint"))
+	}).(*pinpoint.Pinpoint)
+}
+
+func (client *AWSClient) pricingconn() *pricing.Pricing {
+	return client.conn("pricingconn", func() interface{} {
+		return pricing.New(client.endpointSession("pricing"))
+	}).(*pricing.Pricing)
+}
+
+func (client *AWSClient) qldbconn() *qldb.QLDB {
+	return client.conn("qldbconn", func() interface{} {
+		return qldb.New(client.endpointSession("qldb"))
+	}).(*qldb.QLDB)
+}
+
+func (client *AWSClient) quicksightconn() *quicksight.QuickSight {
+	return client.conn("quicksightconn", func() interface{} {
+		return quicksight.New(client.endpointSession("quicksight"))
+	}).(*quicksight.QuickSight)
+}
+
+func (client *AWSClient) r53conn() *route53.Route53 {
+	return client.conn("r53conn", func() interface{} {
+		config := &aws.Config{
+			Endpoint: aws.String(client.endpoint("route53")),
 		}
-	})
 
-	client.storagegatewayconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
-		if isAWSErr(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
-			r.Retryable = aws.Bool(true)
+		// Force "global" service to correct region
+		switch client.partition {
+		case endpoints.AwsPartitionID:
+			config.Region = aws.String(endpoints.UsEast1RegionID)
+		case endpoints.AwsCnPartitionID:
+			// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
+			// This can likely be removed in the future.
+			if aws.StringValue(config.Endpoint) == "" {
+				config.Endpoint = aws.String("https://api.route53.cn")
+			}
+			config.Region = aws.String(endpoints.CnNorthwest1RegionID)
+		case endpoints.AwsUsGovPartitionID:
+			config.Region = aws.String(endpoints.UsGovWest1RegionID)
 		}
-	})
 
-	client.wafv2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
-			r.Retryable = aws.Bool(true)
+		return route53.New(client.serviceSession("route53", config))
+	}).(*route53.Route53)
+}
+
+func (client *AWSClient) ramconn() *ram.RAM {
+	return client.conn("ramconn", func() interface{} {
+		return ram.New(client.endpointSession("ram"))
+	}).(*ram.RAM)
+}
+
+func (client *AWSClient) rdsconn() *rds.RDS {
+	return client.conn("rdsconn", func() interface{} {
+		return rds.New(client.endpointSession("rds"))
+	}).(*rds.RDS)
+}
+
+func (client *AWSClient) redshiftconn() *redshift.Redshift {
+	return client.conn("redshiftconn", func() interface{} {
+		return redshift.New(client.endpointSession("redshift"))
+	}).(*redshift.Redshift)
+}
+
+func (client *AWSClient) resourcegroupsconn() *resourcegroups.ResourceGroups {
+	return client.conn("resourcegroupsconn", func() interface{} {
+		return resourcegroups.New(client.endpointSession("resourcegroups"))
+	}).(*resourcegroups.ResourceGroups)
+}
+
+func (client *AWSClient) resourcegroupstaggingapiconn() *resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
+	return client.conn("resourcegroupstaggingapiconn", func() interface{} {
+		return resourcegroupstaggingapi.New(client.endpointSession("resourcegroupstaggingapi"))
+	}).(*resourcegroupstaggingapi.ResourceGroupsTaggingAPI)
+}
+
+func (client *AWSClient) route53domainsconn() *route53domains.Route53Domains {
+	return client.conn("route53domainsconn", func() interface{} {
+		return route53domains.New(client.endpointSession("route53domains"))
+	}).(*route53domains.Route53Domains)
+}
+
+func (client *AWSClient) route53resolverconn() *route53resolver.Route53Resolver {
+	return client.conn("route53resolverconn", func() interface{} {
+		return route53resolver.New(client.endpointSession("route53resolver"))
+	}).(*route53resolver.Route53Resolver)
+}
+
+func (client *AWSClient) s3conn() *s3.S3 {
+	return client.conn("s3conn", func() interface{} {
+		return s3.New(client.serviceSession("s3", &aws.Config{
+			Endpoint:         aws.String(client.endpoint("s3")),
+			S3ForcePathStyle: aws.Bool(client.s3ForcePathStyle),
+		}))
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) s3connUriCleaningDisabled() *s3.S3 {
+	return client.conn("s3connUriCleaningDisabled", func() interface{} {
+		return s3.New(client.serviceSession("s3", &aws.Config{
+			DisableRestProtocolURICleaning: aws.Bool(true),
+			Endpoint:                       aws.String(client.endpoint("s3")),
+			S3ForcePathStyle:               aws.Bool(client.s3ForcePathStyle),
+		}))
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) s3controlconn() *s3control.S3Control {
+	return client.conn("s3controlconn", func() interface{} {
+		return s3control.New(client.endpointSession("s3control"))
+	}).(*s3control.S3Control)
+}
+
+func (client *AWSClient) s3outpostsconn() *s3outposts.S3Outposts {
+	return client.conn("s3outpostsconn", func() interface{} {
+		return s3outposts.New(client.endpointSession("s3outposts"))
+	}).(*s3outposts.S3Outposts)
+}
+
+func (client *AWSClient) sagemakerconn() *sagemaker.SageMaker {
+	return client.conn("sagemakerconn", func() interface{} {
+		return sagemaker.New(client.endpointSession("sagemaker"))
+	}).(*sagemaker.SageMaker)
+}
+
+func (client *AWSClient) scconn() *servicecatalog.ServiceCatalog {
+	return client.conn("scconn", func() interface{} {
+		return servicecatalog.New(client.endpointSession("servicecatalog"))
+	}).(*servicecatalog.ServiceCatalog)
+}
+
+func (client *AWSClient) sdconn() *servicediscovery.ServiceDiscovery {
+	return client.conn("sdconn", func() interface{} {
+		return servicediscovery.New(client.endpointSession("servicediscovery"))
+	}).(*servicediscovery.ServiceDiscovery)
+}
+
+func (client *AWSClient) secretsmanagerconn() *secretsmanager.SecretsManager {
+	return client.conn("secretsmanagerconn", func() interface{} {
+		return secretsmanager.New(client.endpointSession("secretsmanager"))
+	}).(*secretsmanager.SecretsManager)
+}
+
+func (client *AWSClient) securityhubconn() *securityhub.SecurityHub {
+	return client.conn("securityhubconn", func() interface{} {
+		return securityhub.New(client.endpointSession("securityhub"))
+	}).(*securityhub.SecurityHub)
+}
+
+func (client *AWSClient) serverlessapplicationrepositoryconn() *serverlessapplicationrepository.ServerlessApplicationRepository {
+	return client.conn("serverlessapplicationrepositoryconn", func() interface{} {
+		return serverlessapplicationrepository.New(client.endpointSession("serverlessrepo"))
+	}).(*serverlessapplicationrepository.ServerlessApplicationRepository)
+}
+
+func (client *AWSClient) servicequotasconn() *servicequotas.ServiceQuotas {
+	return client.conn("servicequotasconn", func() interface{} {
+		return servicequotas.New(client.endpointSession("servicequotas"))
+	}).(*servicequotas.ServiceQuotas)
+}
+
+func (client *AWSClient) sesconn() *ses.SES {
+	return client.conn("sesconn", func() interface{} {
+		return ses.New(client.endpointSession("ses"))
+	}).(*ses.SES)
+}
+
+func (client *AWSClient) sfnconn() *sfn.SFN {
+	return client.conn("sfnconn", func() interface{} {
+		return sfn.New(client.endpointSession("stepfunctions"))
+	}).(*sfn.SFN)
+}
+
+func (client *AWSClient) shieldconn() *shield.Shield {
+	return client.conn("shieldconn", func() interface{} {
+		config := &aws.Config{
+			Endpoint: aws.String(client.endpoint("shield")),
 		}
 
-		if isAWSErr(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
-			r.Retryable = aws.Bool(true)
+		// Force "global" service to correct region
+		if client.partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsEast1RegionID)
 		}
 
-		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
-			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
-			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
-			if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
+		return shield.New(client.serviceSession("shield", config))
+	}).(*shield.Shield)
+}
+
+func (client *AWSClient) signerconn() *signer.Signer {
+	return client.conn("signerconn", func() interface{} {
+		return signer.New(client.endpointSession("signer"))
+	}).(*signer.Signer)
+}
+
+func (client *AWSClient) simpledbconn() *simpledb.SimpleDB {
+	return client.conn("simpledbconn", func() interface{} {
+		return simpledb.New(client.endpointSession("sdb"))
+	}).(*simpledb.SimpleDB)
+}
+
+func (client *AWSClient) snsconn() *sns.SNS {
+	return client.conn("snsconn", func() interface{} {
+		return sns.New(client.endpointSession("sns"))
+	}).(*sns.SNS)
+}
+
+func (client *AWSClient) sqsconn() *sqs.SQS {
+	return client.conn("sqsconn", func() interface{} {
+		return sqs.New(client.endpointSession("sqs"))
+	}).(*sqs.SQS)
+}
+
+func (client *AWSClient) ssmconn() *ssm.SSM {
+	return client.conn("ssmconn", func() interface{} {
+		return ssm.New(client.endpointSession("ssm"))
+	}).(*ssm.SSM)
+}
+
+func (client *AWSClient) ssoadminconn() *ssoadmin.SSOAdmin {
+	return client.conn("ssoadminconn", func() interface{} {
+		return ssoadmin.New(client.endpointSession("ssoadmin"))
+	}).(*ssoadmin.SSOAdmin)
+}
+
+func (client *AWSClient) storagegatewayconn() *storagegateway.StorageGateway {
+	return client.conn("storagegatewayconn", func() interface{} {
+		conn := storagegateway.New(client.endpointSession("storagegateway"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+			if isAWSErr(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
 				r.Retryable = aws.Bool(true)
 			}
-			if isAWSErr(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+		})
+
+		return conn
+	}).(*storagegateway.StorageGateway)
+}
+
+func (client *AWSClient) stsconn() *sts.STS {
+	return client.conn("stsconn", func() interface{} {
+		return sts.New(client.endpointSession("sts"))
+	}).(*sts.STS)
+}
+
+func (client *AWSClient) swfconn() *swf.SWF {
+	return client.conn("swfconn", func() interface{} {
+		return swf.New(client.endpointSession("swf"))
+	}).(*swf.SWF)
+}
+
+func (client *AWSClient) syntheticsconn() *synthetics.Synthetics {
+	return client.conn("syntheticsconn", func() interface{} {
+		return synthetics.New(client.endpointSession("synthetics"))
+	}).(*synthetics.Synthetics)
+}
+
+func (client *AWSClient) timestreamwriteconn() *timestreamwrite.TimestreamWrite {
+	return client.conn("timestreamwriteconn", func() interface{} {
+		return timestreamwrite.New(client.endpointSession("timestreamwrite"))
+	}).(*timestreamwrite.TimestreamWrite)
+}
+
+func (client *AWSClient) transferconn() *transfer.Transfer {
+	return client.conn("transferconn", func() interface{} {
+		return transfer.New(client.endpointSession("transfer"))
+	}).(*transfer.Transfer)
+}
+
+func (client *AWSClient) wafconn() *waf.WAF {
+	return client.conn("wafconn", func() interface{} {
+		return waf.New(client.endpointSession("waf"))
+	}).(*waf.WAF)
+}
+
+func (client *AWSClient) wafregionalconn() *wafregional.WAFRegional {
+	return client.conn("wafregionalconn", func() interface{} {
+		return wafregional.New(client.endpointSession("wafregional"))
+	}).(*wafregional.WAFRegional)
+}
+
+func (client *AWSClient) wafv2conn() *wafv2.WAFV2 {
+	return client.conn("wafv2conn", func() interface{} {
+		conn := wafv2.New(client.endpointSession("wafv2"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if isAWSErr(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
 				r.Retryable = aws.Bool(true)
 			}
-		}
-	})
 
-	if !c.SkipGetEC2Platforms {
-		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
-		if err != nil {
-			// We intentionally fail *silently* because there's a chance
-			// user just doesn't have ec2:DescribeAccountAttributes permissions
-			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
-		} else {
-			client.supportedplatforms = supportedPlatforms
-		}
-	}
+			if isAWSErr(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
+				r.Retryable = aws.Bool(true)
+			}
 
-	return client, nil
+			if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
+				r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
+				// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+				if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+				if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*wafv2.WAFV2)
+}
+
+func (client *AWSClient) worklinkconn() *worklink.WorkLink {
+	return client.conn("worklinkconn", func() interface{} {
+		return worklink.New(client.endpointSession("worklink"))
+	}).(*worklink.WorkLink)
+}
+
+func (client *AWSClient) workmailconn() *workmail.WorkMail {
+	return client.conn("workmailconn", func() interface{} {
+		return workmail.New(client.endpointSession("workmail"))
+	}).(*workmail.WorkMail)
+}
+
+func (client *AWSClient) workspacesconn() *workspaces.WorkSpaces {
+	return client.conn("workspacesconn", func() interface{} {
+		return workspaces.New(client.endpointSession("workspaces"))
+	}).(*workspaces.WorkSpaces)
+}
+
+func (client *AWSClient) xrayconn() *xray.XRay {
+	return client.conn("xrayconn", func() interface{} {
+		return xray.New(client.endpointSession("xray"))
+	}).(*xray.XRay)
 }
 
 func hasEc2Classic(platforms []string) bool {
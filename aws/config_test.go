@@ -2,8 +2,12 @@ package aws
 
 import (
 	"reflect"
+	"sync"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 )
@@ -82,6 +86,162 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestAWSClientConn(t *testing.T) {
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("EC2", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	client := &AWSClient{
+		partition: endpoints.AwsPartitionID,
+		session:   sess,
+	}
+
+	if len(client.conns) != 0 {
+		t.Fatalf("expected no service clients before first use, got %d", len(client.conns))
+	}
+
+	var wg sync.WaitGroup
+	conns := make([]*ec2.EC2, 10)
+	for i := range conns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			conns[i] = client.ec2conn()
+		}(i)
+	}
+	wg.Wait()
+
+	for i, conn := range conns {
+		if conn == nil {
+			t.Fatalf("expected service client %d, got nil", i)
+		}
+		if conn != conns[0] {
+			t.Errorf("expected service client %d to be cached, got new instance", i)
+		}
+	}
+
+	if got, expected := len(client.conns), 1; got != expected {
+		t.Errorf("got %d service clients, expected %d", got, expected)
+	}
+
+	if got, expected := aws.StringValue(client.r53conn().Config.Region), endpoints.UsEast1RegionID; got != expected {
+		t.Errorf("got Route 53 region %s, expected %s", got, expected)
+	}
+}
+
+func TestAWSClientRegionalClient(t *testing.T) {
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("EC2", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	client := &AWSClient{
+		dnsSuffix: "amazonaws.com",
+		partition: endpoints.AwsPartitionID,
+		region:    endpoints.UsEast1RegionID,
+		session:   sess,
+	}
+
+	if got, err := client.regionalClient(""); err != nil || got != client {
+		t.Errorf("expected provider client for empty region, got %v (%v)", got, err)
+	}
+
+	if got, err := client.regionalClient(endpoints.UsEast1RegionID); err != nil || got != client {
+		t.Errorf("expected provider client for provider region, got %v (%v)", got, err)
+	}
+
+	regionalClient, err := client.regionalClient(endpoints.EuWest1RegionID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := regionalClient.region, endpoints.EuWest1RegionID; got != expected {
+		t.Errorf("got region %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(regionalClient.ec2conn().Config.Region), endpoints.EuWest1RegionID; got != expected {
+		t.Errorf("got EC2 region %s, expected %s", got, expected)
+	}
+
+	if got, expected := regionalClient.RegionalHostname("test"), "test.eu-west-1.amazonaws.com"; got != expected {
+		t.Errorf("got hostname %s, expected %s", got, expected)
+	}
+
+	if got, _ := client.regionalClient(endpoints.EuWest1RegionID); got != regionalClient {
+		t.Errorf("expected regional client to be cached")
+	}
+
+	if _, err := client.regionalClient(endpoints.CnNorth1RegionID); err == nil {
+		t.Errorf("expected error for region in another partition")
+	}
+}
+
+func TestAWSClientEndpoint(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region: aws.String(endpoints.UsEast1RegionID),
+	}))
+
+	client := &AWSClient{
+		endpointURL: "http://localhost:4566",
+		endpoints: map[string]string{
+			"dynamodb": "http://localhost:8000",
+			"ec2":      "",
+		},
+		partition: endpoints.AwsPartitionID,
+		region:    endpoints.UsEast1RegionID,
+		session:   sess,
+	}
+
+	testCases := []struct {
+		Name     string
+		Endpoint string
+		Expected string
+	}{
+		{
+			Name:     "service endpoint",
+			Endpoint: client.dynamodbconn().Endpoint,
+			Expected: "http://localhost:8000",
+		},
+		{
+			Name:     "empty service endpoint",
+			Endpoint: client.ec2conn().Endpoint,
+			Expected: "http://localhost:4566",
+		},
+		{
+			Name:     "no service endpoint",
+			Endpoint: client.snsconn().Endpoint,
+			Expected: "http://localhost:4566",
+		},
+		{
+			Name:     "S3",
+			Endpoint: client.s3conn().Endpoint,
+			Expected: "http://localhost:4566",
+		},
+		{
+			Name:     "Route 53",
+			Endpoint: client.r53conn().Endpoint,
+			Expected: "http://localhost:4566",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if testCase.Endpoint != testCase.Expected {
+				t.Errorf("got endpoint %s, expected %s", testCase.Endpoint, testCase.Expected)
+			}
+		})
+	}
+
+	client.endpointURL = ""
+
+	if got, expected := client.endpoint("sns"), ""; got != expected {
+		t.Errorf("got endpoint %q, expected %q", got, expected)
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*awsbase.MockEndpoint{
 		{
@@ -54,7 +54,7 @@ func testAccPreCheckCur(t *testing.T) {
 		}
 	})
 
-	conn := testAccProviderCur.Meta().(*AWSClient).costandusagereportconn
+	conn := testAccProviderCur.Meta().(*AWSClient).costandusagereportconn()
 
 	input := &costandusagereportservice.DescribeReportDefinitionsInput{
 		MaxResults: aws.Int64(5),
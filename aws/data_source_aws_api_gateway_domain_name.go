@@ -82,7 +82,7 @@ func dataSourceAwsApiGatewayDomainName() *schema.Resource {
 }
 
 func dataSourceAwsApiGatewayDomainNameRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayconn
+	conn := meta.(*AWSClient).apigatewayconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	input := &apigateway.GetDomainNameInput{}
@@ -172,7 +172,7 @@ func TestAccDataSourceAwsAvailabilityZone_ZoneId(t *testing.T) {
 }
 
 func testAccPreCheckAWSLocalZoneAvailable(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeAvailabilityZonesInput{
 		Filters: buildEC2AttributeFilterList(map[string]string{
@@ -138,7 +138,7 @@ func dataSourceAwsCloudFrontCachePolicy() *schema.Resource {
 	}
 }
 func dataSourceAwsCloudFrontCachePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	if d.Id() == "" {
 		if err := dataSourceAwsCloudFrontCachePolicyFindByName(d, conn); err != nil {
@@ -47,7 +47,7 @@ func dataSourceAwsCodeArtifactAuthorizationToken() *schema.Resource {
 }
 
 func dataSourceAwsCodeArtifactAuthorizationTokenRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codeartifactconn
+	conn := meta.(*AWSClient).codeartifactconn()
 	domain := d.Get("domain").(string)
 	domainOwner := meta.(*AWSClient).accountid
 	params := &codeartifact.GetAuthorizationTokenInput{
@@ -43,7 +43,7 @@ func dataSourceAwsCodeArtifactRepositoryEndpoint() *schema.Resource {
 }
 
 func dataSourceAwsCodeArtifactRepositoryEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codeartifactconn
+	conn := meta.(*AWSClient).codeartifactconn()
 	domainOwner := meta.(*AWSClient).accountid
 	domain := d.Get("domain").(string)
 	repo := d.Get("repository").(string)
@@ -1,12 +1,14 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider/lister"
 )
 
 func dataSourceAwsCognitoUserPools() *schema.Resource {
@@ -32,7 +34,7 @@ func dataSourceAwsCognitoUserPools() *schema.Resource {
 }
 
 func dataSourceAwsCognitoUserPoolsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 	name := d.Get("name").(string)
 	var ids []string
 	var arns []string
@@ -69,28 +71,10 @@ func dataSourceAwsCognitoUserPoolsRead(d *schema.ResourceData, meta interface{})
 }
 
 func getAllCognitoUserPools(conn *cognitoidentityprovider.CognitoIdentityProvider) ([]*cognitoidentityprovider.UserPoolDescriptionType, error) {
-	var pools []*cognitoidentityprovider.UserPoolDescriptionType
-	var nextToken string
-
-	for {
-		input := &cognitoidentityprovider.ListUserPoolsInput{
-			// MaxResults Valid Range: Minimum value of 1. Maximum value of 60
-			MaxResults: aws.Int64(int64(60)),
-		}
-		if nextToken != "" {
-			input.NextToken = aws.String(nextToken)
-		}
-		out, err := conn.ListUserPools(input)
-		if err != nil {
-			return pools, err
-		}
-		pools = append(pools, out.UserPools...)
-
-		if out.NextToken == nil {
-			break
-		}
-		nextToken = aws.StringValue(out.NextToken)
+	input := &cognitoidentityprovider.ListUserPoolsInput{
+		// MaxResults Valid Range: Minimum value of 1. Maximum value of 60
+		MaxResults: aws.Int64(int64(60)),
 	}
 
-	return pools, nil
+	return lister.ListUserPoolsAll(context.Background(), conn, input)
 }
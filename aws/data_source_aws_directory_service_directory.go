@@ -128,7 +128,7 @@ func dataSourceAwsDirectoryServiceDirectory() *schema.Resource {
 }
 
 func dataSourceAwsDirectoryServiceDirectoryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dsconn
+	conn := meta.(*AWSClient).dsconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	directoryID := d.Get("directory_id").(string)
@@ -72,7 +72,7 @@ func dataSourceAwsDocdbEngineVersion() *schema.Resource {
 }
 
 func dataSourceAwsDocdbEngineVersionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 
 	input := &docdb.DescribeDBEngineVersionsInput{}
 
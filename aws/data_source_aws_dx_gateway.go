@@ -31,7 +31,7 @@ func dataSourceAwsDxGateway() *schema.Resource {
 }
 
 func dataSourceAwsDxGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 	name := d.Get("name").(string)
 
 	gateways := make([]*directconnect.Gateway, 0)
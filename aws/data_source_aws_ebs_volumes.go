@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/lister"
 )
 
 func dataSourceAwsEbsVolumes() *schema.Resource {
@@ -29,7 +31,7 @@ func dataSourceAwsEbsVolumes() *schema.Resource {
 }
 
 func dataSourceAwsEbsVolumesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeVolumesInput{}
 
@@ -50,18 +52,18 @@ func dataSourceAwsEbsVolumesRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] DescribeVolumes %s\n", req)
-	resp, err := conn.DescribeVolumes(req)
+	output, err := lister.DescribeVolumesAll(context.Background(), conn, req)
 	if err != nil {
 		return fmt.Errorf("error describing EC2 Volumes: %w", err)
 	}
 
-	if resp == nil || len(resp.Volumes) == 0 {
+	if len(output) == 0 {
 		return errors.New("no matching volumes found")
 	}
 
 	volumes := make([]string, 0)
 
-	for _, volume := range resp.Volumes {
+	for _, volume := range output {
 		volumes = append(volumes, *volume.VolumeId)
 	}
 
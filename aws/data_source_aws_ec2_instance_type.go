@@ -343,7 +343,7 @@ func dataSourceAwsEc2InstanceType() *schema.Resource {
 }
 
 func dataSourceAwsEc2InstanceTypeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	params := &ec2.DescribeInstanceTypesInput{}
 
@@ -67,7 +67,7 @@ func dataSourceAwsEc2ManagedPrefixList() *schema.Resource {
 }
 
 func dataSourceAwsEc2ManagedPrefixListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	input := ec2.DescribeManagedPrefixListsInput{}
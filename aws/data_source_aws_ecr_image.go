@@ -51,7 +51,7 @@ func dataSourceAwsEcrImage() *schema.Resource {
 }
 
 func dataSourceAwsEcrImageRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ecrconn()
 
 	params := &ecr.DescribeImagesInput{
 		RepositoryName: aws.String(d.Get("repository_name").(string)),
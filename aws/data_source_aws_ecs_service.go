@@ -47,7 +47,7 @@ func dataSourceAwsEcsService() *schema.Resource {
 }
 
 func dataSourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 
 	clusterArn := d.Get("cluster_arn").(string)
 	serviceName := d.Get("service_name").(string)
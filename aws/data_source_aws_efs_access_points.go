@@ -34,7 +34,7 @@ func dataSourceAwsEfsAccessPoints() *schema.Resource {
 }
 
 func dataSourceAwsEfsAccessPointsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	fileSystemId := d.Get("file_system_id").(string)
 	input := &efs.DescribeAccessPointsInput{
@@ -69,7 +69,7 @@ func dataSourceAwsEfsMountTarget() *schema.Resource {
 }
 
 func dataSourceAwsEfsMountTargetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	describeEfsOpts := &efs.DescribeMountTargetsInput{
 		MountTargetId: aws.String(d.Get("mount_target_id").(string)),
@@ -150,7 +150,7 @@ func dataSourceAwsElastiCacheCluster() *schema.Resource {
 }
 
 func dataSourceAwsElastiCacheClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	clusterID := d.Get("cluster_id").(string)
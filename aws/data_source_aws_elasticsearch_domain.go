@@ -276,7 +276,7 @@ func dataSourceAwsElasticSearchDomain() *schema.Resource {
 }
 
 func dataSourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}) error {
-	esconn := meta.(*AWSClient).esconn
+	esconn := meta.(*AWSClient).esconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	req := &elasticsearchservice.DescribeElasticsearchDomainInput{
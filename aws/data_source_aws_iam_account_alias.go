@@ -23,7 +23,7 @@ func dataSourceAwsIamAccountAlias() *schema.Resource {
 }
 
 func dataSourceAwsIamAccountAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	log.Printf("[DEBUG] Reading IAM Account Aliases.")
 
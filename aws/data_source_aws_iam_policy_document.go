@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
@@ -25,13 +27,35 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Read: dataSourceAwsIamPolicyDocumentRead,
+		ReadContext: dataSourceAwsIamPolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
+			"expanded_actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"json": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"minimize": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"override_json": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -101,6 +125,12 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 					},
 				},
 			},
+			"validation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      IAMPolicyValidationModeOff,
+				ValidateFunc: validation.StringInSlice(IAMPolicyValidationMode_Values(), false),
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -114,12 +144,12 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 	}
 }
 
-func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAwsIamPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	mergedDoc := &IAMPolicyDoc{}
 
 	if v, ok := d.GetOk("source_json"); ok {
 		if err := json.Unmarshal([]byte(v.(string)), mergedDoc); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -136,14 +166,14 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 		for sourceJSONIndex, sourceJSON := range v.([]interface{}) {
 			sourceDoc := &IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(sourceJSON.(string)), sourceDoc); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			// assure all statements in sourceDoc are unique before merging
 			for stmtIndex, stmt := range sourceDoc.Statements {
 				if stmt.Sid != "" {
 					if _, sidExists := sidMap[stmt.Sid]; sidExists {
-						return fmt.Errorf("duplicate Sid (%s) in source_policy_documents (item %d; statement %d). Remove the Sid or ensure Sids are unique.", stmt.Sid, sourceJSONIndex, stmtIndex)
+						return diag.Errorf("duplicate Sid (%s) in source_policy_documents (item %d; statement %d). Remove the Sid or ensure Sids are unique.", stmt.Sid, sourceJSONIndex, stmtIndex)
 					}
 					sidMap[stmt.Sid] = struct{}{}
 				}
@@ -176,7 +206,7 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 
 			if sid, ok := cfgStmt["sid"]; ok {
 				if _, ok := sidMap[sid.(string)]; ok {
-					return fmt.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", sid.(string))
+					return diag.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", sid.(string))
 				}
 				stmt.Sid = sid.(string)
 				if len(stmt.Sid) > 0 {
@@ -197,7 +227,7 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 					iamPolicyDecodeConfigStringList(resources), doc.Version,
 				)
 				if err != nil {
-					return fmt.Errorf("error reading resources: %w", err)
+					return diag.Errorf("error reading resources: %s", err)
 				}
 			}
 			if notResources := cfgStmt["not_resources"].(*schema.Set).List(); len(notResources) > 0 {
@@ -206,7 +236,7 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 					iamPolicyDecodeConfigStringList(notResources), doc.Version,
 				)
 				if err != nil {
-					return fmt.Errorf("error reading not_resources: %w", err)
+					return diag.Errorf("error reading not_resources: %s", err)
 				}
 			}
 
@@ -214,7 +244,7 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 				var err error
 				stmt.Principals, err = dataSourceAwsIamPolicyDocumentMakePrincipals(principals, doc.Version)
 				if err != nil {
-					return fmt.Errorf("error reading principals: %w", err)
+					return diag.Errorf("error reading principals: %s", err)
 				}
 			}
 
@@ -222,7 +252,7 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 				var err error
 				stmt.NotPrincipals, err = dataSourceAwsIamPolicyDocumentMakePrincipals(notPrincipals, doc.Version)
 				if err != nil {
-					return fmt.Errorf("error reading not_principals: %w", err)
+					return diag.Errorf("error reading not_principals: %s", err)
 				}
 			}
 
@@ -230,7 +260,7 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 				var err error
 				stmt.Conditions, err = dataSourceAwsIamPolicyDocumentMakeConditions(conditions, doc.Version)
 				if err != nil {
-					return fmt.Errorf("error reading condition: %w", err)
+					return diag.Errorf("error reading condition: %s", err)
 				}
 			}
 
@@ -249,7 +279,7 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 		for _, overrideJSON := range v.([]interface{}) {
 			overrideDoc := &IAMPolicyDoc{}
 			if err := json.Unmarshal([]byte(overrideJSON.(string)), overrideDoc); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			mergedDoc.Merge(overrideDoc)
@@ -261,23 +291,59 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 	if v, ok := d.GetOk("override_json"); ok {
 		overrideDoc := &IAMPolicyDoc{}
 		if err := json.Unmarshal([]byte(v.(string)), overrideDoc); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		mergedDoc.Merge(overrideDoc)
 	}
 
+	if d.Get("minimize").(bool) {
+		mergedDoc.Minimize()
+	}
+
+	var diags diag.Diagnostics
+	var expandedActions []interface{}
+
+	if mode := d.Get("validation").(string); mode != IAMPolicyValidationModeOff {
+		severity := diag.Warning
+		if mode == IAMPolicyValidationModeError {
+			severity = diag.Error
+		}
+
+		for _, finding := range validateIAMPolicyDoc(mergedDoc) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: severity,
+				Summary:  fmt.Sprintf("IAM policy document %s", finding),
+			})
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+
+		for _, stmt := range mergedDoc.Statements {
+			expandedActions = append(expandedActions, map[string]interface{}{
+				"actions": iamPolicyExpandActions(stmt),
+				"sid":     stmt.Sid,
+			})
+		}
+	}
+
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
-		return err
+		return append(diags, diag.FromErr(err)...)
 	}
 	jsonString := string(jsonDoc)
 
+	if err := d.Set("expanded_actions", expandedActions); err != nil {
+		return append(diags, diag.Errorf("error setting expanded_actions: %s", err)...)
+	}
+
 	d.Set("json", jsonString)
 	d.SetId(strconv.Itoa(hashcode.String(jsonString)))
 
-	return nil
+	return diags
 }
 
 func dataSourceAwsIamPolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+)
+
+func dataSourceAwsIamPolicyDocumentSplit() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIamPolicyDocumentSplitRead,
+
+		Schema: map[string]*schema.Schema{
+			"documents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      IAMPolicyMaxManagedPolicySize,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"minimize": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIAMPolicyJson,
+			},
+		},
+	}
+}
+
+func dataSourceAwsIamPolicyDocumentSplitRead(d *schema.ResourceData, meta interface{}) error {
+	doc, err := iamPolicyDecodeDocument(d.Get("policy").(string))
+
+	if err != nil {
+		return fmt.Errorf("error parsing policy: %w", err)
+	}
+
+	if d.Get("minimize").(bool) {
+		doc.Minimize()
+	}
+
+	docs, err := doc.Split(d.Get("max_size").(int))
+
+	if err != nil {
+		return fmt.Errorf("error splitting policy: %w", err)
+	}
+
+	var documents []string
+
+	for _, doc := range docs {
+		b, err := json.MarshalIndent(doc, "", "  ")
+
+		if err != nil {
+			return fmt.Errorf("error marshaling policy: %w", err)
+		}
+
+		documents = append(documents, string(b))
+	}
+
+	if err := d.Set("documents", documents); err != nil {
+		return fmt.Errorf("error setting documents: %w", err)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(documents, ""))))
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMPolicyDocumentSplit_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document_split.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentSplitConfig(200, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "documents.#", "3"),
+					resource.TestMatchResourceAttr(dataSourceName, "documents.0", regexp.MustCompile(`arn:aws:s3:::one/\*`)),
+					resource.TestMatchResourceAttr(dataSourceName, "documents.1", regexp.MustCompile(`arn:aws:s3:::two/\*`)),
+					resource.TestMatchResourceAttr(dataSourceName, "documents.2", regexp.MustCompile(`arn:aws:s3:::three/\*`)),
+				),
+			},
+			{
+				Config: testAccAWSIAMPolicyDocumentSplitConfig(200, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "documents.#", "1"),
+				),
+			},
+			{
+				Config:      testAccAWSIAMPolicyDocumentSplitConfig(100, true),
+				ExpectError: regexp.MustCompile(`cannot be split`),
+			},
+		},
+	})
+}
+
+func testAccAWSIAMPolicyDocumentSplitConfig(maxSize int, minimize bool) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document_split" "test" {
+  max_size = %[1]d
+  minimize = %[2]t
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      for bucket in ["one", "two", "three"] : {
+        Effect   = "Allow"
+        Action   = "s3:GetObject"
+        Resource = "arn:aws:s3:::${bucket}/*"
+      }
+    ]
+  })
+}
+`, maxSize, minimize)
+}
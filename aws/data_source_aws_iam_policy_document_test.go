@@ -281,6 +281,76 @@ func TestAccAWSDataSourceIAMPolicyDocument_version20081017(t *testing.T) {
 	})
 }
 
+func TestAccAWSDataSourceIAMPolicyDocument_validation(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMPolicyDocumentConfigValidation("error", "s3:GetObjects"),
+				ExpectError: regexp.MustCompile(`statement 0 \(Sid "Read"\): action \(s3:GetObjects\) matches no known action`),
+			},
+			{
+				Config: testAccAWSIAMPolicyDocumentConfigValidation("warn", "s3:GetObjects"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "json"),
+					resource.TestCheckResourceAttr(dataSourceName, "expanded_actions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "expanded_actions.0.sid", "Read"),
+					resource.TestCheckResourceAttr(dataSourceName, "expanded_actions.0.actions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "expanded_actions.0.actions.0", "s3:GetObjects"),
+				),
+			},
+			{
+				Config: testAccAWSIAMPolicyDocumentConfigValidation("error", "sts:AssumeRole*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "expanded_actions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "expanded_actions.0.actions.*", "sts:AssumeRole"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "expanded_actions.0.actions.*", "sts:AssumeRoleWithSAML"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "expanded_actions.0.actions.*", "sts:AssumeRoleWithWebIdentity"),
+				),
+			},
+			{
+				Config: testAccAWSIAMPolicyDocumentConfigValidation("off", "s3:GetObjects"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "expanded_actions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_minimize(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentConfigMinimize,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", "json",
+						testAccAWSIAMPolicyDocumentConfigMinimizeExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_validationFullAccess(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMPolicyDocumentConfigValidationFullAccess,
+				ExpectError: regexp.MustCompile(`statement allows all actions on all resources`),
+			},
+		},
+	})
+}
+
 var testAccAWSIAMPolicyDocumentConfig = `
 data "aws_partition" "current" {}
 
@@ -1241,3 +1311,71 @@ func testAccAWSIAMPolicyDocumentExpectedJSONStatementPrincipalIdentifiersMultipl
   ]
 }`, testAccGetPartition())
 }
+
+func testAccAWSIAMPolicyDocumentConfigValidation(mode, action string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  validation = %[1]q
+
+  statement {
+    sid       = "Read"
+    actions   = [%[2]q]
+    resources = ["*"]
+  }
+}
+`, mode, action)
+}
+
+var testAccAWSIAMPolicyDocumentConfigValidationFullAccess = `
+data "aws_iam_policy_document" "test" {
+  validation = "error"
+
+  statement {
+    actions   = ["*"]
+    resources = ["*"]
+  }
+}
+`
+
+var testAccAWSIAMPolicyDocumentConfigMinimize = `
+data "aws_iam_policy_document" "test" {
+  minimize = true
+
+  statement {
+    actions   = ["s3:Get*", "s3:GetObject"]
+    resources = ["arn:aws:s3:::example/*"]
+  }
+
+  statement {
+    actions   = ["s3:PutObject"]
+    resources = ["arn:aws:s3:::example/*"]
+  }
+
+  statement {
+    sid       = "List"
+    actions   = ["s3:ListBucket"]
+    resources = ["arn:aws:s3:::example"]
+  }
+}
+`
+
+var testAccAWSIAMPolicyDocumentConfigMinimizeExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": [
+        "s3:PutObject",
+        "s3:Get*"
+      ],
+      "Resource": "arn:aws:s3:::example/*"
+    },
+    {
+      "Sid": "List",
+      "Effect": "Allow",
+      "Action": "s3:ListBucket",
+      "Resource": "arn:aws:s3:::example"
+    }
+  ]
+}`
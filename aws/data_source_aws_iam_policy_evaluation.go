@@ -0,0 +1,243 @@
+package aws
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+)
+
+func dataSourceAwsIamPolicyEvaluation() *schema.Resource {
+	listOfPolicy := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateIAMPolicyJson,
+		},
+	}
+
+	return &schema.Resource{
+		Read: dataSourceAwsIamPolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"identity_policies":             listOfPolicy,
+			"permissions_boundary_policies": listOfPolicy,
+			"request": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"context": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"principal": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"identifier": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "*",
+						},
+					},
+				},
+			},
+			"resource_policies": listOfPolicy,
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"sid": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_policy_index": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"source_policy_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"statement_index": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIamPolicyEvaluationRead(d *schema.ResourceData, meta interface{}) error {
+	evaluator := &IAMPolicyEvaluator{}
+
+	for _, v := range []struct {
+		key      string
+		policies *[]*IAMPolicyDoc
+	}{
+		{"identity_policies", &evaluator.IdentityPolicies},
+		{"permissions_boundary_policies", &evaluator.PermissionsBoundaryPolicies},
+		{"resource_policies", &evaluator.ResourcePolicies},
+	} {
+		for i, policy := range d.Get(v.key).([]interface{}) {
+			doc, err := iamPolicyDecodeDocument(policy.(string))
+
+			if err != nil {
+				return fmt.Errorf("error parsing %s (%d): %w", v.key, i, err)
+			}
+
+			*v.policies = append(*v.policies, doc)
+		}
+	}
+
+	if len(evaluator.IdentityPolicies) == 0 && len(evaluator.ResourcePolicies) == 0 {
+		return fmt.Errorf("at least one of identity_policies or resource_policies must be specified")
+	}
+
+	var results []interface{}
+	var ids []string
+	allAllowed := true
+
+	for i, r := range d.Get("request").([]interface{}) {
+		req := expandIamPolicyEvaluationRequest(r.(map[string]interface{}))
+
+		result, err := evaluator.Evaluate(req)
+
+		if err != nil {
+			return fmt.Errorf("error evaluating request (%d): %w", i, err)
+		}
+
+		if result.Decision != iam.PolicyEvaluationDecisionTypeAllowed {
+			allAllowed = false
+		}
+
+		results = append(results, map[string]interface{}{
+			"action":             req.Action,
+			"allowed":            result.Decision == iam.PolicyEvaluationDecisionTypeAllowed,
+			"decision":           result.Decision,
+			"matched_statements": flattenIamPolicyEvaluationMatchedStatements(result.MatchedStatements),
+			"resource":           req.Resource,
+		})
+		ids = append(ids, fmt.Sprintf("%s,%s,%s", req.Action, req.Resource, result.Decision))
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return fmt.Errorf("error setting results: %w", err)
+	}
+
+	d.Set("all_allowed", allAllowed)
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, "|"))))
+
+	return nil
+}
+
+func expandIamPolicyEvaluationRequest(tfMap map[string]interface{}) *IAMPolicyEvaluationRequest {
+	req := &IAMPolicyEvaluationRequest{
+		Action:   tfMap["action"].(string),
+		Resource: tfMap["resource"].(string),
+		Context:  map[string][]string{},
+	}
+
+	if v, ok := tfMap["principal"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		principal := v[0].(map[string]interface{})
+
+		req.Principal = &IAMPolicyStatementPrincipal{
+			Type:        principal["type"].(string),
+			Identifiers: principal["identifier"].(string),
+		}
+	}
+
+	for _, v := range tfMap["context"].(*schema.Set).List() {
+		entry := v.(map[string]interface{})
+		key := entry["key"].(string)
+
+		req.Context[key] = append(req.Context[key], aws.StringValueSlice(expandStringList(entry["values"].([]interface{})))...)
+	}
+
+	return req
+}
+
+func flattenIamPolicyEvaluationMatchedStatements(apiObjects []IAMPolicyEvaluationMatchedStatement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"sid":                 apiObject.Sid,
+			"source_policy_index": apiObject.SourcePolicyIndex,
+			"source_policy_type":  apiObject.SourcePolicyType,
+			"statement_index":     apiObject.StatementIndex,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMPolicyEvaluation_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyEvaluationConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", iam.PolicyEvaluationDecisionTypeAllowed),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.sid", "AllowRead"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.source_policy_type", IAMPolicySourceTypeIdentity),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", iam.PolicyEvaluationDecisionTypeExplicitDeny),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statements.0.sid", "DenyInsecureTransport"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statements.0.source_policy_type", IAMPolicySourceTypeResource),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.decision", iam.PolicyEvaluationDecisionTypeImplicitDeny),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.matched_statements.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyEvaluation_noPolicies(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMPolicyEvaluationConfigNoPolicies,
+				ExpectError: regexp.MustCompile(`at least one of identity_policies or resource_policies must be specified`),
+			},
+		},
+	})
+}
+
+const testAccAWSIAMPolicyEvaluationConfig = `
+data "aws_iam_policy_document" "identity" {
+  statement {
+    sid       = "AllowRead"
+    actions   = ["s3:Get*", "s3:List*"]
+    resources = ["arn:aws:s3:::example/*"]
+  }
+}
+
+data "aws_iam_policy_document" "resource" {
+  statement {
+    sid       = "DenyInsecureTransport"
+    effect    = "Deny"
+    actions   = ["s3:*"]
+    resources = ["arn:aws:s3:::example/*"]
+
+    principals {
+      type        = "*"
+      identifiers = ["*"]
+    }
+
+    condition {
+      test     = "Bool"
+      variable = "aws:SecureTransport"
+      values   = ["false"]
+    }
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  identity_policies = [data.aws_iam_policy_document.identity.json]
+  resource_policies = [data.aws_iam_policy_document.resource.json]
+
+  request {
+    action   = "s3:GetObject"
+    resource = "arn:aws:s3:::example/key"
+
+    context {
+      key    = "aws:SecureTransport"
+      values = ["true"]
+    }
+  }
+
+  request {
+    action   = "s3:GetObject"
+    resource = "arn:aws:s3:::example/key"
+
+    context {
+      key    = "aws:SecureTransport"
+      values = ["false"]
+    }
+  }
+
+  request {
+    action   = "s3:PutObject"
+    resource = "arn:aws:s3:::example/key"
+  }
+}
+`
+
+const testAccAWSIAMPolicyEvaluationConfigNoPolicies = `
+data "aws_iam_policy_evaluation" "test" {
+  request {
+    action = "s3:GetObject"
+  }
+}
+`
@@ -105,7 +105,7 @@ func dataSourceAwsImageBuilderImagePipeline() *schema.Resource {
 }
 
 func dataSourceAwsImageBuilderImagePipelineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
 	input := &imagebuilder.GetImagePipelineInput{}
 
@@ -47,7 +47,7 @@ func dataSourceAwsLambdaAlias() *schema.Resource {
 }
 
 func dataSourceAwsLambdaAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	functionName := d.Get("function_name").(string)
 	name := d.Get("name").(string)
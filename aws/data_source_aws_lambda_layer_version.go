@@ -79,7 +79,7 @@ func dataSourceAwsLambdaLayerVersion() *schema.Resource {
 }
 
 func dataSourceAwsLambdaLayerVersionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 	layerName := d.Get("layer_name").(string)
 
 	var version int64
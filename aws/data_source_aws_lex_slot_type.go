@@ -79,7 +79,7 @@ func dataSourceAwsLexSlotType() *schema.Resource {
 func dataSourceAwsLexSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
 	slotTypeName := d.Get("name").(string)
 
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	resp, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
 		Name:    aws.String(slotTypeName),
@@ -193,7 +193,7 @@ func dataSourceAwsmQBrokerRead(d *schema.ResourceData, meta interface{}) error {
 	if brokerId, ok := d.GetOk("broker_id"); ok {
 		d.SetId(brokerId.(string))
 	} else {
-		conn := meta.(*AWSClient).mqconn
+		conn := meta.(*AWSClient).mqconn()
 		brokerName := d.Get("broker_name").(string)
 		var nextToken string
 		for {
@@ -219,5 +219,15 @@ func dataSourceAwsmQBrokerRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return resourceAwsMqBrokerRead(d, meta)
+	tags, err := mqBrokerRead(d, meta)
+
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
 }
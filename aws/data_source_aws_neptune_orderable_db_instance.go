@@ -131,7 +131,7 @@ func dataSourceAwsNeptuneOrderableDbInstance() *schema.Resource {
 }
 
 func dataSourceAwsNeptuneOrderableDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).neptuneconn
+	conn := meta.(*AWSClient).neptuneconn()
 
 	input := &neptune.DescribeOrderableDBInstanceOptionsInput{}
 
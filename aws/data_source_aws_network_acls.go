@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/lister"
 )
 
 func dataSourceAwsNetworkAcls() *schema.Resource {
@@ -35,7 +37,7 @@ func dataSourceAwsNetworkAcls() *schema.Resource {
 }
 
 func dataSourceAwsNetworkAclsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeNetworkAclsInput{}
 
@@ -68,18 +70,18 @@ func dataSourceAwsNetworkAclsRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	log.Printf("[DEBUG] DescribeNetworkAcls %s\n", req)
-	resp, err := conn.DescribeNetworkAcls(req)
+	output, err := lister.DescribeNetworkAclsAll(context.Background(), conn, req)
 	if err != nil {
 		return err
 	}
 
-	if resp == nil || len(resp.NetworkAcls) == 0 {
+	if len(output) == 0 {
 		return errors.New("no matching network ACLs found")
 	}
 
 	networkAcls := make([]string, 0)
 
-	for _, networkAcl := range resp.NetworkAcls {
+	for _, networkAcl := range output {
 		networkAcls = append(networkAcls, aws.StringValue(networkAcl.NetworkAclId))
 	}
 
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/lister"
 )
 
 func dataSourceAwsNetworkInterfaces() *schema.Resource {
@@ -31,7 +33,7 @@ func dataSourceAwsNetworkInterfaces() *schema.Resource {
 }
 
 func dataSourceAwsNetworkInterfacesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeNetworkInterfacesInput{}
 
@@ -55,18 +57,18 @@ func dataSourceAwsNetworkInterfacesRead(d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("[DEBUG] DescribeNetworkInterfaces %s\n", req)
-	resp, err := conn.DescribeNetworkInterfaces(req)
+	output, err := lister.DescribeNetworkInterfacesAll(context.Background(), conn, req)
 	if err != nil {
 		return err
 	}
 
-	if resp == nil || len(resp.NetworkInterfaces) == 0 {
+	if len(output) == 0 {
 		return errors.New("no matching network interfaces found")
 	}
 
 	networkInterfaces := make([]string, 0)
 
-	for _, networkInterface := range resp.NetworkInterfaces {
+	for _, networkInterface := range output {
 		networkInterfaces = append(networkInterfaces, aws.StringValue(networkInterface.NetworkInterfaceId))
 	}
 
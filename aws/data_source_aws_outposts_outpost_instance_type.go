@@ -35,7 +35,7 @@ func dataSourceAwsOutpostsOutpostInstanceType() *schema.Resource {
 }
 
 func dataSourceAwsOutpostsOutpostInstanceTypeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).outpostsconn
+	conn := meta.(*AWSClient).outpostsconn()
 
 	input := &outposts.GetOutpostInstanceTypesInput{
 		OutpostId: aws.String(d.Get("arn").(string)), // Accepts both ARN and ID; prefer ARN which is more common
@@ -23,7 +23,7 @@ func dataSourceAwsOutpostsSites() *schema.Resource {
 }
 
 func dataSourceAwsOutpostsSitesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).outpostsconn
+	conn := meta.(*AWSClient).outpostsconn()
 
 	input := &outposts.ListSitesInput{}
 
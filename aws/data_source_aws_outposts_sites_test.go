@@ -43,7 +43,7 @@ func testAccCheckOutpostsSitesAttributes(dataSourceName string) resource.TestChe
 }
 
 func testAccPreCheckAWSOutpostsSites(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).outpostsconn
+	conn := testAccProvider.Meta().(*AWSClient).outpostsconn()
 
 	input := &outposts.ListSitesInput{}
 
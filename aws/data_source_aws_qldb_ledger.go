@@ -38,7 +38,7 @@ func dataSourceAwsQLDBLedger() *schema.Resource {
 }
 
 func dataSourceAwsQLDBLedgerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).qldbconn
+	conn := meta.(*AWSClient).qldbconn()
 
 	target := d.Get("name")
 
@@ -71,7 +71,7 @@ func dataSourceAwsRoute53ResolverEndpoint() *schema.Resource {
 }
 
 func dataSourceAwsRoute53ResolverEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 	req := &route53resolver.ListResolverEndpointsInput{}
 
 	resolvers := make([]*route53resolver.ResolverEndpoint, 0)
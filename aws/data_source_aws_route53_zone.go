@@ -67,7 +67,7 @@ func dataSourceAwsRoute53Zone() *schema.Resource {
 }
 
 func dataSourceAwsRoute53ZoneRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	name, nameExists := d.GetOk("name")
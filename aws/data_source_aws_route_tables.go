@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/lister"
 )
 
 func dataSourceAwsRouteTables() *schema.Resource {
@@ -35,7 +37,7 @@ func dataSourceAwsRouteTables() *schema.Resource {
 }
 
 func dataSourceAwsRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeRouteTablesInput{}
 
@@ -56,18 +58,18 @@ func dataSourceAwsRouteTablesRead(d *schema.ResourceData, meta interface{}) erro
 	)...)
 
 	log.Printf("[DEBUG] DescribeRouteTables %s\n", req)
-	resp, err := conn.DescribeRouteTables(req)
+	output, err := lister.DescribeRouteTablesAll(context.Background(), conn, req)
 	if err != nil {
 		return err
 	}
 
-	if resp == nil || len(resp.RouteTables) == 0 {
+	if len(output) == 0 {
 		return fmt.Errorf("no matching route tables found for vpc with id %s", d.Get("vpc_id").(string))
 	}
 
 	routeTables := make([]string, 0)
 
-	for _, routeTable := range resp.RouteTables {
+	for _, routeTable := range output {
 		routeTables = append(routeTables, aws.StringValue(routeTable.RouteTableId))
 	}
 
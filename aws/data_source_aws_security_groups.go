@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/lister"
 )
 
 func dataSourceAwsSecurityGroups() *schema.Resource {
@@ -39,7 +41,7 @@ func dataSourceAwsSecurityGroups() *schema.Resource {
 }
 
 func dataSourceAwsSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	req := &ec2.DescribeSecurityGroupsInput{}
 
 	filters, filtersOk := d.GetOk("filter")
@@ -62,31 +64,25 @@ func dataSourceAwsSecurityGroupsRead(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[DEBUG] Reading Security Groups with request: %s", req)
 
 	var ids, vpcIds, arns []string
-	for {
-		resp, err := conn.DescribeSecurityGroups(req)
-		if err != nil {
-			return fmt.Errorf("error reading security groups: %w", err)
-		}
-
-		for _, sg := range resp.SecurityGroups {
-			ids = append(ids, aws.StringValue(sg.GroupId))
-			vpcIds = append(vpcIds, aws.StringValue(sg.VpcId))
-
-			arn := arn.ARN{
-				Partition: meta.(*AWSClient).partition,
-				Service:   ec2.ServiceName,
-				Region:    meta.(*AWSClient).region,
-				AccountID: aws.StringValue(sg.OwnerId),
-				Resource:  fmt.Sprintf("security-group/%s", aws.StringValue(sg.GroupId)),
-			}.String()
-
-			arns = append(arns, arn)
-		}
-
-		if resp.NextToken == nil {
-			break
-		}
-		req.NextToken = resp.NextToken
+	err := lister.DescribeSecurityGroupsItems(context.Background(), conn, req, func(sg *ec2.SecurityGroup) bool {
+		ids = append(ids, aws.StringValue(sg.GroupId))
+		vpcIds = append(vpcIds, aws.StringValue(sg.VpcId))
+
+		arn := arn.ARN{
+			Partition: meta.(*AWSClient).partition,
+			Service:   ec2.ServiceName,
+			Region:    meta.(*AWSClient).region,
+			AccountID: aws.StringValue(sg.OwnerId),
+			Resource:  fmt.Sprintf("security-group/%s", aws.StringValue(sg.GroupId)),
+		}.String()
+
+		arns = append(arns, arn)
+
+		return true
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading security groups: %w", err)
 	}
 
 	if len(ids) < 1 {
@@ -97,7 +93,7 @@ func dataSourceAwsSecurityGroupsRead(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(meta.(*AWSClient).region)
 
-	err := d.Set("ids", ids)
+	err = d.Set("ids", ids)
 	if err != nil {
 		return err
 	}
@@ -43,7 +43,7 @@ func dataSourceAwsSfnActivity() *schema.Resource {
 
 func dataSourceAwsSfnActivityRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient)
-	conn := client.sfnconn
+	conn := client.sfnconn()
 	log.Print("[DEBUG] Reading Step Function Activity")
 
 	if nm, ok := d.GetOk("name"); ok {
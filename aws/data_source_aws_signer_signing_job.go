@@ -143,7 +143,7 @@ func dataSourceAwsSignerSigningJob() *schema.Resource {
 }
 
 func dataSourceAwsSignerSigningJobRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 	jobId := d.Get("job_id").(string)
 
 	describeSigningJobOutput, err := conn.DescribeSigningJob(&signer.DescribeSigningJobInput{
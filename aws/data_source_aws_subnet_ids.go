@@ -1,12 +1,14 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/lister"
 )
 
 func dataSourceAwsSubnetIDs() *schema.Resource {
@@ -33,7 +35,7 @@ func dataSourceAwsSubnetIDs() *schema.Resource {
 }
 
 func dataSourceAwsSubnetIDsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeSubnetsInput{}
 
@@ -62,18 +64,18 @@ func dataSourceAwsSubnetIDsRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] DescribeSubnets %s\n", req)
-	resp, err := conn.DescribeSubnets(req)
+	output, err := lister.DescribeSubnetsAll(context.Background(), conn, req)
 	if err != nil {
 		return err
 	}
 
-	if resp == nil || len(resp.Subnets) == 0 {
+	if len(output) == 0 {
 		return fmt.Errorf("no matching subnet found for vpc with id %s", d.Get("vpc_id").(string))
 	}
 
 	subnets := make([]string, 0)
 
-	for _, subnet := range resp.Subnets {
+	for _, subnet := range output {
 		subnets = append(subnets, *subnet.SubnetId)
 	}
 
@@ -1,12 +1,14 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/lister"
 )
 
 func dataSourceAwsVpcPeeringConnections() *schema.Resource {
@@ -27,7 +29,7 @@ func dataSourceAwsVpcPeeringConnections() *schema.Resource {
 }
 
 func dataSourceAwsVpcPeeringConnectionsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeVpcPeeringConnectionsInput{}
 
@@ -42,16 +44,16 @@ func dataSourceAwsVpcPeeringConnectionsRead(d *schema.ResourceData, meta interfa
 		req.Filters = nil
 	}
 
-	resp, err := conn.DescribeVpcPeeringConnections(req)
+	output, err := lister.DescribeVpcPeeringConnectionsAll(context.Background(), conn, req)
 	if err != nil {
 		return err
 	}
-	if resp == nil || len(resp.VpcPeeringConnections) == 0 {
+	if len(output) == 0 {
 		return fmt.Errorf("no matching VPC peering connections found")
 	}
 
 	var ids []string
-	for _, pcx := range resp.VpcPeeringConnections {
+	for _, pcx := range output {
 		ids = append(ids, aws.StringValue(pcx.VpcPeeringConnectionId))
 	}
 
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/lister"
 )
 
 func dataSourceAwsVpcs() *schema.Resource {
@@ -29,7 +31,7 @@ func dataSourceAwsVpcs() *schema.Resource {
 }
 
 func dataSourceAwsVpcsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeVpcsInput{}
 
@@ -50,18 +52,18 @@ func dataSourceAwsVpcsRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] DescribeVpcs %s\n", req)
-	resp, err := conn.DescribeVpcs(req)
+	output, err := lister.DescribeVpcsAll(context.Background(), conn, req)
 	if err != nil {
 		return err
 	}
 
-	if resp == nil || len(resp.Vpcs) == 0 {
+	if len(output) == 0 {
 		return fmt.Errorf("no matching VPC found")
 	}
 
 	vpcs := make([]string, 0)
 
-	for _, vpc := range resp.Vpcs {
+	for _, vpc := range output {
 		vpcs = append(vpcs, aws.StringValue(vpc.VpcId))
 	}
 
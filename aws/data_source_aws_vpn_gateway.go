@@ -53,7 +53,7 @@ func dataSourceAwsVpnGateway() *schema.Resource {
 }
 
 func dataSourceAwsVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	req := &ec2.DescribeVpnGatewaysInput{}
@@ -22,7 +22,7 @@ func dataSourceAwsWafIpSet() *schema.Resource {
 }
 
 func dataSourceAWSWafIpSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 	name := d.Get("name").(string)
 
 	ipsets := make([]*waf.IPSetSummary, 0)
@@ -22,7 +22,7 @@ func dataSourceAwsWafRegionalRule() *schema.Resource {
 }
 
 func dataSourceAwsWafRegionalRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	name := d.Get("name").(string)
 
 	rules := make([]*waf.RuleSummary, 0)
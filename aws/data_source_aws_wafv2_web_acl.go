@@ -39,7 +39,7 @@ func dataSourceAwsWafv2WebACL() *schema.Resource {
 }
 
 func dataSourceAwsWafv2WebACLRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafv2conn
+	conn := meta.(*AWSClient).wafv2conn()
 	name := d.Get("name").(string)
 
 	var foundWebACL *wafv2.WebACLSummary
@@ -0,0 +1,688 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+const (
+	IAMPolicySourceTypeIdentity            = "identity"
+	IAMPolicySourceTypeResource            = "resource"
+	IAMPolicySourceTypePermissionsBoundary = "permissions_boundary"
+)
+
+// IAMPolicyEvaluationRequest is a request evaluated offline against policies
+// with IAMPolicyEvaluator.
+type IAMPolicyEvaluationRequest struct {
+	Action   string
+	Resource string
+
+	// Principal is only matched against resource policy statements.
+	// It may be nil, in which case only statements that apply to any principal
+	// match.
+	Principal *IAMPolicyStatementPrincipal
+
+	// Context holds the condition context keys of the request, e.g.
+	// "aws:SourceIp", and their values.
+	Context map[string][]string
+}
+
+// IAMPolicyEvaluationMatchedStatement identifies a policy statement that
+// matched a request.
+type IAMPolicyEvaluationMatchedStatement struct {
+	SourcePolicyType  string
+	SourcePolicyIndex int
+	StatementIndex    int
+	Sid               string
+}
+
+// IAMPolicyEvaluationResult is the result of evaluating a request.
+type IAMPolicyEvaluationResult struct {
+	// Decision is one of the iam.PolicyEvaluationDecisionType values.
+	Decision string
+
+	// MatchedStatements are the statements that determined the decision: the
+	// matching Deny statements for an explicit deny, or the matching Allow
+	// statements for an allow.
+	MatchedStatements []IAMPolicyEvaluationMatchedStatement
+}
+
+// IAMPolicyEvaluator evaluates requests against identity, resource and
+// permissions boundary policies without calling AWS.
+//
+// The evaluation follows the IAM policy evaluation logic for requests within
+// a single account: an explicit Deny in any policy overrides any Allow, the
+// request must be allowed by an identity or resource policy, and, if any
+// permissions boundary policies are given, also by a permissions boundary.
+// Service control policies, session policies and cross-account access are not
+// modeled.
+type IAMPolicyEvaluator struct {
+	IdentityPolicies            []*IAMPolicyDoc
+	ResourcePolicies            []*IAMPolicyDoc
+	PermissionsBoundaryPolicies []*IAMPolicyDoc
+}
+
+// Evaluate returns the result of evaluating the request.
+func (e *IAMPolicyEvaluator) Evaluate(req *IAMPolicyEvaluationRequest) (*IAMPolicyEvaluationResult, error) {
+	var denied, allowed, boundaryAllowed []IAMPolicyEvaluationMatchedStatement
+
+	sources := []struct {
+		policyType string
+		policies   []*IAMPolicyDoc
+	}{
+		{IAMPolicySourceTypeIdentity, e.IdentityPolicies},
+		{IAMPolicySourceTypeResource, e.ResourcePolicies},
+		{IAMPolicySourceTypePermissionsBoundary, e.PermissionsBoundaryPolicies},
+	}
+
+	for _, source := range sources {
+		for i, policy := range source.policies {
+			for j, stmt := range policy.Statements {
+				matched, err := iamPolicyStatementMatches(stmt, req, source.policyType == IAMPolicySourceTypeResource)
+
+				if err != nil {
+					return nil, fmt.Errorf("error evaluating %s policy (%d) statement (%d): %w", source.policyType, i, j, err)
+				}
+
+				if !matched {
+					continue
+				}
+
+				match := IAMPolicyEvaluationMatchedStatement{
+					SourcePolicyType:  source.policyType,
+					SourcePolicyIndex: i,
+					StatementIndex:    j,
+					Sid:               stmt.Sid,
+				}
+
+				switch {
+				case stmt.Effect == "Deny":
+					denied = append(denied, match)
+				case source.policyType == IAMPolicySourceTypePermissionsBoundary:
+					boundaryAllowed = append(boundaryAllowed, match)
+				default:
+					allowed = append(allowed, match)
+				}
+			}
+		}
+	}
+
+	if len(denied) > 0 {
+		return &IAMPolicyEvaluationResult{
+			Decision:          iam.PolicyEvaluationDecisionTypeExplicitDeny,
+			MatchedStatements: denied,
+		}, nil
+	}
+
+	if len(allowed) == 0 || (len(e.PermissionsBoundaryPolicies) > 0 && len(boundaryAllowed) == 0) {
+		return &IAMPolicyEvaluationResult{
+			Decision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		}, nil
+	}
+
+	return &IAMPolicyEvaluationResult{
+		Decision:          iam.PolicyEvaluationDecisionTypeAllowed,
+		MatchedStatements: append(allowed, boundaryAllowed...),
+	}, nil
+}
+
+// iamPolicyDecodeDocument decodes a JSON policy document, which may have a
+// single statement object rather than a list of statements.
+func iamPolicyDecodeDocument(policy string) (*IAMPolicyDoc, error) {
+	var raw struct {
+		Version   string
+		Id        string
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, err
+	}
+
+	doc := &IAMPolicyDoc{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	if len(raw.Statement) == 0 {
+		return doc, nil
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(string(raw.Statement)), "{") {
+		stmt := &IAMPolicyStatement{}
+
+		if err := json.Unmarshal(raw.Statement, stmt); err != nil {
+			return nil, err
+		}
+
+		doc.Statements = []*IAMPolicyStatement{stmt}
+
+		return doc, nil
+	}
+
+	if err := json.Unmarshal(raw.Statement, &doc.Statements); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// iamPolicyStringSlice returns a statement element, which may be a single
+// string or a list of strings, as a slice.
+func iamPolicyStringSlice(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+
+	return nil
+}
+
+func iamPolicyStatementMatches(stmt *IAMPolicyStatement, req *IAMPolicyEvaluationRequest, resourcePolicy bool) (bool, error) {
+	if stmt == nil {
+		return false, nil
+	}
+
+	switch {
+	case stmt.Actions != nil:
+		if !iamPolicyActionMatchesAny(iamPolicyStringSlice(stmt.Actions), req.Action) {
+			return false, nil
+		}
+	case stmt.NotActions != nil:
+		if iamPolicyActionMatchesAny(iamPolicyStringSlice(stmt.NotActions), req.Action) {
+			return false, nil
+		}
+	default:
+		return false, nil
+	}
+
+	switch {
+	case stmt.Resources != nil:
+		if !iamPolicyResourceMatchesAny(iamPolicySubstituteVariables(iamPolicyStringSlice(stmt.Resources), req.Context), req.Resource) {
+			return false, nil
+		}
+	case stmt.NotResources != nil:
+		if iamPolicyResourceMatchesAny(iamPolicySubstituteVariables(iamPolicyStringSlice(stmt.NotResources), req.Context), req.Resource) {
+			return false, nil
+		}
+	}
+
+	if resourcePolicy {
+		switch {
+		case len(stmt.Principals) > 0:
+			if !iamPolicyPrincipalMatchesAny(stmt.Principals, req.Principal) {
+				return false, nil
+			}
+		case len(stmt.NotPrincipals) > 0:
+			if req.Principal != nil && iamPolicyPrincipalMatchesAny(stmt.NotPrincipals, req.Principal) {
+				return false, nil
+			}
+		}
+	}
+
+	for _, condition := range stmt.Conditions {
+		matched, err := iamPolicyConditionMatches(condition, req.Context)
+
+		if err != nil {
+			return false, err
+		}
+
+		if !matched {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// iamPolicyWildcardMatch returns true if the value matches the pattern, in
+// which "*" matches any sequence of characters and "?" any single character.
+func iamPolicyWildcardMatch(pattern, value string) bool {
+	// Iterative glob matching, backtracking to the last "*".
+	p, v := 0, 0
+	star, mark := -1, 0
+
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			star, mark = p, v
+			p++
+		case star != -1:
+			p = star + 1
+			mark++
+			v = mark
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+// iamPolicyActionMatchesAny returns true if the action matches any of the
+// patterns. Actions are matched case-insensitively.
+func iamPolicyActionMatchesAny(patterns []string, action string) bool {
+	for _, pattern := range patterns {
+		if iamPolicyWildcardMatch(strings.ToLower(pattern), strings.ToLower(action)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// iamPolicyResourceMatchesAny returns true if the resource matches any of the
+// patterns. ARNs are matched segment by segment, so that wildcards do not span
+// the colon separated ARN segments other than the resource.
+func iamPolicyResourceMatchesAny(patterns []string, resource string) bool {
+	for _, pattern := range patterns {
+		if iamPolicyArnMatches(pattern, resource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func iamPolicyArnMatches(pattern, value string) bool {
+	if pattern == "*" {
+		return true
+	}
+
+	patternSegments := strings.SplitN(pattern, ":", 6)
+	valueSegments := strings.SplitN(value, ":", 6)
+
+	switch {
+	case len(patternSegments) != 6 && len(valueSegments) != 6:
+		// Neither is an ARN.
+		return iamPolicyWildcardMatch(pattern, value)
+	case len(patternSegments) != 6 || len(valueSegments) != 6:
+		return false
+	}
+
+	for i := range patternSegments {
+		if !iamPolicyWildcardMatch(patternSegments[i], valueSegments[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func iamPolicyPrincipalMatchesAny(principals IAMPolicyStatementPrincipalSet, principal *IAMPolicyStatementPrincipal) bool {
+	for _, p := range principals {
+		for _, identifier := range iamPolicyStringSlice(p.Identifiers) {
+			if p.Type == "*" || (p.Type == "AWS" && identifier == "*") {
+				return true
+			}
+
+			if principal == nil || p.Type != principal.Type {
+				continue
+			}
+
+			for _, requestIdentifier := range iamPolicyStringSlice(principal.Identifiers) {
+				if iamPolicyPrincipalIdentifierMatches(p.Type, identifier, requestIdentifier) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func iamPolicyPrincipalIdentifierMatches(principalType, identifier, requestIdentifier string) bool {
+	if identifier == "*" || identifier == requestIdentifier {
+		return true
+	}
+
+	if principalType != "AWS" {
+		return false
+	}
+
+	// An account ID or account root ARN matches any principal in the account.
+	accountID := identifier
+
+	if v, err := arn.Parse(identifier); err == nil {
+		if v.Resource != "root" {
+			return false
+		}
+
+		accountID = v.AccountID
+	}
+
+	if requestIdentifier == accountID {
+		return true
+	}
+
+	if v, err := arn.Parse(requestIdentifier); err == nil {
+		return v.AccountID == accountID
+	}
+
+	return false
+}
+
+var iamPolicyVariableRegexp = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// iamPolicySubstituteVariables replaces policy variables, e.g.
+// "${aws:username}", with their single value in the request context.
+// Variables without a value are left as is, so that they never match.
+func iamPolicySubstituteVariables(values []string, context map[string][]string) []string {
+	out := make([]string, len(values))
+
+	for i, value := range values {
+		out[i] = iamPolicyVariableRegexp.ReplaceAllStringFunc(value, func(variable string) string {
+			key := variable[2 : len(variable)-1]
+
+			switch key {
+			case "*", "?", "$":
+				return key
+			}
+
+			if v, ok := iamPolicyContextValues(context, key); ok && len(v) == 1 {
+				return v[0]
+			}
+
+			return variable
+		})
+	}
+
+	return out
+}
+
+// iamPolicyContextValues returns the values of the condition context key.
+// Condition keys are case-insensitive.
+func iamPolicyContextValues(context map[string][]string, key string) ([]string, bool) {
+	if v, ok := context[key]; ok {
+		return v, true
+	}
+
+	for k, v := range context {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+// iamPolicyConditionOperator is a condition operator, which returns true if a
+// single request value satisfies the condition values.
+type iamPolicyConditionOperator struct {
+	match   func(policyValues []string, value string) (bool, error)
+	negated bool
+}
+
+var iamPolicyConditionOperators = map[string]iamPolicyConditionOperator{
+	"StringEquals":              {match: iamPolicyStringEquals},
+	"StringNotEquals":           {match: iamPolicyStringEquals, negated: true},
+	"StringEqualsIgnoreCase":    {match: iamPolicyStringEqualsIgnoreCase},
+	"StringNotEqualsIgnoreCase": {match: iamPolicyStringEqualsIgnoreCase, negated: true},
+	"StringLike":                {match: iamPolicyStringLike},
+	"StringNotLike":             {match: iamPolicyStringLike, negated: true},
+	"NumericEquals":             {match: iamPolicyNumericCompare(func(c int) bool { return c == 0 })},
+	"NumericNotEquals":          {match: iamPolicyNumericCompare(func(c int) bool { return c == 0 }), negated: true},
+	"NumericLessThan":           {match: iamPolicyNumericCompare(func(c int) bool { return c < 0 })},
+	"NumericLessThanEquals":     {match: iamPolicyNumericCompare(func(c int) bool { return c <= 0 })},
+	"NumericGreaterThan":        {match: iamPolicyNumericCompare(func(c int) bool { return c > 0 })},
+	"NumericGreaterThanEquals":  {match: iamPolicyNumericCompare(func(c int) bool { return c >= 0 })},
+	"DateEquals":                {match: iamPolicyDateCompare(func(c int) bool { return c == 0 })},
+	"DateNotEquals":             {match: iamPolicyDateCompare(func(c int) bool { return c == 0 }), negated: true},
+	"DateLessThan":              {match: iamPolicyDateCompare(func(c int) bool { return c < 0 })},
+	"DateLessThanEquals":        {match: iamPolicyDateCompare(func(c int) bool { return c <= 0 })},
+	"DateGreaterThan":           {match: iamPolicyDateCompare(func(c int) bool { return c > 0 })},
+	"DateGreaterThanEquals":     {match: iamPolicyDateCompare(func(c int) bool { return c >= 0 })},
+	"Bool":                      {match: iamPolicyStringEqualsIgnoreCase},
+	"BinaryEquals":              {match: iamPolicyStringEquals},
+	"IpAddress":                 {match: iamPolicyIpAddress},
+	"NotIpAddress":              {match: iamPolicyIpAddress, negated: true},
+	"ArnEquals":                 {match: iamPolicyArnLike},
+	"ArnNotEquals":              {match: iamPolicyArnLike, negated: true},
+	"ArnLike":                   {match: iamPolicyArnLike},
+	"ArnNotLike":                {match: iamPolicyArnLike, negated: true},
+}
+
+// iamPolicyConditionMatches returns true if the request context satisfies the
+// condition, including the "ForAnyValue:" and "ForAllValues:" set operator
+// prefixes and the "IfExists" suffix.
+func iamPolicyConditionMatches(condition IAMPolicyStatementCondition, context map[string][]string) (bool, error) {
+	test := condition.Test
+	policyValues := iamPolicySubstituteVariables(iamPolicyStringSlice(condition.Values), context)
+
+	var forAnyValue, forAllValues, ifExists bool
+
+	switch {
+	case strings.HasPrefix(test, "ForAnyValue:"):
+		forAnyValue = true
+		test = strings.TrimPrefix(test, "ForAnyValue:")
+	case strings.HasPrefix(test, "ForAllValues:"):
+		forAllValues = true
+		test = strings.TrimPrefix(test, "ForAllValues:")
+	}
+
+	values, exists := iamPolicyContextValues(context, condition.Variable)
+
+	if test == "Null" {
+		for _, v := range policyValues {
+			if strings.EqualFold(v, strconv.FormatBool(!exists)) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	if strings.HasSuffix(test, "IfExists") {
+		ifExists = true
+		test = strings.TrimSuffix(test, "IfExists")
+	}
+
+	operator, ok := iamPolicyConditionOperators[test]
+
+	if !ok {
+		return false, fmt.Errorf("unsupported condition operator: %s", condition.Test)
+	}
+
+	if !exists || len(values) == 0 {
+		// Missing keys never match, except for "IfExists" and negated
+		// operators, and "ForAllValues:", which matches an empty set.
+		return ifExists || forAllValues || (operator.negated && !forAnyValue), nil
+	}
+
+	results := make([]bool, len(values))
+
+	for i, value := range values {
+		matched, err := operator.match(policyValues, value)
+
+		if err != nil {
+			return false, fmt.Errorf("error evaluating condition (%s) on %s: %w", condition.Test, condition.Variable, err)
+		}
+
+		results[i] = matched != operator.negated
+	}
+
+	// Without a set operator, positive operators match if any value matches
+	// and negated operators if all values match, i.e. none of the values
+	// equal any of the condition values.
+	all := forAllValues || (!forAnyValue && operator.negated)
+
+	for _, result := range results {
+		if all && !result {
+			return false, nil
+		}
+
+		if !all && result {
+			return true, nil
+		}
+	}
+
+	return all, nil
+}
+
+func iamPolicyStringEquals(policyValues []string, value string) (bool, error) {
+	for _, v := range policyValues {
+		if v == value {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func iamPolicyStringEqualsIgnoreCase(policyValues []string, value string) (bool, error) {
+	for _, v := range policyValues {
+		if strings.EqualFold(v, value) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func iamPolicyStringLike(policyValues []string, value string) (bool, error) {
+	for _, v := range policyValues {
+		if iamPolicyWildcardMatch(v, value) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func iamPolicyArnLike(policyValues []string, value string) (bool, error) {
+	return iamPolicyResourceMatchesAny(policyValues, value), nil
+}
+
+func iamPolicyNumericCompare(f func(int) bool) func([]string, string) (bool, error) {
+	return func(policyValues []string, value string) (bool, error) {
+		n, err := strconv.ParseFloat(value, 64)
+
+		if err != nil {
+			return false, err
+		}
+
+		for _, v := range policyValues {
+			pn, err := strconv.ParseFloat(v, 64)
+
+			if err != nil {
+				return false, err
+			}
+
+			c := 0
+			switch {
+			case n < pn:
+				c = -1
+			case n > pn:
+				c = 1
+			}
+
+			if f(c) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+}
+
+func iamPolicyDateCompare(f func(int) bool) func([]string, string) (bool, error) {
+	return func(policyValues []string, value string) (bool, error) {
+		t, err := iamPolicyParseDate(value)
+
+		if err != nil {
+			return false, err
+		}
+
+		for _, v := range policyValues {
+			pt, err := iamPolicyParseDate(v)
+
+			if err != nil {
+				return false, err
+			}
+
+			c := 0
+			switch {
+			case t.Before(pt):
+				c = -1
+			case t.After(pt):
+				c = 1
+			}
+
+			if f(c) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+}
+
+// iamPolicyParseDate parses an ISO 8601 date, date and time or epoch time.
+func iamPolicyParseDate(value string) (time.Time, error) {
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(n, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date: %s", value)
+}
+
+func iamPolicyIpAddress(policyValues []string, value string) (bool, error) {
+	ip := net.ParseIP(value)
+
+	if ip == nil {
+		return false, fmt.Errorf("invalid IP address: %s", value)
+	}
+
+	for _, v := range policyValues {
+		if !strings.Contains(v, "/") {
+			if pip := net.ParseIP(v); pip != nil && pip.Equal(ip) {
+				return true, nil
+			}
+
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(v)
+
+		if err != nil {
+			return false, err
+		}
+
+		if ipNet.Contains(ip) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
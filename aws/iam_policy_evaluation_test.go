@@ -0,0 +1,392 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+func TestIamPolicyWildcardMatch(t *testing.T) {
+	testCases := []struct {
+		Pattern  string
+		Value    string
+		Expected bool
+	}{
+		{"*", "", true},
+		{"*", "anything", true},
+		{"s3:Get*", "s3:GetObject", true},
+		{"s3:Get*", "s3:PutObject", false},
+		{"s3:*Object", "s3:GetObject", true},
+		{"s3:Get?bject", "s3:GetObject", true},
+		{"s3:Get?bject", "s3:GetOObject", false},
+		{"a*b*c", "aXXbYYc", true},
+		{"a*b*c", "aXXbYY", false},
+		{"exact", "exact", true},
+		{"exact", "exactly", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := iamPolicyWildcardMatch(testCase.Pattern, testCase.Value); got != testCase.Expected {
+			t.Errorf("iamPolicyWildcardMatch(%q, %q) = %t, expected %t", testCase.Pattern, testCase.Value, got, testCase.Expected)
+		}
+	}
+}
+
+func TestIamPolicyArnMatches(t *testing.T) {
+	testCases := []struct {
+		Pattern  string
+		Value    string
+		Expected bool
+	}{
+		{"*", "arn:aws:s3:::bucket/key", true},
+		{"arn:aws:s3:::bucket/*", "arn:aws:s3:::bucket/key", true},
+		{"arn:aws:s3:::bucket/*", "arn:aws:s3:::other/key", false},
+		{"arn:aws:iam::*:role/example", "arn:aws:iam::123456789012:role/example", true},
+		// Wildcards do not span ARN segments other than the resource.
+		{"arn:aws:*:role/example", "arn:aws:iam::123456789012:role/example", false},
+		{"arn:aws:logs:*:*:log-group:example:*", "arn:aws:logs:us-west-2:123456789012:log-group:example:log-stream:one", true},
+	}
+
+	for _, testCase := range testCases {
+		if got := iamPolicyArnMatches(testCase.Pattern, testCase.Value); got != testCase.Expected {
+			t.Errorf("iamPolicyArnMatches(%q, %q) = %t, expected %t", testCase.Pattern, testCase.Value, got, testCase.Expected)
+		}
+	}
+}
+
+func TestIamPolicyConditionMatches(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Condition IAMPolicyStatementCondition
+		Context   map[string][]string
+		Expected  bool
+		ExpectErr bool
+	}{
+		{
+			Name:      "StringEquals",
+			Condition: IAMPolicyStatementCondition{Test: "StringEquals", Variable: "aws:username", Values: []string{"alice", "bob"}},
+			Context:   map[string][]string{"aws:username": {"bob"}},
+			Expected:  true,
+		},
+		{
+			Name:      "StringEquals case-insensitive key",
+			Condition: IAMPolicyStatementCondition{Test: "StringEquals", Variable: "aws:UserName", Values: "bob"},
+			Context:   map[string][]string{"aws:username": {"bob"}},
+			Expected:  true,
+		},
+		{
+			Name:      "StringEquals missing key",
+			Condition: IAMPolicyStatementCondition{Test: "StringEquals", Variable: "aws:username", Values: []string{"bob"}},
+			Expected:  false,
+		},
+		{
+			Name:      "StringEqualsIfExists missing key",
+			Condition: IAMPolicyStatementCondition{Test: "StringEqualsIfExists", Variable: "aws:username", Values: []string{"bob"}},
+			Expected:  true,
+		},
+		{
+			Name:      "StringNotEquals",
+			Condition: IAMPolicyStatementCondition{Test: "StringNotEquals", Variable: "aws:username", Values: []string{"alice", "bob"}},
+			Context:   map[string][]string{"aws:username": {"bob"}},
+			Expected:  false,
+		},
+		{
+			Name:      "StringNotEquals missing key",
+			Condition: IAMPolicyStatementCondition{Test: "StringNotEquals", Variable: "aws:username", Values: []string{"bob"}},
+			Expected:  true,
+		},
+		{
+			Name:      "StringLike",
+			Condition: IAMPolicyStatementCondition{Test: "StringLike", Variable: "s3:prefix", Values: []string{"home/*"}},
+			Context:   map[string][]string{"s3:prefix": {"home/bob/"}},
+			Expected:  true,
+		},
+		{
+			Name:      "NumericLessThan",
+			Condition: IAMPolicyStatementCondition{Test: "NumericLessThan", Variable: "s3:max-keys", Values: []string{"10"}},
+			Context:   map[string][]string{"s3:max-keys": {"5"}},
+			Expected:  true,
+		},
+		{
+			Name:      "NumericLessThan invalid",
+			Condition: IAMPolicyStatementCondition{Test: "NumericLessThan", Variable: "s3:max-keys", Values: []string{"10"}},
+			Context:   map[string][]string{"s3:max-keys": {"five"}},
+			ExpectErr: true,
+		},
+		{
+			Name:      "DateGreaterThan",
+			Condition: IAMPolicyStatementCondition{Test: "DateGreaterThan", Variable: "aws:CurrentTime", Values: []string{"2020-01-01T00:00:00Z"}},
+			Context:   map[string][]string{"aws:CurrentTime": {"2021-06-01T12:00:00Z"}},
+			Expected:  true,
+		},
+		{
+			Name:      "Bool",
+			Condition: IAMPolicyStatementCondition{Test: "Bool", Variable: "aws:SecureTransport", Values: []string{"false"}},
+			Context:   map[string][]string{"aws:SecureTransport": {"False"}},
+			Expected:  true,
+		},
+		{
+			Name:      "IpAddress",
+			Condition: IAMPolicyStatementCondition{Test: "IpAddress", Variable: "aws:SourceIp", Values: []string{"203.0.113.0/24"}},
+			Context:   map[string][]string{"aws:SourceIp": {"203.0.113.10"}},
+			Expected:  true,
+		},
+		{
+			Name:      "NotIpAddress",
+			Condition: IAMPolicyStatementCondition{Test: "NotIpAddress", Variable: "aws:SourceIp", Values: []string{"203.0.113.0/24"}},
+			Context:   map[string][]string{"aws:SourceIp": {"203.0.113.10"}},
+			Expected:  false,
+		},
+		{
+			Name:      "ArnLike",
+			Condition: IAMPolicyStatementCondition{Test: "ArnLike", Variable: "aws:SourceArn", Values: []string{"arn:aws:sns:*:123456789012:*"}},
+			Context:   map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:topic"}},
+			Expected:  true,
+		},
+		{
+			Name:      "Null true",
+			Condition: IAMPolicyStatementCondition{Test: "Null", Variable: "aws:TokenIssueTime", Values: []string{"true"}},
+			Expected:  true,
+		},
+		{
+			Name:      "Null false",
+			Condition: IAMPolicyStatementCondition{Test: "Null", Variable: "aws:TokenIssueTime", Values: []string{"true"}},
+			Context:   map[string][]string{"aws:TokenIssueTime": {"2021-06-01T12:00:00Z"}},
+			Expected:  false,
+		},
+		{
+			Name:      "ForAnyValue",
+			Condition: IAMPolicyStatementCondition{Test: "ForAnyValue:StringEquals", Variable: "aws:TagKeys", Values: []string{"environment"}},
+			Context:   map[string][]string{"aws:TagKeys": {"environment", "owner"}},
+			Expected:  true,
+		},
+		{
+			Name:      "ForAllValues",
+			Condition: IAMPolicyStatementCondition{Test: "ForAllValues:StringEquals", Variable: "aws:TagKeys", Values: []string{"environment"}},
+			Context:   map[string][]string{"aws:TagKeys": {"environment", "owner"}},
+			Expected:  false,
+		},
+		{
+			Name:      "ForAllValues missing key",
+			Condition: IAMPolicyStatementCondition{Test: "ForAllValues:StringEquals", Variable: "aws:TagKeys", Values: []string{"environment"}},
+			Expected:  true,
+		},
+		{
+			Name:      "policy variable",
+			Condition: IAMPolicyStatementCondition{Test: "StringLike", Variable: "s3:prefix", Values: []string{"home/${aws:username}/*"}},
+			Context:   map[string][]string{"aws:username": {"bob"}, "s3:prefix": {"home/bob/documents"}},
+			Expected:  true,
+		},
+		{
+			Name:      "unsupported operator",
+			Condition: IAMPolicyStatementCondition{Test: "StringSortOf", Variable: "aws:username", Values: []string{"bob"}},
+			Context:   map[string][]string{"aws:username": {"bob"}},
+			ExpectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := iamPolicyConditionMatches(testCase.Condition, testCase.Context)
+
+			if testCase.ExpectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyEvaluatorEvaluate(t *testing.T) {
+	identityPolicy := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowRead",
+      "Effect": "Allow",
+      "Action": ["s3:Get*", "s3:List*"],
+      "Resource": "*"
+    },
+    {
+      "Sid": "DenySecrets",
+      "Effect": "Deny",
+      "Action": "s3:*",
+      "Resource": "arn:aws:s3:::secrets/*"
+    },
+    {
+      "Sid": "AllowAllButIam",
+      "Effect": "Allow",
+      "NotAction": "iam:*",
+      "NotResource": "arn:aws:s3:::*"
+    }
+  ]
+}`
+	resourcePolicy := `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Sid": "PublicPut",
+    "Effect": "Allow",
+    "Principal": {"AWS": "123456789012"},
+    "Action": "s3:PutObject",
+    "Resource": "arn:aws:s3:::uploads/*",
+    "Condition": {"Bool": {"aws:SecureTransport": "true"}}
+  }
+}`
+	boundaryPolicy := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Boundary",
+      "Effect": "Allow",
+      "Action": ["s3:*", "ec2:Describe*"],
+      "Resource": "*"
+    }
+  ]
+}`
+
+	decode := func(policy string) *IAMPolicyDoc {
+		doc, err := iamPolicyDecodeDocument(policy)
+
+		if err != nil {
+			t.Fatalf("error decoding policy: %s", err)
+		}
+
+		return doc
+	}
+
+	principal := &IAMPolicyStatementPrincipal{Type: "AWS", Identifiers: "arn:aws:iam::123456789012:user/bob"}
+
+	testCases := []struct {
+		Name             string
+		Evaluator        *IAMPolicyEvaluator
+		Request          *IAMPolicyEvaluationRequest
+		ExpectedDecision string
+		ExpectedSids     []string
+	}{
+		{
+			Name:             "allowed",
+			Evaluator:        &IAMPolicyEvaluator{IdentityPolicies: []*IAMPolicyDoc{decode(identityPolicy)}},
+			Request:          &IAMPolicyEvaluationRequest{Action: "S3:GetObject", Resource: "arn:aws:s3:::bucket/key"},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"AllowRead"},
+		},
+		{
+			Name:             "explicit deny",
+			Evaluator:        &IAMPolicyEvaluator{IdentityPolicies: []*IAMPolicyDoc{decode(identityPolicy)}},
+			Request:          &IAMPolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::secrets/key"},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeExplicitDeny,
+			ExpectedSids:     []string{"DenySecrets"},
+		},
+		{
+			Name:             "implicit deny",
+			Evaluator:        &IAMPolicyEvaluator{IdentityPolicies: []*IAMPolicyDoc{decode(identityPolicy)}},
+			Request:          &IAMPolicyEvaluationRequest{Action: "s3:PutObject", Resource: "arn:aws:s3:::bucket/key"},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:             "NotAction and NotResource",
+			Evaluator:        &IAMPolicyEvaluator{IdentityPolicies: []*IAMPolicyDoc{decode(identityPolicy)}},
+			Request:          &IAMPolicyEvaluationRequest{Action: "ec2:RunInstances", Resource: "arn:aws:ec2:us-west-2:123456789012:instance/*"},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"AllowAllButIam"},
+		},
+		{
+			Name:             "NotAction excluded",
+			Evaluator:        &IAMPolicyEvaluator{IdentityPolicies: []*IAMPolicyDoc{decode(identityPolicy)}},
+			Request:          &IAMPolicyEvaluationRequest{Action: "iam:CreateUser", Resource: "arn:aws:iam::123456789012:user/alice"},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:      "resource policy",
+			Evaluator: &IAMPolicyEvaluator{ResourcePolicies: []*IAMPolicyDoc{decode(resourcePolicy)}},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:    "s3:PutObject",
+				Resource:  "arn:aws:s3:::uploads/file",
+				Principal: principal,
+				Context:   map[string][]string{"aws:SecureTransport": {"true"}},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"PublicPut"},
+		},
+		{
+			Name:      "resource policy other account",
+			Evaluator: &IAMPolicyEvaluator{ResourcePolicies: []*IAMPolicyDoc{decode(resourcePolicy)}},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:    "s3:PutObject",
+				Resource:  "arn:aws:s3:::uploads/file",
+				Principal: &IAMPolicyStatementPrincipal{Type: "AWS", Identifiers: "arn:aws:iam::210987654321:user/bob"},
+				Context:   map[string][]string{"aws:SecureTransport": {"true"}},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name:      "resource policy condition not met",
+			Evaluator: &IAMPolicyEvaluator{ResourcePolicies: []*IAMPolicyDoc{decode(resourcePolicy)}},
+			Request: &IAMPolicyEvaluationRequest{
+				Action:    "s3:PutObject",
+				Resource:  "arn:aws:s3:::uploads/file",
+				Principal: principal,
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name: "permissions boundary",
+			Evaluator: &IAMPolicyEvaluator{
+				IdentityPolicies:            []*IAMPolicyDoc{decode(identityPolicy)},
+				PermissionsBoundaryPolicies: []*IAMPolicyDoc{decode(boundaryPolicy)},
+			},
+			Request:          &IAMPolicyEvaluationRequest{Action: "s3:ListBucket", Resource: "arn:aws:s3:::bucket"},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedSids:     []string{"AllowRead", "Boundary"},
+		},
+		{
+			Name: "permissions boundary implicit deny",
+			Evaluator: &IAMPolicyEvaluator{
+				IdentityPolicies:            []*IAMPolicyDoc{decode(identityPolicy)},
+				PermissionsBoundaryPolicies: []*IAMPolicyDoc{decode(boundaryPolicy)},
+			},
+			Request:          &IAMPolicyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*"},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := testCase.Evaluator.Evaluate(testCase.Request)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.Decision != testCase.ExpectedDecision {
+				t.Errorf("got decision %s, expected %s", got.Decision, testCase.ExpectedDecision)
+			}
+
+			var sids []string
+			for _, match := range got.MatchedStatements {
+				sids = append(sids, match.Sid)
+			}
+
+			if len(sids) != len(testCase.ExpectedSids) {
+				t.Fatalf("got matched statements %v, expected %v", sids, testCase.ExpectedSids)
+			}
+
+			for i := range sids {
+				if sids[i] != testCase.ExpectedSids[i] {
+					t.Errorf("got matched statements %v, expected %v", sids, testCase.ExpectedSids)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,390 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// IAMPolicyMaxManagedPolicySize is the maximum size of an IAM managed policy
+// document, not counting white space.
+const IAMPolicyMaxManagedPolicySize = 6144
+
+// Minimize reduces the size of the policy document without changing its
+// meaning. Duplicate actions and resources, and those covered by a wildcard in
+// the same statement, are removed. Statements without a Sid that have the same
+// effect, principals and conditions are merged if they have the same resources,
+// by combining their actions, or the same actions, by combining their
+// resources. Statements with a Sid are not merged, so that they can still be
+// overridden.
+func (s *IAMPolicyDoc) Minimize() {
+	for _, stmt := range s.Statements {
+		if stmt == nil {
+			continue
+		}
+
+		stmt.Actions = iamPolicyMinimizeList(stmt.Actions, iamPolicyActionCovers)
+		stmt.NotActions = iamPolicyMinimizeList(stmt.NotActions, iamPolicyActionCovers)
+		stmt.Resources = iamPolicyMinimizeList(stmt.Resources, iamPolicyArnCovers)
+		stmt.NotResources = iamPolicyMinimizeList(stmt.NotResources, iamPolicyArnCovers)
+	}
+
+	for merged := true; merged; {
+		merged = s.mergeStatements(func(stmt *IAMPolicyStatement) (string, bool) {
+			if stmt.Actions == nil || stmt.Resources == nil {
+				return "", false
+			}
+			return "resources:" + iamPolicyListKey(stmt.Resources), true
+		}, func(to, from *IAMPolicyStatement) {
+			to.Actions = iamPolicyMinimizeList(iamPolicyConcatLists(to.Actions, from.Actions), iamPolicyActionCovers)
+		})
+
+		merged = s.mergeStatements(func(stmt *IAMPolicyStatement) (string, bool) {
+			if stmt.Actions == nil || stmt.Resources == nil {
+				return "", false
+			}
+			return "actions:" + iamPolicyListKey(stmt.Actions), true
+		}, func(to, from *IAMPolicyStatement) {
+			to.Resources = iamPolicyMinimizeList(iamPolicyConcatLists(to.Resources, from.Resources), iamPolicyArnCovers)
+		}) || merged
+	}
+}
+
+// mergeStatements merges statements without a Sid that have the same effect,
+// principals, conditions and key, returning whether any were merged.
+func (s *IAMPolicyDoc) mergeStatements(key func(*IAMPolicyStatement) (string, bool), merge func(to, from *IAMPolicyStatement)) bool {
+	seen := make(map[string]*IAMPolicyStatement)
+	statements := make([]*IAMPolicyStatement, 0, len(s.Statements))
+	merged := false
+
+	for _, stmt := range s.Statements {
+		if stmt == nil || stmt.Sid != "" {
+			statements = append(statements, stmt)
+			continue
+		}
+
+		k, ok := key(stmt)
+
+		if !ok {
+			statements = append(statements, stmt)
+			continue
+		}
+
+		groupKey, err := iamPolicyStatementGroupKey(stmt)
+
+		if err != nil {
+			statements = append(statements, stmt)
+			continue
+		}
+
+		k = groupKey + "|" + k
+
+		if to, ok := seen[k]; ok {
+			merge(to, stmt)
+			merged = true
+			continue
+		}
+
+		seen[k] = stmt
+		statements = append(statements, stmt)
+	}
+
+	s.Statements = statements
+
+	return merged
+}
+
+// iamPolicyStatementGroupKey returns a key identifying the statement's effect,
+// principals and conditions.
+func iamPolicyStatementGroupKey(stmt *IAMPolicyStatement) (string, error) {
+	effect := stmt.Effect
+	if effect == "" {
+		effect = "Allow"
+	}
+
+	principals, err := json.Marshal(stmt.Principals)
+	if err != nil {
+		return "", err
+	}
+
+	notPrincipals, err := json.Marshal(stmt.NotPrincipals)
+	if err != nil {
+		return "", err
+	}
+
+	conditions := make([]string, 0, len(stmt.Conditions))
+	for _, c := range stmt.Conditions {
+		conditions = append(conditions, c.Test+"\x00"+c.Variable+"\x00"+strings.Join(iamPolicyStringSlice(c.Values), "\x00"))
+	}
+	sort.Strings(conditions)
+
+	return strings.Join([]string{effect, string(principals), string(notPrincipals), strings.Join(conditions, "\x01")}, "|"), nil
+}
+
+// iamPolicyMinimizeList removes duplicates and values covered by another value
+// of the list, returning the list in the same form as the
+// aws_iam_policy_document data source.
+func iamPolicyMinimizeList(v interface{}, covers func(pattern, value string) bool) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	values := iamPolicyStringSlice(v)
+	var out []string
+
+	for i, value := range values {
+		covered := false
+
+		for j, other := range values {
+			if i == j {
+				continue
+			}
+
+			if !covers(other, value) {
+				continue
+			}
+
+			// Of values that cover each other, e.g. duplicates, keep the first.
+			if covers(value, other) && j > i {
+				continue
+			}
+
+			covered = true
+			break
+		}
+
+		if !covered {
+			out = append(out, value)
+		}
+	}
+
+	if len(out) == 1 {
+		return out[0]
+	}
+
+	sort.Sort(sort.Reverse(sort.StringSlice(out)))
+
+	return out
+}
+
+func iamPolicyConcatLists(a, b interface{}) []string {
+	return append(append([]string{}, iamPolicyStringSlice(a)...), iamPolicyStringSlice(b)...)
+}
+
+func iamPolicyListKey(v interface{}) string {
+	values := append([]string{}, iamPolicyStringSlice(v)...)
+	sort.Strings(values)
+
+	return strings.Join(values, "\x00")
+}
+
+// iamPolicyActionCovers returns whether every action matching value also
+// matches pattern. Only literal values, equal values and any value for the
+// pattern "*" are considered covered.
+func iamPolicyActionCovers(pattern, value string) bool {
+	if pattern == "*" || strings.EqualFold(pattern, value) {
+		return true
+	}
+
+	if strings.ContainsAny(value, "*?") {
+		return false
+	}
+
+	return iamPolicyActionMatchesAny([]string{pattern}, value)
+}
+
+// iamPolicyArnCovers returns whether every resource matching value also
+// matches pattern. Only literal values without policy variables, equal values
+// and any value for the pattern "*" are considered covered.
+func iamPolicyArnCovers(pattern, value string) bool {
+	if pattern == "*" || pattern == value {
+		return true
+	}
+
+	if strings.ContainsAny(value, "*?") || strings.Contains(value, "${") || strings.Contains(pattern, "${") {
+		return false
+	}
+
+	return iamPolicyArnMatches(pattern, value)
+}
+
+// Split splits the policy document into as few documents as it can of at
+// most maxSize characters each, not counting white space, by packing its
+// statements with the first-fit decreasing heuristic. Statements larger than
+// maxSize on their own are split by their actions or resources, with a
+// numeric suffix added to their Sid.
+func (s *IAMPolicyDoc) Split(maxSize int) ([]*IAMPolicyDoc, error) {
+	type sizedStatement struct {
+		index     int
+		size      int
+		statement *IAMPolicyStatement
+	}
+
+	var statements []sizedStatement
+
+	for _, stmt := range s.Statements {
+		parts, err := s.splitStatement(stmt, maxSize)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, part := range parts {
+			size, err := s.sizeWith([]*IAMPolicyStatement{part})
+
+			if err != nil {
+				return nil, err
+			}
+
+			statements = append(statements, sizedStatement{
+				index:     len(statements),
+				size:      size,
+				statement: part,
+			})
+		}
+	}
+
+	sort.SliceStable(statements, func(i, j int) bool {
+		return statements[i].size > statements[j].size
+	})
+
+	var bins [][]sizedStatement
+
+	for _, stmt := range statements {
+		placed := false
+
+		for i, bin := range bins {
+			candidate := make([]*IAMPolicyStatement, 0, len(bin)+1)
+			for _, v := range bin {
+				candidate = append(candidate, v.statement)
+			}
+			candidate = append(candidate, stmt.statement)
+
+			size, err := s.sizeWith(candidate)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if size <= maxSize {
+				bins[i] = append(bin, stmt)
+				placed = true
+				break
+			}
+		}
+
+		if !placed {
+			bins = append(bins, []sizedStatement{stmt})
+		}
+	}
+
+	if len(bins) == 0 {
+		return []*IAMPolicyDoc{s.withStatements(nil)}, nil
+	}
+
+	docs := make([]*IAMPolicyDoc, 0, len(bins))
+
+	for _, bin := range bins {
+		// Keep the statements in their original order.
+		sort.Slice(bin, func(i, j int) bool {
+			return bin[i].index < bin[j].index
+		})
+
+		statements := make([]*IAMPolicyStatement, 0, len(bin))
+		for _, v := range bin {
+			statements = append(statements, v.statement)
+		}
+
+		docs = append(docs, s.withStatements(statements))
+	}
+
+	return docs, nil
+}
+
+// splitStatement splits the statement, by halving its actions or else its
+// resources, until each part fits in a document of at most maxSize characters.
+func (s *IAMPolicyDoc) splitStatement(stmt *IAMPolicyStatement, maxSize int) ([]*IAMPolicyStatement, error) {
+	parts, err := s.splitStatementParts(stmt, maxSize)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parts) > 1 && stmt.Sid != "" {
+		for i, part := range parts {
+			part.Sid = stmt.Sid + strconv.Itoa(i+1)
+		}
+	}
+
+	return parts, nil
+}
+
+func (s *IAMPolicyDoc) splitStatementParts(stmt *IAMPolicyStatement, maxSize int) ([]*IAMPolicyStatement, error) {
+	size, err := s.sizeWith([]*IAMPolicyStatement{stmt})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if size <= maxSize {
+		return []*IAMPolicyStatement{stmt}, nil
+	}
+
+	var first, second IAMPolicyStatement
+
+	if actions := iamPolicyStringSlice(stmt.Actions); len(actions) > 1 {
+		first, second = *stmt, *stmt
+		first.Actions = iamPolicyListValue(actions[:len(actions)/2])
+		second.Actions = iamPolicyListValue(actions[len(actions)/2:])
+	} else if resources := iamPolicyStringSlice(stmt.Resources); len(resources) > 1 {
+		first, second = *stmt, *stmt
+		first.Resources = iamPolicyListValue(resources[:len(resources)/2])
+		second.Resources = iamPolicyListValue(resources[len(resources)/2:])
+	} else {
+		return nil, fmt.Errorf("statement (%s) is larger than the maximum size (%d) and cannot be split", stmt.Sid, maxSize)
+	}
+
+	var parts []*IAMPolicyStatement
+
+	for _, half := range []*IAMPolicyStatement{&first, &second} {
+		v, err := s.splitStatementParts(half, maxSize)
+
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, v...)
+	}
+
+	return parts, nil
+}
+
+// sizeWith returns the size, not counting white space, of the policy document
+// with the given statements.
+func (s *IAMPolicyDoc) sizeWith(statements []*IAMPolicyStatement) (int, error) {
+	b, err := json.Marshal(s.withStatements(statements))
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+func (s *IAMPolicyDoc) withStatements(statements []*IAMPolicyStatement) *IAMPolicyDoc {
+	return &IAMPolicyDoc{
+		Version:    s.Version,
+		Id:         s.Id,
+		Statements: statements,
+	}
+}
+
+func iamPolicyListValue(values []string) interface{} {
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return append([]string{}, values...)
+}
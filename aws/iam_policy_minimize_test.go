@@ -0,0 +1,261 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIAMPolicyDocMinimize(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Statements []*IAMPolicyStatement
+		Expected   []*IAMPolicyStatement
+	}{
+		{
+			Name: "duplicates",
+			Statements: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: []string{"s3:GetObject", "S3:getobject"}, Resources: []string{"arn:aws:s3:::example", "arn:aws:s3:::example"}},
+			},
+			Expected: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: "s3:GetObject", Resources: "arn:aws:s3:::example"},
+			},
+		},
+		{
+			Name: "wildcards",
+			Statements: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: []string{"s3:GetObject", "s3:Get*", "s3:PutObject"}, Resources: []string{"arn:aws:s3:::example/key", "arn:aws:s3:::example/*"}},
+			},
+			Expected: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: []string{"s3:PutObject", "s3:Get*"}, Resources: "arn:aws:s3:::example/*"},
+			},
+		},
+		{
+			Name: "same resources",
+			Statements: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: "s3:GetObject", Resources: "arn:aws:s3:::example/*"},
+				{Effect: "Allow", Actions: "s3:PutObject", Resources: "arn:aws:s3:::example/*"},
+			},
+			Expected: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: []string{"s3:PutObject", "s3:GetObject"}, Resources: "arn:aws:s3:::example/*"},
+			},
+		},
+		{
+			Name: "same actions",
+			Statements: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: "s3:GetObject", Resources: "arn:aws:s3:::one/*"},
+				{Effect: "Allow", Actions: "s3:GetObject", Resources: "arn:aws:s3:::two/*"},
+			},
+			Expected: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: "s3:GetObject", Resources: []string{"arn:aws:s3:::two/*", "arn:aws:s3:::one/*"}},
+			},
+		},
+		{
+			Name: "repeated merges",
+			Statements: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: "s3:GetObject", Resources: "arn:aws:s3:::one/*"},
+				{Effect: "Allow", Actions: "s3:PutObject", Resources: "arn:aws:s3:::one/*"},
+				{Effect: "Allow", Actions: []string{"s3:PutObject", "s3:GetObject"}, Resources: "arn:aws:s3:::two/*"},
+			},
+			Expected: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: []string{"s3:PutObject", "s3:GetObject"}, Resources: []string{"arn:aws:s3:::two/*", "arn:aws:s3:::one/*"}},
+			},
+		},
+		{
+			Name: "different effect, principals and conditions",
+			Statements: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: "s3:GetObject", Resources: "*"},
+				{Effect: "Deny", Actions: "s3:PutObject", Resources: "*"},
+				{Effect: "Allow", Actions: "s3:PutObject", Resources: "*", Principals: IAMPolicyStatementPrincipalSet{{Type: "AWS", Identifiers: "123456789012"}}},
+				{Effect: "Allow", Actions: "s3:DeleteObject", Resources: "*", Conditions: IAMPolicyStatementConditionSet{{Test: "Bool", Variable: "aws:SecureTransport", Values: "true"}}},
+			},
+			Expected: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: "s3:GetObject", Resources: "*"},
+				{Effect: "Deny", Actions: "s3:PutObject", Resources: "*"},
+				{Effect: "Allow", Actions: "s3:PutObject", Resources: "*", Principals: IAMPolicyStatementPrincipalSet{{Type: "AWS", Identifiers: "123456789012"}}},
+				{Effect: "Allow", Actions: "s3:DeleteObject", Resources: "*", Conditions: IAMPolicyStatementConditionSet{{Test: "Bool", Variable: "aws:SecureTransport", Values: "true"}}},
+			},
+		},
+		{
+			Name: "sid",
+			Statements: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: "s3:GetObject", Resources: "*"},
+				{Sid: "Put", Effect: "Allow", Actions: "s3:PutObject", Resources: "*"},
+			},
+			Expected: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: "s3:GetObject", Resources: "*"},
+				{Sid: "Put", Effect: "Allow", Actions: "s3:PutObject", Resources: "*"},
+			},
+		},
+		{
+			Name: "different actions and resources",
+			Statements: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: "s3:GetObject", Resources: "arn:aws:s3:::one/*"},
+				{Effect: "Allow", Actions: "s3:PutObject", Resources: "arn:aws:s3:::two/*"},
+			},
+			Expected: []*IAMPolicyStatement{
+				{Effect: "Allow", Actions: "s3:GetObject", Resources: "arn:aws:s3:::one/*"},
+				{Effect: "Allow", Actions: "s3:PutObject", Resources: "arn:aws:s3:::two/*"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := &IAMPolicyDoc{Statements: testCase.Statements}
+			doc.Minimize()
+
+			if !reflect.DeepEqual(doc.Statements, testCase.Expected) {
+				got, _ := json.Marshal(doc.Statements)
+				expected, _ := json.Marshal(testCase.Expected)
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyDocSplit(t *testing.T) {
+	var statements []*IAMPolicyStatement
+
+	for i := 0; i < 40; i++ {
+		var actions []string
+		for j := 0; j <= i%7; j++ {
+			actions = append(actions, fmt.Sprintf("s3:Action%d", j))
+		}
+
+		statements = append(statements, &IAMPolicyStatement{
+			Sid:       fmt.Sprintf("Statement%d", i),
+			Effect:    "Allow",
+			Actions:   actions,
+			Resources: fmt.Sprintf("arn:aws:s3:::bucket%d/*", i),
+		})
+	}
+
+	doc := &IAMPolicyDoc{Version: "2012-10-17", Statements: statements}
+
+	size, err := doc.sizeWith(doc.Statements)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	maxSize := 1024
+	docs, err := doc.Split(maxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each document has some overhead, so no fewer than this are possible.
+	if minimum := (size + maxSize - 1) / maxSize; len(docs) < minimum || len(docs) > minimum+1 {
+		t.Errorf("got %d documents, expected %d or %d", len(docs), minimum, minimum+1)
+	}
+
+	seen := make(map[string]int)
+
+	for i, v := range docs {
+		if v.Version != doc.Version {
+			t.Errorf("document %d: got version %q, expected %q", i, v.Version, doc.Version)
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(b) > maxSize {
+			t.Errorf("document %d: got size %d, expected at most %d", i, len(b), maxSize)
+		}
+
+		last := -1
+		for _, stmt := range v.Statements {
+			var index int
+			if _, err := fmt.Sscanf(stmt.Sid, "Statement%d", &index); err != nil {
+				t.Fatal(err)
+			}
+
+			if index < last {
+				t.Errorf("document %d: statement %d out of order", i, index)
+			}
+
+			last = index
+			seen[stmt.Sid]++
+		}
+	}
+
+	for _, stmt := range statements {
+		if seen[stmt.Sid] != 1 {
+			t.Errorf("statement %s: found %d times, expected 1", stmt.Sid, seen[stmt.Sid])
+		}
+	}
+}
+
+func TestIAMPolicyDocSplit_fits(t *testing.T) {
+	doc := &IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*IAMPolicyStatement{
+			{Sid: "Read", Effect: "Allow", Actions: "s3:GetObject", Resources: "*"},
+		},
+	}
+
+	docs, err := doc.Split(IAMPolicyMaxManagedPolicySize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(docs) != 1 || !reflect.DeepEqual(docs[0], doc) {
+		t.Errorf("got %v, expected [%v]", docs, doc)
+	}
+}
+
+func TestIAMPolicyDocSplit_largeStatement(t *testing.T) {
+	var actions []string
+	for i := 0; i < 100; i++ {
+		actions = append(actions, fmt.Sprintf("s3:Action%03d", i))
+	}
+
+	doc := &IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*IAMPolicyStatement{
+			{Sid: "Large", Effect: "Allow", Actions: actions, Resources: "*"},
+		},
+	}
+
+	docs, err := doc.Split(512)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(docs) < 2 {
+		t.Fatalf("got %d documents, expected at least 2", len(docs))
+	}
+
+	var got []string
+
+	for i, v := range docs {
+		for j, stmt := range v.Statements {
+			if !strings.HasPrefix(stmt.Sid, "Large") || stmt.Sid == "Large" {
+				t.Errorf("document %d statement %d: got Sid %q, expected a numeric suffix", i, j, stmt.Sid)
+			}
+
+			got = append(got, iamPolicyStringSlice(stmt.Actions)...)
+		}
+	}
+
+	if len(got) != len(actions) {
+		t.Errorf("got %d actions, expected %d", len(got), len(actions))
+	}
+}
+
+func TestIAMPolicyDocSplit_tooLarge(t *testing.T) {
+	doc := &IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*IAMPolicyStatement{
+			{Sid: "Read", Effect: "Allow", Actions: "s3:GetObject", Resources: "arn:aws:s3:::example/*"},
+		},
+	}
+
+	if _, err := doc.Split(64); err == nil {
+		t.Error("expected an error")
+	}
+}
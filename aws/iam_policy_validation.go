@@ -0,0 +1,206 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/policycatalog"
+)
+
+const (
+	IAMPolicyValidationModeError = "error"
+	IAMPolicyValidationModeOff   = "off"
+	IAMPolicyValidationModeWarn  = "warn"
+)
+
+func IAMPolicyValidationMode_Values() []string {
+	return []string{
+		IAMPolicyValidationModeError,
+		IAMPolicyValidationModeOff,
+		IAMPolicyValidationModeWarn,
+	}
+}
+
+// IAMPolicyValidationFinding is a problem found in a policy statement.
+type IAMPolicyValidationFinding struct {
+	StatementIndex int
+	Sid            string
+	Summary        string
+}
+
+func (f IAMPolicyValidationFinding) String() string {
+	if f.Sid == "" {
+		return fmt.Sprintf("statement %d: %s", f.StatementIndex, f.Summary)
+	}
+
+	return fmt.Sprintf("statement %d (Sid %q): %s", f.StatementIndex, f.Sid, f.Summary)
+}
+
+// validateIAMPolicyDoc validates the policy document against the IAM policy
+// catalog, returning misspelled actions, unknown condition keys and operators,
+// resources that match no ARN format, statements that can never match and
+// overly permissive statements.
+func validateIAMPolicyDoc(doc *IAMPolicyDoc) []IAMPolicyValidationFinding {
+	var findings []IAMPolicyValidationFinding
+
+	for i, stmt := range doc.Statements {
+		if stmt == nil {
+			continue
+		}
+
+		for _, summary := range validateIAMPolicyStatement(stmt) {
+			findings = append(findings, IAMPolicyValidationFinding{
+				StatementIndex: i,
+				Sid:            stmt.Sid,
+				Summary:        summary,
+			})
+		}
+	}
+
+	return findings
+}
+
+func validateIAMPolicyStatement(stmt *IAMPolicyStatement) []string {
+	var summaries []string
+
+	actions := iamPolicyStringSlice(stmt.Actions)
+	notActions := iamPolicyStringSlice(stmt.NotActions)
+	resources := iamPolicyStringSlice(stmt.Resources)
+
+	if len(actions) == 0 && len(notActions) == 0 {
+		summaries = append(summaries, "statement has no actions or not_actions and can never match")
+	}
+
+	matchingActions := 0
+
+	for _, action := range actions {
+		if summary := validateIAMPolicyAction(action); summary != "" {
+			summaries = append(summaries, summary)
+		} else {
+			matchingActions++
+		}
+	}
+
+	if len(actions) > 0 && matchingActions == 0 {
+		summaries = append(summaries, "statement can never match: none of its actions match a known action")
+	}
+
+	for _, action := range notActions {
+		if summary := validateIAMPolicyAction(action); summary != "" {
+			summaries = append(summaries, summary)
+		}
+	}
+
+	matchingResources := 0
+
+	for _, resource := range resources {
+		if match, checked := policycatalog.MatchesArnFormat(resource); checked && !match {
+			summaries = append(summaries, fmt.Sprintf("resource (%s) matches no known ARN format of its service", resource))
+		} else {
+			matchingResources++
+		}
+	}
+
+	if len(resources) > 0 && matchingResources == 0 {
+		summaries = append(summaries, "statement can never match: none of its resources match a known ARN format")
+	}
+
+	for _, condition := range stmt.Conditions {
+		if !iamPolicyIsSupportedConditionOperator(condition.Test) {
+			summaries = append(summaries, fmt.Sprintf("unknown condition operator (%s)", condition.Test))
+		}
+
+		if known, checked := policycatalog.IsKnownConditionKey(condition.Variable); checked && !known {
+			summaries = append(summaries, fmt.Sprintf("unknown condition key (%s)", condition.Variable))
+		}
+	}
+
+	if stmt.Effect != "Deny" && len(stmt.Conditions) == 0 && iamPolicyContains(resources, "*") {
+		switch {
+		case iamPolicyContains(actions, "*"):
+			summaries = append(summaries, "statement allows all actions on all resources")
+		case len(notActions) > 0:
+			summaries = append(summaries, "statement allows all actions except its not_actions on all resources")
+		}
+	}
+
+	return summaries
+}
+
+// validateIAMPolicyAction returns a summary of the problem with the action
+// pattern, or an empty string if it matches a known action or cannot be
+// validated.
+func validateIAMPolicyAction(action string) string {
+	if action == "*" {
+		return ""
+	}
+
+	if !strings.Contains(action, ":") {
+		return fmt.Sprintf("action (%s) is not of the form service:action", action)
+	}
+
+	expanded, known := policycatalog.ExpandActions(action)
+
+	if !known {
+		return fmt.Sprintf("action (%s) has an unknown service prefix", action)
+	}
+
+	if len(expanded) == 0 {
+		return fmt.Sprintf("action (%s) matches no known action", action)
+	}
+
+	return ""
+}
+
+// iamPolicyExpandActions returns the statement's actions with wildcards
+// expanded into the matching known actions. Actions that match no known
+// action and a bare "*" are returned as is.
+func iamPolicyExpandActions(stmt *IAMPolicyStatement) []string {
+	set := make(map[string]struct{})
+
+	for _, action := range iamPolicyStringSlice(stmt.Actions) {
+		expanded, _ := policycatalog.ExpandActions(action)
+
+		if len(expanded) == 0 {
+			expanded = []string{action}
+		}
+
+		for _, v := range expanded {
+			set[v] = struct{}{}
+		}
+	}
+
+	out := make([]string, 0, len(set))
+
+	for v := range set {
+		out = append(out, v)
+	}
+
+	sort.Strings(out)
+
+	return out
+}
+
+func iamPolicyIsSupportedConditionOperator(test string) bool {
+	test = strings.TrimPrefix(test, "ForAnyValue:")
+	test = strings.TrimPrefix(test, "ForAllValues:")
+
+	if test == "Null" {
+		return true
+	}
+
+	_, ok := iamPolicyConditionOperators[strings.TrimSuffix(test, "IfExists")]
+
+	return ok
+}
+
+func iamPolicyContains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateIAMPolicyDoc(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Statement        *IAMPolicyStatement
+		ExpectedFindings []string
+	}{
+		{
+			Name: "valid",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   []string{"s3:GetObject", "s3:List*"},
+				Resources: []string{"arn:aws:s3:::example", "arn:aws:s3:::example/${aws:username}/*"},
+				Conditions: IAMPolicyStatementConditionSet{
+					{Test: "StringLike", Variable: "s3:prefix", Values: []string{"home/"}},
+					{Test: "ForAnyValue:StringEqualsIfExists", Variable: "aws:TagKeys", Values: []string{"owner"}},
+				},
+			},
+		},
+		{
+			Name: "unvalidated service",
+			Statement: &IAMPolicyStatement{
+				Effect:     "Allow",
+				Actions:    "ec2:DescribeInstances",
+				Resources:  "arn:aws:ec2:*:*:instance/*",
+				Conditions: IAMPolicyStatementConditionSet{{Test: "StringEquals", Variable: "ec2:InstanceType", Values: "t3.micro"}},
+			},
+		},
+		{
+			Name: "misspelled action",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   []string{"s3:GetObjects", "s3:PutObject"},
+				Resources: "*",
+			},
+			ExpectedFindings: []string{"action (s3:GetObjects) matches no known action"},
+		},
+		{
+			Name: "no matching actions",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   []string{"s4:GetObject", "GetObject"},
+				Resources: "*",
+			},
+			ExpectedFindings: []string{
+				"action (s4:GetObject) has an unknown service prefix",
+				"action (GetObject) is not of the form service:action",
+				"statement can never match: none of its actions match a known action",
+			},
+		},
+		{
+			Name: "resources",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   "s3:GetObject",
+				Resources: []string{"arn:aws:s3:*", "arn:aws:s3:us-west-2:123456789012:example"},
+			},
+			ExpectedFindings: []string{
+				"resource (arn:aws:s3:*) matches no known ARN format of its service",
+				"resource (arn:aws:s3:us-west-2:123456789012:example) matches no known ARN format of its service",
+				"statement can never match: none of its resources match a known ARN format",
+			},
+		},
+		{
+			Name: "conditions",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Deny",
+				Actions:   "s3:*",
+				Resources: "*",
+				Conditions: IAMPolicyStatementConditionSet{
+					{Test: "StringEquals", Variable: "aws:SourceIpAddress", Values: "203.0.113.10"},
+					{Test: "StringEqualz", Variable: "s3:prefixes", Values: "home/"},
+				},
+			},
+			ExpectedFindings: []string{
+				"unknown condition key (aws:SourceIpAddress)",
+				"unknown condition operator (StringEqualz)",
+				"unknown condition key (s3:prefixes)",
+			},
+		},
+		{
+			Name: "full access",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Actions:   "*",
+				Resources: "*",
+			},
+			ExpectedFindings: []string{"statement allows all actions on all resources"},
+		},
+		{
+			Name: "full access with NotAction",
+			Statement: &IAMPolicyStatement{
+				Effect:     "Allow",
+				NotActions: "iam:*",
+				Resources:  "*",
+			},
+			ExpectedFindings: []string{"statement allows all actions except its not_actions on all resources"},
+		},
+		{
+			Name: "deny all",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Deny",
+				Actions:   "*",
+				Resources: "*",
+			},
+		},
+		{
+			Name: "no actions",
+			Statement: &IAMPolicyStatement{
+				Effect:    "Allow",
+				Resources: "arn:aws:s3:::example",
+			},
+			ExpectedFindings: []string{"statement has no actions or not_actions and can never match"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			doc := &IAMPolicyDoc{
+				Statements: []*IAMPolicyStatement{testCase.Statement},
+			}
+
+			var got []string
+			for _, finding := range validateIAMPolicyDoc(doc) {
+				got = append(got, finding.Summary)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedFindings) {
+				t.Errorf("got findings:\n%s\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(testCase.ExpectedFindings, "\n"))
+			}
+		})
+	}
+}
+
+func TestIamPolicyExpandActions(t *testing.T) {
+	got := iamPolicyExpandActions(&IAMPolicyStatement{
+		Actions: []string{"sts:AssumeRole*", "sts:AssumeRole", "s4:GetObject"},
+	})
+	expected := []string{
+		"s4:GetObject",
+		"sts:AssumeRole",
+		"sts:AssumeRoleWithSAML",
+		"sts:AssumeRoleWithWebIdentity",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
@@ -1,29 +1,62 @@
 package encryption
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"strings"
 
+	"github.com/keybase/go-crypto/openpgp/armor"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/vault/helper/pgpkeys"
 )
 
+const (
+	// filePrefix is the prefix of PGP key values that are the path of a file
+	// containing the key.
+	filePrefix = "file:"
+
+	// keybasePrefix is the prefix of PGP key values that are keybase usernames.
+	keybasePrefix = "keybase:"
+)
+
 // RetrieveGPGKey returns the PGP key specified as the pgpKey parameter, or queries
 // the public key from the keybase service if the parameter is a keybase username
-// prefixed with the phrase "keybase:"
+// prefixed with the phrase "keybase:", or reads the public key from a file if the
+// parameter is a path prefixed with the phrase "file:".
+// Keys may be base64 encoded or ASCII armored, and are returned base64 encoded.
 func RetrieveGPGKey(pgpKey string) (string, error) {
-	const keybasePrefix = "keybase:"
-
-	encryptionKey := pgpKey
-	if strings.HasPrefix(pgpKey, keybasePrefix) {
+	switch {
+	case strings.HasPrefix(pgpKey, keybasePrefix):
 		publicKeys, err := pgpkeys.FetchKeybasePubkeys([]string{pgpKey})
 		if err != nil {
 			return "", fmt.Errorf("Error retrieving Public Key for %s: %w", pgpKey, err)
 		}
-		encryptionKey = publicKeys[pgpKey]
+
+		return publicKeys[pgpKey], nil
+	case strings.HasPrefix(pgpKey, filePrefix):
+		path := strings.TrimPrefix(pgpKey, filePrefix)
+
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("Error reading Public Key file (%s): %w", path, err)
+		}
+
+		if isArmored(string(b)) {
+			return dearmor(string(b))
+		}
+
+		// Binary keys are read as is, base64 encoded keys as text.
+		if _, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(b))); err == nil {
+			return strings.TrimSpace(string(b)), nil
+		}
+
+		return base64.StdEncoding.EncodeToString(b), nil
+	case isArmored(pgpKey):
+		return dearmor(pgpKey)
 	}
 
-	return encryptionKey, nil
+	return pgpKey, nil
 }
 
 // EncryptValue encrypts the given value with the given encryption key. Description
@@ -37,3 +70,24 @@ func EncryptValue(encryptionKey, value, description string) (string, string, err
 
 	return fingerprints[0], base64.StdEncoding.EncodeToString(encryptedValue[0]), nil
 }
+
+// isArmored returns true if the PGP key is ASCII armored.
+func isArmored(pgpKey string) bool {
+	return strings.HasPrefix(strings.TrimSpace(pgpKey), "-----BEGIN PGP")
+}
+
+// dearmor returns the base64 encoding of an ASCII armored PGP key.
+func dearmor(pgpKey string) (string, error) {
+	block, err := armor.Decode(strings.NewReader(strings.TrimSpace(pgpKey)))
+	if err != nil {
+		return "", fmt.Errorf("Error decoding ASCII armored Public Key: %w", err)
+	}
+
+	var buf bytes.Buffer
+
+	if _, err := buf.ReadFrom(block.Body); err != nil {
+		return "", fmt.Errorf("Error reading ASCII armored Public Key: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
@@ -0,0 +1,147 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/keybase/go-crypto/openpgp"
+	"github.com/keybase/go-crypto/openpgp/armor"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/vault/helper/pgpkeys"
+)
+
+// testKeys returns a new PGP key pair, as the binary public key and the base64
+// encoded private key.
+func testKeys(t *testing.T) ([]byte, string) {
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+
+	if err != nil {
+		t.Fatalf("error generating PGP key: %s", err)
+	}
+
+	var privateKey bytes.Buffer
+
+	// Also signs the identities, which is required to serialize the public key.
+	if err := entity.SerializePrivate(&privateKey, nil); err != nil {
+		t.Fatalf("error serializing PGP private key: %s", err)
+	}
+
+	var publicKey bytes.Buffer
+
+	if err := entity.Serialize(&publicKey); err != nil {
+		t.Fatalf("error serializing PGP public key: %s", err)
+	}
+
+	return publicKey.Bytes(), base64.StdEncoding.EncodeToString(privateKey.Bytes())
+}
+
+func armored(t *testing.T, publicKey []byte) string {
+	var buf bytes.Buffer
+
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+
+	if err != nil {
+		t.Fatalf("error armoring PGP public key: %s", err)
+	}
+
+	if _, err := w.Write(publicKey); err != nil {
+		t.Fatalf("error armoring PGP public key: %s", err)
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("error armoring PGP public key: %s", err)
+	}
+
+	return buf.String() + "\n"
+}
+
+func TestRetrieveGPGKey(t *testing.T) {
+	publicKey, privateKey := testKeys(t)
+	encodedPublicKey := base64.StdEncoding.EncodeToString(publicKey)
+	armoredPublicKey := armored(t, publicKey)
+
+	dir, err := ioutil.TempDir("", "tf-encryption-test")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	files := map[string][]byte{
+		"binary.gpg":  publicKey,
+		"base64.txt":  []byte(encodedPublicKey + "\n"),
+		"armored.asc": []byte(armoredPublicKey),
+	}
+
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), content, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		TestName string
+		PgpKey   string
+	}{
+		{
+			TestName: "base64",
+			PgpKey:   encodedPublicKey,
+		},
+		{
+			TestName: "armored",
+			PgpKey:   armoredPublicKey,
+		},
+		{
+			TestName: "binary file",
+			PgpKey:   "file:" + filepath.Join(dir, "binary.gpg"),
+		},
+		{
+			TestName: "base64 file",
+			PgpKey:   "file:" + filepath.Join(dir, "base64.txt"),
+		},
+		{
+			TestName: "armored file",
+			PgpKey:   "file:" + filepath.Join(dir, "armored.asc"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := RetrieveGPGKey(testCase.PgpKey)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != encodedPublicKey {
+				t.Fatalf("got %s, expected %s", got, encodedPublicKey)
+			}
+
+			_, encrypted, err := EncryptValue(got, "test", "test value")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			decrypted, err := pgpkeys.DecryptBytes(encrypted, privateKey)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if decrypted.String() != "test" {
+				t.Errorf("got decrypted value %s, expected test", decrypted.String())
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		if _, err := RetrieveGPGKey("file:" + filepath.Join(dir, "missing.asc")); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+// KmsEnvelope is the JSON document, base64 encoded, returned by
+// EncryptValueWithKMS.
+//
+// The value is encrypted with AES-256-GCM using a data key generated by KMS.
+// To decrypt it, decrypt EncryptedDataKey with the KMS Decrypt API, then
+// decrypt Ciphertext with the resulting data key and Nonce.
+type KmsEnvelope struct {
+	// EncryptedDataKey is the base64 encoded KMS encrypted data key.
+	EncryptedDataKey string `json:"encrypted_data_key"`
+
+	// Nonce is the base64 encoded AES-GCM nonce.
+	Nonce string `json:"nonce"`
+
+	// Ciphertext is the base64 encoded AES-GCM ciphertext, including the
+	// authentication tag.
+	Ciphertext string `json:"ciphertext"`
+}
+
+// EncryptValueWithKMS encrypts the given value with envelope encryption using a
+// data key generated under the given KMS key. It returns the ARN of the KMS key
+// and the base64 encoded KmsEnvelope. Description should be set such that errors
+// return a meaningful user-facing response.
+func EncryptValueWithKMS(conn kmsiface.KMSAPI, keyID, value, description string) (string, string, error) {
+	output, err := conn.GenerateDataKey(&kms.GenerateDataKeyInput{
+		KeyId:   aws.String(keyID),
+		KeySpec: aws.String(kms.DataKeySpecAes256),
+	})
+
+	if err != nil {
+		return "", "", fmt.Errorf("Error generating KMS data key (%s) to encrypt %s: %w", keyID, description, err)
+	}
+
+	gcm, err := newGCM(output.Plaintext)
+
+	if err != nil {
+		return "", "", fmt.Errorf("Error encrypting %s: %w", description, err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return "", "", fmt.Errorf("Error encrypting %s: %w", description, err)
+	}
+
+	envelope := KmsEnvelope{
+		EncryptedDataKey: base64.StdEncoding.EncodeToString(output.CiphertextBlob),
+		Nonce:            base64.StdEncoding.EncodeToString(nonce),
+		Ciphertext:       base64.StdEncoding.EncodeToString(gcm.Seal(nil, nonce, []byte(value), nil)),
+	}
+
+	b, err := json.Marshal(envelope)
+
+	if err != nil {
+		return "", "", fmt.Errorf("Error encrypting %s: %w", description, err)
+	}
+
+	return aws.StringValue(output.KeyId), base64.StdEncoding.EncodeToString(b), nil
+}
+
+// DecryptValueWithKMS decrypts a value encrypted with EncryptValueWithKMS.
+func DecryptValueWithKMS(conn kmsiface.KMSAPI, encryptedValue string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(encryptedValue)
+
+	if err != nil {
+		return "", fmt.Errorf("error decoding base64 encrypted value: %w", err)
+	}
+
+	var envelope KmsEnvelope
+
+	if err := json.Unmarshal(b, &envelope); err != nil {
+		return "", fmt.Errorf("error parsing KMS envelope: %w", err)
+	}
+
+	encryptedDataKey, err := base64.StdEncoding.DecodeString(envelope.EncryptedDataKey)
+
+	if err != nil {
+		return "", fmt.Errorf("error decoding base64 encrypted data key: %w", err)
+	}
+
+	nonce, err := base64.StdEncoding.DecodeString(envelope.Nonce)
+
+	if err != nil {
+		return "", fmt.Errorf("error decoding base64 nonce: %w", err)
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(envelope.Ciphertext)
+
+	if err != nil {
+		return "", fmt.Errorf("error decoding base64 ciphertext: %w", err)
+	}
+
+	output, err := conn.Decrypt(&kms.DecryptInput{
+		CiphertextBlob: encryptedDataKey,
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("error decrypting KMS data key: %w", err)
+	}
+
+	gcm, err := newGCM(output.Plaintext)
+
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+
+	if err != nil {
+		return "", fmt.Errorf("error decrypting value: %w", err)
+	}
+
+	return string(plaintext), nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,111 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+const testKmsKeyArn = "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+// testKmsClient is a stand-in for KMS which "encrypts" data keys by prefixing
+// them with the key ARN.
+type testKmsClient struct {
+	kmsiface.KMSAPI
+}
+
+func (c *testKmsClient) GenerateDataKey(input *kms.GenerateDataKeyInput) (*kms.GenerateDataKeyOutput, error) {
+	if aws.StringValue(input.KeySpec) != kms.DataKeySpecAes256 {
+		return nil, errors.New("unexpected key spec")
+	}
+
+	plaintext := make([]byte, 32)
+
+	if _, err := rand.Read(plaintext); err != nil {
+		return nil, err
+	}
+
+	return &kms.GenerateDataKeyOutput{
+		CiphertextBlob: append([]byte(testKmsKeyArn), plaintext...),
+		KeyId:          aws.String(testKmsKeyArn),
+		Plaintext:      plaintext,
+	}, nil
+}
+
+func (c *testKmsClient) Decrypt(input *kms.DecryptInput) (*kms.DecryptOutput, error) {
+	if !bytes.HasPrefix(input.CiphertextBlob, []byte(testKmsKeyArn)) {
+		return nil, errors.New("invalid ciphertext")
+	}
+
+	return &kms.DecryptOutput{
+		KeyId:     aws.String(testKmsKeyArn),
+		Plaintext: bytes.TrimPrefix(input.CiphertextBlob, []byte(testKmsKeyArn)),
+	}, nil
+}
+
+func TestEncryptValueWithKMS(t *testing.T) {
+	conn := &testKmsClient{}
+
+	keyArn, encrypted, err := EncryptValueWithKMS(conn, "alias/test", "test", "test value")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if keyArn != testKmsKeyArn {
+		t.Errorf("got key ARN %s, expected %s", keyArn, testKmsKeyArn)
+	}
+
+	decrypted, err := DecryptValueWithKMS(conn, encrypted)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if decrypted != "test" {
+		t.Errorf("got decrypted value %s, expected test", decrypted)
+	}
+
+	_, other, err := EncryptValueWithKMS(conn, "alias/test", "test", "test value")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if other == encrypted {
+		t.Error("expected different data keys and nonces for each encryption")
+	}
+}
+
+func TestEncryptSecret(t *testing.T) {
+	conn := &testKmsClient{}
+
+	got, err := EncryptSecret(conn, "", "", "test", "test value")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != nil {
+		t.Errorf("got %#v, expected nil without keys", got)
+	}
+
+	got, err = EncryptSecret(conn, "", "alias/test", "test", "test value")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil || got.KmsKeyArn != testKmsKeyArn || got.KeyFingerprint != "" {
+		t.Fatalf("got %#v, expected KMS encrypted secret", got)
+	}
+
+	if decrypted, err := DecryptValueWithKMS(conn, got.Value); err != nil || decrypted != "test" {
+		t.Errorf("got decrypted value %s (%v), expected test", decrypted, err)
+	}
+}
@@ -0,0 +1,60 @@
+package encryption
+
+import (
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+// EncryptedSecret is a generated secret value encrypted by EncryptSecret.
+type EncryptedSecret struct {
+	// Value is the base64 encoded encrypted value.
+	Value string
+
+	// KeyFingerprint is the fingerprint of the PGP key used, if any.
+	KeyFingerprint string
+
+	// KmsKeyArn is the ARN of the KMS key used, if any.
+	KmsKeyArn string
+}
+
+// EncryptSecret encrypts a generated secret value, such as a password or secret
+// key output by a resource, with the PGP key if pgpKey is non-empty (see
+// RetrieveGPGKey), or else with KMS envelope encryption under the KMS key if
+// kmsKeyID is non-empty (see EncryptValueWithKMS).
+// It returns nil if neither is set, in which case the value should be output
+// unencrypted. Description should be set such that errors return a meaningful
+// user-facing response.
+func EncryptSecret(conn kmsiface.KMSAPI, pgpKey, kmsKeyID, value, description string) (*EncryptedSecret, error) {
+	if pgpKey != "" {
+		encryptionKey, err := RetrieveGPGKey(pgpKey)
+
+		if err != nil {
+			return nil, err
+		}
+
+		fingerprint, encrypted, err := EncryptValue(encryptionKey, value, description)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &EncryptedSecret{
+			Value:          encrypted,
+			KeyFingerprint: fingerprint,
+		}, nil
+	}
+
+	if kmsKeyID != "" {
+		keyArn, encrypted, err := EncryptValueWithKMS(conn, kmsKeyID, value, description)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &EncryptedSecret{
+			Value:     encrypted,
+			KmsKeyArn: keyArn,
+		}, nil
+	}
+
+	return nil, nil
+}
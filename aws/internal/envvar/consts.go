@@ -47,4 +47,8 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	TfAccAssumeRoleArn = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For recording (record) or replaying (replay) the AWS API requests of acceptance tests
+	// Cassettes are stored in aws/testdata/cassettes and tests must run serially
+	TfAwsRecord = "TF_AWS_RECORD"
 )
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	importFormatBlock   = "block"
+	importFormatCommand = "command"
+)
+
+// providerAttributes are top-level attributes derived from the provider
+// configuration, such as the per-resource region, which are omitted.
+var providerAttributes = map[string]bool{
+	"region":   true,
+	"tags_all": true,
+}
+
+// writeHCL writes a resource block for each resource, with the arguments
+// read by the provider. Computed-only, deprecated, default and provider
+// arguments are omitted, and sensitive arguments are replaced with a comment.
+func writeHCL(w io.Writer, resources []*Resource) error {
+	f := hclwrite.NewFile()
+	body := f.Body()
+
+	for i, r := range resources {
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		s := make(map[string]*schema.Schema, len(r.resource.Schema))
+		values := make(map[string]interface{}, len(r.resource.Schema))
+
+		for k, v := range r.resource.Schema {
+			if providerAttributes[k] {
+				continue
+			}
+
+			s[k] = v
+			values[k] = r.data.Get(k)
+		}
+
+		block := body.AppendNewBlock("resource", []string{r.Type, r.Name})
+		writeBody(block.Body(), s, values)
+	}
+
+	_, err := w.Write(f.Bytes())
+
+	return err
+}
+
+// writeImports writes the imports of the resources, either as import blocks
+// or as terraform import commands.
+func writeImports(w io.Writer, resources []*Resource, format string) error {
+	switch format {
+	case importFormatBlock:
+		f := hclwrite.NewFile()
+		body := f.Body()
+
+		for i, r := range resources {
+			if i > 0 {
+				body.AppendNewline()
+			}
+
+			block := body.AppendNewBlock("import", nil)
+			block.Body().SetAttributeTraversal("to", hcl.Traversal{
+				hcl.TraverseRoot{Name: r.Type},
+				hcl.TraverseAttr{Name: r.Name},
+			})
+			block.Body().SetAttributeValue("id", cty.StringVal(r.ImportID))
+		}
+
+		_, err := w.Write(f.Bytes())
+
+		return err
+	case importFormatCommand:
+		for _, r := range resources {
+			if _, err := fmt.Fprintf(w, "terraform import %s.%s %s\n", r.Type, r.Name, shellQuote(r.ImportID)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported import format: %s", format)
+	}
+}
+
+// writeBody writes the arguments, then the nested blocks, of a schema.
+func writeBody(body *hclwrite.Body, s map[string]*schema.Schema, values map[string]interface{}) {
+	keys := make([]string, 0, len(s))
+
+	for k := range s {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	written := make(map[string]bool)
+
+	for _, k := range keys {
+		v := s[k]
+
+		if !isArgument(v) || isBlock(v) || conflicts(v, written) {
+			continue
+		}
+
+		value, ok := argumentValue(v, values[k])
+
+		if !ok {
+			continue
+		}
+
+		written[k] = true
+
+		if v.Sensitive {
+			body.AppendUnstructuredTokens(hclwrite.Tokens{
+				{
+					Type:  hclsyntax.TokenComment,
+					Bytes: []byte(fmt.Sprintf("# %s is sensitive and must be set manually.\n", k)),
+				},
+			})
+			continue
+		}
+
+		body.SetAttributeValue(k, value)
+	}
+
+	for _, k := range keys {
+		v := s[k]
+
+		if !isArgument(v) || !isBlock(v) || conflicts(v, written) {
+			continue
+		}
+
+		elem := v.Elem.(*schema.Resource)
+
+		for _, raw := range listValues(values[k]) {
+			m, _ := raw.(map[string]interface{})
+			block := body.AppendNewBlock(k, nil)
+			writeBody(block.Body(), elem.Schema, m)
+		}
+	}
+}
+
+// isArgument returns whether the attribute can be set in configuration.
+func isArgument(v *schema.Schema) bool {
+	return (v.Required || v.Optional) && v.Deprecated == ""
+}
+
+// isBlock returns whether the attribute is configured with nested blocks.
+func isBlock(v *schema.Schema) bool {
+	if v.Type != schema.TypeList && v.Type != schema.TypeSet {
+		return false
+	}
+
+	if v.ConfigMode == schema.SchemaConfigModeAttr {
+		return false
+	}
+
+	_, ok := v.Elem.(*schema.Resource)
+
+	return ok
+}
+
+// conflicts returns whether the attribute conflicts with a written one.
+func conflicts(v *schema.Schema, written map[string]bool) bool {
+	for _, k := range v.ConflictsWith {
+		if written[k] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// argumentValue returns the value of an argument, and false if it should be
+// omitted because it is empty or its default.
+func argumentValue(v *schema.Schema, raw interface{}) (cty.Value, bool) {
+	if raw == nil {
+		return cty.NilVal, false
+	}
+
+	if v.Default != nil && reflect.DeepEqual(raw, v.Default) {
+		return cty.NilVal, false
+	}
+
+	switch v.Type {
+	case schema.TypeList, schema.TypeSet:
+		elemType := schema.TypeString
+
+		if elem, ok := v.Elem.(*schema.Schema); ok {
+			elemType = elem.Type
+		}
+
+		var elems []cty.Value
+
+		for _, raw := range listValues(raw) {
+			if value, ok := primitiveValue(elemType, raw); ok {
+				elems = append(elems, value)
+			}
+		}
+
+		if len(elems) == 0 {
+			return cty.ListValEmpty(cty.String), v.Required
+		}
+
+		return cty.ListVal(elems), true
+	case schema.TypeMap:
+		elemType := schema.TypeString
+
+		if elem, ok := v.Elem.(*schema.Schema); ok {
+			elemType = elem.Type
+		}
+
+		m, _ := raw.(map[string]interface{})
+		elems := make(map[string]cty.Value, len(m))
+
+		for k, raw := range m {
+			if value, ok := primitiveValue(elemType, raw); ok {
+				elems[k] = value
+			}
+		}
+
+		if len(elems) == 0 {
+			return cty.MapValEmpty(cty.String), v.Required
+		}
+
+		return cty.MapVal(elems), true
+	default:
+		value, ok := primitiveValue(v.Type, raw)
+
+		if !ok {
+			return cty.NilVal, false
+		}
+
+		// Zero values are omitted unless required, as they are equivalent to
+		// an unset argument.
+		if !v.Required && v.Default == nil && reflect.ValueOf(raw).IsZero() {
+			return cty.NilVal, false
+		}
+
+		return value, true
+	}
+}
+
+// primitiveValue returns the value of a primitive attribute.
+func primitiveValue(t schema.ValueType, raw interface{}) (cty.Value, bool) {
+	switch t {
+	case schema.TypeBool:
+		v, ok := raw.(bool)
+		return cty.BoolVal(v), ok
+	case schema.TypeFloat:
+		v, ok := raw.(float64)
+		return cty.NumberFloatVal(v), ok
+	case schema.TypeInt:
+		v, ok := raw.(int)
+		return cty.NumberIntVal(int64(v)), ok
+	case schema.TypeString:
+		v, ok := raw.(string)
+		return cty.StringVal(v), ok
+	default:
+		return cty.NilVal, false
+	}
+}
+
+// listValues returns the elements of a list or set attribute.
+func listValues(raw interface{}) []interface{} {
+	switch v := raw.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	default:
+		return nil
+	}
+}
+
+// shellQuote quotes s for POSIX shells.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Config configures the provider and the AWS client session used to list
+// resources.
+type Config struct {
+	// AccessKey and SecretKey, if set, are static credentials used instead of
+	// the default credential chain.
+	AccessKey string
+	SecretKey string
+
+	// Endpoint, if set, overrides the endpoint URL of all services, e.g. with
+	// a local stand-in. Credential and account validation are then skipped.
+	Endpoint string
+
+	Profile string
+	Region  string
+}
+
+// Inventory lists existing resources and reads them with the provider.
+type Inventory struct {
+	provider *schema.Provider
+	session  *session.Session
+}
+
+// Resource is an existing resource, as read by the provider.
+type Resource struct {
+	// ImportID is the ID to import the resource with.
+	ImportID string
+
+	// Name is the name of the resource in the generated configuration, unique
+	// among the resources of its type.
+	Name string
+
+	Type string
+
+	data     *schema.ResourceData
+	resource *schema.Resource
+}
+
+// New returns an Inventory reading resources with the provider, which it
+// configures in read-only mode.
+func New(ctx context.Context, provider *schema.Provider, config Config) (*Inventory, error) {
+	raw := map[string]interface{}{
+		"read_only": true,
+		"region":    config.Region,
+	}
+
+	if config.AccessKey != "" {
+		raw["access_key"] = config.AccessKey
+		raw["secret_key"] = config.SecretKey
+	}
+
+	if config.Profile != "" {
+		raw["profile"] = config.Profile
+	}
+
+	if config.Endpoint != "" {
+		raw["endpoint_url"] = config.Endpoint
+		raw["s3_force_path_style"] = true
+		raw["skip_credentials_validation"] = true
+		raw["skip_get_ec2_platforms"] = true
+		raw["skip_metadata_api_check"] = true
+		raw["skip_region_validation"] = true
+		raw["skip_requesting_account_id"] = true
+	}
+
+	if diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		return nil, fmt.Errorf("error configuring provider: %w", diagnosticsError(diags))
+	}
+
+	options := session.Options{
+		Config: aws.Config{
+			Region: aws.String(config.Region),
+		},
+		Profile:           config.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	}
+
+	if config.AccessKey != "" {
+		options.Config.Credentials = credentials.NewStaticCredentials(config.AccessKey, config.SecretKey, "")
+	}
+
+	if config.Endpoint != "" {
+		options.Config.Endpoint = aws.String(config.Endpoint)
+		options.Config.S3ForcePathStyle = aws.Bool(true)
+	}
+
+	sess, err := session.NewSessionWithOptions(options)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	inventory := &Inventory{
+		provider: provider,
+		session:  sess,
+	}
+
+	return inventory, nil
+}
+
+// ResourceTypes returns the supported resource types, sorted.
+func ResourceTypes() []string {
+	var types []string
+
+	for t := range listers {
+		types = append(types, t)
+	}
+
+	sort.Strings(types)
+
+	return types
+}
+
+// List lists and reads the existing resources of the given types. Resources
+// that cannot be read are reported in the returned error and skipped, so the
+// returned resources are usable even if an error is returned.
+func (inv *Inventory) List(ctx context.Context, resourceTypes []string) ([]*Resource, error) {
+	var resources []*Resource
+	var errs *multierror.Error
+
+	for _, resourceType := range resourceTypes {
+		list, ok := listers[resourceType]
+
+		if !ok {
+			errs = multierror.Append(errs, fmt.Errorf("unsupported resource type: %s", resourceType))
+			continue
+		}
+
+		r, ok := inv.provider.ResourcesMap[resourceType]
+
+		if !ok || r.Importer == nil {
+			errs = multierror.Append(errs, fmt.Errorf("resource type %s cannot be imported", resourceType))
+			continue
+		}
+
+		ids, err := list(ctx, inv.session)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing %s: %w", resourceType, err))
+		}
+
+		for _, id := range ids {
+			d, err := inv.read(ctx, resourceType, r, id)
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error reading %s (%s): %w", resourceType, id, err))
+				continue
+			}
+
+			// The resource no longer exists.
+			if d == nil {
+				continue
+			}
+
+			resources = append(resources, &Resource{
+				ImportID: id,
+				Type:     resourceType,
+				data:     d,
+				resource: r,
+			})
+		}
+	}
+
+	nameResources(resources)
+
+	return resources, errs.ErrorOrNil()
+}
+
+// read imports and reads a resource as Terraform does, returning nil if the
+// resource does not exist.
+func (inv *Inventory) read(ctx context.Context, resourceType string, r *schema.Resource, id string) (*schema.ResourceData, error) {
+	states, err := inv.provider.ImportState(ctx, &terraform.InstanceInfo{Type: resourceType}, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, state := range states {
+		// Importers may return the state of additional resources of other types.
+		if state.Ephemeral.Type != resourceType {
+			continue
+		}
+
+		state, diags := r.RefreshWithoutUpgrade(ctx, state, inv.provider.Meta())
+
+		if diags.HasError() {
+			return nil, diagnosticsError(diags)
+		}
+
+		if state == nil || state.ID == "" {
+			return nil, nil
+		}
+
+		return r.Data(state), nil
+	}
+
+	return nil, nil
+}
+
+// nameResources sets the names of the resources from their "name" attribute,
+// "Name" tag or import ID, made unique among the resources of each type.
+func nameResources(resources []*Resource) {
+	names := make(map[string]map[string]bool)
+
+	for _, r := range resources {
+		if names[r.Type] == nil {
+			names[r.Type] = make(map[string]bool)
+		}
+
+		base := resourceName(r.displayName())
+		name := base
+
+		for i := 2; names[r.Type][name]; i++ {
+			name = fmt.Sprintf("%s_%d", base, i)
+		}
+
+		names[r.Type][name] = true
+		r.Name = name
+	}
+}
+
+// displayName returns the "name" attribute or "Name" tag of the resource, if
+// any, or its import ID.
+func (r *Resource) displayName() string {
+	if _, ok := r.resource.Schema["name"]; ok {
+		if v, ok := r.data.Get("name").(string); ok && v != "" {
+			return v
+		}
+	}
+
+	if _, ok := r.resource.Schema["tags"]; ok {
+		if v, ok := r.data.Get("tags").(map[string]interface{})["Name"].(string); ok && v != "" {
+			return v
+		}
+	}
+
+	return r.ImportID
+}
+
+// resourceName returns a valid Terraform resource name derived from s.
+func resourceName(s string) string {
+	var b strings.Builder
+
+	for _, c := range strings.ToLower(s) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '_', c == '-':
+			b.WriteRune(c)
+		default:
+			b.WriteRune('_')
+		}
+	}
+
+	name := strings.Trim(b.String(), "_")
+
+	if name == "" {
+		return "r"
+	}
+
+	if c := name[0]; c < 'a' || c > 'z' {
+		name = "r_" + name
+	}
+
+	return name
+}
+
+// diagnosticsError returns the error diagnostics as an error, if any.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []string
+
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+
+		if d.Detail == "" {
+			errs = append(errs, d.Summary)
+		} else {
+			errs = append(errs, fmt.Sprintf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s", strings.Join(errs, "; "))
+}
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfaws "github.com/terraform-providers/terraform-provider-aws/aws"
+)
+
+func TestResourceName(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected string
+	}{
+		{"tf-acc-test", "tf-acc-test"},
+		{"My Bus", "my_bus"},
+		{"vpc-0123456789abcdef0", "vpc-0123456789abcdef0"},
+		{"0123", "r_0123"},
+		{"arn:aws:kinesisanalytics:us-west-2:123456789012:application/app", "arn_aws_kinesisanalytics_us-west-2_123456789012_application_app"},
+		{"/_/", "r"},
+	}
+
+	for _, testCase := range testCases {
+		if got := resourceName(testCase.Input); got != testCase.Expected {
+			t.Errorf("resourceName(%q) = %q, expected %q", testCase.Input, got, testCase.Expected)
+		}
+	}
+}
+
+func TestWriteHCL(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name_prefix"},
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+			},
+			"old": {
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "use name",
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	d := r.TestResourceData()
+	d.SetId("test")
+	d.Set("arn", "arn:aws:test:us-west-2:123456789012:test")
+	d.Set("count", 3)
+	d.Set("name", "test")
+	d.Set("name_prefix", "te")
+	d.Set("old", "test")
+	d.Set("password", "secret")
+	d.Set("rule", []interface{}{map[string]interface{}{"values": []interface{}{"a"}}})
+	d.Set("tags", map[string]interface{}{"Name": "test", "Environment Name": "dev"})
+
+	resources := []*Resource{
+		{
+			ImportID: "test",
+			Name:     "test",
+			Type:     "aws_test",
+			data:     d,
+			resource: r,
+		},
+	}
+
+	var buf bytes.Buffer
+
+	if err := writeHCL(&buf, resources); err != nil {
+		t.Fatalf("error writing HCL: %s", err)
+	}
+
+	expected := `resource "aws_test" "test" {
+  count = 3
+  name  = "test"
+  # password is sensitive and must be set manually.
+  tags = { "Environment Name" = "dev", Name = "test" }
+  rule {
+    values = ["a"]
+  }
+}
+`
+
+	if got := buf.String(); got != expected {
+		t.Errorf("unexpected HCL:\n%s\nexpected:\n%s", got, expected)
+	}
+}
+
+func TestWriteImports(t *testing.T) {
+	resources := []*Resource{
+		{
+			ImportID: "bus/rule",
+			Name:     "rule",
+			Type:     "aws_cloudwatch_event_rule",
+		},
+		{
+			ImportID: "it's",
+			Name:     "r_1",
+			Type:     "aws_cloudwatch_event_rule",
+		},
+	}
+
+	testCases := []struct {
+		Format   string
+		Expected string
+	}{
+		{
+			Format: importFormatBlock,
+			Expected: `import {
+  to = aws_cloudwatch_event_rule.rule
+  id = "bus/rule"
+}
+
+import {
+  to = aws_cloudwatch_event_rule.r_1
+  id = "it's"
+}
+`,
+		},
+		{
+			Format: importFormatCommand,
+			Expected: `terraform import aws_cloudwatch_event_rule.rule 'bus/rule'
+terraform import aws_cloudwatch_event_rule.r_1 'it'\''s'
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var buf bytes.Buffer
+
+		if err := writeImports(&buf, resources, testCase.Format); err != nil {
+			t.Fatalf("error writing %s imports: %s", testCase.Format, err)
+		}
+
+		if got := buf.String(); got != testCase.Expected {
+			t.Errorf("unexpected %s imports:\n%s\nexpected:\n%s", testCase.Format, got, testCase.Expected)
+		}
+	}
+}
+
+// TestInventoryList lists and reads event buses from a local stand-in of the
+// CloudWatch Events API.
+func TestInventoryList(t *testing.T) {
+	const arnPrefix = "arn:aws:events:us-west-2:123456789012:event-bus/"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var input map[string]interface{}
+
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var output interface{}
+
+		target := r.Header.Get("X-Amz-Target")
+
+		switch target {
+		case "AWSEvents.ListEventBuses":
+			output = map[string]interface{}{
+				"EventBuses": []interface{}{
+					map[string]interface{}{"Name": "default", "Arn": arnPrefix + "default"},
+					map[string]interface{}{"Name": "tf-acc-test", "Arn": arnPrefix + "tf-acc-test"},
+					map[string]interface{}{"Name": "tf acc test", "Arn": arnPrefix + "tf acc test"},
+				},
+			}
+		case "AWSEvents.DescribeEventBus":
+			output = map[string]interface{}{"Name": input["Name"], "Arn": arnPrefix + input["Name"].(string)}
+		case "AWSEvents.ListTagsForResource":
+			output = map[string]interface{}{
+				"Tags": []interface{}{
+					map[string]interface{}{"Key": "Environment", "Value": "test"},
+				},
+			}
+		default:
+			t.Errorf("unexpected operation: %s", target)
+			http.Error(w, target, http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+
+		if err := json.NewEncoder(w).Encode(output); err != nil {
+			t.Errorf("error encoding %s output: %s", target, err)
+		}
+	}))
+	defer server.Close()
+
+	config := Config{
+		AccessKey: "mock_access_key",
+		Endpoint:  server.URL,
+		Region:    "us-west-2",
+		SecretKey: "mock_secret_key",
+	}
+
+	ctx := context.Background()
+
+	inventory, err := New(ctx, tfaws.Provider(), config)
+
+	if err != nil {
+		t.Fatalf("error creating inventory: %s", err)
+	}
+
+	resources, err := inventory.List(ctx, []string{"aws_cloudwatch_event_bus"})
+
+	if err != nil {
+		t.Fatalf("error listing resources: %s", err)
+	}
+
+	var buf bytes.Buffer
+
+	if err := writeHCL(&buf, resources); err != nil {
+		t.Fatalf("error writing HCL: %s", err)
+	}
+
+	buf.WriteString("\n")
+
+	if err := writeImports(&buf, resources, importFormatBlock); err != nil {
+		t.Fatalf("error writing imports: %s", err)
+	}
+
+	expected := `resource "aws_cloudwatch_event_bus" "tf-acc-test" {
+  name = "tf-acc-test"
+  tags = { Environment = "test" }
+}
+
+resource "aws_cloudwatch_event_bus" "tf_acc_test" {
+  name = "tf acc test"
+  tags = { Environment = "test" }
+}
+
+import {
+  to = aws_cloudwatch_event_bus.tf-acc-test
+  id = "tf-acc-test"
+}
+
+import {
+  to = aws_cloudwatch_event_bus.tf_acc_test
+  id = "tf acc test"
+}
+`
+
+	if got := buf.String(); got != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", got, expected)
+	}
+}
+
+func TestInventoryListUnsupportedType(t *testing.T) {
+	inventory := &Inventory{provider: tfaws.Provider()}
+
+	if _, err := inventory.List(context.Background(), []string{"aws_unsupported"}); err == nil {
+		t.Error("expected an error listing an unsupported resource type")
+	}
+}
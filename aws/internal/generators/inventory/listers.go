@@ -0,0 +1,619 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	tfevents "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents"
+	eventslister "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/lister"
+	dslister "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/directoryservice/lister"
+	kalister "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalytics/lister"
+	kav2lister "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/lister"
+	waflister "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/waf/lister"
+	wafv2lister "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/wafv2/lister"
+)
+
+// listFunc returns the import IDs of the existing resources of a type.
+type listFunc func(ctx context.Context, sess *session.Session) ([]string, error)
+
+// listers are the list functions of the supported resource types.
+var listers = map[string]listFunc{
+	"aws_cloudwatch_event_bus":           listCloudWatchEventBuses,
+	"aws_cloudwatch_event_rule":          listCloudWatchEventRules,
+	"aws_cloudwatch_event_target":        listCloudWatchEventTargets,
+	"aws_directory_service_directory":    listDirectoryServiceDirectories,
+	"aws_kinesis_analytics_application":  listKinesisAnalyticsApplications,
+	"aws_kinesisanalyticsv2_application": listKinesisAnalyticsV2Applications,
+	"aws_security_group":                 listSecurityGroups,
+	"aws_subnet":                         listSubnets,
+	"aws_vpc":                            listVpcs,
+	"aws_waf_byte_match_set":             listWafByteMatchSets,
+	"aws_waf_geo_match_set":              listWafGeoMatchSets,
+	"aws_waf_ipset":                      listWafIPSets,
+	"aws_waf_rate_based_rule":            listWafRateBasedRules,
+	"aws_waf_regex_match_set":            listWafRegexMatchSets,
+	"aws_waf_regex_pattern_set":          listWafRegexPatternSets,
+	"aws_waf_rule":                       listWafRules,
+	"aws_waf_rule_group":                 listWafRuleGroups,
+	"aws_waf_size_constraint_set":        listWafSizeConstraintSets,
+	"aws_waf_sql_injection_match_set":    listWafSqlInjectionMatchSets,
+	"aws_waf_web_acl":                    listWafWebACLs,
+	"aws_waf_xss_match_set":              listWafXssMatchSets,
+	"aws_wafv2_ip_set":                   listWafv2IPSets,
+	"aws_wafv2_regex_pattern_set":        listWafv2RegexPatternSets,
+	"aws_wafv2_rule_group":               listWafv2RuleGroups,
+	"aws_wafv2_web_acl":                  listWafv2WebACLs,
+}
+
+// listCloudWatchEventBusNames returns the names of all event buses, including
+// the default event bus.
+func listCloudWatchEventBusNames(ctx context.Context, conn *events.CloudWatchEvents) ([]string, error) {
+	var names []string
+
+	err := eventslister.ListEventBusesPagesWithContext(ctx, conn, &events.ListEventBusesInput{}, func(page *events.ListEventBusesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, bus := range page.EventBuses {
+			names = append(names, aws.StringValue(bus.Name))
+		}
+
+		return !lastPage
+	})
+
+	return names, err
+}
+
+func listCloudWatchEventBuses(ctx context.Context, sess *session.Session) ([]string, error) {
+	names, err := listCloudWatchEventBusNames(ctx, events.New(sess))
+
+	var ids []string
+
+	for _, name := range names {
+		// The default event bus cannot be managed by Terraform.
+		if name == tfevents.DefaultEventBusName {
+			continue
+		}
+
+		ids = append(ids, name)
+	}
+
+	return ids, err
+}
+
+// listCloudWatchEventRuleNames returns the names of the rules of an event bus,
+// except the rules managed by other AWS services.
+func listCloudWatchEventRuleNames(ctx context.Context, conn *events.CloudWatchEvents, busName string) ([]string, error) {
+	var names []string
+
+	input := &events.ListRulesInput{
+		EventBusName: aws.String(busName),
+	}
+
+	err := eventslister.ListRulesPagesWithContext(ctx, conn, input, func(page *events.ListRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, rule := range page.Rules {
+			if rule.ManagedBy != nil {
+				continue
+			}
+
+			names = append(names, aws.StringValue(rule.Name))
+		}
+
+		return !lastPage
+	})
+
+	return names, err
+}
+
+func listCloudWatchEventRules(ctx context.Context, sess *session.Session) ([]string, error) {
+	conn := events.New(sess)
+
+	busNames, err := listCloudWatchEventBusNames(ctx, conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+
+	for _, busName := range busNames {
+		ruleNames, err := listCloudWatchEventRuleNames(ctx, conn, busName)
+
+		if err != nil {
+			return ids, err
+		}
+
+		for _, ruleName := range ruleNames {
+			ids = append(ids, tfevents.RuleCreateID(busName, ruleName))
+		}
+	}
+
+	return ids, nil
+}
+
+func listCloudWatchEventTargets(ctx context.Context, sess *session.Session) ([]string, error) {
+	conn := events.New(sess)
+
+	busNames, err := listCloudWatchEventBusNames(ctx, conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+
+	for _, busName := range busNames {
+		ruleNames, err := listCloudWatchEventRuleNames(ctx, conn, busName)
+
+		if err != nil {
+			return ids, err
+		}
+
+		for _, ruleName := range ruleNames {
+			// Import IDs of targets are the rule ID and the target ID, separated by "/".
+			ruleID := tfevents.RuleCreateID(busName, ruleName)
+
+			err := eventslister.ListAllTargetsForRulePages(conn, busName, ruleName, func(page *events.ListTargetsByRuleOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, target := range page.Targets {
+					ids = append(ids, strings.Join([]string{ruleID, aws.StringValue(target.Id)}, "/"))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				return ids, err
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+func listDirectoryServiceDirectories(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := dslister.DescribeDirectoriesPagesWithContext(ctx, directoryservice.New(sess), &directoryservice.DescribeDirectoriesInput{}, func(page *directoryservice.DescribeDirectoriesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, directory := range page.DirectoryDescriptions {
+			ids = append(ids, aws.StringValue(directory.DirectoryId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listKinesisAnalyticsApplications(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := kalister.ListApplicationsPages(kinesisanalytics.New(sess), &kinesisanalytics.ListApplicationsInput{}, func(page *kinesisanalytics.ListApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, application := range page.ApplicationSummaries {
+			ids = append(ids, aws.StringValue(application.ApplicationARN))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listKinesisAnalyticsV2Applications(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := kav2lister.ListApplicationsPagesWithContext(ctx, kinesisanalyticsv2.New(sess), &kinesisanalyticsv2.ListApplicationsInput{}, func(page *kinesisanalyticsv2.ListApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, application := range page.ApplicationSummaries {
+			ids = append(ids, aws.StringValue(application.ApplicationARN))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listSecurityGroups(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := ec2.New(sess).DescribeSecurityGroupsPagesWithContext(ctx, &ec2.DescribeSecurityGroupsInput{}, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, group := range page.SecurityGroups {
+			// Default security groups cannot be managed by aws_security_group.
+			if aws.StringValue(group.GroupName) == "default" {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(group.GroupId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listSubnets(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := ec2.New(sess).DescribeSubnetsPagesWithContext(ctx, &ec2.DescribeSubnetsInput{}, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, subnet := range page.Subnets {
+			if aws.BoolValue(subnet.DefaultForAz) {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(subnet.SubnetId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listVpcs(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := ec2.New(sess).DescribeVpcsPagesWithContext(ctx, &ec2.DescribeVpcsInput{}, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, vpc := range page.Vpcs {
+			if aws.BoolValue(vpc.IsDefault) {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(vpc.VpcId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafByteMatchSets(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := waflister.ListByteMatchSetsPagesWithContext(ctx, waf.New(sess), &waf.ListByteMatchSetsInput{}, func(page *waf.ListByteMatchSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, set := range page.ByteMatchSets {
+			ids = append(ids, aws.StringValue(set.ByteMatchSetId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafGeoMatchSets(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := waflister.ListGeoMatchSetsPagesWithContext(ctx, waf.New(sess), &waf.ListGeoMatchSetsInput{}, func(page *waf.ListGeoMatchSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, set := range page.GeoMatchSets {
+			ids = append(ids, aws.StringValue(set.GeoMatchSetId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafIPSets(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := waflister.ListIPSetsPagesWithContext(ctx, waf.New(sess), &waf.ListIPSetsInput{}, func(page *waf.ListIPSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, set := range page.IPSets {
+			ids = append(ids, aws.StringValue(set.IPSetId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafRateBasedRules(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := waflister.ListRateBasedRulesPagesWithContext(ctx, waf.New(sess), &waf.ListRateBasedRulesInput{}, func(page *waf.ListRateBasedRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, rule := range page.Rules {
+			ids = append(ids, aws.StringValue(rule.RuleId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafRegexMatchSets(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := waflister.ListRegexMatchSetsPagesWithContext(ctx, waf.New(sess), &waf.ListRegexMatchSetsInput{}, func(page *waf.ListRegexMatchSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, set := range page.RegexMatchSets {
+			ids = append(ids, aws.StringValue(set.RegexMatchSetId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafRegexPatternSets(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := waflister.ListRegexPatternSetsPagesWithContext(ctx, waf.New(sess), &waf.ListRegexPatternSetsInput{}, func(page *waf.ListRegexPatternSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, set := range page.RegexPatternSets {
+			ids = append(ids, aws.StringValue(set.RegexPatternSetId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafRules(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := waflister.ListRulesPagesWithContext(ctx, waf.New(sess), &waf.ListRulesInput{}, func(page *waf.ListRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, rule := range page.Rules {
+			ids = append(ids, aws.StringValue(rule.RuleId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafRuleGroups(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := waflister.ListRuleGroupsPagesWithContext(ctx, waf.New(sess), &waf.ListRuleGroupsInput{}, func(page *waf.ListRuleGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, group := range page.RuleGroups {
+			ids = append(ids, aws.StringValue(group.RuleGroupId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafSizeConstraintSets(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := waflister.ListSizeConstraintSetsPagesWithContext(ctx, waf.New(sess), &waf.ListSizeConstraintSetsInput{}, func(page *waf.ListSizeConstraintSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, set := range page.SizeConstraintSets {
+			ids = append(ids, aws.StringValue(set.SizeConstraintSetId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafSqlInjectionMatchSets(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := waflister.ListSqlInjectionMatchSetsPagesWithContext(ctx, waf.New(sess), &waf.ListSqlInjectionMatchSetsInput{}, func(page *waf.ListSqlInjectionMatchSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, set := range page.SqlInjectionMatchSets {
+			ids = append(ids, aws.StringValue(set.SqlInjectionMatchSetId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafWebACLs(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := waflister.ListWebACLsPagesWithContext(ctx, waf.New(sess), &waf.ListWebACLsInput{}, func(page *waf.ListWebACLsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, acl := range page.WebACLs {
+			ids = append(ids, aws.StringValue(acl.WebACLId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafXssMatchSets(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	err := waflister.ListXssMatchSetsPagesWithContext(ctx, waf.New(sess), &waf.ListXssMatchSetsInput{}, func(page *waf.ListXssMatchSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, set := range page.XssMatchSets {
+			ids = append(ids, aws.StringValue(set.XssMatchSetId))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+// wafv2ImportID returns the import ID of a regional WAFv2 resource.
+func wafv2ImportID(id, name *string) string {
+	return strings.Join([]string{aws.StringValue(id), aws.StringValue(name), wafv2.ScopeRegional}, "/")
+}
+
+func listWafv2IPSets(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	input := &wafv2.ListIPSetsInput{
+		Scope: aws.String(wafv2.ScopeRegional),
+	}
+
+	err := wafv2lister.ListIPSetsPagesWithContext(ctx, wafv2.New(sess), input, func(page *wafv2.ListIPSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, set := range page.IPSets {
+			ids = append(ids, wafv2ImportID(set.Id, set.Name))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafv2RegexPatternSets(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	input := &wafv2.ListRegexPatternSetsInput{
+		Scope: aws.String(wafv2.ScopeRegional),
+	}
+
+	err := wafv2lister.ListRegexPatternSetsPagesWithContext(ctx, wafv2.New(sess), input, func(page *wafv2.ListRegexPatternSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, set := range page.RegexPatternSets {
+			ids = append(ids, wafv2ImportID(set.Id, set.Name))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafv2RuleGroups(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	input := &wafv2.ListRuleGroupsInput{
+		Scope: aws.String(wafv2.ScopeRegional),
+	}
+
+	err := wafv2lister.ListRuleGroupsPagesWithContext(ctx, wafv2.New(sess), input, func(page *wafv2.ListRuleGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, group := range page.RuleGroups {
+			ids = append(ids, wafv2ImportID(group.Id, group.Name))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
+
+func listWafv2WebACLs(ctx context.Context, sess *session.Session) ([]string, error) {
+	var ids []string
+
+	input := &wafv2.ListWebACLsInput{
+		Scope: aws.String(wafv2.ScopeRegional),
+	}
+
+	err := wafv2lister.ListWebACLsPagesWithContext(ctx, wafv2.New(sess), input, func(page *wafv2.ListWebACLsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, acl := range page.WebACLs {
+			ids = append(ids, wafv2ImportID(acl.Id, acl.Name))
+		}
+
+		return !lastPage
+	})
+
+	return ids, err
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	tfaws "github.com/terraform-providers/terraform-provider-aws/aws"
+)
+
+var (
+	accessKey    = flag.String("access-key", "", "static access key, instead of the default credential chain")
+	endpoint     = flag.String("endpoint", "", "endpoint URL of all services, e.g. a local stand-in")
+	importFormat = flag.String("import-format", importFormatBlock, "format of the imports: block or command")
+	importOutput = flag.String("import-output", "", "file to write the imports to (default: the configuration output)")
+	listTypes    = flag.Bool("list-types", false, "list the supported resource types and exit")
+	output       = flag.String("output", "", "file to write the configuration to (default: standard output)")
+	profile      = flag.String("profile", "", "shared configuration profile")
+	region       = flag.String("region", os.Getenv("AWS_DEFAULT_REGION"), "region of the resources")
+	secretKey    = flag.String("secret-key", "", "static secret key, instead of the default credential chain")
+	types        = flag.String("types", "", "comma-separated resource types to list")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tinventory -types <resource-type>[,<resource-type>] [flags]\n")
+	fmt.Fprintf(os.Stderr, "\tinventory -list-types\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("inventory: ")
+	flag.Usage = usage
+	flag.Parse()
+
+	if *listTypes {
+		for _, t := range ResourceTypes() {
+			fmt.Println(t)
+		}
+		return
+	}
+
+	if *types == "" || *region == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *importFormat != importFormatBlock && *importFormat != importFormatCommand {
+		log.Fatalf("unsupported import format: %s", *importFormat)
+	}
+
+	ctx := context.Background()
+
+	config := Config{
+		AccessKey: *accessKey,
+		Endpoint:  *endpoint,
+		Profile:   *profile,
+		Region:    *region,
+		SecretKey: *secretKey,
+	}
+
+	inventory, err := New(ctx, tfaws.Provider(), config)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	resources, listErr := inventory.List(ctx, strings.Split(*types, ","))
+
+	// Resources that could be read are written even if others could not.
+	if listErr != nil {
+		log.Print(listErr)
+	}
+
+	if err := write(resources); err != nil {
+		log.Fatal(err)
+	}
+
+	if listErr != nil {
+		os.Exit(1)
+	}
+}
+
+// write writes the configuration and imports of the resources to their
+// outputs.
+func write(resources []*Resource) error {
+	var w io.Writer = os.Stdout
+
+	if *output != "" {
+		f, err := os.Create(*output)
+
+		if err != nil {
+			return err
+		}
+
+		defer f.Close()
+
+		w = f
+	}
+
+	if err := writeHCL(w, resources); err != nil {
+		return fmt.Errorf("error writing configuration: %w", err)
+	}
+
+	importWriter := w
+
+	if *importOutput != "" && *importOutput != *output {
+		f, err := os.Create(*importOutput)
+
+		if err != nil {
+			return err
+		}
+
+		defer f.Close()
+
+		importWriter = f
+	} else if len(resources) > 0 {
+		fmt.Fprintln(w)
+	}
+
+	if err := writeImports(importWriter, resources, *importFormat); err != nil {
+		return fmt.Errorf("error writing imports: %w", err)
+	}
+
+	return nil
+}
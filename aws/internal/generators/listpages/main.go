@@ -8,12 +8,13 @@ import (
 	"fmt"
 	"go/ast"
 	"go/format"
-	"html/template"
+	"go/types"
 	"io/ioutil"
 	"log"
 	"os"
 	"sort"
 	"strings"
+	"text/template"
 
 	"golang.org/x/tools/go/packages"
 )
@@ -23,14 +24,15 @@ const (
 )
 
 var (
-	functionNames = flag.String("function", "", "comma-separated list of API List functions; required")
+	functionNames = flag.String("function", "", "comma-separated list of API List functions, each optionally followed by \":<items-field>\"; required")
 	paginatorName = flag.String("paginator", "NextToken", "name of the pagination token field")
 	packageName   = flag.String("package", "", "override package name for generated code")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\tmain.go [flags] -function <function-name>[,<function-name>] <source-package>\n\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] -function <function-name>[:<items-field>][,<function-name>[:<items-field>]] <source-package>\n\n")
+	fmt.Fprintf(os.Stderr, "\tThe items field of each function output is detected if it is the only slice field.\n\n")
 	fmt.Fprintf(os.Stderr, "\tDestination package is read from the environment variable $GOPACKAGE by default. Override it with the flag -package.\n\n")
 	fmt.Fprintf(os.Stderr, "Flags:\n")
 	flag.PrintDefaults()
@@ -60,14 +62,25 @@ func main() {
 	}
 	g.parsePackage(sourcePackage)
 
-	g.printHeader(HeaderInfo{
+	var funcSpecs []FuncSpec
+	for _, function := range functions {
+		funcSpecs = append(funcSpecs, g.funcSpec(function))
+	}
+
+	headerInfo := HeaderInfo{
 		Parameters:         strings.Join(os.Args[1:], " "),
 		DestinationPackage: destinationPackage,
 		SourcePackage:      sourcePackage,
-	})
+	}
+	for _, funcSpec := range funcSpecs {
+		if !funcSpec.SDKPages {
+			headerInfo.ImportAWS = true
+		}
+	}
+	g.printHeader(headerInfo)
 
-	for _, functionName := range functions {
-		g.generateFunction(functionName)
+	for _, funcSpec := range funcSpecs {
+		g.generateFunction(funcSpec)
 	}
 
 	src := g.format()
@@ -79,6 +92,7 @@ func main() {
 }
 
 type HeaderInfo struct {
+	ImportAWS          bool
 	Parameters         string
 	DestinationPackage string
 	SourcePackage      string
@@ -145,43 +159,139 @@ type FuncSpec struct {
 	ParamType  string
 	ResultType string
 	Paginator  string
+	ItemsField string
+	ItemType   string
+	SDKPages   bool
+}
+
+// funcSpec returns the specification of a function, given as
+// <function-name>[:<items-field>].
+func (g *Generator) funcSpec(function string) FuncSpec {
+	functionName, itemsField := function, ""
+	if i := strings.Index(function, ":"); i >= 0 {
+		functionName, itemsField = function[:i], function[i+1:]
+	}
+
+	funcDecl := g.findFunc(functionName)
+	if funcDecl == nil {
+		log.Fatalf("function \"%s\" not found", functionName)
+	}
+
+	funcSpec := FuncSpec{
+		Name:       funcDecl.Name.Name,
+		RecvType:   g.expandTypeField(funcDecl.Recv),
+		ParamType:  g.expandTypeField(funcDecl.Type.Params),  // Assumes there is a single input parameter
+		ResultType: g.expandTypeField(funcDecl.Type.Results), // Assumes we can take the first return parameter
+		Paginator:  g.paginator,
+		SDKPages:   g.findFunc(functionName+"PagesWithContext") != nil,
+	}
+
+	resultTypeName := funcDecl.Type.Results.List[0].Type.(*ast.StarExpr).X.(*ast.Ident).Name
+	funcSpec.ItemsField, funcSpec.ItemType = g.itemsField(resultTypeName, itemsField)
+
+	return funcSpec
 }
 
-func (g *Generator) generateFunction(functionName string) {
-	var function *ast.FuncDecl
+func (g *Generator) findFunc(functionName string) *ast.FuncDecl {
+	for _, file := range g.pkg.files {
+		if file.file == nil {
+			continue
+		}
+		for _, decl := range file.file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok {
+				if funcDecl.Name.Name == functionName {
+					return funcDecl
+				}
+			}
+		}
+	}
+
+	return nil
+}
 
-	// TODO: check if a Pages() function has been defined
+func (g *Generator) findStruct(typeName string) *ast.StructType {
 	for _, file := range g.pkg.files {
-		if file.file != nil {
-			for _, decl := range file.file.Decls {
-				if funcDecl, ok := decl.(*ast.FuncDecl); ok {
-					if funcDecl.Name.Name == functionName {
-						function = funcDecl
-						break
+		if file.file == nil {
+			continue
+		}
+		for _, decl := range file.file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				if typeSpec, ok := spec.(*ast.TypeSpec); ok && typeSpec.Name.Name == typeName {
+					if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+						return structType
 					}
 				}
 			}
-			if function != nil {
-				break
+		}
+	}
+
+	return nil
+}
+
+// itemsField returns the name and element type of the items field of a
+// function output. If fieldName is empty, the field is the only slice field.
+func (g *Generator) itemsField(typeName, fieldName string) (string, string) {
+	structType := g.findStruct(typeName)
+	if structType == nil {
+		log.Fatalf("type \"%s\" not found", typeName)
+	}
+
+	var names, types []string
+	for _, field := range structType.Fields.List {
+		arrayType, ok := field.Type.(*ast.ArrayType)
+		if !ok {
+			continue
+		}
+		for _, name := range field.Names {
+			if fieldName != "" && name.Name != fieldName {
+				continue
 			}
+			names = append(names, name.Name)
+			types = append(types, g.expandElemType(arrayType.Elt))
 		}
 	}
 
-	if function == nil {
-		log.Fatalf("function \"%s\" not found", functionName)
+	switch {
+	case len(names) == 1:
+		return names[0], types[0]
+	case fieldName != "":
+		log.Fatalf("slice field \"%s\" not found in type \"%s\"", fieldName, typeName)
+	case len(names) == 0:
+		log.Fatalf("no slice field found in type \"%s\"", typeName)
+	default:
+		log.Fatalf("several slice fields (%s) found in type \"%s\", specify one with <function-name>:<items-field>", strings.Join(names, ", "), typeName)
 	}
 
-	funcSpec := FuncSpec{
-		Name:       function.Name.Name,
-		RecvType:   g.expandTypeField(function.Recv),
-		ParamType:  g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
-		ResultType: g.expandTypeField(function.Type.Results), // Assumes we can take the first return parameter
-		Paginator:  g.paginator,
+	return "", ""
+}
+
+func (g *Generator) expandElemType(expr ast.Expr) string {
+	star, ok := expr.(*ast.StarExpr)
+	if !ok {
+		log.Fatalf("Unexpected element type expression: (%[1]T) %[1]v", expr)
+	}
+
+	ident, ok := star.X.(*ast.Ident)
+	if !ok {
+		log.Fatalf("Unexpected element type expression: (%[1]T) %[1]v", star.X)
 	}
 
+	// Predeclared types, e.g. *string, are not qualified.
+	if types.Universe.Lookup(ident.Name) != nil {
+		return fmt.Sprintf("*%s", ident.Name)
+	}
+
+	return fmt.Sprintf("*%s", g.expandTypeExpr(ident))
+}
+
+func (g *Generator) generateFunction(funcSpec FuncSpec) {
 	err := g.tmpl.Execute(&g.buf, funcSpec)
 	if err != nil {
-		log.Fatalf("error writing function \"%s\": %s", functionName, err)
+		log.Fatalf("error writing function \"%s\": %s", funcSpec.Name, err)
 	}
 }
 
@@ -211,12 +321,13 @@ package {{ .DestinationPackage }}
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"{{ .SourcePackage }}"
+{{ if .ImportAWS }}	"github.com/aws/aws-sdk-go/aws"
+{{ end }}	"{{ .SourcePackage }}"
 )
 `
 
 const functionTemplate = `
+{{ if not .SDKPages }}
 
 func {{ .Name }}Pages(conn {{ .RecvType }}, input {{ .ParamType }}, fn func({{ .ResultType }}, bool) bool) error {
 	return {{ .Name }}PagesWithContext(context.Background(), conn, input, fn)
@@ -238,6 +349,55 @@ func {{ .Name }}PagesWithContext(ctx context.Context, conn {{ .RecvType }}, inpu
 	}
 	return nil
 }
+{{- end }}
+
+// {{ .Name }}Items calls fn with each item of the {{ .Name }} pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func {{ .Name }}Items(ctx context.Context, conn {{ .RecvType }}, input {{ .ParamType }}, fn func({{ .ItemType }}) bool) error {
+	{{ if .SDKPages }}err := conn.{{ .Name }}PagesWithContext(ctx, input, {{ else }}err := {{ .Name }}PagesWithContext(ctx, conn, input, {{ end }}func(page {{ .ResultType }}, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.{{ .ItemsField }} {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// {{ .Name }}All returns the items of all {{ .Name }} pages for which all
+// filters return true.
+func {{ .Name }}All(ctx context.Context, conn {{ .RecvType }}, input {{ .ParamType }}, filters ...func({{ .ItemType }}) bool) ([]{{ .ItemType }}, error) {
+	var items []{{ .ItemType }}
+
+	err := {{ .Name }}Items(ctx, conn, input, func(item {{ .ItemType }}) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
 `
 
 func (g *Generator) format() []byte {
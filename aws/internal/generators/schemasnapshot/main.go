@@ -0,0 +1,116 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	tfaws "github.com/terraform-providers/terraform-provider-aws/aws"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/schemasnapshot"
+)
+
+const defaultSnapshotFile = "aws/internal/schemasnapshot/snapshot.json"
+
+var (
+	check    = flag.Bool("check", false, "compare the provider schema with the snapshot instead of writing it")
+	failOn   = flag.String("fail-on", schemasnapshot.Breaking.String(), "least severe class of change failing the check: breaking, behavioral or additive")
+	snapshot = flag.String("snapshot", defaultSnapshotFile, "snapshot file")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tschemasnapshot [-snapshot <file>]\n")
+	fmt.Fprintf(os.Stderr, "\tschemasnapshot -check [-fail-on <class>] [-snapshot <file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("schemasnapshot: ")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	current := schemasnapshot.New(tfaws.Provider())
+
+	if !*check {
+		if err := writeSnapshot(*snapshot, current); err != nil {
+			log.Fatalf("error writing snapshot: %s", err)
+		}
+
+		return
+	}
+
+	threshold, err := parseClass(*failOn)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	f, err := os.Open(*snapshot)
+
+	if err != nil {
+		log.Fatalf("error opening snapshot: %s", err)
+	}
+
+	previous, err := schemasnapshot.Read(f)
+	f.Close()
+
+	if err != nil {
+		log.Fatalf("error reading snapshot (%s): %s", *snapshot, err)
+	}
+
+	changes := schemasnapshot.Diff(previous, current)
+	failed := false
+
+	for _, change := range changes {
+		fmt.Println(change)
+
+		if change.Class >= threshold {
+			failed = true
+		}
+	}
+
+	if len(changes) == 0 {
+		fmt.Println("No schema changes.")
+		return
+	}
+
+	fmt.Printf("\n%s changes.\n", schemasnapshot.Summary(changes))
+
+	if failed {
+		fmt.Printf("Schema changes classified %s or worse found. If they are intended, run 'make schemasnapshot' and commit.\n", threshold)
+		os.Exit(1)
+	}
+}
+
+func writeSnapshot(filename string, s *schemasnapshot.Snapshot) error {
+	f, err := os.Create(filename)
+
+	if err != nil {
+		return err
+	}
+
+	if err := s.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func parseClass(s string) (schemasnapshot.Class, error) {
+	for _, class := range []schemasnapshot.Class{schemasnapshot.Additive, schemasnapshot.Behavioral, schemasnapshot.Breaking} {
+		if s == class.String() {
+			return class, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unsupported class of change: %s", s)
+}
@@ -17,12 +17,107 @@ import (
 const filename = `get_tag_gen.go`
 
 var serviceNames = []string{
+	"accessanalyzer",
+	"acm",
+	"acmpca",
+	"amplify",
+	"apigatewayv2",
+	"appmesh",
+	"appstream",
+	"appsync",
+	"athena",
 	"autoscaling",
+	"backup",
 	"batch",
+	"cloud9",
+	"cloudfront",
+	"cloudhsmv2",
+	"cloudtrail",
+	"cloudwatch",
+	"cloudwatchevents",
+	"cloudwatchlogs",
+	"codeartifact",
+	"codecommit",
+	"codedeploy",
+	"codepipeline",
+	"codestarconnections",
+	"codestarnotifications",
+	"cognitoidentity",
+	"cognitoidentityprovider",
+	"configservice",
+	"databasemigrationservice",
+	"dataexchange",
+	"datasync",
+	"dax",
+	"devicefarm",
+	"directconnect",
+	"directoryservice",
+	"dlm",
+	"docdb",
 	"dynamodb",
 	"ec2",
+	"ecr",
 	"ecs",
+	"efs",
+	"eks",
+	"elasticache",
+	"elasticbeanstalk",
+	"elasticsearchservice",
+	"elb",
+	"elbv2",
+	"firehose",
+	"fsx",
+	"gamelift",
+	"glacier",
+	"globalaccelerator",
+	"glue",
+	"greengrass",
+	"guardduty",
+	"imagebuilder",
+	"iot",
+	"iotanalytics",
+	"iotevents",
+	"kafka",
+	"kinesis",
+	"kinesisanalytics",
+	"kinesisanalyticsv2",
+	"kinesisvideo",
+	"kms",
+	"lambda",
+	"licensemanager",
+	"mediaconnect",
+	"mediaconvert",
+	"medialive",
+	"mediapackage",
+	"mediastore",
+	"mq",
+	"neptune",
+	"networkfirewall",
+	"networkmanager",
+	"opsworks",
+	"organizations",
+	"pinpoint",
+	"qldb",
+	"quicksight",
+	"rds",
+	"resourcegroups",
 	"route53resolver",
+	"sagemaker",
+	"securityhub",
+	"servicediscovery",
+	"sfn",
+	"signer",
+	"sns",
+	"sqs",
+	"storagegateway",
+	"swf",
+	"transfer",
+	"waf",
+	"wafregional",
+	"wafv2",
+	"worklink",
+	"workspaces",
+	"xray",
 }
 
 type TemplateData struct {
@@ -0,0 +1,554 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+const (
+	docsFilenameFormat     = `../website/docs/r/%s_tag.html.markdown`
+	filename               = `tag_resources_gen.go`
+	resourceFilenameFormat = `resource_aws_%s_tag_gen.go`
+	testConfigFilename     = `resource_aws_%s_tag_test.go`
+	testFilenameFormat     = `resource_aws_%s_tag_gen_test.go`
+)
+
+// serviceNames lists the services with an aws_{SERVICE}_tag resource.
+// Each service must also be listed in the gettag and updatetags generators.
+var serviceNames = []string{
+	"accessanalyzer",
+	"acm",
+	"acmpca",
+	"amplify",
+	"apigatewayv2",
+	"appmesh",
+	"appstream",
+	"appsync",
+	"athena",
+	"backup",
+	"batch",
+	"cloud9",
+	"cloudfront",
+	"cloudhsmv2",
+	"cloudtrail",
+	"cloudwatch",
+	"cloudwatchevents",
+	"cloudwatchlogs",
+	"codeartifact",
+	"codecommit",
+	"codedeploy",
+	"codepipeline",
+	"codestarconnections",
+	"codestarnotifications",
+	"cognitoidentity",
+	"cognitoidentityprovider",
+	"configservice",
+	"databasemigrationservice",
+	"dataexchange",
+	"datasync",
+	"dax",
+	"devicefarm",
+	"directconnect",
+	"directoryservice",
+	"dlm",
+	"docdb",
+	"dynamodb",
+	"ecr",
+	"ecs",
+	"efs",
+	"eks",
+	"elasticache",
+	"elasticbeanstalk",
+	"elasticsearchservice",
+	"elb",
+	"elbv2",
+	"firehose",
+	"fsx",
+	"gamelift",
+	"glacier",
+	"globalaccelerator",
+	"glue",
+	"greengrass",
+	"guardduty",
+	"imagebuilder",
+	"iot",
+	"iotanalytics",
+	"iotevents",
+	"kafka",
+	"kinesis",
+	"kinesisanalytics",
+	"kinesisanalyticsv2",
+	"kinesisvideo",
+	"kms",
+	"lambda",
+	"licensemanager",
+	"mediaconnect",
+	"mediaconvert",
+	"medialive",
+	"mediapackage",
+	"mediastore",
+	"mq",
+	"neptune",
+	"networkfirewall",
+	"networkmanager",
+	"opsworks",
+	"organizations",
+	"pinpoint",
+	"qldb",
+	"quicksight",
+	"rds",
+	"resourcegroups",
+	"route53resolver",
+	"sagemaker",
+	"securityhub",
+	"servicediscovery",
+	"sfn",
+	"signer",
+	"sns",
+	"sqs",
+	"storagegateway",
+	"swf",
+	"transfer",
+	"waf",
+	"wafregional",
+	"wafv2",
+	"worklink",
+	"workspaces",
+	"xray",
+}
+
+type TemplateData struct {
+	ServiceNames []string
+}
+
+type ServiceTemplateData struct {
+	ServiceName string
+}
+
+func main() {
+	// Always sort to reduce any potential generation churn
+	sort.Strings(serviceNames)
+
+	templateFuncMap := template.FuncMap{
+		"ConnFunction":    keyvaluetags.ServiceConnFunction,
+		"DocsSubcategory": keyvaluetags.ServiceDocsSubcategory,
+		"Title":           strings.Title,
+	}
+
+	writeTemplate(filename, templateBody, templateFuncMap, TemplateData{ServiceNames: serviceNames}, true)
+
+	for _, serviceName := range serviceNames {
+		templateData := ServiceTemplateData{ServiceName: serviceName}
+
+		writeTemplate(fmt.Sprintf(resourceFilenameFormat, serviceName), resourceTemplateBody, templateFuncMap, templateData, true)
+		writeTemplate(fmt.Sprintf(docsFilenameFormat, serviceName), docsTemplateBody, templateFuncMap, templateData, false)
+
+		// Acceptance tests are only generated for services with a hand written
+		// testAcc{SERVICE}TagConfig() function, which configures the tagged resource.
+		if _, err := os.Stat(fmt.Sprintf(testConfigFilename, serviceName)); err == nil {
+			writeTemplate(fmt.Sprintf(testFilenameFormat, serviceName), testTemplateBody, templateFuncMap, templateData, true)
+		}
+	}
+}
+
+func writeTemplate(filename string, body string, templateFuncMap template.FuncMap, templateData interface{}, formatSource bool) {
+	tmpl, err := template.New("tagresources").Funcs(templateFuncMap).Parse(body)
+
+	if err != nil {
+		log.Fatalf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	generatedFileContents := buffer.Bytes()
+
+	if formatSource {
+		generatedFileContents, err = format.Source(generatedFileContents)
+
+		if err != nil {
+			log.Fatalf("error formatting generated file (%s): %s", filename, err)
+		}
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		log.Fatalf("error creating file (%s): %s", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+}
+
+var templateBody = `
+// Code generated by internal/keyvaluetags/generators/tagresources/main.go; DO NOT EDIT.
+
+package aws
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// tagResources returns the generated aws_{SERVICE}_tag resources.
+func tagResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+{{- range .ServiceNames }}
+		"aws_{{ . }}_tag": resourceAws{{ . | Title }}Tag(),
+{{- end }}
+	}
+}
+`
+
+var resourceTemplateBody = `
+// Code generated by internal/keyvaluetags/generators/tagresources/main.go; DO NOT EDIT.
+
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+{{- with .ServiceName }}
+
+func resourceAws{{ . | Title }}Tag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAws{{ . | Title }}TagCreate,
+		Read:   resourceAws{{ . | Title }}TagRead,
+		Update: resourceAws{{ . | Title }}TagUpdate,
+		Delete: resourceAws{{ . | Title }}TagDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAws{{ . | Title }}TagCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).{{ . | ConnFunction }}()
+
+	identifier := d.Get("resource_id").(string)
+	key := d.Get("key").(string)
+	value := d.Get("value").(string)
+
+	if err := keyvaluetags.{{ . | Title }}UpdateTags(conn, identifier, nil, map[string]string{key: value}); err != nil {
+		return fmt.Errorf("error creating {{ . }} service tag (%s) for resource (%s): %w", key, identifier, err)
+	}
+
+	d.SetId(tagResourceID(identifier, key))
+
+	return resourceAws{{ . | Title }}TagRead(d, meta)
+}
+
+func resourceAws{{ . | Title }}TagRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).{{ . | ConnFunction }}()
+	identifier, key, err := tagResourceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	exists, value, err := keyvaluetags.{{ . | Title }}GetTag(conn, identifier, key)
+
+	if err != nil {
+		return fmt.Errorf("error reading {{ . }} service tag (%s) for resource (%s): %w", key, identifier, err)
+	}
+
+	if !exists {
+		log.Printf("[WARN] {{ . }} service tag (%s) for resource (%s) not found, removing from state", key, identifier)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("key", key)
+	d.Set("resource_id", identifier)
+	d.Set("value", value)
+
+	return nil
+}
+
+func resourceAws{{ . | Title }}TagUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).{{ . | ConnFunction }}()
+	identifier, key, err := tagResourceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if err := keyvaluetags.{{ . | Title }}UpdateTags(conn, identifier, nil, map[string]string{key: d.Get("value").(string)}); err != nil {
+		return fmt.Errorf("error updating {{ . }} service tag (%s) for resource (%s): %w", key, identifier, err)
+	}
+
+	return resourceAws{{ . | Title }}TagRead(d, meta)
+}
+
+func resourceAws{{ . | Title }}TagDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).{{ . | ConnFunction }}()
+	identifier, key, err := tagResourceParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if err := keyvaluetags.{{ . | Title }}UpdateTags(conn, identifier, map[string]string{key: d.Get("value").(string)}, nil); err != nil {
+		return fmt.Errorf("error deleting {{ . }} service tag (%s) for resource (%s): %w", key, identifier, err)
+	}
+
+	return nil
+}
+{{- end }}
+`
+
+var testTemplateBody = `
+// Code generated by internal/keyvaluetags/generators/tagresources/main.go; DO NOT EDIT.
+
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+{{- with .ServiceName }}
+
+func TestAccAWS{{ . | Title }}Tag_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_{{ . }}_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheck{{ . | Title }}TagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAcc{{ . | Title }}TagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{ . | Title }}TagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWS{{ . | Title }}Tag_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_{{ . }}_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheck{{ . | Title }}TagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAcc{{ . | Title }}TagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{ . | Title }}TagExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAws{{ . | Title }}Tag(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWS{{ . | Title }}Tag_Value(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_{{ . }}_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheck{{ . | Title }}TagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAcc{{ . | Title }}TagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{ . | Title }}TagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAcc{{ . | Title }}TagConfig(rName, "key1", "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{ . | Title }}TagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheck{{ . | Title }}TagDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).{{ . | ConnFunction }}()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_{{ . }}_tag" {
+			continue
+		}
+
+		identifier, key, err := tagResourceParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		exists, _, err := keyvaluetags.{{ . | Title }}GetTag(conn, identifier, key)
+
+		// The tagged resource is destroyed with the tag, and the error returned
+		// for a missing resource differs between services.
+		if err != nil {
+			continue
+		}
+
+		if exists {
+			return fmt.Errorf("Tag (%s) for resource (%s) still exists", key, identifier)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheck{{ . | Title }}TagExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		identifier, key, err := tagResourceParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).{{ . | ConnFunction }}()
+
+		exists, _, err := keyvaluetags.{{ . | Title }}GetTag(conn, identifier, key)
+
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return fmt.Errorf("Tag (%s) for resource (%s) not found", key, identifier)
+		}
+
+		return nil
+	}
+}
+{{- end }}
+`
+
+var docsTemplateBody = `---
+subcategory: "{{ .ServiceName | DocsSubcategory }}"
+layout: "aws"
+page_title: "AWS: aws_{{ .ServiceName }}_tag"
+description: |-
+  Manages an individual {{ .ServiceName }} service resource tag
+---
+
+<!-- Code generated by internal/keyvaluetags/generators/tagresources/main.go; DO NOT EDIT. -->
+
+# Resource: aws_{{ .ServiceName }}_tag
+
+Manages an individual {{ .ServiceName }} service resource tag. This resource should only be used in cases where {{ .ServiceName }} service resources are created outside Terraform (e.g. resources implicitly created by other AWS services).
+
+~> **NOTE:** This tagging resource should not be combined with the Terraform resource for managing the parent resource. For example, using a resource with a ` + "`tags`" + ` argument and ` + "`aws_{{ .ServiceName }}_tag`" + ` to manage tags of the same resource will cause a perpetual difference where the ` + "`aws_{{ .ServiceName }}_tag`" + ` resource will try to create the tag while the other resource will try to remove it.
+
+~> **NOTE:** This tagging resource does not use the [provider ` + "`ignore_tags`" + ` configuration](/docs/providers/aws/index.html#ignore_tags).
+
+## Example Usage
+
+` + "```hcl" + `
+resource "aws_{{ .ServiceName }}_tag" "example" {
+  resource_id = "arn:aws:{{ .ServiceName }}:us-west-2:123456789012:example"
+  key         = "Name"
+  value       = "Hello World"
+}
+` + "```" + `
+
+## Argument Reference
+
+The following arguments are supported:
+
+* ` + "`resource_id`" + ` - (Required) The identifier of the {{ .ServiceName }} service resource to tag. This is typically the Amazon Resource Name (ARN), although it may also be a different identifier depending on the service.
+* ` + "`key`" + ` - (Required) The tag name.
+* ` + "`value`" + ` - (Required) The value of the tag.
+
+## Attributes Reference
+
+In addition to all arguments above, the following attributes are exported:
+
+* ` + "`id`" + ` - {{ .ServiceName }} service resource identifier and key, separated by a comma (` + "`,`" + `)
+
+## Import
+
+` + "`aws_{{ .ServiceName }}_tag`" + ` can be imported by using the resource identifier and key, separated by a comma (` + "`,`" + `), e.g.
+
+` + "```" + `
+$ terraform import aws_{{ .ServiceName }}_tag.example arn:aws:{{ .ServiceName }}:us-west-2:123456789012:example,Name
+` + "```" + `
+`
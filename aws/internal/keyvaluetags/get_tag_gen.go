@@ -4,14 +4,244 @@ package keyvaluetags
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codeartifact"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/aws/aws-sdk-go/service/codestarnotifications"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/dataexchange"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/docdb"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/signer"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/worklink"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/xray"
 )
 
+// AccessanalyzerGetTag fetches an individual accessanalyzer service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over AccessanalyzerListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func AccessanalyzerGetTag(conn *accessanalyzer.AccessAnalyzer, identifier string, key string) (bool, *string, error) {
+	listTags, err := AccessanalyzerListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// AcmGetTag fetches an individual acm service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over AcmListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func AcmGetTag(conn *acm.ACM, identifier string, key string) (bool, *string, error) {
+	listTags, err := AcmListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// AcmpcaGetTag fetches an individual acmpca service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over AcmpcaListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func AcmpcaGetTag(conn *acmpca.ACMPCA, identifier string, key string) (bool, *string, error) {
+	listTags, err := AcmpcaListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// AmplifyGetTag fetches an individual amplify service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over AmplifyListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func AmplifyGetTag(conn *amplify.Amplify, identifier string, key string) (bool, *string, error) {
+	listTags, err := AmplifyListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// Apigatewayv2GetTag fetches an individual apigatewayv2 service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over Apigatewayv2ListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Apigatewayv2GetTag(conn *apigatewayv2.ApiGatewayV2, identifier string, key string) (bool, *string, error) {
+	listTags, err := Apigatewayv2ListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// AppmeshGetTag fetches an individual appmesh service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over AppmeshListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func AppmeshGetTag(conn *appmesh.AppMesh, identifier string, key string) (bool, *string, error) {
+	listTags, err := AppmeshListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// AppstreamGetTag fetches an individual appstream service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over AppstreamListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func AppstreamGetTag(conn *appstream.AppStream, identifier string, key string) (bool, *string, error) {
+	listTags, err := AppstreamListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// AppsyncGetTag fetches an individual appsync service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over AppsyncListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func AppsyncGetTag(conn *appsync.AppSync, identifier string, key string) (bool, *string, error) {
+	listTags, err := AppsyncListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// AthenaGetTag fetches an individual athena service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over AthenaListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func AthenaGetTag(conn *athena.Athena, identifier string, key string) (bool, *string, error) {
+	listTags, err := AthenaListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
 // AutoscalingGetTag fetches an individual autoscaling service tag for a resource.
 // Returns whether the key exists, the key value, and any errors.
 // This function will optimise the handling over AutoscalingListTags, if possible.
@@ -42,6 +272,21 @@ func AutoscalingGetTag(conn *autoscaling.AutoScaling, identifier string, resourc
 	return listTags.KeyExists(key), listTags.KeyTagData(key), nil
 }
 
+// BackupGetTag fetches an individual backup service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over BackupListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func BackupGetTag(conn *backup.Backup, identifier string, key string) (bool, *string, error) {
+	listTags, err := BackupListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
 // BatchGetTag fetches an individual batch service tag for a resource.
 // Returns whether the key exists, the key value, and any errors.
 // This function will optimise the handling over BatchListTags, if possible.
@@ -57,13 +302,13 @@ func BatchGetTag(conn *batch.Batch, identifier string, key string) (bool, *strin
 	return listTags.KeyExists(key), listTags.KeyValue(key), nil
 }
 
-// DynamodbGetTag fetches an individual dynamodb service tag for a resource.
+// Cloud9GetTag fetches an individual cloud9 service tag for a resource.
 // Returns whether the key exists, the key value, and any errors.
-// This function will optimise the handling over DynamodbListTags, if possible.
+// This function will optimise the handling over Cloud9ListTags, if possible.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func DynamodbGetTag(conn *dynamodb.DynamoDB, identifier string, key string) (bool, *string, error) {
-	listTags, err := DynamodbListTags(conn, identifier)
+func Cloud9GetTag(conn *cloud9.Cloud9, identifier string, key string) (bool, *string, error) {
+	listTags, err := Cloud9ListTags(conn, identifier)
 
 	if err != nil {
 		return false, nil, err
@@ -72,43 +317,58 @@ func DynamodbGetTag(conn *dynamodb.DynamoDB, identifier string, key string) (boo
 	return listTags.KeyExists(key), listTags.KeyValue(key), nil
 }
 
-// Ec2GetTag fetches an individual ec2 service tag for a resource.
+// CloudfrontGetTag fetches an individual cloudfront service tag for a resource.
 // Returns whether the key exists, the key value, and any errors.
-// This function will optimise the handling over Ec2ListTags, if possible.
+// This function will optimise the handling over CloudfrontListTags, if possible.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func Ec2GetTag(conn *ec2.EC2, identifier string, key string) (bool, *string, error) {
-	input := &ec2.DescribeTagsInput{
-		Filters: []*ec2.Filter{
-			{
-				Name:   aws.String("resource-id"),
-				Values: []*string{aws.String(identifier)},
-			},
-			{
-				Name:   aws.String("key"),
-				Values: []*string{aws.String(key)},
-			},
-		},
+func CloudfrontGetTag(conn *cloudfront.CloudFront, identifier string, key string) (bool, *string, error) {
+	listTags, err := CloudfrontListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
 	}
 
-	output, err := conn.DescribeTags(input)
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// Cloudhsmv2GetTag fetches an individual cloudhsmv2 service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over Cloudhsmv2ListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Cloudhsmv2GetTag(conn *cloudhsmv2.CloudHSMV2, identifier string, key string) (bool, *string, error) {
+	listTags, err := Cloudhsmv2ListTags(conn, identifier)
 
 	if err != nil {
 		return false, nil, err
 	}
 
-	listTags := Ec2KeyValueTags(output.Tags)
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// CloudtrailGetTag fetches an individual cloudtrail service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over CloudtrailListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func CloudtrailGetTag(conn *cloudtrail.CloudTrail, identifier string, key string) (bool, *string, error) {
+	listTags, err := CloudtrailListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
 
 	return listTags.KeyExists(key), listTags.KeyValue(key), nil
 }
 
-// EcsGetTag fetches an individual ecs service tag for a resource.
+// CloudwatchGetTag fetches an individual cloudwatch service tag for a resource.
 // Returns whether the key exists, the key value, and any errors.
-// This function will optimise the handling over EcsListTags, if possible.
+// This function will optimise the handling over CloudwatchListTags, if possible.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func EcsGetTag(conn *ecs.ECS, identifier string, key string) (bool, *string, error) {
-	listTags, err := EcsListTags(conn, identifier)
+func CloudwatchGetTag(conn *cloudwatch.CloudWatch, identifier string, key string) (bool, *string, error) {
+	listTags, err := CloudwatchListTags(conn, identifier)
 
 	if err != nil {
 		return false, nil, err
@@ -117,13 +377,1273 @@ func EcsGetTag(conn *ecs.ECS, identifier string, key string) (bool, *string, err
 	return listTags.KeyExists(key), listTags.KeyValue(key), nil
 }
 
-// Route53resolverGetTag fetches an individual route53resolver service tag for a resource.
+// CloudwatcheventsGetTag fetches an individual cloudwatchevents service tag for a resource.
 // Returns whether the key exists, the key value, and any errors.
-// This function will optimise the handling over Route53resolverListTags, if possible.
+// This function will optimise the handling over CloudwatcheventsListTags, if possible.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func Route53resolverGetTag(conn *route53resolver.Route53Resolver, identifier string, key string) (bool, *string, error) {
-	listTags, err := Route53resolverListTags(conn, identifier)
+func CloudwatcheventsGetTag(conn *cloudwatchevents.CloudWatchEvents, identifier string, key string) (bool, *string, error) {
+	listTags, err := CloudwatcheventsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// CloudwatchlogsGetTag fetches an individual cloudwatchlogs service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over CloudwatchlogsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func CloudwatchlogsGetTag(conn *cloudwatchlogs.CloudWatchLogs, identifier string, key string) (bool, *string, error) {
+	listTags, err := CloudwatchlogsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// CodeartifactGetTag fetches an individual codeartifact service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over CodeartifactListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func CodeartifactGetTag(conn *codeartifact.CodeArtifact, identifier string, key string) (bool, *string, error) {
+	listTags, err := CodeartifactListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// CodecommitGetTag fetches an individual codecommit service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over CodecommitListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func CodecommitGetTag(conn *codecommit.CodeCommit, identifier string, key string) (bool, *string, error) {
+	listTags, err := CodecommitListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// CodedeployGetTag fetches an individual codedeploy service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over CodedeployListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func CodedeployGetTag(conn *codedeploy.CodeDeploy, identifier string, key string) (bool, *string, error) {
+	listTags, err := CodedeployListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// CodepipelineGetTag fetches an individual codepipeline service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over CodepipelineListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func CodepipelineGetTag(conn *codepipeline.CodePipeline, identifier string, key string) (bool, *string, error) {
+	listTags, err := CodepipelineListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// CodestarconnectionsGetTag fetches an individual codestarconnections service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over CodestarconnectionsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func CodestarconnectionsGetTag(conn *codestarconnections.CodeStarConnections, identifier string, key string) (bool, *string, error) {
+	listTags, err := CodestarconnectionsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// CodestarnotificationsGetTag fetches an individual codestarnotifications service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over CodestarnotificationsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func CodestarnotificationsGetTag(conn *codestarnotifications.CodeStarNotifications, identifier string, key string) (bool, *string, error) {
+	listTags, err := CodestarnotificationsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// CognitoidentityGetTag fetches an individual cognitoidentity service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over CognitoidentityListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func CognitoidentityGetTag(conn *cognitoidentity.CognitoIdentity, identifier string, key string) (bool, *string, error) {
+	listTags, err := CognitoidentityListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// CognitoidentityproviderGetTag fetches an individual cognitoidentityprovider service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over CognitoidentityproviderListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func CognitoidentityproviderGetTag(conn *cognitoidentityprovider.CognitoIdentityProvider, identifier string, key string) (bool, *string, error) {
+	listTags, err := CognitoidentityproviderListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// ConfigserviceGetTag fetches an individual configservice service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over ConfigserviceListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ConfigserviceGetTag(conn *configservice.ConfigService, identifier string, key string) (bool, *string, error) {
+	listTags, err := ConfigserviceListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// DatabasemigrationserviceGetTag fetches an individual databasemigrationservice service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over DatabasemigrationserviceListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DatabasemigrationserviceGetTag(conn *databasemigrationservice.DatabaseMigrationService, identifier string, key string) (bool, *string, error) {
+	listTags, err := DatabasemigrationserviceListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// DataexchangeGetTag fetches an individual dataexchange service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over DataexchangeListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DataexchangeGetTag(conn *dataexchange.DataExchange, identifier string, key string) (bool, *string, error) {
+	listTags, err := DataexchangeListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// DatasyncGetTag fetches an individual datasync service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over DatasyncListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DatasyncGetTag(conn *datasync.DataSync, identifier string, key string) (bool, *string, error) {
+	listTags, err := DatasyncListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// DaxGetTag fetches an individual dax service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over DaxListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DaxGetTag(conn *dax.DAX, identifier string, key string) (bool, *string, error) {
+	listTags, err := DaxListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// DevicefarmGetTag fetches an individual devicefarm service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over DevicefarmListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DevicefarmGetTag(conn *devicefarm.DeviceFarm, identifier string, key string) (bool, *string, error) {
+	listTags, err := DevicefarmListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// DirectconnectGetTag fetches an individual directconnect service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over DirectconnectListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DirectconnectGetTag(conn *directconnect.DirectConnect, identifier string, key string) (bool, *string, error) {
+	listTags, err := DirectconnectListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// DirectoryserviceGetTag fetches an individual directoryservice service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over DirectoryserviceListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DirectoryserviceGetTag(conn *directoryservice.DirectoryService, identifier string, key string) (bool, *string, error) {
+	listTags, err := DirectoryserviceListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// DlmGetTag fetches an individual dlm service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over DlmListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DlmGetTag(conn *dlm.DLM, identifier string, key string) (bool, *string, error) {
+	listTags, err := DlmListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// DocdbGetTag fetches an individual docdb service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over DocdbListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DocdbGetTag(conn *docdb.DocDB, identifier string, key string) (bool, *string, error) {
+	listTags, err := DocdbListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// DynamodbGetTag fetches an individual dynamodb service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over DynamodbListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func DynamodbGetTag(conn *dynamodb.DynamoDB, identifier string, key string) (bool, *string, error) {
+	listTags, err := DynamodbListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// Ec2GetTag fetches an individual ec2 service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over Ec2ListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Ec2GetTag(conn *ec2.EC2, identifier string, key string) (bool, *string, error) {
+	input := &ec2.DescribeTagsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("resource-id"),
+				Values: []*string{aws.String(identifier)},
+			},
+			{
+				Name:   aws.String("key"),
+				Values: []*string{aws.String(key)},
+			},
+		},
+	}
+
+	output, err := conn.DescribeTags(input)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	listTags := Ec2KeyValueTags(output.Tags)
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// EcrGetTag fetches an individual ecr service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over EcrListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func EcrGetTag(conn *ecr.ECR, identifier string, key string) (bool, *string, error) {
+	listTags, err := EcrListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// EcsGetTag fetches an individual ecs service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over EcsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func EcsGetTag(conn *ecs.ECS, identifier string, key string) (bool, *string, error) {
+	listTags, err := EcsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// EfsGetTag fetches an individual efs service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over EfsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func EfsGetTag(conn *efs.EFS, identifier string, key string) (bool, *string, error) {
+	listTags, err := EfsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// EksGetTag fetches an individual eks service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over EksListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func EksGetTag(conn *eks.EKS, identifier string, key string) (bool, *string, error) {
+	listTags, err := EksListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// ElasticacheGetTag fetches an individual elasticache service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over ElasticacheListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ElasticacheGetTag(conn *elasticache.ElastiCache, identifier string, key string) (bool, *string, error) {
+	listTags, err := ElasticacheListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// ElasticbeanstalkGetTag fetches an individual elasticbeanstalk service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over ElasticbeanstalkListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ElasticbeanstalkGetTag(conn *elasticbeanstalk.ElasticBeanstalk, identifier string, key string) (bool, *string, error) {
+	listTags, err := ElasticbeanstalkListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// ElasticsearchserviceGetTag fetches an individual elasticsearchservice service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over ElasticsearchserviceListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ElasticsearchserviceGetTag(conn *elasticsearchservice.ElasticsearchService, identifier string, key string) (bool, *string, error) {
+	listTags, err := ElasticsearchserviceListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// ElbGetTag fetches an individual elb service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over ElbListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ElbGetTag(conn *elb.ELB, identifier string, key string) (bool, *string, error) {
+	listTags, err := ElbListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// Elbv2GetTag fetches an individual elbv2 service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over Elbv2ListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Elbv2GetTag(conn *elbv2.ELBV2, identifier string, key string) (bool, *string, error) {
+	listTags, err := Elbv2ListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// FirehoseGetTag fetches an individual firehose service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over FirehoseListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func FirehoseGetTag(conn *firehose.Firehose, identifier string, key string) (bool, *string, error) {
+	listTags, err := FirehoseListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// FsxGetTag fetches an individual fsx service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over FsxListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func FsxGetTag(conn *fsx.FSx, identifier string, key string) (bool, *string, error) {
+	listTags, err := FsxListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// GameliftGetTag fetches an individual gamelift service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over GameliftListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func GameliftGetTag(conn *gamelift.GameLift, identifier string, key string) (bool, *string, error) {
+	listTags, err := GameliftListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// GlacierGetTag fetches an individual glacier service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over GlacierListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func GlacierGetTag(conn *glacier.Glacier, identifier string, key string) (bool, *string, error) {
+	listTags, err := GlacierListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// GlobalacceleratorGetTag fetches an individual globalaccelerator service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over GlobalacceleratorListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func GlobalacceleratorGetTag(conn *globalaccelerator.GlobalAccelerator, identifier string, key string) (bool, *string, error) {
+	listTags, err := GlobalacceleratorListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// GlueGetTag fetches an individual glue service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over GlueListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func GlueGetTag(conn *glue.Glue, identifier string, key string) (bool, *string, error) {
+	listTags, err := GlueListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// GreengrassGetTag fetches an individual greengrass service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over GreengrassListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func GreengrassGetTag(conn *greengrass.Greengrass, identifier string, key string) (bool, *string, error) {
+	listTags, err := GreengrassListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// GuarddutyGetTag fetches an individual guardduty service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over GuarddutyListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func GuarddutyGetTag(conn *guardduty.GuardDuty, identifier string, key string) (bool, *string, error) {
+	listTags, err := GuarddutyListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// ImagebuilderGetTag fetches an individual imagebuilder service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over ImagebuilderListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ImagebuilderGetTag(conn *imagebuilder.Imagebuilder, identifier string, key string) (bool, *string, error) {
+	listTags, err := ImagebuilderListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// IotGetTag fetches an individual iot service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over IotListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func IotGetTag(conn *iot.IoT, identifier string, key string) (bool, *string, error) {
+	listTags, err := IotListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// IotanalyticsGetTag fetches an individual iotanalytics service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over IotanalyticsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func IotanalyticsGetTag(conn *iotanalytics.IoTAnalytics, identifier string, key string) (bool, *string, error) {
+	listTags, err := IotanalyticsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// IoteventsGetTag fetches an individual iotevents service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over IoteventsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func IoteventsGetTag(conn *iotevents.IoTEvents, identifier string, key string) (bool, *string, error) {
+	listTags, err := IoteventsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// KafkaGetTag fetches an individual kafka service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over KafkaListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func KafkaGetTag(conn *kafka.Kafka, identifier string, key string) (bool, *string, error) {
+	listTags, err := KafkaListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// KinesisGetTag fetches an individual kinesis service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over KinesisListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func KinesisGetTag(conn *kinesis.Kinesis, identifier string, key string) (bool, *string, error) {
+	listTags, err := KinesisListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// KinesisanalyticsGetTag fetches an individual kinesisanalytics service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over KinesisanalyticsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func KinesisanalyticsGetTag(conn *kinesisanalytics.KinesisAnalytics, identifier string, key string) (bool, *string, error) {
+	listTags, err := KinesisanalyticsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// Kinesisanalyticsv2GetTag fetches an individual kinesisanalyticsv2 service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over Kinesisanalyticsv2ListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Kinesisanalyticsv2GetTag(conn *kinesisanalyticsv2.KinesisAnalyticsV2, identifier string, key string) (bool, *string, error) {
+	listTags, err := Kinesisanalyticsv2ListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// KinesisvideoGetTag fetches an individual kinesisvideo service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over KinesisvideoListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func KinesisvideoGetTag(conn *kinesisvideo.KinesisVideo, identifier string, key string) (bool, *string, error) {
+	listTags, err := KinesisvideoListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// KmsGetTag fetches an individual kms service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over KmsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func KmsGetTag(conn *kms.KMS, identifier string, key string) (bool, *string, error) {
+	listTags, err := KmsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// LambdaGetTag fetches an individual lambda service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over LambdaListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func LambdaGetTag(conn *lambda.Lambda, identifier string, key string) (bool, *string, error) {
+	listTags, err := LambdaListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// LicensemanagerGetTag fetches an individual licensemanager service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over LicensemanagerListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func LicensemanagerGetTag(conn *licensemanager.LicenseManager, identifier string, key string) (bool, *string, error) {
+	listTags, err := LicensemanagerListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// MediaconnectGetTag fetches an individual mediaconnect service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over MediaconnectListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func MediaconnectGetTag(conn *mediaconnect.MediaConnect, identifier string, key string) (bool, *string, error) {
+	listTags, err := MediaconnectListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// MediaconvertGetTag fetches an individual mediaconvert service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over MediaconvertListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func MediaconvertGetTag(conn *mediaconvert.MediaConvert, identifier string, key string) (bool, *string, error) {
+	listTags, err := MediaconvertListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// MedialiveGetTag fetches an individual medialive service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over MedialiveListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func MedialiveGetTag(conn *medialive.MediaLive, identifier string, key string) (bool, *string, error) {
+	listTags, err := MedialiveListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// MediapackageGetTag fetches an individual mediapackage service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over MediapackageListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func MediapackageGetTag(conn *mediapackage.MediaPackage, identifier string, key string) (bool, *string, error) {
+	listTags, err := MediapackageListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// MediastoreGetTag fetches an individual mediastore service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over MediastoreListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func MediastoreGetTag(conn *mediastore.MediaStore, identifier string, key string) (bool, *string, error) {
+	listTags, err := MediastoreListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// MqGetTag fetches an individual mq service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over MqListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func MqGetTag(conn *mq.MQ, identifier string, key string) (bool, *string, error) {
+	listTags, err := MqListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// NeptuneGetTag fetches an individual neptune service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over NeptuneListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func NeptuneGetTag(conn *neptune.Neptune, identifier string, key string) (bool, *string, error) {
+	listTags, err := NeptuneListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// NetworkfirewallGetTag fetches an individual networkfirewall service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over NetworkfirewallListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func NetworkfirewallGetTag(conn *networkfirewall.NetworkFirewall, identifier string, key string) (bool, *string, error) {
+	listTags, err := NetworkfirewallListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// NetworkmanagerGetTag fetches an individual networkmanager service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over NetworkmanagerListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func NetworkmanagerGetTag(conn *networkmanager.NetworkManager, identifier string, key string) (bool, *string, error) {
+	listTags, err := NetworkmanagerListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// OpsworksGetTag fetches an individual opsworks service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over OpsworksListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func OpsworksGetTag(conn *opsworks.OpsWorks, identifier string, key string) (bool, *string, error) {
+	listTags, err := OpsworksListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// OrganizationsGetTag fetches an individual organizations service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over OrganizationsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func OrganizationsGetTag(conn *organizations.Organizations, identifier string, key string) (bool, *string, error) {
+	listTags, err := OrganizationsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// PinpointGetTag fetches an individual pinpoint service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over PinpointListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func PinpointGetTag(conn *pinpoint.Pinpoint, identifier string, key string) (bool, *string, error) {
+	listTags, err := PinpointListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// QldbGetTag fetches an individual qldb service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over QldbListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func QldbGetTag(conn *qldb.QLDB, identifier string, key string) (bool, *string, error) {
+	listTags, err := QldbListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// QuicksightGetTag fetches an individual quicksight service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over QuicksightListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func QuicksightGetTag(conn *quicksight.QuickSight, identifier string, key string) (bool, *string, error) {
+	listTags, err := QuicksightListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// RdsGetTag fetches an individual rds service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over RdsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func RdsGetTag(conn *rds.RDS, identifier string, key string) (bool, *string, error) {
+	listTags, err := RdsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// ResourcegroupsGetTag fetches an individual resourcegroups service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over ResourcegroupsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ResourcegroupsGetTag(conn *resourcegroups.ResourceGroups, identifier string, key string) (bool, *string, error) {
+	listTags, err := ResourcegroupsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// Route53resolverGetTag fetches an individual route53resolver service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over Route53resolverListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Route53resolverGetTag(conn *route53resolver.Route53Resolver, identifier string, key string) (bool, *string, error) {
+	listTags, err := Route53resolverListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// SagemakerGetTag fetches an individual sagemaker service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over SagemakerListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SagemakerGetTag(conn *sagemaker.SageMaker, identifier string, key string) (bool, *string, error) {
+	listTags, err := SagemakerListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// SecurityhubGetTag fetches an individual securityhub service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over SecurityhubListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SecurityhubGetTag(conn *securityhub.SecurityHub, identifier string, key string) (bool, *string, error) {
+	listTags, err := SecurityhubListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// ServicediscoveryGetTag fetches an individual servicediscovery service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over ServicediscoveryListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func ServicediscoveryGetTag(conn *servicediscovery.ServiceDiscovery, identifier string, key string) (bool, *string, error) {
+	listTags, err := ServicediscoveryListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// SfnGetTag fetches an individual sfn service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over SfnListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SfnGetTag(conn *sfn.SFN, identifier string, key string) (bool, *string, error) {
+	listTags, err := SfnListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// SignerGetTag fetches an individual signer service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over SignerListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SignerGetTag(conn *signer.Signer, identifier string, key string) (bool, *string, error) {
+	listTags, err := SignerListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// SnsGetTag fetches an individual sns service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over SnsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SnsGetTag(conn *sns.SNS, identifier string, key string) (bool, *string, error) {
+	listTags, err := SnsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// SqsGetTag fetches an individual sqs service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over SqsListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SqsGetTag(conn *sqs.SQS, identifier string, key string) (bool, *string, error) {
+	listTags, err := SqsListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// StoragegatewayGetTag fetches an individual storagegateway service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over StoragegatewayListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func StoragegatewayGetTag(conn *storagegateway.StorageGateway, identifier string, key string) (bool, *string, error) {
+	listTags, err := StoragegatewayListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// SwfGetTag fetches an individual swf service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over SwfListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func SwfGetTag(conn *swf.SWF, identifier string, key string) (bool, *string, error) {
+	listTags, err := SwfListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// TransferGetTag fetches an individual transfer service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over TransferListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func TransferGetTag(conn *transfer.Transfer, identifier string, key string) (bool, *string, error) {
+	listTags, err := TransferListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// WafGetTag fetches an individual waf service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over WafListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func WafGetTag(conn *waf.WAF, identifier string, key string) (bool, *string, error) {
+	listTags, err := WafListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// WafregionalGetTag fetches an individual wafregional service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over WafregionalListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func WafregionalGetTag(conn *wafregional.WAFRegional, identifier string, key string) (bool, *string, error) {
+	listTags, err := WafregionalListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// Wafv2GetTag fetches an individual wafv2 service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over Wafv2ListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func Wafv2GetTag(conn *wafv2.WAFV2, identifier string, key string) (bool, *string, error) {
+	listTags, err := Wafv2ListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// WorklinkGetTag fetches an individual worklink service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over WorklinkListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func WorklinkGetTag(conn *worklink.WorkLink, identifier string, key string) (bool, *string, error) {
+	listTags, err := WorklinkListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// WorkspacesGetTag fetches an individual workspaces service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over WorkspacesListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func WorkspacesGetTag(conn *workspaces.WorkSpaces, identifier string, key string) (bool, *string, error) {
+	listTags, err := WorkspacesListTags(conn, identifier)
+
+	if err != nil {
+		return false, nil, err
+	}
+
+	return listTags.KeyExists(key), listTags.KeyValue(key), nil
+}
+
+// XrayGetTag fetches an individual xray service tag for a resource.
+// Returns whether the key exists, the key value, and any errors.
+// This function will optimise the handling over XrayListTags, if possible.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func XrayGetTag(conn *xray.XRay, identifier string, key string) (bool, *string, error) {
+	listTags, err := XrayListTags(conn, identifier)
 
 	if err != nil {
 		return false, nil, err
@@ -25,10 +25,47 @@ const (
 	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
 )
 
+// DefaultConfig contains tags to default across all resources.
+type DefaultConfig struct {
+	Tags KeyValueTags
+}
+
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeyRegexes  []*regexp.Regexp
+	KeyValues   KeyValueTags
+}
+
+// MergeTags returns the result of keyvaluetags.Merge() on the given
+// DefaultConfig.Tags with KeyValueTags provided as an argument,
+// overriding the value of any tag with a matching key.
+func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	return dc.Tags.Merge(tags)
+}
+
+// TagsEqual returns true if the given configuration's Tags
+// are equal to those passed in as an argument;
+// otherwise returns false.
+func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
+	if dc == nil || dc.Tags == nil {
+		return tags == nil
+	}
+
+	if tags == nil {
+		return false
+	}
+
+	if len(tags) == 0 {
+		return len(dc.Tags) == 0
+	}
+
+	return dc.Tags.ContainsAll(tags) && tags.ContainsAll(dc.Tags)
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -58,6 +95,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreRegexes(config.KeyRegexes)
+	result = result.IgnoreKeyValues(config.KeyValues)
 
 	return result
 }
@@ -162,6 +201,64 @@ func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
 	return result
 }
 
+// IgnoreKeyValues returns non-matching tag keys and values.
+// Tags are only ignored if both the key and the value match.
+func (tags KeyValueTags) IgnoreKeyValues(ignoreTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+	ignoreValues := ignoreTags.Map()
+	values := tags.Map()
+
+	for k, v := range tags {
+		if ignoreValue, ok := ignoreValues[k]; ok && ignoreValue == values[k] {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreRegexes returns tag keys not matching any of the given regular expressions.
+func (tags KeyValueTags) IgnoreRegexes(ignoreTagRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, ignoreTagRegex := range ignoreTagRegexes {
+			if ignoreTagRegex.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if !ignore {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// RemoveDefaultConfig returns tags not present in a DefaultConfig object
+// in addition to tags with key/value pairs that override those in a DefaultConfig.
+func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if defaultVal, ok := dc.Tags[k]; !ok || !v.Equal(defaultVal) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
 // KeyAdditionalBoolValue returns the boolean value of an additional tag field.
 // If the key or additional field is not found, returns nil.
 func (tags KeyValueTags) KeyAdditionalBoolValue(key string, fieldName string) *bool {
@@ -436,10 +533,12 @@ func (tags KeyValueTags) UrlEncode() string {
 }
 
 // New creates KeyValueTags from common Terraform Provider SDK types.
-// Supports map[string]string, map[string]*string, map[string]interface{}, and []interface{}.
+// Supports KeyValueTags, map[string]string, map[string]*string, map[string]interface{}, and []interface{}.
 // When passed []interface{}, all elements are treated as keys and assigned nil values.
 func New(i interface{}) KeyValueTags {
 	switch value := i.(type) {
+	case KeyValueTags:
+		return make(KeyValueTags).Merge(value)
 	case map[string]*TagData:
 		kvtm := make(KeyValueTags, len(value))
 
@@ -1,9 +1,161 @@
 package keyvaluetags
 
 import (
+	"regexp"
 	"testing"
 )
 
+func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "empty config",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "no tags",
+			tags: New(map[string]string{}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "keys all matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys some matching with resource override",
+			tags: New(map[string]string{
+				"key1": "value1updated",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.MergeTags(testCase.tags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          bool
+	}{
+		{
+			name:          "nil both",
+			tags:          nil,
+			defaultConfig: nil,
+			want:          true,
+		},
+		{
+			name:          "nil config",
+			tags:          New(map[string]string{"key1": "value1"}),
+			defaultConfig: nil,
+			want:          false,
+		},
+		{
+			name:          "nil tags",
+			tags:          nil,
+			defaultConfig: &DefaultConfig{Tags: New(map[string]string{"key1": "value1"})},
+			want:          false,
+		},
+		{
+			name:          "empty tags",
+			tags:          New(map[string]string{}),
+			defaultConfig: &DefaultConfig{Tags: New(map[string]string{"key1": "value1"})},
+			want:          false,
+		},
+		{
+			name:          "equal",
+			tags:          New(map[string]string{"key1": "value1", "key2": "value2"}),
+			defaultConfig: &DefaultConfig{Tags: New(map[string]string{"key1": "value1", "key2": "value2"})},
+			want:          true,
+		},
+		{
+			name:          "different value",
+			tags:          New(map[string]string{"key1": "value1", "key2": "value2updated"}),
+			defaultConfig: &DefaultConfig{Tags: New(map[string]string{"key1": "value1", "key2": "value2"})},
+			want:          false,
+		},
+		{
+			name:          "subset",
+			tags:          New(map[string]string{"key1": "value1"}),
+			defaultConfig: &DefaultConfig{Tags: New(map[string]string{"key1": "value1", "key2": "value2"})},
+			want:          false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.TagsEqual(testCase.tags)
+
+			if got != testCase.want {
+				t.Errorf("got %t; want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAws(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -246,6 +398,68 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes some matching",
+			tags: New(map[string]string{
+				"ScanResult-2024-03-01": "value1",
+				"ScanResult-2024-04-01": "value2",
+				"key3":                  "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^ScanResult-2024-03-`),
+				},
+			},
+			want: map[string]string{
+				"ScanResult-2024-04-01": "value2",
+				"key3":                  "value3",
+			},
+		},
+		{
+			name: "key values some matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyValues: New(map[string]string{
+					"key1": "value1",
+					"key2": "other",
+				}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "all matchers",
+			tags: New(map[string]string{
+				"key1":   "value1",
+				"key2":   "value2",
+				"key3":   "value3",
+				"Owner":  "auto",
+				"Scan-1": "value5",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{
+					"key1",
+				}),
+				KeyPrefixes: New([]string{
+					"key2",
+				}),
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^Scan-\d+$`),
+				},
+				KeyValues: New(map[string]string{
+					"Owner": "auto",
+				}),
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -533,6 +747,214 @@ func TestKeyValueTagsIgnore(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreKeyValues(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tags       KeyValueTags
+		ignoreTags KeyValueTags
+		want       map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			ignoreTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "",
+			}),
+			ignoreTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value3",
+				"key3": "",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTags: New(map[string]string{
+				"key1": "value2",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreKeyValues(testCase.ignoreTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreRegexes(t *testing.T) {
+	testCases := []struct {
+		name             string
+		tags             KeyValueTags
+		ignoreTagRegexes []*regexp.Regexp
+		want             map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`key`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^key\d$`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1":  "value1",
+				"key2":  "value2",
+				"other": "value3",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`1$`),
+				regexp.MustCompile(`^ot`),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^value`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreRegexes(testCase.ignoreTagRegexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "all matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "some matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "matching key with overridden value",
+			tags: New(map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.RemoveDefaultConfig(testCase.defaultConfig)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsKeyAdditionalBoolValue(t *testing.T) {
 	testCases := []struct {
 		name  string
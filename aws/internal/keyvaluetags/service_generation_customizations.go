@@ -364,6 +364,236 @@ func ServiceClientType(serviceName string) string {
 	return funcType.Out(0).String()
 }
 
+// ServiceConnFunction determines the AWSClient function returning the service client.
+func ServiceConnFunction(serviceName string) string {
+	switch serviceName {
+	case "cognitoidentity":
+		return "cognitoconn"
+	case "cognitoidentityprovider":
+		return "cognitoidpconn"
+	case "configservice":
+		return "configconn"
+	case "databasemigrationservice":
+		return "dmsconn"
+	case "directconnect":
+		return "dxconn"
+	case "directoryservice":
+		return "dsconn"
+	case "elasticsearchservice":
+		return "esconn"
+	case "servicediscovery":
+		return "sdconn"
+	default:
+		return serviceName + "conn"
+	}
+}
+
+// ServiceDocsSubcategory determines the website documentation subcategory of the service.
+func ServiceDocsSubcategory(serviceName string) string {
+	switch serviceName {
+	case "accessanalyzer":
+		return "Access Analyzer"
+	case "acm":
+		return "ACM"
+	case "acmpca":
+		return "ACM PCA"
+	case "amplify":
+		return "Amplify"
+	case "apigatewayv2":
+		return "API Gateway v2 (WebSocket and HTTP APIs)"
+	case "appmesh":
+		return "AppMesh"
+	case "appstream":
+		return "AppStream"
+	case "appsync":
+		return "AppSync"
+	case "athena":
+		return "Athena"
+	case "backup":
+		return "Backup"
+	case "batch":
+		return "Batch"
+	case "cloud9":
+		return "Cloud9"
+	case "cloudfront":
+		return "CloudFront"
+	case "cloudhsmv2":
+		return "CloudHSM v2"
+	case "cloudtrail":
+		return "CloudTrail"
+	case "cloudwatch":
+		return "CloudWatch"
+	case "cloudwatchevents":
+		return "EventBridge (CloudWatch Events)"
+	case "cloudwatchlogs":
+		return "CloudWatch"
+	case "codeartifact":
+		return "CodeArtifact"
+	case "codecommit":
+		return "CodeCommit"
+	case "codedeploy":
+		return "CodeDeploy"
+	case "codepipeline":
+		return "CodePipeline"
+	case "codestarconnections":
+		return "CodeStar Connections"
+	case "codestarnotifications":
+		return "CodeStar Notifications"
+	case "cognitoidentity":
+		return "Cognito"
+	case "cognitoidentityprovider":
+		return "Cognito"
+	case "configservice":
+		return "Config"
+	case "databasemigrationservice":
+		return "Database Migration Service (DMS)"
+	case "dataexchange":
+		return "Data Exchange"
+	case "datasync":
+		return "DataSync"
+	case "dax":
+		return "DynamoDB Accelerator (DAX)"
+	case "devicefarm":
+		return "Device Farm"
+	case "directconnect":
+		return "Direct Connect"
+	case "directoryservice":
+		return "Directory Service"
+	case "dlm":
+		return "Data Lifecycle Manager (DLM)"
+	case "docdb":
+		return "DocumentDB"
+	case "dynamodb":
+		return "DynamoDB"
+	case "ecr":
+		return "ECR"
+	case "ecs":
+		return "ECS"
+	case "efs":
+		return "EFS"
+	case "eks":
+		return "EKS"
+	case "elasticache":
+		return "ElastiCache"
+	case "elasticbeanstalk":
+		return "Elastic Beanstalk"
+	case "elasticsearchservice":
+		return "ElasticSearch"
+	case "elb":
+		return "Elastic Load Balancing (ELB Classic)"
+	case "elbv2":
+		return "Elastic Load Balancing v2 (ALB/NLB)"
+	case "firehose":
+		return "Kinesis Firehose"
+	case "fsx":
+		return "File System (FSx)"
+	case "gamelift":
+		return "Gamelift"
+	case "glacier":
+		return "Glacier"
+	case "globalaccelerator":
+		return "Global Accelerator"
+	case "glue":
+		return "Glue"
+	case "greengrass":
+		return "IoT"
+	case "guardduty":
+		return "GuardDuty"
+	case "imagebuilder":
+		return "Image Builder"
+	case "iot":
+		return "IoT"
+	case "iotanalytics":
+		return "IoT"
+	case "iotevents":
+		return "IoT"
+	case "kafka":
+		return "Managed Streaming for Kafka (MSK)"
+	case "kinesis":
+		return "Kinesis"
+	case "kinesisanalytics":
+		return "Kinesis Data Analytics (SQL Applications)"
+	case "kinesisanalyticsv2":
+		return "Kinesis Data Analytics v2 (SQL and Flink Applications)"
+	case "kinesisvideo":
+		return "Kinesis Video"
+	case "kms":
+		return "KMS"
+	case "lambda":
+		return "Lambda"
+	case "licensemanager":
+		return "License Manager"
+	case "mediaconnect":
+		return "MediaConnect"
+	case "mediaconvert":
+		return "MediaConvert"
+	case "medialive":
+		return "MediaLive"
+	case "mediapackage":
+		return "MediaPackage"
+	case "mediastore":
+		return "MediaStore"
+	case "mq":
+		return "MQ"
+	case "neptune":
+		return "Neptune"
+	case "networkfirewall":
+		return "Network Firewall"
+	case "networkmanager":
+		return "Transit Gateway Network Manager"
+	case "opsworks":
+		return "OpsWorks"
+	case "organizations":
+		return "Organizations"
+	case "pinpoint":
+		return "Pinpoint"
+	case "qldb":
+		return "Quantum Ledger Database (QLDB)"
+	case "quicksight":
+		return "QuickSight"
+	case "rds":
+		return "RDS"
+	case "resourcegroups":
+		return "Resource Groups"
+	case "route53resolver":
+		return "Route53 Resolver"
+	case "sagemaker":
+		return "Sagemaker"
+	case "securityhub":
+		return "Security Hub"
+	case "servicediscovery":
+		return "Service Discovery"
+	case "sfn":
+		return "Step Function (SFN)"
+	case "signer":
+		return "Signer"
+	case "sns":
+		return "SNS"
+	case "sqs":
+		return "SQS"
+	case "storagegateway":
+		return "Storage Gateway"
+	case "swf":
+		return "SWF"
+	case "transfer":
+		return "Transfer"
+	case "waf":
+		return "WAF"
+	case "wafregional":
+		return "WAF Regional"
+	case "wafv2":
+		return "WAFv2"
+	case "worklink":
+		return "WorkLink"
+	case "workspaces":
+		return "WorkSpaces"
+	case "xray":
+		return "XRay"
+	default:
+		return ""
+	}
+}
+
 // ServiceListTagsFunction determines the service list tagging function.
 func ServiceListTagsFunction(serviceName string) string {
 	switch serviceName {
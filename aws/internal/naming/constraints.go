@@ -0,0 +1,133 @@
+package naming
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// MinHashedSuffixLength is the shortest suffix generated with SuffixStrategyHashed.
+const MinHashedSuffixLength = 8
+
+// Case describes the letter case rules of a name.
+type Case int
+
+const (
+	// CaseAny allows both upper and lower case letters.
+	CaseAny Case = iota
+	// CaseLower allows only lower case letters.
+	CaseLower
+)
+
+// SuffixStrategy describes how the unique suffix of a generated name is formed.
+type SuffixStrategy int
+
+const (
+	// SuffixStrategyUniqueID always appends the resource.UniqueId() suffix,
+	// which is resource.UniqueIDSuffixLength characters long.
+	SuffixStrategyUniqueID SuffixStrategy = iota
+	// SuffixStrategyHashed appends the resource.UniqueId() suffix when it fits
+	// within the maximum length, otherwise a hash of it shortened to fit, but no
+	// shorter than MinHashedSuffixLength characters.
+	// Since the length of a hashed suffix depends on the prefix, the prefix of
+	// such a name cannot be recovered with NamePrefixFromName.
+	SuffixStrategyHashed
+)
+
+// Constraints describes the rules names of a resource type must follow, so that
+// names can be generated and prefixes validated within them.
+type Constraints struct {
+	// MaxLength is the maximum length of names, or 0 if unlimited.
+	MaxLength int
+
+	// Charset is the regular expression character class, without brackets,
+	// of characters allowed in names, e.g. `0-9A-Za-z-`.
+	// An empty Charset allows all characters.
+	Charset string
+
+	// Case is the letter case rule of names.
+	Case Case
+
+	// DefaultPrefix is the prefix of names generated without a name or name
+	// prefix. Defaults to resource.UniqueIdPrefix.
+	DefaultPrefix string
+
+	// SuffixStrategy is how the unique suffix of generated names is formed.
+	SuffixStrategy SuffixStrategy
+}
+
+// Generate returns in order the name if non-empty, a prefix generated name if non-empty, or fully generated name prefixed with the default prefix,
+// with generated names following the constraints
+func (c Constraints) Generate(name string, namePrefix string) string {
+	if name != "" {
+		return name
+	}
+
+	if namePrefix == "" {
+		namePrefix = c.defaultPrefix()
+	}
+
+	uniqueID := resource.PrefixedUniqueId(namePrefix)
+
+	if c.SuffixStrategy != SuffixStrategyHashed || c.MaxLength == 0 || len(uniqueID) <= c.MaxLength {
+		return uniqueID
+	}
+
+	suffixLength := c.MaxLength - len(namePrefix)
+
+	if suffixLength < MinHashedSuffixLength {
+		suffixLength = MinHashedSuffixLength
+	}
+
+	hash := sha256.Sum256([]byte(uniqueID))
+
+	return namePrefix + hex.EncodeToString(hash[:])[:suffixLength]
+}
+
+// MaxPrefixLength returns the maximum length of name prefixes, or 0 if unlimited.
+func (c Constraints) MaxPrefixLength() int {
+	if c.MaxLength == 0 {
+		return 0
+	}
+
+	if c.SuffixStrategy == SuffixStrategyHashed {
+		return c.MaxLength - MinHashedSuffixLength
+	}
+
+	return c.MaxLength - resource.UniqueIDSuffixLength
+}
+
+// ValidatePrefix is a SchemaValidateFunc which verifies that a name prefix
+// leaves room for a generated suffix and only contains allowed characters.
+func (c Constraints) ValidatePrefix(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if maxLength := c.MaxPrefixLength(); maxLength > 0 && len(value) > maxLength {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters: %q", k, maxLength, value))
+	}
+
+	if c.Charset != "" && !regexp.MustCompile(`^[`+c.Charset+`]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("only characters matching [%s] allowed in %q: %q", c.Charset, k, value))
+	} else if c.Case == CaseLower && strings.ToLower(value) != value {
+		errors = append(errors, fmt.Errorf("only lowercase characters allowed in %q: %q", k, value))
+	}
+
+	return
+}
+
+func (c Constraints) defaultPrefix() string {
+	if c.DefaultPrefix != "" {
+		return c.DefaultPrefix
+	}
+
+	return resource.UniqueIdPrefix
+}
@@ -0,0 +1,178 @@
+package naming
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestConstraintsGenerate(t *testing.T) {
+	testCases := []struct {
+		TestName              string
+		Constraints           Constraints
+		Name                  string
+		NamePrefix            string
+		ExpectedRegexpPattern string
+	}{
+		{
+			TestName:              "name",
+			Constraints:           ElbLoadBalancerName,
+			Name:                  "test",
+			NamePrefix:            "",
+			ExpectedRegexpPattern: "^test$",
+		},
+		{
+			TestName:              "fully generated",
+			Constraints:           IamRoleName,
+			Name:                  "",
+			NamePrefix:            "",
+			ExpectedRegexpPattern: resourceUniqueIDRegexpPattern,
+		},
+		{
+			TestName:              "fully generated with default prefix",
+			Constraints:           ElbLoadBalancerName,
+			Name:                  "",
+			NamePrefix:            "",
+			ExpectedRegexpPattern: resourcePrefixedUniqueIDRegexpPattern("tf-lb-"),
+		},
+		{
+			TestName:              "name prefix with unique ID suffix",
+			Constraints:           ElbLoadBalancerName,
+			Name:                  "",
+			NamePrefix:            "test-",
+			ExpectedRegexpPattern: resourcePrefixedUniqueIDRegexpPattern("test-"),
+		},
+		{
+			TestName:              "name prefix with hashed suffix",
+			Constraints:           ElbLoadBalancerName,
+			Name:                  "",
+			NamePrefix:            "test-load-balancer-",
+			ExpectedRegexpPattern: "^test-load-balancer-[[:xdigit:]]{13}$",
+		},
+		{
+			TestName:              "name prefix with minimum hashed suffix",
+			Constraints:           ElbLoadBalancerName,
+			Name:                  "",
+			NamePrefix:            "test-load-balancer-0123-",
+			ExpectedRegexpPattern: "^test-load-balancer-0123-[[:xdigit:]]{8}$",
+		},
+		{
+			TestName: "name prefix with unique ID suffix strategy",
+			Constraints: Constraints{
+				MaxLength: 32,
+			},
+			Name:                  "",
+			NamePrefix:            "test-load-balancer-",
+			ExpectedRegexpPattern: resourcePrefixedUniqueIDRegexpPattern("test-load-balancer-"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := testCase.Constraints.Generate(testCase.Name, testCase.NamePrefix)
+
+			expectedRegexp, err := regexp.Compile(testCase.ExpectedRegexpPattern)
+
+			if err != nil {
+				t.Errorf("unable to compile regular expression pattern %s: %s", testCase.ExpectedRegexpPattern, err)
+			}
+
+			if !expectedRegexp.MatchString(got) {
+				t.Errorf("got %s, expected to match regular expression pattern %s", got, testCase.ExpectedRegexpPattern)
+			}
+		})
+	}
+
+	t.Run("hashed suffixes are unique", func(t *testing.T) {
+		names := make(map[string]bool)
+
+		for i := 0; i < 100; i++ {
+			name := ElbLoadBalancerName.Generate("", "test-load-balancer-0123-")
+
+			if names[name] {
+				t.Fatalf("run%d: duplicate name %s", i, name)
+			}
+
+			names[name] = true
+		}
+	})
+}
+
+func TestConstraintsValidatePrefix(t *testing.T) {
+	testCases := []struct {
+		TestName    string
+		Constraints Constraints
+		Value       string
+		ErrCount    int
+	}{
+		{
+			TestName:    "valid",
+			Constraints: ElbLoadBalancerName,
+			Value:       "test-",
+			ErrCount:    0,
+		},
+		{
+			TestName:    "longest with hashed suffix",
+			Constraints: ElbLoadBalancerName,
+			Value:       strings.Repeat("a", 24),
+			ErrCount:    0,
+		},
+		{
+			TestName:    "too long with hashed suffix",
+			Constraints: ElbLoadBalancerName,
+			Value:       strings.Repeat("a", 25),
+			ErrCount:    1,
+		},
+		{
+			TestName: "too long with unique ID suffix",
+			Constraints: Constraints{
+				MaxLength: 32,
+			},
+			Value:    strings.Repeat("a", 7),
+			ErrCount: 1,
+		},
+		{
+			TestName:    "unlimited",
+			Constraints: Constraints{},
+			Value:       strings.Repeat("a", 1024),
+			ErrCount:    0,
+		},
+		{
+			TestName:    "invalid characters",
+			Constraints: ElbLoadBalancerName,
+			Value:       "test.",
+			ErrCount:    1,
+		},
+		{
+			TestName:    "invalid characters and too long",
+			Constraints: ElbLoadBalancerName,
+			Value:       strings.Repeat("a.", 16),
+			ErrCount:    2,
+		},
+		{
+			TestName:    "uppercase",
+			Constraints: RdsDbInstanceIdentifier,
+			Value:       "Test-",
+			ErrCount:    1,
+		},
+		{
+			TestName: "uppercase with any case charset",
+			Constraints: Constraints{
+				Charset: `0-9A-Za-z-`,
+				Case:    CaseLower,
+			},
+			Value:    "Test-",
+			ErrCount: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			_, errors := testCase.Constraints.ValidatePrefix(testCase.Value, "name_prefix")
+
+			if len(errors) != testCase.ErrCount {
+				t.Errorf("got %d errors (%v), expected %d", len(errors), errors, testCase.ErrCount)
+			}
+		})
+	}
+}
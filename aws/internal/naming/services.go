@@ -0,0 +1,75 @@
+package naming
+
+// Naming constraints of resource types whose maximum name length does not leave
+// room for a prefix and the full resource.UniqueId() suffix.
+var (
+	// Reference: https://docs.aws.amazon.com/elasticache/latest/APIReference/API_CreateCacheCluster.html
+	ElastiCacheClusterID = Constraints{
+		MaxLength:      50,
+		Charset:        `0-9a-z-`,
+		Case:           CaseLower,
+		DefaultPrefix:  "tf-",
+		SuffixStrategy: SuffixStrategyHashed,
+	}
+
+	// Reference: https://docs.aws.amazon.com/elasticache/latest/APIReference/API_CreateReplicationGroup.html
+	ElastiCacheReplicationGroupID = Constraints{
+		MaxLength:      40,
+		Charset:        `0-9A-Za-z-`,
+		DefaultPrefix:  "tf-",
+		SuffixStrategy: SuffixStrategyHashed,
+	}
+
+	// Classic, Application, Gateway and Network Load Balancers share the same
+	// naming rules.
+	// Reference: https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_CreateLoadBalancer.html
+	ElbLoadBalancerName = Constraints{
+		MaxLength:      32,
+		Charset:        `0-9A-Za-z-`,
+		DefaultPrefix:  "tf-lb-",
+		SuffixStrategy: SuffixStrategyHashed,
+	}
+
+	// Reference: https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_CreateTargetGroup.html
+	Elbv2TargetGroupName = Constraints{
+		MaxLength:      32,
+		Charset:        `0-9A-Za-z-`,
+		DefaultPrefix:  "tf-",
+		SuffixStrategy: SuffixStrategyHashed,
+	}
+
+	// Reference: https://docs.aws.amazon.com/IAM/latest/APIReference/API_CreateRole.html
+	IamRoleName = Constraints{
+		MaxLength:      64,
+		Charset:        `\w+=,.@-`,
+		SuffixStrategy: SuffixStrategyHashed,
+	}
+
+	// Reference: https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_CreateDBCluster.html
+	RdsClusterIdentifier = Constraints{
+		MaxLength:      63,
+		Charset:        `0-9a-z-`,
+		Case:           CaseLower,
+		DefaultPrefix:  "tf-",
+		SuffixStrategy: SuffixStrategyHashed,
+	}
+
+	// Cluster instances are DB instances, but historically use a different
+	// default prefix.
+	// Reference: https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_CreateDBInstance.html
+	RdsClusterInstanceIdentifier = Constraints{
+		MaxLength:      63,
+		Charset:        `0-9a-z-`,
+		Case:           CaseLower,
+		DefaultPrefix:  "tf-",
+		SuffixStrategy: SuffixStrategyHashed,
+	}
+
+	// Reference: https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_CreateDBInstance.html
+	RdsDbInstanceIdentifier = Constraints{
+		MaxLength:      63,
+		Charset:        `0-9a-z-`,
+		Case:           CaseLower,
+		SuffixStrategy: SuffixStrategyHashed,
+	}
+)
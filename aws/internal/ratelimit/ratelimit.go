@@ -0,0 +1,149 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// throttledRateFactor is the factor the current rate is multiplied by
+	// when a throttling error is reported.
+	throttledRateFactor = 0.5
+
+	// recoveryRateFactor is the fraction of the maximum rate the current rate
+	// is increased by for each successful request after throttling.
+	recoveryRateFactor = 0.05
+
+	// minRateFactor is the fraction of the maximum rate that the current rate
+	// will not be reduced below.
+	minRateFactor = 0.05
+)
+
+// AdaptiveLimiter is a token bucket rate limiter whose rate is reduced
+// multiplicatively when throttling errors are reported and is restored
+// additively, up to the maximum rate, as requests succeed.
+type AdaptiveLimiter struct {
+	lock sync.Mutex
+
+	maxRate float64
+	minRate float64
+	rate    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+
+	now func() time.Time
+}
+
+// NewAdaptiveLimiter returns an AdaptiveLimiter allowing up to
+// requestsPerSecond requests per second, with bursts of up to one second
+// of requests.
+func NewAdaptiveLimiter(requestsPerSecond float64) *AdaptiveLimiter {
+	burst := requestsPerSecond
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &AdaptiveLimiter{
+		maxRate: requestsPerSecond,
+		minRate: requestsPerSecond * minRateFactor,
+		rate:    requestsPerSecond,
+		burst:   burst,
+		tokens:  burst,
+		now:     time.Now,
+	}
+}
+
+// Rate returns the current rate in requests per second.
+func (l *AdaptiveLimiter) Rate() float64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.rate
+}
+
+// Wait blocks until a request may be made or the context is done.
+func (l *AdaptiveLimiter) Wait(ctx context.Context) error {
+	l.lock.Lock()
+	l.refill()
+	l.tokens--
+	delay := l.delay()
+	l.lock.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Return the unused token.
+		l.lock.Lock()
+		l.tokens++
+		l.lock.Unlock()
+
+		return ctx.Err()
+	}
+}
+
+// Throttled reports that a request was throttled, reducing the rate.
+func (l *AdaptiveLimiter) Throttled() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.refill()
+	l.rate *= throttledRateFactor
+
+	if l.rate < l.minRate {
+		l.rate = l.minRate
+	}
+}
+
+// Succeeded reports that a request succeeded, gradually restoring the rate.
+func (l *AdaptiveLimiter) Succeeded() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.rate >= l.maxRate {
+		return
+	}
+
+	l.refill()
+	l.rate += l.maxRate * recoveryRateFactor
+
+	if l.rate > l.maxRate {
+		l.rate = l.maxRate
+	}
+}
+
+// refill adds the tokens accrued since the last refill at the current rate.
+// The lock must be held.
+func (l *AdaptiveLimiter) refill() {
+	now := l.now()
+
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+
+	l.last = now
+}
+
+// delay returns how long to wait until the token debt is repaid at the
+// current rate. The lock must be held.
+func (l *AdaptiveLimiter) delay() time.Duration {
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestAdaptiveLimiterWait(t *testing.T) {
+	now := time.Now()
+	l := NewAdaptiveLimiter(2)
+	l.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// The initial burst is not delayed.
+	for i := 0; i < 2; i++ {
+		l.lock.Lock()
+		l.refill()
+		l.tokens--
+		delay := l.delay()
+		l.lock.Unlock()
+
+		if delay != 0 {
+			t.Fatalf("request %d: got delay %s, expected none", i, delay)
+		}
+	}
+
+	l.lock.Lock()
+	l.refill()
+	l.tokens--
+	delay := l.delay()
+	l.lock.Unlock()
+
+	if got, expected := delay, 500*time.Millisecond; got != expected {
+		t.Errorf("got delay %s, expected %s", got, expected)
+	}
+
+	// Tokens accrue at the current rate.
+	now = now.Add(time.Second)
+
+	if err := l.Wait(ctx); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestAdaptiveLimiterWaitCanceled(t *testing.T) {
+	l := NewAdaptiveLimiter(0.001)
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.Wait(ctx); err != context.Canceled {
+		t.Errorf("got error %v, expected %v", err, context.Canceled)
+	}
+}
+
+func TestAdaptiveLimiterThrottled(t *testing.T) {
+	l := NewAdaptiveLimiter(100)
+
+	l.Throttled()
+
+	if got, expected := l.Rate(), 50.0; got != expected {
+		t.Errorf("got rate %f, expected %f", got, expected)
+	}
+
+	for i := 0; i < 10; i++ {
+		l.Throttled()
+	}
+
+	if got, expected := l.Rate(), 5.0; got != expected {
+		t.Errorf("got minimum rate %f, expected %f", got, expected)
+	}
+
+	l.Succeeded()
+
+	if got, expected := l.Rate(), 10.0; got != expected {
+		t.Errorf("got rate %f, expected %f", got, expected)
+	}
+
+	for i := 0; i < 100; i++ {
+		l.Succeeded()
+	}
+
+	if got, expected := l.Rate(), 100.0; got != expected {
+		t.Errorf("got maximum rate %f, expected %f", got, expected)
+	}
+}
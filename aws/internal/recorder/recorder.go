@@ -0,0 +1,456 @@
+// Package recorder records the HTTP interactions of acceptance tests with AWS
+// APIs to cassette files and replays them, so that tests can run without
+// network access or AWS credentials.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Mode is whether interactions are recorded or replayed.
+type Mode string
+
+const (
+	ModeRecord Mode = "record"
+	ModeReplay Mode = "replay"
+)
+
+// ParseMode returns the mode named by s.
+func ParseMode(s string) (Mode, error) {
+	switch mode := Mode(s); mode {
+	case ModeRecord, ModeReplay:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unsupported recorder mode (%s), expected %s or %s", s, ModeRecord, ModeReplay)
+	}
+}
+
+// Recorder records or replays the interactions of the active cassette.
+// A single cassette is active at a time, so tests using a Recorder must run
+// serially.
+type Recorder struct {
+	dir  string
+	mode Mode
+
+	lock     sync.Mutex
+	cassette *cassette
+}
+
+// New returns a Recorder in the given mode, with cassettes in dir.
+func New(mode Mode, dir string) *Recorder {
+	return &Recorder{
+		dir:  dir,
+		mode: mode,
+	}
+}
+
+// Mode returns the mode of the Recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Active returns the name of the active cassette, if any.
+func (r *Recorder) Active() string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.cassette == nil {
+		return ""
+	}
+
+	return r.cassette.name
+}
+
+// Start activates the cassette with the given name, typically the test name.
+// In replay mode, its interactions are read from the cassette file.
+// Starting the active cassette again has no effect.
+func (r *Recorder) Start(name string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.cassette != nil {
+		if r.cassette.name == name {
+			return nil
+		}
+
+		return fmt.Errorf("cannot start cassette (%s) while cassette (%s) is active, tests must run serially", name, r.cassette.name)
+	}
+
+	c := &cassette{
+		name:    name,
+		path:    filepath.Join(r.dir, cassetteFilename(name)),
+		mapping: make(map[string]string),
+	}
+
+	if r.mode == ModeReplay {
+		b, err := ioutil.ReadFile(c.path)
+
+		if err != nil {
+			return fmt.Errorf("error reading cassette (%s): %w", name, err)
+		}
+
+		if err := json.Unmarshal(b, &c.Interactions); err != nil {
+			return fmt.Errorf("error reading cassette (%s): %w", name, err)
+		}
+
+		c.used = make([]bool, len(c.Interactions))
+	}
+
+	r.cassette = c
+
+	return nil
+}
+
+// Stop deactivates the active cassette. In replay mode, an error is returned
+// if any request did not match a recorded interaction or any recorded
+// interaction was not replayed.
+func (r *Recorder) Stop() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	c := r.cassette
+	r.cassette = nil
+
+	if c == nil || r.mode != ModeReplay {
+		return nil
+	}
+
+	var errs []string
+
+	for _, request := range c.unmatched {
+		errs = append(errs, fmt.Sprintf("no recorded interaction matches request %s", request))
+	}
+
+	for i, used := range c.used {
+		if !used {
+			errs = append(errs, fmt.Sprintf("recorded interaction %d (%s) was not replayed", i, c.Interactions[i].Request))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("cassette (%s) does not match the requests of the test, record it again:\n\t%s", c.name, strings.Join(errs, "\n\t"))
+	}
+
+	return nil
+}
+
+// Transport returns an http.RoundTripper recording the interactions of next,
+// or replaying them without calling next.
+func (r *Recorder) Transport(next http.RoundTripper) http.RoundTripper {
+	return &transport{
+		next:     next,
+		recorder: r,
+	}
+}
+
+type transport struct {
+	next     http.RoundTripper
+	recorder *Recorder
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	request, err := newRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r := t.recorder
+
+	if r.mode == ModeReplay {
+		return r.replay(req, request)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := newResponse(resp)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.record(Interaction{Request: request, Response: response}); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *Recorder) record(interaction Interaction) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	c := r.cassette
+
+	// Requests made outside a test, e.g. by sweepers without a cassette, are
+	// not recorded.
+	if c == nil {
+		return nil
+	}
+
+	c.Interactions = append(c.Interactions, interaction)
+
+	// The cassette is written after each interaction, so that it is complete
+	// even if the test process exits without stopping it.
+	b, err := json.MarshalIndent(c.Interactions, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error writing cassette (%s): %w", c.name, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return fmt.Errorf("error writing cassette (%s): %w", c.name, err)
+	}
+
+	if err := ioutil.WriteFile(c.path, b, 0644); err != nil {
+		return fmt.Errorf("error writing cassette (%s): %w", c.name, err)
+	}
+
+	return nil
+}
+
+func (r *Recorder) replay(req *http.Request, request Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	c := r.cassette
+
+	if c == nil {
+		return nil, fmt.Errorf("no active cassette to replay request %s", request)
+	}
+
+	for i, interaction := range c.Interactions {
+		if c.used[i] || !interaction.Request.matches(request) {
+			continue
+		}
+
+		c.used[i] = true
+		c.learnMapping(interaction.Request, request)
+
+		return interaction.Response.httpResponse(req, c.mapping), nil
+	}
+
+	c.unmatched = append(c.unmatched, request)
+
+	return nil, fmt.Errorf("cassette (%s) has no recorded interaction matching request %s", c.name, request)
+}
+
+// cassette holds the interactions of a test.
+type cassette struct {
+	Interactions []Interaction
+
+	name string
+	path string
+
+	// mapping maps the random names in recorded interactions to those of the
+	// replaying test.
+	mapping map[string]string
+
+	unmatched []Request
+	used      []bool
+}
+
+// learnMapping adds the random names of request, in order, as replacements of
+// those of the matching recorded request.
+func (c *cassette) learnMapping(recorded Request, request Request) {
+	recordedNames := randomNameRegexp.FindAllString(recorded.URL+recorded.Body, -1)
+	names := randomNameRegexp.FindAllString(request.URL+request.Body, -1)
+
+	for i := range recordedNames {
+		if i < len(names) {
+			c.mapping[recordedNames[i]] = names[i]
+		}
+	}
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request. Only the fields identifying the operation
+// and its input are recorded, never credentials or signatures.
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Target string `json:"target,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+func newRequest(req *http.Request) (Request, error) {
+	request := Request{
+		Method: req.Method,
+		URL:    normalizeURL(req.URL.String()),
+		Target: req.Header.Get("X-Amz-Target"),
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		b, err := ioutil.ReadAll(req.Body)
+
+		if err != nil {
+			return request, fmt.Errorf("error reading request body: %w", err)
+		}
+
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+		request.Body = string(b)
+	}
+
+	return request, nil
+}
+
+// matches returns whether the requests are the same, but for volatile values.
+func (r Request) matches(other Request) bool {
+	return r.Method == other.Method &&
+		r.Target == other.Target &&
+		normalizeVolatile(r.URL) == normalizeVolatile(other.URL) &&
+		normalizeVolatile(r.Body) == normalizeVolatile(other.Body)
+}
+
+func (r Request) String() string {
+	s := fmt.Sprintf("%s %s", r.Method, r.URL)
+
+	if r.Target != "" {
+		s += fmt.Sprintf(" (%s)", r.Target)
+	}
+
+	if r.Body != "" {
+		s += fmt.Sprintf(": %s", r.Body)
+	}
+
+	return s
+}
+
+// Response is a recorded response, without volatile headers and request IDs.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+func newResponse(resp *http.Response) (Response, error) {
+	response := Response{
+		StatusCode: resp.StatusCode,
+		Headers:    make(http.Header),
+	}
+
+	for k, v := range resp.Header {
+		if !volatileResponseHeaders[http.CanonicalHeaderKey(k)] {
+			response.Headers[k] = v
+		}
+	}
+
+	if resp.Body != nil {
+		b, err := ioutil.ReadAll(resp.Body)
+
+		if err != nil {
+			return response, fmt.Errorf("error reading response body: %w", err)
+		}
+
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+		response.Body = normalizeRequestIDs(string(b))
+	}
+
+	return response, nil
+}
+
+func (r Response) httpResponse(req *http.Request, mapping map[string]string) *http.Response {
+	body := replaceRandomNames(r.Body, mapping)
+	header := make(http.Header)
+
+	for k, v := range r.Headers {
+		for _, s := range v {
+			header.Add(k, replaceRandomNames(s, mapping))
+		}
+	}
+
+	return &http.Response{
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Header:        header,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Request:       req,
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+	}
+}
+
+// ZeroRequestID replaces the request IDs of recorded responses.
+const ZeroRequestID = "00000000-0000-0000-0000-000000000000"
+
+var (
+	// randomNameRegexp matches the random names of acceptance tests, generated
+	// with acctest.RandomWithPrefix("tf-acc-test").
+	randomNameRegexp = regexp.MustCompile(`tf-acc-test-[0-9]+`)
+
+	// uuidRegexp matches UUIDs, e.g. idempotency tokens generated by the AWS SDK.
+	uuidRegexp = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+
+	// signedQueryRegexp matches the signature query parameters of presigned URLs.
+	signedQueryRegexp = regexp.MustCompile(`([?&])X-Amz-(Algorithm|Credential|Date|Expires|Security-Token|Signature|SignedHeaders)=[^&]*`)
+
+	requestIDRegexps = []*regexp.Regexp{
+		regexp.MustCompile(`(<Request[Ii][Dd]>)[^<]*(</Request[Ii][Dd]>)`),
+		regexp.MustCompile(`("Request[Ii][Dd]"\s*:\s*")[^"]*(")`),
+	}
+
+	volatileResponseHeaders = map[string]bool{
+		"Content-Length":   true,
+		"Date":             true,
+		"X-Amz-Cf-Id":      true,
+		"X-Amz-Id-2":       true,
+		"X-Amz-Request-Id": true,
+		"X-Amzn-Requestid": true,
+		"X-Amzn-Trace-Id":  true,
+	}
+)
+
+func normalizeURL(s string) string {
+	return signedQueryRegexp.ReplaceAllString(s, "$1")
+}
+
+func normalizeRequestIDs(s string) string {
+	for _, re := range requestIDRegexps {
+		s = re.ReplaceAllString(s, "${1}"+ZeroRequestID+"${2}")
+	}
+
+	return s
+}
+
+func normalizeVolatile(s string) string {
+	s = randomNameRegexp.ReplaceAllString(s, "tf-acc-test-RANDOM")
+	s = uuidRegexp.ReplaceAllString(s, ZeroRequestID)
+
+	return s
+}
+
+func replaceRandomNames(s string, mapping map[string]string) string {
+	return randomNameRegexp.ReplaceAllStringFunc(s, func(name string) string {
+		if v, ok := mapping[name]; ok {
+			return v
+		}
+
+		return name
+	})
+}
+
+func cassetteFilename(name string) string {
+	return strings.NewReplacer("/", "_", " ", "_").Replace(name) + ".json"
+}
@@ -0,0 +1,315 @@
+package schemasnapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Class classifies a schema change by its impact on existing configurations.
+type Class int
+
+const (
+	// Additive changes do not affect existing configurations, e.g. a new
+	// optional attribute.
+	Additive Class = iota
+
+	// Behavioral changes keep existing configurations valid but may change
+	// their plans, e.g. a changed default.
+	Behavioral
+
+	// Breaking changes may invalidate existing configurations or replace
+	// existing resources, e.g. a removed attribute or ForceNew added.
+	Breaking
+)
+
+func (c Class) String() string {
+	switch c {
+	case Additive:
+		return "additive"
+	case Behavioral:
+		return "behavioral"
+	case Breaking:
+		return "breaking"
+	default:
+		return fmt.Sprintf("Class(%d)", int(c))
+	}
+}
+
+// Change is a classified schema change.
+type Change struct {
+	Class Class
+
+	// Kind is either "resource" or "data source".
+	Kind string
+
+	// Name is the name of the resource or data source.
+	Name string
+
+	// Attribute is the path of the changed attribute, with nested attributes
+	// separated by ".", or empty if the change applies to the whole resource.
+	Attribute string
+
+	Description string
+}
+
+func (c Change) String() string {
+	if c.Attribute == "" {
+		return fmt.Sprintf("%s: %s %s: %s", c.Class, c.Kind, c.Name, c.Description)
+	}
+
+	return fmt.Sprintf("%s: %s %s: %s: %s", c.Class, c.Kind, c.Name, c.Attribute, c.Description)
+}
+
+// Diff returns the changes from the old to the new snapshot, ordered by kind,
+// name and attribute.
+func Diff(old, new *Snapshot) []Change {
+	var changes []Change
+
+	changes = append(changes, diffResources("data source", old.DataSources, new.DataSources)...)
+	changes = append(changes, diffResources("resource", old.Resources, new.Resources)...)
+
+	return changes
+}
+
+// HasBreaking returns whether any of the changes is breaking.
+func HasBreaking(changes []Change) bool {
+	for _, change := range changes {
+		if change.Class == Breaking {
+			return true
+		}
+	}
+
+	return false
+}
+
+// differ accumulates the changes of a resource or data source.
+type differ struct {
+	kind    string
+	name    string
+	changes []Change
+}
+
+func (d *differ) add(class Class, attribute, format string, a ...interface{}) {
+	d.changes = append(d.changes, Change{
+		Class:       class,
+		Kind:        d.kind,
+		Name:        d.name,
+		Attribute:   attribute,
+		Description: fmt.Sprintf(format, a...),
+	})
+}
+
+func diffResources(kind string, old, new map[string]*Resource) []Change {
+	var changes []Change
+
+	for _, name := range resourceNames(old, new) {
+		d := &differ{
+			kind: kind,
+			name: name,
+		}
+
+		o, n := old[name], new[name]
+
+		switch {
+		case n == nil:
+			d.add(Breaking, "", "%s removed", kind)
+		case o == nil:
+			d.add(Additive, "", "%s added", kind)
+		default:
+			if o.Deprecated == "" && n.Deprecated != "" {
+				d.add(Behavioral, "", "deprecated: %s", n.Deprecated)
+			}
+
+			d.diffAttributes("", o.Attributes, n.Attributes)
+		}
+
+		changes = append(changes, d.changes...)
+	}
+
+	return changes
+}
+
+func (d *differ) diffAttributes(prefix string, old, new map[string]*Attribute) {
+	for _, k := range attributeNames(old, new) {
+		path := k
+
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+
+		o, n := old[k], new[k]
+
+		switch {
+		case n == nil:
+			d.add(Breaking, path, "attribute removed")
+		case o == nil:
+			if n.Required {
+				d.add(Breaking, path, "required attribute added")
+			} else {
+				d.add(Additive, path, "attribute added")
+			}
+		default:
+			d.diffAttribute(path, o, n)
+		}
+	}
+}
+
+func (d *differ) diffAttribute(path string, o, n *Attribute) {
+	if o.Type != n.Type {
+		d.add(Breaking, path, "type changed from %s to %s", o.Type, n.Type)
+
+		// Other differences follow from the type change.
+		return
+	}
+
+	switch {
+	case !o.Required && n.Required:
+		d.add(Breaking, path, "Required added")
+	case o.Required && !n.Required:
+		d.add(Additive, path, "Required removed")
+	}
+
+	// Computed-only attributes cannot be configured.
+	oConfigurable, nConfigurable := o.Required || o.Optional, n.Required || n.Optional
+
+	switch {
+	case oConfigurable && !nConfigurable:
+		d.add(Breaking, path, "attribute no longer configurable")
+	case !oConfigurable && nConfigurable:
+		d.add(Additive, path, "attribute now configurable")
+	}
+
+	switch {
+	case o.Computed && !n.Computed:
+		d.add(Behavioral, path, "Computed removed")
+	case !o.Computed && n.Computed:
+		d.add(Additive, path, "Computed added")
+	}
+
+	switch {
+	case !o.ForceNew && n.ForceNew:
+		d.add(Breaking, path, "ForceNew added")
+	case o.ForceNew && !n.ForceNew:
+		d.add(Behavioral, path, "ForceNew removed")
+	}
+
+	switch {
+	case !o.Sensitive && n.Sensitive:
+		d.add(Behavioral, path, "Sensitive added")
+	case o.Sensitive && !n.Sensitive:
+		d.add(Behavioral, path, "Sensitive removed")
+	}
+
+	if oDefault, nDefault := defaultString(o.Default), defaultString(n.Default); oDefault != nDefault {
+		d.add(Behavioral, path, "default changed from %s to %s", oDefault, nDefault)
+	}
+
+	if o.Deprecated == "" && n.Deprecated != "" {
+		d.add(Behavioral, path, "deprecated: %s", n.Deprecated)
+	}
+
+	if n.MaxItems != 0 && (o.MaxItems == 0 || n.MaxItems < o.MaxItems) {
+		d.add(Breaking, path, "MaxItems decreased from %s to %d", itemsString(o.MaxItems), n.MaxItems)
+	} else if o.MaxItems != n.MaxItems {
+		d.add(Additive, path, "MaxItems increased from %d to %s", o.MaxItems, itemsString(n.MaxItems))
+	}
+
+	if n.MinItems > o.MinItems {
+		d.add(Breaking, path, "MinItems increased from %d to %d", o.MinItems, n.MinItems)
+	} else if n.MinItems < o.MinItems {
+		d.add(Additive, path, "MinItems decreased from %d to %d", o.MinItems, n.MinItems)
+	}
+
+	switch {
+	case o.Elem != nil && n.Elem != nil:
+		if o.Elem.Type != n.Elem.Type {
+			d.add(Breaking, path, "element type changed from %s to %s", o.Elem.Type, n.Elem.Type)
+		}
+	case o.Block != n.Block:
+		d.add(Breaking, path, "changed between nested block and attribute")
+	case o.Block:
+		d.diffAttributes(path, o.Attributes, n.Attributes)
+	}
+}
+
+// defaultString returns the JSON encoding of a default value, so that values
+// read from a snapshot compare equal to those of the schema.
+func defaultString(v interface{}) string {
+	if v == nil {
+		return "none"
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
+
+func itemsString(n int) string {
+	if n == 0 {
+		return "unlimited"
+	}
+
+	return fmt.Sprintf("%d", n)
+}
+
+// resourceNames returns the names of the resources of either map, sorted.
+func resourceNames(old, new map[string]*Resource) []string {
+	var names []string
+
+	for k := range old {
+		names = append(names, k)
+	}
+
+	for k := range new {
+		if _, ok := old[k]; !ok {
+			names = append(names, k)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// attributeNames returns the names of the attributes of either map, sorted.
+func attributeNames(old, new map[string]*Attribute) []string {
+	var names []string
+
+	for k := range old {
+		names = append(names, k)
+	}
+
+	for k := range new {
+		if _, ok := old[k]; !ok {
+			names = append(names, k)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Summary returns the number of changes of each class, e.g.
+// "2 breaking, 0 behavioral, 5 additive".
+func Summary(changes []Change) string {
+	counts := make(map[Class]int)
+
+	for _, change := range changes {
+		counts[change.Class]++
+	}
+
+	parts := make([]string, 0, 3)
+
+	for _, class := range []Class{Breaking, Behavioral, Additive} {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[class], class))
+	}
+
+	return strings.Join(parts, ", ")
+}
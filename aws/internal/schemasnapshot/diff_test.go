@@ -0,0 +1,216 @@
+package schemasnapshot
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testProvider(attributes map[string]*schema.Schema) *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_test": {
+				Schema: attributes,
+			},
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Old      map[string]*schema.Schema
+		New      map[string]*schema.Schema
+		Expected []string
+	}{
+		{
+			Name: "no change",
+			Old: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Required: true, ForceNew: true},
+			},
+			New: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Required: true, ForceNew: true},
+			},
+		},
+		{
+			Name: "optional attribute added",
+			Old:  map[string]*schema.Schema{},
+			New: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Optional: true},
+			},
+			Expected: []string{"additive: resource aws_test: name: attribute added"},
+		},
+		{
+			Name: "required attribute added",
+			Old:  map[string]*schema.Schema{},
+			New: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Required: true},
+			},
+			Expected: []string{"breaking: resource aws_test: name: required attribute added"},
+		},
+		{
+			Name: "computed attribute removed",
+			Old: map[string]*schema.Schema{
+				"arn": {Type: schema.TypeString, Computed: true},
+			},
+			New:      map[string]*schema.Schema{},
+			Expected: []string{"breaking: resource aws_test: arn: attribute removed"},
+		},
+		{
+			Name: "Required added",
+			Old: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Optional: true},
+			},
+			New: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Required: true},
+			},
+			Expected: []string{"breaking: resource aws_test: name: Required added"},
+		},
+		{
+			Name: "ForceNew added",
+			Old: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Optional: true},
+			},
+			New: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Optional: true, ForceNew: true},
+			},
+			Expected: []string{"breaking: resource aws_test: name: ForceNew added"},
+		},
+		{
+			Name: "type changed",
+			Old: map[string]*schema.Schema{
+				"port": {Type: schema.TypeString, Optional: true},
+			},
+			New: map[string]*schema.Schema{
+				"port": {Type: schema.TypeInt, Optional: true, ForceNew: true},
+			},
+			Expected: []string{"breaking: resource aws_test: port: type changed from string to int"},
+		},
+		{
+			Name: "default changed",
+			Old: map[string]*schema.Schema{
+				"enabled": {Type: schema.TypeBool, Optional: true, Default: false},
+				"port":    {Type: schema.TypeInt, Optional: true, Default: 80},
+			},
+			New: map[string]*schema.Schema{
+				"enabled": {Type: schema.TypeBool, Optional: true, Default: true},
+				"port":    {Type: schema.TypeInt, Optional: true},
+			},
+			Expected: []string{
+				"behavioral: resource aws_test: enabled: default changed from false to true",
+				"behavioral: resource aws_test: port: default changed from 80 to none",
+			},
+		},
+		{
+			Name: "nested block",
+			Old: map[string]*schema.Schema{
+				"rule": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"action": {Type: schema.TypeString, Optional: true},
+						},
+					},
+				},
+			},
+			New: map[string]*schema.Schema{
+				"rule": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"action":   {Type: schema.TypeString, Optional: true, Computed: true},
+							"priority": {Type: schema.TypeInt, Optional: true},
+						},
+					},
+				},
+			},
+			Expected: []string{
+				"breaking: resource aws_test: rule: MaxItems decreased from unlimited to 1",
+				"additive: resource aws_test: rule.action: Computed added",
+				"additive: resource aws_test: rule.priority: attribute added",
+			},
+		},
+		{
+			Name: "element type changed",
+			Old: map[string]*schema.Schema{
+				"ports": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			},
+			New: map[string]*schema.Schema{
+				"ports": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeInt}},
+			},
+			Expected: []string{"breaking: resource aws_test: ports: element type changed from string to int"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// Round trip the old snapshot through JSON, as when read from a file.
+			var buf bytes.Buffer
+
+			if err := New(testProvider(testCase.Old)).Write(&buf); err != nil {
+				t.Fatalf("error writing snapshot: %s", err)
+			}
+
+			old, err := Read(&buf)
+
+			if err != nil {
+				t.Fatalf("error reading snapshot: %s", err)
+			}
+
+			var got []string
+
+			for _, change := range Diff(old, New(testProvider(testCase.New))) {
+				got = append(got, change.String())
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got changes %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDiffResources(t *testing.T) {
+	old := &Snapshot{
+		DataSources: map[string]*Resource{},
+		Resources: map[string]*Resource{
+			"aws_old": {},
+		},
+	}
+	new := &Snapshot{
+		DataSources: map[string]*Resource{
+			"aws_new": {},
+		},
+		Resources: map[string]*Resource{},
+	}
+
+	changes := Diff(old, new)
+
+	var got []string
+
+	for _, change := range changes {
+		got = append(got, change.String())
+	}
+
+	expected := []string{
+		"additive: data source aws_new: data source added",
+		"breaking: resource aws_old: resource removed",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got changes %q, expected %q", got, expected)
+	}
+
+	if !HasBreaking(changes) {
+		t.Error("expected breaking changes")
+	}
+
+	if got, expected := Summary(changes), "1 breaking, 0 behavioral, 1 additive"; got != expected {
+		t.Errorf("got summary %q, expected %q", got, expected)
+	}
+}
@@ -0,0 +1,124 @@
+// Package schemasnapshot serializes the resource and data source schemas of a
+// provider into a stable JSON snapshot and classifies the changes between two
+// snapshots, so that breaking schema changes are caught before release.
+package schemasnapshot
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Snapshot is the schema of the resources and data sources of a provider.
+type Snapshot struct {
+	DataSources map[string]*Resource `json:"data_sources"`
+	Resources   map[string]*Resource `json:"resources"`
+}
+
+// Resource is the schema of a resource or data source.
+type Resource struct {
+	Attributes map[string]*Attribute `json:"attributes"`
+	Deprecated string                `json:"deprecated,omitempty"`
+}
+
+// Attribute is the schema of an attribute. The elements of a primitive
+// collection are described by Elem, and the attributes of a nested block, if
+// Block is set, by Attributes.
+type Attribute struct {
+	Type       string                `json:"type"`
+	Required   bool                  `json:"required,omitempty"`
+	Optional   bool                  `json:"optional,omitempty"`
+	Computed   bool                  `json:"computed,omitempty"`
+	ForceNew   bool                  `json:"force_new,omitempty"`
+	Sensitive  bool                  `json:"sensitive,omitempty"`
+	Default    interface{}           `json:"default,omitempty"`
+	Deprecated string                `json:"deprecated,omitempty"`
+	MaxItems   int                   `json:"max_items,omitempty"`
+	MinItems   int                   `json:"min_items,omitempty"`
+	Elem       *Attribute            `json:"elem,omitempty"`
+	Block      bool                  `json:"block,omitempty"`
+	Attributes map[string]*Attribute `json:"attributes,omitempty"`
+}
+
+// New returns the snapshot of the schema of a provider.
+func New(p *schema.Provider) *Snapshot {
+	snapshot := &Snapshot{
+		DataSources: make(map[string]*Resource, len(p.DataSourcesMap)),
+		Resources:   make(map[string]*Resource, len(p.ResourcesMap)),
+	}
+
+	for name, r := range p.DataSourcesMap {
+		snapshot.DataSources[name] = newResource(r)
+	}
+
+	for name, r := range p.ResourcesMap {
+		snapshot.Resources[name] = newResource(r)
+	}
+
+	return snapshot
+}
+
+// Read reads a snapshot written by Write.
+func Read(r io.Reader) (*Snapshot, error) {
+	var snapshot Snapshot
+
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// Write writes the snapshot as indented JSON, with sorted keys so that the
+// output only changes with the schema.
+func (s *Snapshot) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(s)
+}
+
+func newResource(r *schema.Resource) *Resource {
+	return &Resource{
+		Attributes: newAttributes(r.Schema),
+		Deprecated: r.DeprecationMessage,
+	}
+}
+
+func newAttributes(m map[string]*schema.Schema) map[string]*Attribute {
+	attributes := make(map[string]*Attribute, len(m))
+
+	for k, v := range m {
+		attributes[k] = newAttribute(v)
+	}
+
+	return attributes
+}
+
+func newAttribute(v *schema.Schema) *Attribute {
+	attribute := &Attribute{
+		Type:       strings.ToLower(strings.TrimPrefix(v.Type.String(), "Type")),
+		Required:   v.Required,
+		Optional:   v.Optional,
+		Computed:   v.Computed,
+		ForceNew:   v.ForceNew,
+		Sensitive:  v.Sensitive,
+		Default:    v.Default,
+		Deprecated: v.Deprecated,
+		MaxItems:   v.MaxItems,
+		MinItems:   v.MinItems,
+	}
+
+	switch elem := v.Elem.(type) {
+	case *schema.Schema:
+		attribute.Elem = newAttribute(elem)
+	case *schema.Resource:
+		attribute.Block = true
+		attribute.Attributes = newAttributes(elem.Schema)
+	}
+
+	return attribute
+}
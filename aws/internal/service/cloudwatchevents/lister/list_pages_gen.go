@@ -30,6 +30,54 @@ func ListEventBusesPagesWithContext(ctx context.Context, conn *cloudwatchevents.
 	return nil
 }
 
+// ListEventBusesItems calls fn with each item of the ListEventBuses pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListEventBusesItems(ctx context.Context, conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListEventBusesInput, fn func(*cloudwatchevents.EventBus) bool) error {
+	err := ListEventBusesPagesWithContext(ctx, conn, input, func(page *cloudwatchevents.ListEventBusesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.EventBuses {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListEventBusesAll returns the items of all ListEventBuses pages for which all
+// filters return true.
+func ListEventBusesAll(ctx context.Context, conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListEventBusesInput, filters ...func(*cloudwatchevents.EventBus) bool) ([]*cloudwatchevents.EventBus, error) {
+	var items []*cloudwatchevents.EventBus
+
+	err := ListEventBusesItems(ctx, conn, input, func(item *cloudwatchevents.EventBus) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListRulesPages(conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListRulesInput, fn func(*cloudwatchevents.ListRulesOutput, bool) bool) error {
 	return ListRulesPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -51,6 +99,54 @@ func ListRulesPagesWithContext(ctx context.Context, conn *cloudwatchevents.Cloud
 	return nil
 }
 
+// ListRulesItems calls fn with each item of the ListRules pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListRulesItems(ctx context.Context, conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListRulesInput, fn func(*cloudwatchevents.Rule) bool) error {
+	err := ListRulesPagesWithContext(ctx, conn, input, func(page *cloudwatchevents.ListRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Rules {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListRulesAll returns the items of all ListRules pages for which all
+// filters return true.
+func ListRulesAll(ctx context.Context, conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListRulesInput, filters ...func(*cloudwatchevents.Rule) bool) ([]*cloudwatchevents.Rule, error) {
+	var items []*cloudwatchevents.Rule
+
+	err := ListRulesItems(ctx, conn, input, func(item *cloudwatchevents.Rule) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListTargetsByRulePages(conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListTargetsByRuleInput, fn func(*cloudwatchevents.ListTargetsByRuleOutput, bool) bool) error {
 	return ListTargetsByRulePagesWithContext(context.Background(), conn, input, fn)
 }
@@ -71,3 +167,51 @@ func ListTargetsByRulePagesWithContext(ctx context.Context, conn *cloudwatcheven
 	}
 	return nil
 }
+
+// ListTargetsByRuleItems calls fn with each item of the ListTargetsByRule pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListTargetsByRuleItems(ctx context.Context, conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListTargetsByRuleInput, fn func(*cloudwatchevents.Target) bool) error {
+	err := ListTargetsByRulePagesWithContext(ctx, conn, input, func(page *cloudwatchevents.ListTargetsByRuleOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Targets {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListTargetsByRuleAll returns the items of all ListTargetsByRule pages for which all
+// filters return true.
+func ListTargetsByRuleAll(ctx context.Context, conn *cloudwatchevents.CloudWatchEvents, input *cloudwatchevents.ListTargetsByRuleInput, filters ...func(*cloudwatchevents.Target) bool) ([]*cloudwatchevents.Target, error) {
+	var items []*cloudwatchevents.Target
+
+	err := ListTargetsByRuleItems(ctx, conn, input, func(item *cloudwatchevents.Target) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
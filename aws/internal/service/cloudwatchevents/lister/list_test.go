@@ -0,0 +1,185 @@
+package lister
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+)
+
+// testEventBusPages are the event bus names of each ListEventBuses page
+// returned by testServer.
+var testEventBusPages = [][]string{
+	{"bus-1", "bus-2"},
+	{"bus-3"},
+	{"bus-4", "bus-5"},
+}
+
+// testServer returns a stand-in for the CloudWatch Events API serving
+// testEventBusPages, and the number of requests it received.
+func testServer(t *testing.T) (*events.CloudWatchEvents, *int32) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		var input struct {
+			NextToken string
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		page := 0
+
+		if input.NextToken != "" {
+			fmt.Sscanf(input.NextToken, "page-%d", &page)
+		}
+
+		var buses []map[string]string
+
+		for _, name := range testEventBusPages[page] {
+			buses = append(buses, map[string]string{"Name": name})
+		}
+
+		output := map[string]interface{}{
+			"EventBuses": buses,
+		}
+
+		if page < len(testEventBusPages)-1 {
+			output["NextToken"] = fmt.Sprintf("page-%d", page+1)
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+
+		if err := json.NewEncoder(w).Encode(output); err != nil {
+			t.Errorf("error encoding output: %s", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("mock_access_key", "mock_secret_key", ""),
+		Endpoint:    aws.String(server.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-west-2"),
+	}))
+
+	return events.New(sess), &requests
+}
+
+func TestListEventBusesItems(t *testing.T) {
+	conn, requests := testServer(t)
+
+	var names []string
+
+	err := ListEventBusesItems(context.Background(), conn, &events.ListEventBusesInput{}, func(bus *events.EventBus) bool {
+		names = append(names, aws.StringValue(bus.Name))
+
+		return true
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{"bus-1", "bus-2", "bus-3", "bus-4", "bus-5"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("got %v, expected %v", names, expected)
+	}
+
+	if got := atomic.LoadInt32(requests); got != 3 {
+		t.Errorf("got %d requests, expected 3", got)
+	}
+}
+
+func TestListEventBusesItems_earlyTermination(t *testing.T) {
+	conn, requests := testServer(t)
+
+	var names []string
+
+	err := ListEventBusesItems(context.Background(), conn, &events.ListEventBusesInput{}, func(bus *events.EventBus) bool {
+		names = append(names, aws.StringValue(bus.Name))
+
+		return len(names) < 3
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{"bus-1", "bus-2", "bus-3"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("got %v, expected %v", names, expected)
+	}
+
+	// The last page is never requested.
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("got %d requests, expected 2", got)
+	}
+}
+
+func TestListEventBusesItems_contextCanceled(t *testing.T) {
+	conn, requests := testServer(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var names []string
+
+	err := ListEventBusesItems(ctx, conn, &events.ListEventBusesInput{}, func(bus *events.EventBus) bool {
+		names = append(names, aws.StringValue(bus.Name))
+
+		if len(names) == 2 {
+			cancel()
+		}
+
+		return true
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled error, got: %v", err)
+	}
+
+	if expected := []string{"bus-1", "bus-2"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("got %v, expected %v", names, expected)
+	}
+
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("got %d requests, expected 1", got)
+	}
+}
+
+func TestListEventBusesAll(t *testing.T) {
+	conn, _ := testServer(t)
+
+	buses, err := ListEventBusesAll(context.Background(), conn, &events.ListEventBusesInput{}, func(bus *events.EventBus) bool {
+		return aws.StringValue(bus.Name) != "bus-2"
+	}, func(bus *events.EventBus) bool {
+		return aws.StringValue(bus.Name) != "bus-5"
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var names []string
+
+	for _, bus := range buses {
+		names = append(names, aws.StringValue(bus.Name))
+	}
+
+	if expected := []string{"bus-1", "bus-3", "bus-4"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("got %v, expected %v", names, expected)
+	}
+}
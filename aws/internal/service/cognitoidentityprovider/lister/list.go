@@ -0,0 +1,3 @@
+//go:generate go run ../../../generators/listpages/main.go -function=ListUserPools github.com/aws/aws-sdk-go/service/cognitoidentityprovider
+
+package lister
@@ -29,3 +29,51 @@ func DescribeDirectoriesPagesWithContext(ctx context.Context, conn *directoryser
 	}
 	return nil
 }
+
+// DescribeDirectoriesItems calls fn with each item of the DescribeDirectories pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func DescribeDirectoriesItems(ctx context.Context, conn *directoryservice.DirectoryService, input *directoryservice.DescribeDirectoriesInput, fn func(*directoryservice.DirectoryDescription) bool) error {
+	err := DescribeDirectoriesPagesWithContext(ctx, conn, input, func(page *directoryservice.DescribeDirectoriesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.DirectoryDescriptions {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// DescribeDirectoriesAll returns the items of all DescribeDirectories pages for which all
+// filters return true.
+func DescribeDirectoriesAll(ctx context.Context, conn *directoryservice.DirectoryService, input *directoryservice.DescribeDirectoriesInput, filters ...func(*directoryservice.DirectoryDescription) bool) ([]*directoryservice.DirectoryDescription, error) {
+	var items []*directoryservice.DirectoryDescription
+
+	err := DescribeDirectoriesItems(ctx, conn, input, func(item *directoryservice.DirectoryDescription) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
@@ -0,0 +1,3 @@
+//go:generate go run ../../../generators/listpages/main.go -function=DescribeNetworkAcls,DescribeNetworkInterfaces,DescribeRouteTables,DescribeSecurityGroups,DescribeSubnets,DescribeVolumes,DescribeVpcPeeringConnections,DescribeVpcs github.com/aws/aws-sdk-go/service/ec2
+
+package lister
@@ -0,0 +1,393 @@
+// Code generated by "aws/internal/generators/listpages/main.go -function=DescribeNetworkAcls,DescribeNetworkInterfaces,DescribeRouteTables,DescribeSecurityGroups,DescribeSubnets,DescribeVolumes,DescribeVpcPeeringConnections,DescribeVpcs github.com/aws/aws-sdk-go/service/ec2"; DO NOT EDIT.
+
+package lister
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// DescribeNetworkAclsItems calls fn with each item of the DescribeNetworkAcls pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func DescribeNetworkAclsItems(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkAclsInput, fn func(*ec2.NetworkAcl) bool) error {
+	err := conn.DescribeNetworkAclsPagesWithContext(ctx, input, func(page *ec2.DescribeNetworkAclsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.NetworkAcls {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// DescribeNetworkAclsAll returns the items of all DescribeNetworkAcls pages for which all
+// filters return true.
+func DescribeNetworkAclsAll(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkAclsInput, filters ...func(*ec2.NetworkAcl) bool) ([]*ec2.NetworkAcl, error) {
+	var items []*ec2.NetworkAcl
+
+	err := DescribeNetworkAclsItems(ctx, conn, input, func(item *ec2.NetworkAcl) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
+// DescribeNetworkInterfacesItems calls fn with each item of the DescribeNetworkInterfaces pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func DescribeNetworkInterfacesItems(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInterfacesInput, fn func(*ec2.NetworkInterface) bool) error {
+	err := conn.DescribeNetworkInterfacesPagesWithContext(ctx, input, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.NetworkInterfaces {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// DescribeNetworkInterfacesAll returns the items of all DescribeNetworkInterfaces pages for which all
+// filters return true.
+func DescribeNetworkInterfacesAll(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInterfacesInput, filters ...func(*ec2.NetworkInterface) bool) ([]*ec2.NetworkInterface, error) {
+	var items []*ec2.NetworkInterface
+
+	err := DescribeNetworkInterfacesItems(ctx, conn, input, func(item *ec2.NetworkInterface) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
+// DescribeRouteTablesItems calls fn with each item of the DescribeRouteTables pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func DescribeRouteTablesItems(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeRouteTablesInput, fn func(*ec2.RouteTable) bool) error {
+	err := conn.DescribeRouteTablesPagesWithContext(ctx, input, func(page *ec2.DescribeRouteTablesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.RouteTables {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// DescribeRouteTablesAll returns the items of all DescribeRouteTables pages for which all
+// filters return true.
+func DescribeRouteTablesAll(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeRouteTablesInput, filters ...func(*ec2.RouteTable) bool) ([]*ec2.RouteTable, error) {
+	var items []*ec2.RouteTable
+
+	err := DescribeRouteTablesItems(ctx, conn, input, func(item *ec2.RouteTable) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
+// DescribeSecurityGroupsItems calls fn with each item of the DescribeSecurityGroups pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func DescribeSecurityGroupsItems(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput, fn func(*ec2.SecurityGroup) bool) error {
+	err := conn.DescribeSecurityGroupsPagesWithContext(ctx, input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.SecurityGroups {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// DescribeSecurityGroupsAll returns the items of all DescribeSecurityGroups pages for which all
+// filters return true.
+func DescribeSecurityGroupsAll(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput, filters ...func(*ec2.SecurityGroup) bool) ([]*ec2.SecurityGroup, error) {
+	var items []*ec2.SecurityGroup
+
+	err := DescribeSecurityGroupsItems(ctx, conn, input, func(item *ec2.SecurityGroup) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
+// DescribeSubnetsItems calls fn with each item of the DescribeSubnets pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func DescribeSubnetsItems(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSubnetsInput, fn func(*ec2.Subnet) bool) error {
+	err := conn.DescribeSubnetsPagesWithContext(ctx, input, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Subnets {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// DescribeSubnetsAll returns the items of all DescribeSubnets pages for which all
+// filters return true.
+func DescribeSubnetsAll(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSubnetsInput, filters ...func(*ec2.Subnet) bool) ([]*ec2.Subnet, error) {
+	var items []*ec2.Subnet
+
+	err := DescribeSubnetsItems(ctx, conn, input, func(item *ec2.Subnet) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
+// DescribeVolumesItems calls fn with each item of the DescribeVolumes pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func DescribeVolumesItems(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVolumesInput, fn func(*ec2.Volume) bool) error {
+	err := conn.DescribeVolumesPagesWithContext(ctx, input, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Volumes {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// DescribeVolumesAll returns the items of all DescribeVolumes pages for which all
+// filters return true.
+func DescribeVolumesAll(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVolumesInput, filters ...func(*ec2.Volume) bool) ([]*ec2.Volume, error) {
+	var items []*ec2.Volume
+
+	err := DescribeVolumesItems(ctx, conn, input, func(item *ec2.Volume) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
+// DescribeVpcPeeringConnectionsItems calls fn with each item of the DescribeVpcPeeringConnections pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func DescribeVpcPeeringConnectionsItems(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcPeeringConnectionsInput, fn func(*ec2.VpcPeeringConnection) bool) error {
+	err := conn.DescribeVpcPeeringConnectionsPagesWithContext(ctx, input, func(page *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.VpcPeeringConnections {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// DescribeVpcPeeringConnectionsAll returns the items of all DescribeVpcPeeringConnections pages for which all
+// filters return true.
+func DescribeVpcPeeringConnectionsAll(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcPeeringConnectionsInput, filters ...func(*ec2.VpcPeeringConnection) bool) ([]*ec2.VpcPeeringConnection, error) {
+	var items []*ec2.VpcPeeringConnection
+
+	err := DescribeVpcPeeringConnectionsItems(ctx, conn, input, func(item *ec2.VpcPeeringConnection) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
+// DescribeVpcsItems calls fn with each item of the DescribeVpcs pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func DescribeVpcsItems(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcsInput, fn func(*ec2.Vpc) bool) error {
+	err := conn.DescribeVpcsPagesWithContext(ctx, input, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Vpcs {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// DescribeVpcsAll returns the items of all DescribeVpcs pages for which all
+// filters return true.
+func DescribeVpcsAll(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcsInput, filters ...func(*ec2.Vpc) bool) ([]*ec2.Vpc, error) {
+	var items []*ec2.Vpc
+
+	err := DescribeVpcsItems(ctx, conn, input, func(item *ec2.Vpc) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
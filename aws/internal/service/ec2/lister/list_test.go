@@ -0,0 +1,116 @@
+package lister
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// testVpcPages are the VPC IDs of each DescribeVpcs page returned by
+// testServer, with the default VPC first.
+var testVpcPages = [][]string{
+	{"vpc-default", "vpc-1"},
+	{"vpc-2"},
+}
+
+// testServer returns a stand-in for the EC2 API serving testVpcPages.
+func testServer(t *testing.T) *ec2.EC2 {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if action := r.Form.Get("Action"); action != "DescribeVpcs" {
+			t.Errorf("unexpected action: %s", action)
+			http.Error(w, action, http.StatusBadRequest)
+			return
+		}
+
+		page := 0
+
+		if nextToken := r.Form.Get("NextToken"); nextToken != "" {
+			fmt.Sscanf(nextToken, "page-%d", &page)
+		}
+
+		var b strings.Builder
+
+		b.WriteString(`<DescribeVpcsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><vpcSet>`)
+
+		for _, id := range testVpcPages[page] {
+			fmt.Fprintf(&b, `<item><vpcId>%s</vpcId><isDefault>%t</isDefault></item>`, id, id == "vpc-default")
+		}
+
+		b.WriteString(`</vpcSet>`)
+
+		if page < len(testVpcPages)-1 {
+			fmt.Fprintf(&b, `<nextToken>page-%d</nextToken>`, page+1)
+		}
+
+		b.WriteString(`</DescribeVpcsResponse>`)
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(w, b.String())
+	}))
+	t.Cleanup(server.Close)
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("mock_access_key", "mock_secret_key", ""),
+		Endpoint:    aws.String(server.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-west-2"),
+	}))
+
+	return ec2.New(sess)
+}
+
+func TestDescribeVpcsAll(t *testing.T) {
+	conn := testServer(t)
+
+	vpcs, err := DescribeVpcsAll(context.Background(), conn, &ec2.DescribeVpcsInput{}, func(vpc *ec2.Vpc) bool {
+		return !aws.BoolValue(vpc.IsDefault)
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var ids []string
+
+	for _, vpc := range vpcs {
+		ids = append(ids, aws.StringValue(vpc.VpcId))
+	}
+
+	if expected := []string{"vpc-1", "vpc-2"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("got %v, expected %v", ids, expected)
+	}
+}
+
+func TestDescribeVpcsItems_earlyTermination(t *testing.T) {
+	conn := testServer(t)
+
+	var ids []string
+
+	err := DescribeVpcsItems(context.Background(), conn, &ec2.DescribeVpcsInput{}, func(vpc *ec2.Vpc) bool {
+		ids = append(ids, aws.StringValue(vpc.VpcId))
+
+		return false
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{"vpc-default"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("got %v, expected %v", ids, expected)
+	}
+}
@@ -0,0 +1,219 @@
+//go:generate go run generator/main.go
+
+// Package policycatalog is a catalog of IAM service actions, resource ARN
+// formats and condition keys, used to validate IAM policy documents offline.
+//
+// Actions are generated from the API operations in the AWS Go SDK API models
+// and supplement.json, which also holds the ARN formats and condition keys.
+// Services without ARN formats or condition keys in the catalog are not
+// validated against them.
+package policycatalog
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Service is the catalog entry of an IAM service prefix, e.g. "s3".
+type Service struct {
+	// Actions are the action names, without the service prefix.
+	Actions []string
+
+	// ArnFormats are the resource ARN formats, with "${...}" placeholders.
+	ArnFormats []string
+
+	// ConditionKeys are the service condition keys, with "${...}" placeholders.
+	ConditionKeys []string
+}
+
+// LookupService returns the catalog entry of the IAM service prefix.
+func LookupService(prefix string) (*Service, bool) {
+	service, ok := services[strings.ToLower(prefix)]
+
+	return service, ok
+}
+
+// ExpandActions returns the actions, with service prefixes, matching the
+// action pattern, e.g. "s3:Get*". The second return value is false if the
+// pattern's service prefix matches no service in the catalog.
+// A bare "*" is not expanded.
+func ExpandActions(pattern string) ([]string, bool) {
+	if pattern == "*" {
+		return []string{pattern}, true
+	}
+
+	parts := strings.SplitN(pattern, ":", 2)
+
+	if len(parts) != 2 {
+		return nil, false
+	}
+
+	prefixPattern, actionPattern := strings.ToLower(parts[0]), strings.ToLower(parts[1])
+	knownPrefix := false
+	var actions []string
+
+	for prefix, service := range services {
+		if !wildcardMatch(prefixPattern, prefix) {
+			continue
+		}
+
+		knownPrefix = true
+
+		for _, action := range service.Actions {
+			if wildcardMatch(actionPattern, strings.ToLower(action)) {
+				actions = append(actions, prefix+":"+action)
+			}
+		}
+	}
+
+	sort.Strings(actions)
+
+	return actions, knownPrefix
+}
+
+// IsKnownConditionKey returns whether the condition key is a global
+// condition key or a condition key of its service. The second return value is
+// false if the catalog has no condition keys for the key's service, in which
+// case the key cannot be validated.
+func IsKnownConditionKey(key string) (bool, bool) {
+	parts := strings.SplitN(key, ":", 2)
+
+	if len(parts) != 2 {
+		return false, false
+	}
+
+	var keys []string
+
+	if strings.EqualFold(parts[0], "aws") {
+		keys = globalConditionKeys
+	} else if service, ok := LookupService(parts[0]); ok {
+		keys = service.ConditionKeys
+	}
+
+	if len(keys) == 0 {
+		return false, false
+	}
+
+	for _, k := range keys {
+		if wildcardMatch(strings.ToLower(placeholderToWildcard(k)), strings.ToLower(key)) {
+			return true, true
+		}
+	}
+
+	return false, true
+}
+
+// MatchesArnFormat returns whether the resource, an ARN which may contain
+// wildcards, can match any ARN format of its service. The second return value
+// is false if the resource is not an ARN or the catalog has no ARN formats for
+// its service, in which case the resource cannot be validated.
+func MatchesArnFormat(resource string) (bool, bool) {
+	segments := strings.SplitN(resource, ":", 6)
+
+	if len(segments) < 3 || segments[0] != "arn" || strings.ContainsAny(segments[2], "*?") {
+		return false, false
+	}
+
+	service, ok := LookupService(segments[2])
+
+	if !ok || len(service.ArnFormats) == 0 {
+		return false, false
+	}
+
+	if len(segments) != 6 {
+		return false, true
+	}
+
+	for _, format := range service.ArnFormats {
+		formatSegments := strings.SplitN(placeholderToWildcard(format), ":", 6)
+		matches := true
+
+		for i := range formatSegments {
+			if !GlobsOverlap(formatSegments[i], segments[i]) {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			return true, true
+		}
+	}
+
+	return false, true
+}
+
+// GlobsOverlap returns whether some string matches both patterns, in which
+// "*" matches any sequence of characters and "?" any single character.
+func GlobsOverlap(a, b string) bool {
+	memo := make(map[[2]int]bool)
+	seen := make(map[[2]int]bool)
+
+	var overlap func(i, j int) bool
+	overlap = func(i, j int) bool {
+		key := [2]int{i, j}
+
+		if seen[key] {
+			return memo[key]
+		}
+
+		var result bool
+
+		switch {
+		case i == len(a) && j == len(b):
+			result = true
+		case i < len(a) && a[i] == '*':
+			// Either a's "*" matches nothing, or it consumes b's next character.
+			result = overlap(i+1, j) || (j < len(b) && overlap(i, j+1))
+		case j < len(b) && b[j] == '*':
+			result = overlap(i, j+1) || (i < len(a) && overlap(i+1, j))
+		case i < len(a) && j < len(b):
+			result = (a[i] == b[j] || a[i] == '?' || b[j] == '?') && overlap(i+1, j+1)
+		}
+
+		seen[key] = true
+		memo[key] = result
+
+		return result
+	}
+
+	return overlap(0, 0)
+}
+
+var placeholderRegexp = regexp.MustCompile(`\$\{[^}]+\}`)
+
+// placeholderToWildcard replaces "${...}" placeholders with "*".
+func placeholderToWildcard(s string) string {
+	return placeholderRegexp.ReplaceAllString(s, "*")
+}
+
+// wildcardMatch returns true if the value matches the pattern, in which "*"
+// matches any sequence of characters and "?" any single character.
+func wildcardMatch(pattern, value string) bool {
+	p, v := 0, 0
+	star, mark := -1, 0
+
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			star, mark = p, v
+			p++
+		case star != -1:
+			p = star + 1
+			mark++
+			v = mark
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
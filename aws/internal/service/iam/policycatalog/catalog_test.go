@@ -0,0 +1,167 @@
+package policycatalog
+
+import (
+	"testing"
+)
+
+func TestExpandActions(t *testing.T) {
+	testCases := []struct {
+		Pattern       string
+		ExpectKnown   bool
+		ExpectActions []string
+		ExpectMissing []string
+	}{
+		{
+			Pattern:       "s3:GetObject",
+			ExpectKnown:   true,
+			ExpectActions: []string{"s3:GetObject"},
+		},
+		{
+			Pattern:       "S3:getobject",
+			ExpectKnown:   true,
+			ExpectActions: []string{"s3:GetObject"},
+		},
+		{
+			Pattern:       "s3:GetObject*",
+			ExpectKnown:   true,
+			ExpectActions: []string{"s3:GetObject", "s3:GetObjectAcl", "s3:GetObjectVersion"},
+			ExpectMissing: []string{"s3:PutObject"},
+		},
+		{
+			Pattern:     "s3:GetObjects",
+			ExpectKnown: true,
+		},
+		{
+			Pattern:       "sts:Assume*",
+			ExpectKnown:   true,
+			ExpectActions: []string{"sts:AssumeRole", "sts:AssumeRoleWithSAML", "sts:AssumeRoleWithWebIdentity"},
+		},
+		{
+			Pattern: "s4:GetObject",
+		},
+		{
+			Pattern: "GetObject",
+		},
+		{
+			Pattern:       "*",
+			ExpectKnown:   true,
+			ExpectActions: []string{"*"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Pattern, func(t *testing.T) {
+			actions, known := ExpandActions(testCase.Pattern)
+
+			if known != testCase.ExpectKnown {
+				t.Errorf("got known %t, expected %t", known, testCase.ExpectKnown)
+			}
+
+			got := make(map[string]bool)
+			for _, action := range actions {
+				got[action] = true
+			}
+
+			for _, action := range testCase.ExpectActions {
+				if !got[action] {
+					t.Errorf("expected %s in %v", action, actions)
+				}
+			}
+
+			for _, action := range testCase.ExpectMissing {
+				if got[action] {
+					t.Errorf("unexpected %s in %v", action, actions)
+				}
+			}
+
+			if testCase.ExpectActions == nil && len(actions) > 0 {
+				t.Errorf("expected no actions, got %v", actions)
+			}
+		})
+	}
+}
+
+func TestIsKnownConditionKey(t *testing.T) {
+	testCases := []struct {
+		Key           string
+		ExpectKnown   bool
+		ExpectChecked bool
+	}{
+		{"aws:SourceIp", true, true},
+		{"aws:sourceip", true, true},
+		{"aws:SourceIpAddress", false, true},
+		{"aws:RequestTag/environment", true, true},
+		{"s3:prefix", true, true},
+		{"s3:ExistingObjectTag/owner", true, true},
+		{"s3:prefixes", false, true},
+		{"kms:EncryptionContext:aws:s3:arn", true, true},
+		{"ec2:InstanceType", false, false},
+		{"accounts.google.com:aud", false, false},
+		{"invalid", false, false},
+	}
+
+	for _, testCase := range testCases {
+		known, checked := IsKnownConditionKey(testCase.Key)
+
+		if known != testCase.ExpectKnown || checked != testCase.ExpectChecked {
+			t.Errorf("IsKnownConditionKey(%q) = %t, %t, expected %t, %t", testCase.Key, known, checked, testCase.ExpectKnown, testCase.ExpectChecked)
+		}
+	}
+}
+
+func TestMatchesArnFormat(t *testing.T) {
+	testCases := []struct {
+		Resource      string
+		ExpectMatch   bool
+		ExpectChecked bool
+	}{
+		{"arn:aws:s3:::example", true, true},
+		{"arn:aws:s3:::example/*", true, true},
+		{"arn:aws:s3:::*", true, true},
+		{"arn:aws:s3:*", false, true},
+		{"arn:aws:s3:us-west-2:123456789012:example", false, true},
+		{"arn:aws:iam::123456789012:role/example", true, true},
+		{"arn:aws:iam::*:*", true, true},
+		{"arn:aws:iam::123456789012:rol/example", false, true},
+		{"arn:aws:lambda:us-west-2:123456789012:function:example:live", true, true},
+		{"arn:aws:ec2:us-west-2:123456789012:instance/*", false, false},
+		{"arn:aws:*:us-west-2:123456789012:example", false, false},
+		{"*", false, false},
+	}
+
+	for _, testCase := range testCases {
+		match, checked := MatchesArnFormat(testCase.Resource)
+
+		if match != testCase.ExpectMatch || checked != testCase.ExpectChecked {
+			t.Errorf("MatchesArnFormat(%q) = %t, %t, expected %t, %t", testCase.Resource, match, checked, testCase.ExpectMatch, testCase.ExpectChecked)
+		}
+	}
+}
+
+func TestGlobsOverlap(t *testing.T) {
+	testCases := []struct {
+		A, B     string
+		Expected bool
+	}{
+		{"", "", true},
+		{"abc", "abc", true},
+		{"abc", "abd", false},
+		{"a*", "*c", true},
+		{"a*b", "a*c", false},
+		{"role/*", "*", true},
+		{"role/*", "rol?/x", true},
+		{"role/*", "user/*", false},
+		{"?", "", false},
+		{"*", "", true},
+	}
+
+	for _, testCase := range testCases {
+		if got := GlobsOverlap(testCase.A, testCase.B); got != testCase.Expected {
+			t.Errorf("GlobsOverlap(%q, %q) = %t, expected %t", testCase.A, testCase.B, got, testCase.Expected)
+		}
+
+		if got := GlobsOverlap(testCase.B, testCase.A); got != testCase.Expected {
+			t.Errorf("GlobsOverlap(%q, %q) = %t, expected %t", testCase.B, testCase.A, got, testCase.Expected)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	outputName     = "catalog_gen.go"
+	sdkModulePath  = "github.com/aws/aws-sdk-go"
+	supplementName = "supplement.json"
+)
+
+var (
+	modelsDir = flag.String("models", "", "path to the AWS Go SDK API models directory (default: models/apis in the AWS Go SDK module)")
+)
+
+// Supplement is the hand-maintained part of the catalog, which cannot be
+// derived from the AWS Go SDK API models.
+type Supplement struct {
+	// Aliases maps API model directory names to IAM service prefixes, for
+	// services whose signing name differs from their IAM service prefix.
+	Aliases map[string]string `json:"aliases"`
+
+	GlobalConditionKeys []string `json:"global_condition_keys"`
+
+	Services map[string]SupplementService `json:"services"`
+}
+
+type SupplementService struct {
+	// Actions are IAM actions without a corresponding API operation.
+	Actions []string `json:"actions"`
+
+	// ReplaceActions replaces the actions derived from API operations, for
+	// services whose IAM actions do not correspond to API operations.
+	ReplaceActions bool `json:"replace_actions"`
+
+	ArnFormats    []string `json:"arn_formats"`
+	ConditionKeys []string `json:"condition_keys"`
+}
+
+type Service struct {
+	Prefix        string
+	Actions       []string
+	ArnFormats    []string
+	ConditionKeys []string
+}
+
+type TemplateData struct {
+	GlobalConditionKeys []string
+	SdkVersion          string
+	Services            []*Service
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "\tGenerates the IAM policy catalog from the AWS Go SDK API models and %s.\n\n", supplementName)
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	sdkDir, sdkVersion := sdkModule()
+
+	if *modelsDir == "" {
+		*modelsDir = filepath.Join(sdkDir, "models", "apis")
+	}
+
+	var supplement Supplement
+
+	b, err := ioutil.ReadFile(supplementName)
+	if err != nil {
+		log.Fatalf("error reading %s: %s", supplementName, err)
+	}
+
+	if err := json.Unmarshal(b, &supplement); err != nil {
+		log.Fatalf("error parsing %s: %s", supplementName, err)
+	}
+
+	actions := modelActions(*modelsDir, supplement.Aliases)
+
+	for prefix, s := range supplement.Services {
+		if s.ReplaceActions {
+			actions[prefix] = map[string]struct{}{}
+		}
+
+		if _, ok := actions[prefix]; !ok {
+			actions[prefix] = map[string]struct{}{}
+		}
+
+		for _, action := range s.Actions {
+			actions[prefix][action] = struct{}{}
+		}
+	}
+
+	data := TemplateData{
+		GlobalConditionKeys: sortedCopy(supplement.GlobalConditionKeys),
+		SdkVersion:          sdkVersion,
+	}
+
+	for prefix, a := range actions {
+		service := &Service{
+			Prefix: prefix,
+		}
+
+		for action := range a {
+			service.Actions = append(service.Actions, action)
+		}
+
+		sort.Strings(service.Actions)
+
+		if s, ok := supplement.Services[prefix]; ok {
+			service.ArnFormats = sortedCopy(s.ArnFormats)
+			service.ConditionKeys = sortedCopy(s.ConditionKeys)
+		}
+
+		data.Services = append(data.Services, service)
+	}
+
+	sort.Slice(data.Services, func(i, j int) bool {
+		return data.Services[i].Prefix < data.Services[j].Prefix
+	})
+
+	var buf bytes.Buffer
+
+	if err := template.Must(template.New("catalog").Parse(catalogTemplate)).Execute(&buf, data); err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("error formatting generated source: %s", err)
+	}
+
+	if err := ioutil.WriteFile(outputName, src, 0644); err != nil {
+		log.Fatalf("error writing %s: %s", outputName, err)
+	}
+}
+
+// sdkModule returns the directory and version of the AWS Go SDK module
+// required by the provider.
+func sdkModule() (string, string) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}} {{.Version}}", sdkModulePath).Output()
+	if err != nil {
+		log.Fatalf("error finding %s module: %s", sdkModulePath, err)
+	}
+
+	fields := strings.Fields(string(out))
+
+	if len(fields) != 2 {
+		log.Fatalf("error finding %s module: unexpected output: %s", sdkModulePath, out)
+	}
+
+	return fields[0], fields[1]
+}
+
+// modelActions returns the API operations of the latest version of each API
+// model, keyed by IAM service prefix.
+func modelActions(dir string, aliases map[string]string) map[string]map[string]struct{} {
+	actions := map[string]map[string]struct{}{}
+
+	models, err := ioutil.ReadDir(dir)
+	if err != nil {
+		log.Fatalf("error reading API models: %s", err)
+	}
+
+	for _, model := range models {
+		if !model.IsDir() {
+			continue
+		}
+
+		paths, err := filepath.Glob(filepath.Join(dir, model.Name(), "*", "api-2.json"))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(paths) == 0 {
+			continue
+		}
+
+		// API versions are dates, so the last one is the latest.
+		sort.Strings(paths)
+
+		b, err := ioutil.ReadFile(paths[len(paths)-1])
+		if err != nil {
+			log.Fatalf("error reading API model: %s", err)
+		}
+
+		var api struct {
+			Metadata struct {
+				EndpointPrefix string `json:"endpointPrefix"`
+				SigningName    string `json:"signingName"`
+			} `json:"metadata"`
+			Operations map[string]json.RawMessage `json:"operations"`
+		}
+
+		if err := json.Unmarshal(b, &api); err != nil {
+			log.Fatalf("error parsing API model (%s): %s", paths[len(paths)-1], err)
+		}
+
+		prefix := api.Metadata.SigningName
+		if prefix == "" {
+			prefix = api.Metadata.EndpointPrefix
+		}
+		if v, ok := aliases[model.Name()]; ok {
+			prefix = v
+		}
+
+		if _, ok := actions[prefix]; !ok {
+			actions[prefix] = map[string]struct{}{}
+		}
+
+		for operation := range api.Operations {
+			actions[prefix][operation] = struct{}{}
+		}
+	}
+
+	return actions
+}
+
+func sortedCopy(in []string) []string {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]string, len(in))
+	copy(out, in)
+	sort.Strings(out)
+
+	return out
+}
+
+var catalogTemplate = `// Code generated by generator/main.go; DO NOT EDIT.
+// Generated from the AWS Go SDK {{ .SdkVersion }} API models and supplement.json.
+
+package policycatalog
+
+var globalConditionKeys = []string{
+{{- range .GlobalConditionKeys }}
+	{{ printf "%q" . }},
+{{- end }}
+}
+
+var services = map[string]*Service{
+{{- range .Services }}
+	{{ printf "%q" .Prefix }}: {
+		Actions: []string{
+		{{- range .Actions }}
+			{{ printf "%q" . }},
+		{{- end }}
+		},
+		{{- if .ArnFormats }}
+		ArnFormats: []string{
+		{{- range .ArnFormats }}
+			{{ printf "%q" . }},
+		{{- end }}
+		},
+		{{- end }}
+		{{- if .ConditionKeys }}
+		ConditionKeys: []string{
+		{{- range .ConditionKeys }}
+			{{ printf "%q" . }},
+		{{- end }}
+		},
+		{{- end }}
+	},
+{{- end }}
+}
+`
@@ -29,3 +29,51 @@ func ListApplicationsPagesWithContext(ctx context.Context, conn *kinesisanalytic
 	}
 	return nil
 }
+
+// ListApplicationsItems calls fn with each item of the ListApplications pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListApplicationsItems(ctx context.Context, conn *kinesisanalyticsv2.KinesisAnalyticsV2, input *kinesisanalyticsv2.ListApplicationsInput, fn func(*kinesisanalyticsv2.ApplicationSummary) bool) error {
+	err := ListApplicationsPagesWithContext(ctx, conn, input, func(page *kinesisanalyticsv2.ListApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.ApplicationSummaries {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListApplicationsAll returns the items of all ListApplications pages for which all
+// filters return true.
+func ListApplicationsAll(ctx context.Context, conn *kinesisanalyticsv2.KinesisAnalyticsV2, input *kinesisanalyticsv2.ListApplicationsInput, filters ...func(*kinesisanalyticsv2.ApplicationSummary) bool) ([]*kinesisanalyticsv2.ApplicationSummary, error) {
+	var items []*kinesisanalyticsv2.ApplicationSummary
+
+	err := ListApplicationsItems(ctx, conn, input, func(item *kinesisanalyticsv2.ApplicationSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
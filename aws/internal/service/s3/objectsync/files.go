@@ -0,0 +1,181 @@
+package objectsync
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// File is a local file to be synchronized.
+type File struct {
+	// Path is the slash-separated path of the file relative to the directory.
+	Path string
+
+	// FullPath is the path of the file on disk.
+	FullPath string
+
+	Size int64
+
+	// ETag is the ETag S3 computes for the file when uploaded unencrypted or
+	// with SSE-S3 by s3manager with the part size.
+	ETag string
+}
+
+// Walk returns the regular files, including symbolic links to regular files,
+// under the directory that are selected by the filter, sorted by path.
+func Walk(dir string, filter *Filter, partSize int64) ([]File, error) {
+	var files []File
+
+	err := filepath.Walk(dir, func(fullPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			info, err = os.Stat(fullPath)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, fullPath)
+
+		if err != nil {
+			return err
+		}
+
+		path := filepath.ToSlash(rel)
+
+		if !filter.Match(path) {
+			return nil
+		}
+
+		etag, err := ETag(fullPath, info.Size(), partSize)
+
+		if err != nil {
+			return err
+		}
+
+		files = append(files, File{
+			Path:     path,
+			FullPath: fullPath,
+			Size:     info.Size(),
+			ETag:     etag,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory (%s): %w", dir, err)
+	}
+
+	return files, nil
+}
+
+// PartSize returns the part size s3manager uses to upload an object of the
+// given size, which is increased from the configured part size for objects
+// that would otherwise need more than the maximum number of parts.
+func PartSize(size, partSize int64) int64 {
+	if size/partSize >= s3manager.MaxUploadParts {
+		return size/s3manager.MaxUploadParts + 1
+	}
+
+	return partSize
+}
+
+// ETag returns the ETag of the file uploaded with the part size: the MD5
+// digest of objects no larger than the part size, which are uploaded with
+// PutObject, and otherwise the MD5 digest of the parts' MD5 digests followed
+// by the number of parts.
+func ETag(path string, size, partSize int64) (string, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	partSize = PartSize(size, partSize)
+
+	if size <= partSize {
+		h := md5.New()
+
+		if _, err := io.Copy(h, file); err != nil {
+			return "", fmt.Errorf("error reading file (%s): %w", path, err)
+		}
+
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	var digests []byte
+	parts := 0
+
+	for offset := int64(0); offset < size; offset += partSize {
+		h := md5.New()
+
+		if _, err := io.Copy(h, io.NewSectionReader(file, offset, partSize)); err != nil {
+			return "", fmt.Errorf("error reading file (%s): %w", path, err)
+		}
+
+		digests = h.Sum(digests)
+		parts++
+	}
+
+	digest := md5.Sum(digests)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(digest[:]), parts), nil
+}
+
+// ContentType returns the MIME type of the file from its extension, or else
+// by sniffing its first 512 bytes.
+func ContentType(path string) (string, error) {
+	if v := mime.TypeByExtension(filepath.Ext(path)); v != "" {
+		return v, nil
+	}
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(file, buf)
+
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", fmt.Errorf("error reading file (%s): %w", path, err)
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
+
+// Path returns the file path of the object key with the key prefix, and
+// whether the key has the prefix.
+func Path(prefix, key string) (string, bool) {
+	if !strings.HasPrefix(key, prefix) || key == prefix {
+		return "", false
+	}
+
+	return strings.TrimPrefix(key, prefix), true
+}
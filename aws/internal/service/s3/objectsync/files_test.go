@@ -0,0 +1,167 @@
+package objectsync
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+func TestETag(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file")
+	content := []byte("abcdefghijkl")
+
+	if err := ioutil.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	digest := func(b []byte) []byte {
+		v := md5.Sum(b)
+		return v[:]
+	}
+
+	var parts []byte
+	parts = append(parts, digest(content[0:5])...)
+	parts = append(parts, digest(content[5:10])...)
+	parts = append(parts, digest(content[10:])...)
+
+	testCases := []struct {
+		PartSize int64
+		Expected string
+	}{
+		{12, hex.EncodeToString(digest(content))},
+		{64, hex.EncodeToString(digest(content))},
+		{5, fmt.Sprintf("%s-3", hex.EncodeToString(digest(parts)))},
+	}
+
+	for _, testCase := range testCases {
+		got, err := ETag(path, int64(len(content)), testCase.PartSize)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != testCase.Expected {
+			t.Errorf("ETag with part size %d = %s, expected %s", testCase.PartSize, got, testCase.Expected)
+		}
+	}
+}
+
+func TestPartSize(t *testing.T) {
+	partSize := s3manager.MinUploadPartSize
+
+	if got := PartSize(partSize*10, partSize); got != partSize {
+		t.Errorf("got %d, expected %d", got, partSize)
+	}
+
+	size := partSize * s3manager.MaxUploadParts
+
+	if got, expected := PartSize(size, partSize), partSize+1; got != expected {
+		t.Errorf("got %d, expected %d", got, expected)
+	}
+}
+
+func TestContentType(t *testing.T) {
+	dir := t.TempDir()
+
+	testCases := []struct {
+		Name     string
+		Content  []byte
+		Expected string
+	}{
+		{"index.html", []byte("<p>hello</p>"), "text/html; charset=utf-8"},
+		{"site.css", []byte("p {}"), "text/css; charset=utf-8"},
+		{"logo", []byte("\x89PNG\x0D\x0A\x1A\x0A"), "image/png"},
+		{"README", []byte("hello"), "text/plain; charset=utf-8"},
+		{"empty", nil, "text/plain; charset=utf-8"},
+	}
+
+	for _, testCase := range testCases {
+		path := filepath.Join(dir, testCase.Name)
+
+		if err := ioutil.WriteFile(path, testCase.Content, 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := ContentType(path)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != testCase.Expected {
+			t.Errorf("ContentType(%q) = %q, expected %q", testCase.Name, got, testCase.Expected)
+		}
+	}
+}
+
+func TestWalk(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, path := range []string{"index.html", "docs/index.html", "docs/drafts/new.html", "img/logo.png"} {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := ioutil.WriteFile(path, []byte(path), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	filter, err := NewFilter([]string{"**/*.html"}, []string{"**/drafts/**"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := Walk(dir, filter, s3manager.MinUploadPartSize)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+
+	for _, file := range files {
+		got = append(got, file.Path)
+
+		if file.ETag == "" {
+			t.Errorf("%s: expected an ETag", file.Path)
+		}
+	}
+
+	if expected := []string{"docs/index.html", "index.html"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestPath(t *testing.T) {
+	testCases := []struct {
+		Prefix     string
+		Key        string
+		Expected   string
+		ExpectedOk bool
+	}{
+		{"", "index.html", "index.html", true},
+		{"site/", "site/docs/index.html", "docs/index.html", true},
+		{"site/", "site/", "", false},
+		{"site/", "other/index.html", "", false},
+	}
+
+	for _, testCase := range testCases {
+		got, ok := Path(testCase.Prefix, testCase.Key)
+
+		if got != testCase.Expected || ok != testCase.ExpectedOk {
+			t.Errorf("Path(%q, %q) = %q, %t, expected %q, %t", testCase.Prefix, testCase.Key, got, ok, testCase.Expected, testCase.ExpectedOk)
+		}
+	}
+}
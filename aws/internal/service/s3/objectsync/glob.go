@@ -0,0 +1,126 @@
+package objectsync
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CompileGlob compiles a glob pattern matching slash-separated paths.
+//
+// As well as the `*`, `?` and `[...]` syntax of path.Match, which do not match
+// `/`, the pattern `**` matches any number of characters including `/`, so
+// that `**/*.html` matches HTML files in any directory, including the top level.
+func CompileGlob(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+
+	b.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+
+		switch c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+
+				// `**/` also matches no directories at all.
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					b.WriteString("(?:.*/)?")
+				} else {
+					b.WriteString(".*")
+				}
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+
+			if end < 0 {
+				return nil, fmt.Errorf("invalid glob pattern (%s): unterminated character class", pattern)
+			}
+
+			class := pattern[i+1 : i+1+end]
+
+			if strings.HasPrefix(class, "!") || strings.HasPrefix(class, "^") {
+				class = "^" + class[1:]
+			}
+
+			b.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+				b.WriteString(regexp.QuoteMeta(string(pattern[i])))
+			}
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	b.WriteString("$")
+
+	re, err := regexp.Compile(b.String())
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob pattern (%s): %w", pattern, err)
+	}
+
+	return re, nil
+}
+
+// Filter selects paths matching any include pattern, or all paths if there
+// are none, and no exclude pattern.
+type Filter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// NewFilter returns a Filter for the include and exclude glob patterns.
+func NewFilter(include, exclude []string) (*Filter, error) {
+	f := &Filter{}
+
+	for _, pattern := range include {
+		re, err := CompileGlob(pattern)
+
+		if err != nil {
+			return nil, err
+		}
+
+		f.include = append(f.include, re)
+	}
+
+	for _, pattern := range exclude {
+		re, err := CompileGlob(pattern)
+
+		if err != nil {
+			return nil, err
+		}
+
+		f.exclude = append(f.exclude, re)
+	}
+
+	return f, nil
+}
+
+// Match returns whether the slash-separated path is selected.
+func (f *Filter) Match(path string) bool {
+	if len(f.include) > 0 && !matchAny(f.include, path) {
+		return false
+	}
+
+	return !matchAny(f.exclude, path)
+}
+
+func matchAny(res []*regexp.Regexp, path string) bool {
+	for _, re := range res {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
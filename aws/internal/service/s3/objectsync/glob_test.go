@@ -0,0 +1,87 @@
+package objectsync
+
+import (
+	"testing"
+)
+
+func TestCompileGlob(t *testing.T) {
+	testCases := []struct {
+		Pattern  string
+		Path     string
+		Expected bool
+	}{
+		{"index.html", "index.html", true},
+		{"index.html", "docs/index.html", false},
+		{"*.html", "index.html", true},
+		{"*.html", "docs/index.html", false},
+		{"**/*.html", "index.html", true},
+		{"**/*.html", "docs/index.html", true},
+		{"**/*.html", "docs/v1/index.html", true},
+		{"**/*.html", "index.htm", false},
+		{"docs/**", "docs/v1/index.html", true},
+		{"docs/**", "assets/docs/index.html", false},
+		{"docs/**/index.html", "docs/index.html", true},
+		{"docs/**/index.html", "docs/v1/v2/index.html", true},
+		{"img/?.png", "img/a.png", true},
+		{"img/?.png", "img/ab.png", false},
+		{"img/[ab].png", "img/b.png", true},
+		{"img/[!ab].png", "img/b.png", false},
+		{"img/[!ab].png", "img/c.png", true},
+		{"a+b(1).txt", "a+b(1).txt", true},
+		{`\*.txt`, "*.txt", true},
+		{`\*.txt`, "a.txt", false},
+	}
+
+	for _, testCase := range testCases {
+		re, err := CompileGlob(testCase.Pattern)
+
+		if err != nil {
+			t.Errorf("CompileGlob(%q): %s", testCase.Pattern, err)
+			continue
+		}
+
+		if got := re.MatchString(testCase.Path); got != testCase.Expected {
+			t.Errorf("CompileGlob(%q) matching %q = %t, expected %t", testCase.Pattern, testCase.Path, got, testCase.Expected)
+		}
+	}
+}
+
+func TestCompileGlob_invalid(t *testing.T) {
+	if _, err := CompileGlob("img/[ab.png"); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	filter, err := NewFilter([]string{"**/*.html", "**/*.css"}, []string{"drafts/**"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Path     string
+		Expected bool
+	}{
+		{"index.html", true},
+		{"css/site.css", true},
+		{"drafts/index.html", false},
+		{"img/logo.png", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := filter.Match(testCase.Path); got != testCase.Expected {
+			t.Errorf("Match(%q) = %t, expected %t", testCase.Path, got, testCase.Expected)
+		}
+	}
+
+	all, err := NewFilter(nil, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !all.Match("img/logo.png") {
+		t.Error("expected a filter without patterns to match all paths")
+	}
+}
@@ -30,6 +30,54 @@ func ListByteMatchSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input
 	return nil
 }
 
+// ListByteMatchSetsItems calls fn with each item of the ListByteMatchSets pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListByteMatchSetsItems(ctx context.Context, conn *waf.WAF, input *waf.ListByteMatchSetsInput, fn func(*waf.ByteMatchSetSummary) bool) error {
+	err := ListByteMatchSetsPagesWithContext(ctx, conn, input, func(page *waf.ListByteMatchSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.ByteMatchSets {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListByteMatchSetsAll returns the items of all ListByteMatchSets pages for which all
+// filters return true.
+func ListByteMatchSetsAll(ctx context.Context, conn *waf.WAF, input *waf.ListByteMatchSetsInput, filters ...func(*waf.ByteMatchSetSummary) bool) ([]*waf.ByteMatchSetSummary, error) {
+	var items []*waf.ByteMatchSetSummary
+
+	err := ListByteMatchSetsItems(ctx, conn, input, func(item *waf.ByteMatchSetSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListGeoMatchSetsPages(conn *waf.WAF, input *waf.ListGeoMatchSetsInput, fn func(*waf.ListGeoMatchSetsOutput, bool) bool) error {
 	return ListGeoMatchSetsPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -51,6 +99,54 @@ func ListGeoMatchSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input
 	return nil
 }
 
+// ListGeoMatchSetsItems calls fn with each item of the ListGeoMatchSets pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListGeoMatchSetsItems(ctx context.Context, conn *waf.WAF, input *waf.ListGeoMatchSetsInput, fn func(*waf.GeoMatchSetSummary) bool) error {
+	err := ListGeoMatchSetsPagesWithContext(ctx, conn, input, func(page *waf.ListGeoMatchSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.GeoMatchSets {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListGeoMatchSetsAll returns the items of all ListGeoMatchSets pages for which all
+// filters return true.
+func ListGeoMatchSetsAll(ctx context.Context, conn *waf.WAF, input *waf.ListGeoMatchSetsInput, filters ...func(*waf.GeoMatchSetSummary) bool) ([]*waf.GeoMatchSetSummary, error) {
+	var items []*waf.GeoMatchSetSummary
+
+	err := ListGeoMatchSetsItems(ctx, conn, input, func(item *waf.GeoMatchSetSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListIPSetsPages(conn *waf.WAF, input *waf.ListIPSetsInput, fn func(*waf.ListIPSetsOutput, bool) bool) error {
 	return ListIPSetsPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -72,6 +168,54 @@ func ListIPSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.L
 	return nil
 }
 
+// ListIPSetsItems calls fn with each item of the ListIPSets pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListIPSetsItems(ctx context.Context, conn *waf.WAF, input *waf.ListIPSetsInput, fn func(*waf.IPSetSummary) bool) error {
+	err := ListIPSetsPagesWithContext(ctx, conn, input, func(page *waf.ListIPSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.IPSets {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListIPSetsAll returns the items of all ListIPSets pages for which all
+// filters return true.
+func ListIPSetsAll(ctx context.Context, conn *waf.WAF, input *waf.ListIPSetsInput, filters ...func(*waf.IPSetSummary) bool) ([]*waf.IPSetSummary, error) {
+	var items []*waf.IPSetSummary
+
+	err := ListIPSetsItems(ctx, conn, input, func(item *waf.IPSetSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListRateBasedRulesPages(conn *waf.WAF, input *waf.ListRateBasedRulesInput, fn func(*waf.ListRateBasedRulesOutput, bool) bool) error {
 	return ListRateBasedRulesPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -93,6 +237,54 @@ func ListRateBasedRulesPagesWithContext(ctx context.Context, conn *waf.WAF, inpu
 	return nil
 }
 
+// ListRateBasedRulesItems calls fn with each item of the ListRateBasedRules pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListRateBasedRulesItems(ctx context.Context, conn *waf.WAF, input *waf.ListRateBasedRulesInput, fn func(*waf.RuleSummary) bool) error {
+	err := ListRateBasedRulesPagesWithContext(ctx, conn, input, func(page *waf.ListRateBasedRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Rules {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListRateBasedRulesAll returns the items of all ListRateBasedRules pages for which all
+// filters return true.
+func ListRateBasedRulesAll(ctx context.Context, conn *waf.WAF, input *waf.ListRateBasedRulesInput, filters ...func(*waf.RuleSummary) bool) ([]*waf.RuleSummary, error) {
+	var items []*waf.RuleSummary
+
+	err := ListRateBasedRulesItems(ctx, conn, input, func(item *waf.RuleSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListRegexMatchSetsPages(conn *waf.WAF, input *waf.ListRegexMatchSetsInput, fn func(*waf.ListRegexMatchSetsOutput, bool) bool) error {
 	return ListRegexMatchSetsPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -114,6 +306,54 @@ func ListRegexMatchSetsPagesWithContext(ctx context.Context, conn *waf.WAF, inpu
 	return nil
 }
 
+// ListRegexMatchSetsItems calls fn with each item of the ListRegexMatchSets pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListRegexMatchSetsItems(ctx context.Context, conn *waf.WAF, input *waf.ListRegexMatchSetsInput, fn func(*waf.RegexMatchSetSummary) bool) error {
+	err := ListRegexMatchSetsPagesWithContext(ctx, conn, input, func(page *waf.ListRegexMatchSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.RegexMatchSets {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListRegexMatchSetsAll returns the items of all ListRegexMatchSets pages for which all
+// filters return true.
+func ListRegexMatchSetsAll(ctx context.Context, conn *waf.WAF, input *waf.ListRegexMatchSetsInput, filters ...func(*waf.RegexMatchSetSummary) bool) ([]*waf.RegexMatchSetSummary, error) {
+	var items []*waf.RegexMatchSetSummary
+
+	err := ListRegexMatchSetsItems(ctx, conn, input, func(item *waf.RegexMatchSetSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListRegexPatternSetsPages(conn *waf.WAF, input *waf.ListRegexPatternSetsInput, fn func(*waf.ListRegexPatternSetsOutput, bool) bool) error {
 	return ListRegexPatternSetsPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -135,6 +375,54 @@ func ListRegexPatternSetsPagesWithContext(ctx context.Context, conn *waf.WAF, in
 	return nil
 }
 
+// ListRegexPatternSetsItems calls fn with each item of the ListRegexPatternSets pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListRegexPatternSetsItems(ctx context.Context, conn *waf.WAF, input *waf.ListRegexPatternSetsInput, fn func(*waf.RegexPatternSetSummary) bool) error {
+	err := ListRegexPatternSetsPagesWithContext(ctx, conn, input, func(page *waf.ListRegexPatternSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.RegexPatternSets {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListRegexPatternSetsAll returns the items of all ListRegexPatternSets pages for which all
+// filters return true.
+func ListRegexPatternSetsAll(ctx context.Context, conn *waf.WAF, input *waf.ListRegexPatternSetsInput, filters ...func(*waf.RegexPatternSetSummary) bool) ([]*waf.RegexPatternSetSummary, error) {
+	var items []*waf.RegexPatternSetSummary
+
+	err := ListRegexPatternSetsItems(ctx, conn, input, func(item *waf.RegexPatternSetSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListRuleGroupsPages(conn *waf.WAF, input *waf.ListRuleGroupsInput, fn func(*waf.ListRuleGroupsOutput, bool) bool) error {
 	return ListRuleGroupsPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -156,6 +444,54 @@ func ListRuleGroupsPagesWithContext(ctx context.Context, conn *waf.WAF, input *w
 	return nil
 }
 
+// ListRuleGroupsItems calls fn with each item of the ListRuleGroups pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListRuleGroupsItems(ctx context.Context, conn *waf.WAF, input *waf.ListRuleGroupsInput, fn func(*waf.RuleGroupSummary) bool) error {
+	err := ListRuleGroupsPagesWithContext(ctx, conn, input, func(page *waf.ListRuleGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.RuleGroups {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListRuleGroupsAll returns the items of all ListRuleGroups pages for which all
+// filters return true.
+func ListRuleGroupsAll(ctx context.Context, conn *waf.WAF, input *waf.ListRuleGroupsInput, filters ...func(*waf.RuleGroupSummary) bool) ([]*waf.RuleGroupSummary, error) {
+	var items []*waf.RuleGroupSummary
+
+	err := ListRuleGroupsItems(ctx, conn, input, func(item *waf.RuleGroupSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListRulesPages(conn *waf.WAF, input *waf.ListRulesInput, fn func(*waf.ListRulesOutput, bool) bool) error {
 	return ListRulesPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -177,6 +513,54 @@ func ListRulesPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.Li
 	return nil
 }
 
+// ListRulesItems calls fn with each item of the ListRules pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListRulesItems(ctx context.Context, conn *waf.WAF, input *waf.ListRulesInput, fn func(*waf.RuleSummary) bool) error {
+	err := ListRulesPagesWithContext(ctx, conn, input, func(page *waf.ListRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Rules {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListRulesAll returns the items of all ListRules pages for which all
+// filters return true.
+func ListRulesAll(ctx context.Context, conn *waf.WAF, input *waf.ListRulesInput, filters ...func(*waf.RuleSummary) bool) ([]*waf.RuleSummary, error) {
+	var items []*waf.RuleSummary
+
+	err := ListRulesItems(ctx, conn, input, func(item *waf.RuleSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListSizeConstraintSetsPages(conn *waf.WAF, input *waf.ListSizeConstraintSetsInput, fn func(*waf.ListSizeConstraintSetsOutput, bool) bool) error {
 	return ListSizeConstraintSetsPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -198,6 +582,54 @@ func ListSizeConstraintSetsPagesWithContext(ctx context.Context, conn *waf.WAF,
 	return nil
 }
 
+// ListSizeConstraintSetsItems calls fn with each item of the ListSizeConstraintSets pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListSizeConstraintSetsItems(ctx context.Context, conn *waf.WAF, input *waf.ListSizeConstraintSetsInput, fn func(*waf.SizeConstraintSetSummary) bool) error {
+	err := ListSizeConstraintSetsPagesWithContext(ctx, conn, input, func(page *waf.ListSizeConstraintSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.SizeConstraintSets {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListSizeConstraintSetsAll returns the items of all ListSizeConstraintSets pages for which all
+// filters return true.
+func ListSizeConstraintSetsAll(ctx context.Context, conn *waf.WAF, input *waf.ListSizeConstraintSetsInput, filters ...func(*waf.SizeConstraintSetSummary) bool) ([]*waf.SizeConstraintSetSummary, error) {
+	var items []*waf.SizeConstraintSetSummary
+
+	err := ListSizeConstraintSetsItems(ctx, conn, input, func(item *waf.SizeConstraintSetSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListSqlInjectionMatchSetsPages(conn *waf.WAF, input *waf.ListSqlInjectionMatchSetsInput, fn func(*waf.ListSqlInjectionMatchSetsOutput, bool) bool) error {
 	return ListSqlInjectionMatchSetsPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -219,6 +651,54 @@ func ListSqlInjectionMatchSetsPagesWithContext(ctx context.Context, conn *waf.WA
 	return nil
 }
 
+// ListSqlInjectionMatchSetsItems calls fn with each item of the ListSqlInjectionMatchSets pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListSqlInjectionMatchSetsItems(ctx context.Context, conn *waf.WAF, input *waf.ListSqlInjectionMatchSetsInput, fn func(*waf.SqlInjectionMatchSetSummary) bool) error {
+	err := ListSqlInjectionMatchSetsPagesWithContext(ctx, conn, input, func(page *waf.ListSqlInjectionMatchSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.SqlInjectionMatchSets {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListSqlInjectionMatchSetsAll returns the items of all ListSqlInjectionMatchSets pages for which all
+// filters return true.
+func ListSqlInjectionMatchSetsAll(ctx context.Context, conn *waf.WAF, input *waf.ListSqlInjectionMatchSetsInput, filters ...func(*waf.SqlInjectionMatchSetSummary) bool) ([]*waf.SqlInjectionMatchSetSummary, error) {
+	var items []*waf.SqlInjectionMatchSetSummary
+
+	err := ListSqlInjectionMatchSetsItems(ctx, conn, input, func(item *waf.SqlInjectionMatchSetSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListWebACLsPages(conn *waf.WAF, input *waf.ListWebACLsInput, fn func(*waf.ListWebACLsOutput, bool) bool) error {
 	return ListWebACLsPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -240,6 +720,54 @@ func ListWebACLsPagesWithContext(ctx context.Context, conn *waf.WAF, input *waf.
 	return nil
 }
 
+// ListWebACLsItems calls fn with each item of the ListWebACLs pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListWebACLsItems(ctx context.Context, conn *waf.WAF, input *waf.ListWebACLsInput, fn func(*waf.WebACLSummary) bool) error {
+	err := ListWebACLsPagesWithContext(ctx, conn, input, func(page *waf.ListWebACLsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.WebACLs {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListWebACLsAll returns the items of all ListWebACLs pages for which all
+// filters return true.
+func ListWebACLsAll(ctx context.Context, conn *waf.WAF, input *waf.ListWebACLsInput, filters ...func(*waf.WebACLSummary) bool) ([]*waf.WebACLSummary, error) {
+	var items []*waf.WebACLSummary
+
+	err := ListWebACLsItems(ctx, conn, input, func(item *waf.WebACLSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListXssMatchSetsPages(conn *waf.WAF, input *waf.ListXssMatchSetsInput, fn func(*waf.ListXssMatchSetsOutput, bool) bool) error {
 	return ListXssMatchSetsPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -260,3 +788,51 @@ func ListXssMatchSetsPagesWithContext(ctx context.Context, conn *waf.WAF, input
 	}
 	return nil
 }
+
+// ListXssMatchSetsItems calls fn with each item of the ListXssMatchSets pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListXssMatchSetsItems(ctx context.Context, conn *waf.WAF, input *waf.ListXssMatchSetsInput, fn func(*waf.XssMatchSetSummary) bool) error {
+	err := ListXssMatchSetsPagesWithContext(ctx, conn, input, func(page *waf.ListXssMatchSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.XssMatchSets {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListXssMatchSetsAll returns the items of all ListXssMatchSets pages for which all
+// filters return true.
+func ListXssMatchSetsAll(ctx context.Context, conn *waf.WAF, input *waf.ListXssMatchSetsInput, filters ...func(*waf.XssMatchSetSummary) bool) ([]*waf.XssMatchSetSummary, error) {
+	var items []*waf.XssMatchSetSummary
+
+	err := ListXssMatchSetsItems(ctx, conn, input, func(item *waf.XssMatchSetSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
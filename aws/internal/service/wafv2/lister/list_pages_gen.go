@@ -30,6 +30,54 @@ func ListIPSetsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2, input *w
 	return nil
 }
 
+// ListIPSetsItems calls fn with each item of the ListIPSets pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListIPSetsItems(ctx context.Context, conn *wafv2.WAFV2, input *wafv2.ListIPSetsInput, fn func(*wafv2.IPSetSummary) bool) error {
+	err := ListIPSetsPagesWithContext(ctx, conn, input, func(page *wafv2.ListIPSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.IPSets {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListIPSetsAll returns the items of all ListIPSets pages for which all
+// filters return true.
+func ListIPSetsAll(ctx context.Context, conn *wafv2.WAFV2, input *wafv2.ListIPSetsInput, filters ...func(*wafv2.IPSetSummary) bool) ([]*wafv2.IPSetSummary, error) {
+	var items []*wafv2.IPSetSummary
+
+	err := ListIPSetsItems(ctx, conn, input, func(item *wafv2.IPSetSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListRegexPatternSetsPages(conn *wafv2.WAFV2, input *wafv2.ListRegexPatternSetsInput, fn func(*wafv2.ListRegexPatternSetsOutput, bool) bool) error {
 	return ListRegexPatternSetsPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -51,6 +99,54 @@ func ListRegexPatternSetsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2
 	return nil
 }
 
+// ListRegexPatternSetsItems calls fn with each item of the ListRegexPatternSets pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListRegexPatternSetsItems(ctx context.Context, conn *wafv2.WAFV2, input *wafv2.ListRegexPatternSetsInput, fn func(*wafv2.RegexPatternSetSummary) bool) error {
+	err := ListRegexPatternSetsPagesWithContext(ctx, conn, input, func(page *wafv2.ListRegexPatternSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.RegexPatternSets {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListRegexPatternSetsAll returns the items of all ListRegexPatternSets pages for which all
+// filters return true.
+func ListRegexPatternSetsAll(ctx context.Context, conn *wafv2.WAFV2, input *wafv2.ListRegexPatternSetsInput, filters ...func(*wafv2.RegexPatternSetSummary) bool) ([]*wafv2.RegexPatternSetSummary, error) {
+	var items []*wafv2.RegexPatternSetSummary
+
+	err := ListRegexPatternSetsItems(ctx, conn, input, func(item *wafv2.RegexPatternSetSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListRuleGroupsPages(conn *wafv2.WAFV2, input *wafv2.ListRuleGroupsInput, fn func(*wafv2.ListRuleGroupsOutput, bool) bool) error {
 	return ListRuleGroupsPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -72,6 +168,54 @@ func ListRuleGroupsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2, inpu
 	return nil
 }
 
+// ListRuleGroupsItems calls fn with each item of the ListRuleGroups pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListRuleGroupsItems(ctx context.Context, conn *wafv2.WAFV2, input *wafv2.ListRuleGroupsInput, fn func(*wafv2.RuleGroupSummary) bool) error {
+	err := ListRuleGroupsPagesWithContext(ctx, conn, input, func(page *wafv2.ListRuleGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.RuleGroups {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListRuleGroupsAll returns the items of all ListRuleGroups pages for which all
+// filters return true.
+func ListRuleGroupsAll(ctx context.Context, conn *wafv2.WAFV2, input *wafv2.ListRuleGroupsInput, filters ...func(*wafv2.RuleGroupSummary) bool) ([]*wafv2.RuleGroupSummary, error) {
+	var items []*wafv2.RuleGroupSummary
+
+	err := ListRuleGroupsItems(ctx, conn, input, func(item *wafv2.RuleGroupSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
+
 func ListWebACLsPages(conn *wafv2.WAFV2, input *wafv2.ListWebACLsInput, fn func(*wafv2.ListWebACLsOutput, bool) bool) error {
 	return ListWebACLsPagesWithContext(context.Background(), conn, input, fn)
 }
@@ -92,3 +236,51 @@ func ListWebACLsPagesWithContext(ctx context.Context, conn *wafv2.WAFV2, input *
 	}
 	return nil
 }
+
+// ListWebACLsItems calls fn with each item of the ListWebACLs pages until fn
+// returns false, the pages are exhausted or ctx is canceled.
+func ListWebACLsItems(ctx context.Context, conn *wafv2.WAFV2, input *wafv2.ListWebACLsInput, fn func(*wafv2.WebACLSummary) bool) error {
+	err := ListWebACLsPagesWithContext(ctx, conn, input, func(page *wafv2.ListWebACLsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.WebACLs {
+			if item == nil {
+				continue
+			}
+
+			if !fn(item) {
+				return false
+			}
+		}
+
+		return !lastPage && ctx.Err() == nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// ListWebACLsAll returns the items of all ListWebACLs pages for which all
+// filters return true.
+func ListWebACLsAll(ctx context.Context, conn *wafv2.WAFV2, input *wafv2.ListWebACLsInput, filters ...func(*wafv2.WebACLSummary) bool) ([]*wafv2.WebACLSummary, error) {
+	var items []*wafv2.WebACLSummary
+
+	err := ListWebACLsItems(ctx, conn, input, func(item *wafv2.WebACLSummary) bool {
+		for _, filter := range filters {
+			if !filter(item) {
+				return true
+			}
+		}
+
+		items = append(items, item)
+
+		return true
+	})
+
+	return items, err
+}
@@ -0,0 +1,205 @@
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Sweepable is a resource to be deleted by a sweeper.
+type Sweepable interface {
+	// ID returns the identifier of the resource, used in logs and reports.
+	ID() string
+
+	// Name returns the name of the resource, matched by name prefix filters.
+	Name() string
+
+	// Tags returns the tags of the resource, matched by tag filters, or nil if
+	// they are not known.
+	Tags() map[string]string
+
+	// Delete deletes the resource.
+	Delete() error
+}
+
+// Resource is a Sweepable deleted by a function, typically calling the delete
+// API operation of the resource.
+type Resource struct {
+	id   string
+	name string
+	tags map[string]string
+
+	delete func() error
+}
+
+// NewResource returns a Resource with the given identifier, deleted by f. Its
+// name is the identifier, unless set with WithName.
+func NewResource(id string, f func() error) *Resource {
+	return &Resource{
+		id:     id,
+		name:   id,
+		delete: f,
+	}
+}
+
+// WithName sets the name of the resource, if it differs from its identifier.
+func (r *Resource) WithName(name string) *Resource {
+	r.name = name
+
+	return r
+}
+
+// WithTags sets the tags of the resource.
+func (r *Resource) WithTags(tags map[string]string) *Resource {
+	r.tags = tags
+
+	return r
+}
+
+func (r *Resource) ID() string {
+	return r.id
+}
+
+func (r *Resource) Name() string {
+	return r.name
+}
+
+func (r *Resource) Tags() map[string]string {
+	return r.tags
+}
+
+func (r *Resource) Delete() error {
+	return r.delete()
+}
+
+// SchemaResource is a Sweepable deleted by the Delete function of its
+// Terraform resource, as if destroyed by Terraform.
+type SchemaResource struct {
+	resource *schema.Resource
+	d        *schema.ResourceData
+	meta     interface{}
+}
+
+// NewSchemaResource returns a SchemaResource deleting d with the Delete
+// function of resource. The name and tags of the resource are those set in d,
+// if any.
+func NewSchemaResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SchemaResource {
+	return &SchemaResource{
+		resource: resource,
+		d:        d,
+		meta:     meta,
+	}
+}
+
+func (r *SchemaResource) ID() string {
+	return r.d.Id()
+}
+
+func (r *SchemaResource) Name() string {
+	if _, ok := r.resource.Schema["name"]; ok {
+		if v, ok := r.d.Get("name").(string); ok && v != "" {
+			return v
+		}
+	}
+
+	return r.d.Id()
+}
+
+func (r *SchemaResource) Tags() map[string]string {
+	if _, ok := r.resource.Schema["tags"]; !ok {
+		return nil
+	}
+
+	m, ok := r.d.Get("tags").(map[string]interface{})
+
+	if !ok || len(m) == 0 {
+		return nil
+	}
+
+	tags := make(map[string]string, len(m))
+
+	for k, v := range m {
+		tags[k] = fmt.Sprint(v)
+	}
+
+	return tags
+}
+
+func (r *SchemaResource) Delete() error {
+	if r.resource.DeleteContext == nil {
+		return r.resource.Delete(r.d, r.meta)
+	}
+
+	return diagnosticsError(r.resource.DeleteContext(context.Background(), r.d, r.meta))
+}
+
+// diagnosticsError returns the error diagnostics as an error, if any.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []string
+
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+
+		if d.Detail == "" {
+			errs = append(errs, d.Summary)
+		} else {
+			errs = append(errs, fmt.Sprintf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s", strings.Join(errs, "; "))
+}
+
+// Filter selects the resources deleted by sweepers, in addition to the
+// criteria of each sweeper. The zero Filter selects all resources.
+type Filter struct {
+	// NamePrefixes, if not empty, selects the resources whose name starts with
+	// any of them.
+	NamePrefixes []string
+
+	// Tags, if not empty, selects the resources with all of these tags. A tag
+	// with an empty value matches any value.
+	Tags map[string]string
+}
+
+// Match returns whether the filter selects the resource.
+func (f Filter) Match(s Sweepable) bool {
+	if len(f.NamePrefixes) > 0 {
+		name := s.Name()
+		matched := false
+
+		for _, prefix := range f.NamePrefixes {
+			if strings.HasPrefix(name, prefix) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	if len(f.Tags) > 0 {
+		tags := s.Tags()
+
+		for k, v := range f.Tags {
+			tagValue, ok := tags[k]
+
+			if !ok || (v != "" && tagValue != v) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
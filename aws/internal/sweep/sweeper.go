@@ -0,0 +1,487 @@
+// Package sweep implements sweepers, which delete the resources left behind by
+// acceptance tests.
+//
+// Sweepers are registered by resource type with AddTestSweepers and run with
+// the -sweep flag of the acceptance tests. Sweepers run after the sweepers of
+// their dependencies and independent sweepers run in parallel. Each sweeper
+// lists the resources to delete, which are then deleted in parallel, with a
+// maximum number of concurrent deletions per service.
+//
+// WARNING: Sweepers are designed to be destructive. They should not run in any
+// environment that is not strictly a test environment.
+package sweep
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// ListFunc lists the resources to delete in a region. Resources returned with
+// an error, e.g. when only some of them could be described, are still deleted
+// but the sweeper fails.
+type ListFunc func(region string) ([]Sweepable, error)
+
+// Sweeper deletes the resources of a type left behind by acceptance tests.
+type Sweeper struct {
+	// Name of the sweeper, typically the resource type.
+	Name string
+
+	// Service of the resources, limiting the concurrent deletions of the
+	// sweepers of the service. Defaults to the name of the sweeper.
+	Service string
+
+	// Dependencies are the names of the sweepers to run before this one, e.g.
+	// those of resources preventing the deletion of its resources.
+	Dependencies []string
+
+	// List lists the resources to delete.
+	List ListFunc
+}
+
+func (s *Sweeper) service() string {
+	if s.Service == "" {
+		return s.Name
+	}
+
+	return s.Service
+}
+
+var sweepers = make(map[string]*Sweeper)
+
+// AddTestSweepers registers a sweeper with the given unique name.
+func AddTestSweepers(name string, s *Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding sweeper (%s): sweeper already exists", name)
+	}
+
+	sweepers[name] = s
+}
+
+// Options configure a run of sweepers.
+type Options struct {
+	// AllowFailures continues the run after a sweeper fails.
+	AllowFailures bool
+
+	// Concurrency is the maximum number of concurrent deletions per service.
+	Concurrency int
+
+	// ServiceConcurrency overrides Concurrency for some services.
+	ServiceConcurrency map[string]int
+
+	// DryRun lists the resources that would be deleted without deleting them.
+	DryRun bool
+
+	// Filter selects the resources to delete.
+	Filter Filter
+
+	// Parallelism is the maximum number of sweepers running concurrently.
+	Parallelism int
+
+	// Run, if not empty, selects the sweepers whose name contains any of
+	// these, with their dependencies.
+	Run []string
+
+	// Report, if set, receives the summary report of each region.
+	Report io.Writer
+}
+
+func (o Options) concurrency(service string) int {
+	if v, ok := o.ServiceConcurrency[service]; ok && v > 0 {
+		return v
+	}
+
+	if o.Concurrency > 0 {
+		return o.Concurrency
+	}
+
+	return 1
+}
+
+func (o Options) parallelism() int {
+	if o.Parallelism > 0 {
+		return o.Parallelism
+	}
+
+	return 1
+}
+
+// Result is the outcome of a sweeper in a region.
+type Result struct {
+	Sweeper string
+	Service string
+	Region  string
+
+	// Found is the number of resources listed by the sweeper.
+	Found int
+
+	// Filtered is the number of listed resources not selected by the filter.
+	Filtered int
+
+	// Deleted is the number of resources deleted, or to delete in a dry run.
+	Deleted int
+
+	// Failed is the number of resources which failed to be deleted.
+	Failed int
+
+	// Resources are the identifiers of the resources deleted, or to delete in
+	// a dry run.
+	Resources []string
+
+	// Skipped is whether the sweeper did not run, because the run stopped
+	// after a failure or its dependencies could not be resolved.
+	Skipped bool
+
+	Duration time.Duration
+	Err      error
+}
+
+// Status returns a short description of the outcome.
+func (r *Result) Status() string {
+	switch {
+	case r.Skipped:
+		return "skipped"
+	case r.Err != nil:
+		return "failed"
+	default:
+		return "ok"
+	}
+}
+
+// Run runs the registered sweepers in each region.
+func Run(regions []string, options Options) ([]*Result, error) {
+	return run(regions, sweepers, options)
+}
+
+func run(regions []string, source map[string]*Sweeper, options Options) ([]*Result, error) {
+	selected := filterSweepers(options.Run, source)
+
+	var results []*Result
+	var failed bool
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+
+		regionResults, err := runRegion(region, selected, options)
+		results = append(results, regionResults...)
+
+		if options.Report != nil {
+			writeReport(options.Report, region, regionResults, options.DryRun)
+		}
+
+		if err != nil {
+			if !options.AllowFailures {
+				return results, err
+			}
+
+			failed = true
+		}
+	}
+
+	if failed {
+		return results, fmt.Errorf("at least one sweeper failed")
+	}
+
+	return results, nil
+}
+
+// filterSweepers returns the sweepers whose name contains any of the given
+// strings, with their dependencies, or all sweepers if none are given.
+func filterSweepers(run []string, source map[string]*Sweeper) map[string]*Sweeper {
+	if len(run) == 0 {
+		return source
+	}
+
+	result := make(map[string]*Sweeper)
+
+	for name := range source {
+		for _, s := range run {
+			if s != "" && strings.Contains(strings.ToLower(name), strings.ToLower(s)) {
+				addSweeperWithDependencies(name, source, result)
+			}
+		}
+	}
+
+	return result
+}
+
+func addSweeperWithDependencies(name string, source map[string]*Sweeper, result map[string]*Sweeper) {
+	if _, ok := result[name]; ok {
+		return
+	}
+
+	s, ok := source[name]
+
+	if !ok {
+		log.Printf("[WARN] Sweeper has dependency (%s), but that sweeper was not found", name)
+		return
+	}
+
+	result[name] = s
+
+	for _, dependency := range s.Dependencies {
+		addSweeperWithDependencies(dependency, source, result)
+	}
+}
+
+// runRegion runs the sweepers in a region, each after its dependencies.
+func runRegion(region string, sweepers map[string]*Sweeper, options Options) ([]*Result, error) {
+	limiter := newLimiter(options)
+	completions := make(chan *Result)
+	results := make(map[string]*Result, len(sweepers))
+
+	var pending []string
+
+	for name := range sweepers {
+		pending = append(pending, name)
+	}
+
+	sort.Strings(pending)
+
+	var firstErr error
+	running := 0
+
+	for {
+		if firstErr == nil || options.AllowFailures {
+			var waiting []string
+
+			for _, name := range pending {
+				if running >= options.parallelism() || !dependenciesCompleted(sweepers[name], sweepers, results) {
+					waiting = append(waiting, name)
+					continue
+				}
+
+				running++
+
+				go func(s *Sweeper) {
+					completions <- s.run(region, limiter, options)
+				}(sweepers[name])
+			}
+
+			pending = waiting
+		}
+
+		if running == 0 {
+			break
+		}
+
+		result := <-completions
+		running--
+		results[result.Sweeper] = result
+
+		if result.Err != nil {
+			log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", result.Sweeper, region, result.Err)
+
+			if firstErr == nil {
+				firstErr = fmt.Errorf("sweeper (%s) for region (%s) failed: %w", result.Sweeper, region, result.Err)
+			}
+		}
+	}
+
+	// Sweepers not run were skipped after a failure, or have a dependency
+	// cycle.
+	for _, name := range pending {
+		s := sweepers[name]
+		result := &Result{
+			Region:  region,
+			Service: s.service(),
+			Skipped: true,
+			Sweeper: name,
+		}
+
+		if firstErr == nil {
+			result.Err = fmt.Errorf("dependency cycle")
+			firstErr = fmt.Errorf("sweeper (%s) for region (%s) failed: %w", name, region, result.Err)
+		}
+
+		results[name] = result
+	}
+
+	var names []string
+
+	for name := range results {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	ordered := make([]*Result, 0, len(names))
+
+	for _, name := range names {
+		ordered = append(ordered, results[name])
+	}
+
+	if firstErr != nil && options.AllowFailures {
+		return ordered, fmt.Errorf("at least one sweeper for region (%s) failed", region)
+	}
+
+	return ordered, firstErr
+}
+
+// dependenciesCompleted returns whether the sweepers of the dependencies of s
+// have run. Dependencies without a sweeper are ignored.
+func dependenciesCompleted(s *Sweeper, sweepers map[string]*Sweeper, results map[string]*Result) bool {
+	for _, dependency := range s.Dependencies {
+		if _, ok := sweepers[dependency]; !ok {
+			continue
+		}
+
+		if _, ok := results[dependency]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// run lists the resources of the sweeper and deletes those selected by the
+// filter.
+func (s *Sweeper) run(region string, limiter *limiter, options Options) *Result {
+	start := time.Now()
+	result := &Result{
+		Region:  region,
+		Service: s.service(),
+		Sweeper: s.Name,
+	}
+
+	defer func() {
+		result.Duration = time.Since(start)
+	}()
+
+	log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", s.Name, region)
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	var errs *multierror.Error
+
+	sweepables, err := s.List(region)
+
+	if err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	result.Found = len(sweepables)
+
+	for _, sweepable := range sweepables {
+		if !options.Filter.Match(sweepable) {
+			result.Filtered++
+			continue
+		}
+
+		if options.DryRun {
+			log.Printf("[INFO] Would delete %s (%s) in region (%s)", s.Name, sweepable.ID(), region)
+			result.Deleted++
+			result.Resources = append(result.Resources, sweepable.ID())
+			continue
+		}
+
+		wg.Add(1)
+		limiter.acquire(result.Service)
+
+		go func(sweepable Sweepable) {
+			defer wg.Done()
+			defer limiter.release(result.Service)
+
+			log.Printf("[INFO] Deleting %s (%s) in region (%s)", s.Name, sweepable.ID(), region)
+			err := sweepable.Delete()
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			if err != nil {
+				result.Failed++
+				errs = multierror.Append(errs, fmt.Errorf("error deleting %s (%s): %w", s.Name, sweepable.ID(), err))
+				return
+			}
+
+			result.Deleted++
+			result.Resources = append(result.Resources, sweepable.ID())
+		}(sweepable)
+	}
+
+	wg.Wait()
+
+	sort.Strings(result.Resources)
+	result.Err = errs.ErrorOrNil()
+
+	return result
+}
+
+// limiter limits the concurrent deletions per service.
+type limiter struct {
+	lock       sync.Mutex
+	options    Options
+	semaphores map[string]chan struct{}
+}
+
+func newLimiter(options Options) *limiter {
+	return &limiter{
+		options:    options,
+		semaphores: make(map[string]chan struct{}),
+	}
+}
+
+func (l *limiter) semaphore(service string) chan struct{} {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	semaphore, ok := l.semaphores[service]
+
+	if !ok {
+		semaphore = make(chan struct{}, l.options.concurrency(service))
+		l.semaphores[service] = semaphore
+	}
+
+	return semaphore
+}
+
+func (l *limiter) acquire(service string) {
+	l.semaphore(service) <- struct{}{}
+}
+
+func (l *limiter) release(service string) {
+	<-l.semaphore(service)
+}
+
+// writeReport writes the summary report of the results in a region.
+func writeReport(w io.Writer, region string, results []*Result, dryRun bool) {
+	deleted := "DELETED"
+
+	if dryRun {
+		deleted = "TO DELETE"
+	}
+
+	fmt.Fprintf(w, "Sweepers for region (%s):\n", region)
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "SWEEPER\tSERVICE\tSTATUS\tFOUND\tFILTERED\t%s\tFAILED\tDURATION\n", deleted)
+
+	for _, r := range results {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\t%d\t%d\t%s\n", r.Sweeper, r.Service, r.Status(), r.Found, r.Filtered, r.Deleted, r.Failed, r.Duration.Round(time.Millisecond))
+	}
+
+	tw.Flush()
+
+	if dryRun {
+		for _, r := range results {
+			for _, id := range r.Resources {
+				fmt.Fprintf(w, "\t- %s: %s\n", r.Sweeper, id)
+			}
+		}
+	}
+
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Fprintf(w, "\t- %s: %s\n", r.Sweeper, r.Err)
+		}
+	}
+}
@@ -0,0 +1,351 @@
+package sweep
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testSweepers records the order in which resources are deleted.
+type testSweepers struct {
+	lock    sync.Mutex
+	deleted []string
+
+	active    int
+	maxActive int
+}
+
+func (ts *testSweepers) sweeper(name string, ids []string, dependencies ...string) *Sweeper {
+	return &Sweeper{
+		Name:         name,
+		Service:      "test",
+		Dependencies: dependencies,
+		List: func(region string) ([]Sweepable, error) {
+			var sweepables []Sweepable
+
+			for _, id := range ids {
+				id := id
+				sweepables = append(sweepables, NewResource(id, func() error {
+					ts.lock.Lock()
+					ts.active++
+					if ts.active > ts.maxActive {
+						ts.maxActive = ts.active
+					}
+					ts.lock.Unlock()
+
+					time.Sleep(10 * time.Millisecond)
+
+					ts.lock.Lock()
+					defer ts.lock.Unlock()
+					ts.active--
+
+					if strings.HasSuffix(id, "-error") {
+						return errors.New("test error")
+					}
+
+					ts.deleted = append(ts.deleted, id)
+
+					return nil
+				}).WithTags(map[string]string{"Name": id}))
+			}
+
+			return sweepables, nil
+		},
+	}
+}
+
+func (ts *testSweepers) indexOf(id string) int {
+	for i, v := range ts.deleted {
+		if v == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func TestRunDependencies(t *testing.T) {
+	ts := &testSweepers{}
+	sweepers := map[string]*Sweeper{
+		"aws_vpc":       ts.sweeper("aws_vpc", []string{"vpc-1", "vpc-2"}, "aws_subnet", "aws_missing"),
+		"aws_subnet":    ts.sweeper("aws_subnet", []string{"subnet-1", "subnet-2", "subnet-3"}, "aws_instance"),
+		"aws_instance":  ts.sweeper("aws_instance", []string{"i-1", "i-2", "i-3", "i-4"}),
+		"aws_sqs_queue": ts.sweeper("aws_sqs_queue", []string{"queue-1"}),
+	}
+
+	var report bytes.Buffer
+
+	results, err := run([]string{"us-west-2"}, sweepers, Options{
+		Concurrency: 2,
+		Parallelism: 4,
+		Report:      &report,
+		Run:         []string{"vpc"},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(results), 3; got != expected {
+		t.Fatalf("got %d results, expected %d", got, expected)
+	}
+
+	if got, expected := len(ts.deleted), 9; got != expected {
+		t.Errorf("got %d deleted resources, expected %d: %v", got, expected, ts.deleted)
+	}
+
+	if ts.indexOf("queue-1") != -1 {
+		t.Error("unexpected deletion of resource of sweeper not run")
+	}
+
+	for _, pair := range [][2]string{{"i-4", "subnet-1"}, {"subnet-3", "vpc-1"}} {
+		if ts.indexOf(pair[0]) > ts.indexOf(pair[1]) {
+			t.Errorf("%s deleted after %s: %v", pair[0], pair[1], ts.deleted)
+		}
+	}
+
+	if ts.maxActive > 2 {
+		t.Errorf("got %d concurrent deletions, expected at most 2", ts.maxActive)
+	}
+
+	if !strings.Contains(report.String(), "Sweepers for region (us-west-2):") || !strings.Contains(report.String(), "aws_subnet") {
+		t.Errorf("unexpected report:\n%s", report.String())
+	}
+}
+
+func TestRunDryRunAndFilter(t *testing.T) {
+	ts := &testSweepers{}
+	sweepers := map[string]*Sweeper{
+		"aws_sqs_queue": ts.sweeper("aws_sqs_queue", []string{"tf-acc-test-1", "tf-acc-test-2", "production"}),
+	}
+
+	var report bytes.Buffer
+
+	results, err := run([]string{"us-west-2"}, sweepers, Options{
+		DryRun: true,
+		Filter: Filter{
+			NamePrefixes: []string{"tf-acc-test", "tf-test"},
+			Tags:         map[string]string{"Name": ""},
+		},
+		Report: &report,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(ts.deleted) != 0 {
+		t.Errorf("unexpected deletions in dry run: %v", ts.deleted)
+	}
+
+	result := results[0]
+
+	if result.Found != 3 || result.Filtered != 1 || result.Deleted != 2 {
+		t.Errorf("got found %d, filtered %d, deleted %d, expected 3, 1, 2", result.Found, result.Filtered, result.Deleted)
+	}
+
+	if expected := []string{"tf-acc-test-1", "tf-acc-test-2"}; !reflect.DeepEqual(result.Resources, expected) {
+		t.Errorf("got resources %v, expected %v", result.Resources, expected)
+	}
+
+	for _, expected := range []string{"TO DELETE", "aws_sqs_queue: tf-acc-test-2"} {
+		if !strings.Contains(report.String(), expected) {
+			t.Errorf("report does not contain %q:\n%s", expected, report.String())
+		}
+	}
+}
+
+func TestRunFailures(t *testing.T) {
+	for _, allowFailures := range []bool{false, true} {
+		t.Run(fmt.Sprintf("AllowFailures=%t", allowFailures), func(t *testing.T) {
+			ts := &testSweepers{}
+			sweepers := map[string]*Sweeper{
+				"aws_instance": ts.sweeper("aws_instance", []string{"i-1", "i-2-error"}),
+				"aws_subnet":   ts.sweeper("aws_subnet", []string{"subnet-1"}, "aws_instance"),
+				"aws_security_group": {
+					Name: "aws_security_group",
+					List: func(region string) ([]Sweepable, error) {
+						return []Sweepable{NewResource("sg-1", func() error { return nil })}, errors.New("test error")
+					},
+				},
+				"aws_vpc": {
+					Name:         "aws_vpc",
+					Dependencies: []string{"aws_vpc"},
+					List: func(region string) ([]Sweepable, error) {
+						return nil, nil
+					},
+				},
+			}
+
+			results, err := run([]string{"us-west-2", "us-east-1"}, sweepers, Options{
+				AllowFailures: allowFailures,
+			})
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			statuses := make(map[string]string)
+
+			for _, result := range results {
+				statuses[result.Region+" "+result.Sweeper] = result.Status()
+			}
+
+			expected := map[string]string{
+				"us-west-2 aws_instance":       "failed",
+				"us-west-2 aws_security_group": "skipped",
+				"us-west-2 aws_subnet":         "skipped",
+				"us-west-2 aws_vpc":            "skipped",
+			}
+
+			if allowFailures {
+				expected = map[string]string{
+					"us-west-2 aws_instance":       "failed",
+					"us-west-2 aws_security_group": "failed",
+					"us-west-2 aws_subnet":         "ok",
+					"us-west-2 aws_vpc":            "skipped",
+					"us-east-1 aws_instance":       "failed",
+					"us-east-1 aws_security_group": "failed",
+					"us-east-1 aws_subnet":         "ok",
+					"us-east-1 aws_vpc":            "skipped",
+				}
+			}
+
+			if !reflect.DeepEqual(statuses, expected) {
+				t.Errorf("got statuses %v, expected %v", statuses, expected)
+			}
+		})
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	resource := NewResource("arn:aws:sqs:us-west-2:123456789012:tf-acc-test-1", nil).WithName("tf-acc-test-1").WithTags(map[string]string{"Env": "test"})
+
+	testCases := []struct {
+		Name     string
+		Filter   Filter
+		Expected bool
+	}{
+		{
+			Name:     "empty",
+			Expected: true,
+		},
+		{
+			Name:     "name prefix",
+			Filter:   Filter{NamePrefixes: []string{"tf-test", "tf-acc-test"}},
+			Expected: true,
+		},
+		{
+			Name:   "other name prefix",
+			Filter: Filter{NamePrefixes: []string{"tf-test"}},
+		},
+		{
+			Name:     "tag",
+			Filter:   Filter{Tags: map[string]string{"Env": "test"}},
+			Expected: true,
+		},
+		{
+			Name:     "tag key",
+			Filter:   Filter{Tags: map[string]string{"Env": ""}},
+			Expected: true,
+		},
+		{
+			Name:   "other tag value",
+			Filter: Filter{Tags: map[string]string{"Env": "production"}},
+		},
+		{
+			Name:   "missing tag",
+			Filter: Filter{NamePrefixes: []string{"tf-acc-test"}, Tags: map[string]string{"Owner": ""}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Filter.Match(resource); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSchemaResource(t *testing.T) {
+	var deleted string
+
+	r := &schema.Resource{
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			deleted = d.Id()
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	d := r.Data(nil)
+	d.SetId("id-1")
+
+	sweepable := NewSchemaResource(r, d, nil)
+
+	if got, expected := sweepable.Name(), "id-1"; got != expected {
+		t.Errorf("got name %s, expected %s", got, expected)
+	}
+
+	if tags := sweepable.Tags(); tags != nil {
+		t.Errorf("got tags %v, expected none", tags)
+	}
+
+	if err := d.Set("name", "tf-acc-test-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.Set("tags", map[string]interface{}{"Env": "test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, expected := sweepable.Name(), "tf-acc-test-1"; got != expected {
+		t.Errorf("got name %s, expected %s", got, expected)
+	}
+
+	if got, expected := sweepable.Tags(), map[string]string{"Env": "test"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got tags %v, expected %v", got, expected)
+	}
+
+	if err := sweepable.Delete(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if deleted != "id-1" {
+		t.Errorf("got deleted %s, expected id-1", deleted)
+	}
+}
+
+func TestParseKeyValues(t *testing.T) {
+	got, err := parseKeyValues("Env=test, Owner=")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := map[string]string{"Env": "test", "Owner": ""}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	if _, err := parseKeyValues("Env"); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,133 @@
+package sweep
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// The -sweep, -sweep-run and -sweep-allow-failures flags are those of the
+// Terraform Plugin SDK test framework.
+var (
+	flagSweepConcurrency        = flag.Int("sweep-concurrency", 5, "Maximum number of concurrent deletions per service by Sweepers")
+	flagSweepDryRun             = flag.Bool("sweep-dry-run", false, "List the resources Sweepers would delete, without deleting them")
+	flagSweepNamePrefixes       = flag.String("sweep-name-prefixes", "", "Comma separated list of name prefixes of the resources deleted by Sweepers")
+	flagSweepParallelism        = flag.Int("sweep-parallelism", 4, "Maximum number of Sweepers running concurrently")
+	flagSweepServiceConcurrency = flag.String("sweep-service-concurrency", "", "Comma separated list of SERVICE=N overrides of -sweep-concurrency")
+	flagSweepTags               = flag.String("sweep-tags", "", "Comma separated list of KEY=VALUE tags of the resources deleted by Sweepers")
+)
+
+// TestMain runs the registered sweepers if the -sweep flag is set, otherwise
+// the tests.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	options, err := optionsFromFlags()
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	if _, err := Run(strings.Split(regions, ","), options); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+}
+
+// optionsFromFlags returns the options set by the command line flags.
+func optionsFromFlags() (Options, error) {
+	options := Options{
+		AllowFailures: flagValue("sweep-allow-failures") == "true",
+		Concurrency:   *flagSweepConcurrency,
+		DryRun:        *flagSweepDryRun,
+		Filter: Filter{
+			NamePrefixes: splitList(*flagSweepNamePrefixes),
+		},
+		Parallelism: *flagSweepParallelism,
+		Report:      os.Stdout,
+		Run:         splitList(flagValue("sweep-run")),
+	}
+
+	tags, err := parseKeyValues(*flagSweepTags)
+
+	if err != nil {
+		return options, fmt.Errorf("error parsing -sweep-tags: %w", err)
+	}
+
+	options.Filter.Tags = tags
+
+	serviceConcurrency, err := parseKeyValues(*flagSweepServiceConcurrency)
+
+	if err != nil {
+		return options, fmt.Errorf("error parsing -sweep-service-concurrency: %w", err)
+	}
+
+	options.ServiceConcurrency = make(map[string]int, len(serviceConcurrency))
+
+	for service, v := range serviceConcurrency {
+		n, err := strconv.Atoi(v)
+
+		if err != nil || n < 1 {
+			return options, fmt.Errorf("error parsing -sweep-service-concurrency: invalid concurrency (%s) for service (%s)", v, service)
+		}
+
+		options.ServiceConcurrency[service] = n
+	}
+
+	return options, nil
+}
+
+// flagValue returns the value of a flag defined by another package.
+func flagValue(name string) string {
+	f := flag.Lookup(name)
+
+	if f == nil {
+		return ""
+	}
+
+	return f.Value.String()
+}
+
+func splitList(s string) []string {
+	var result []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// parseKeyValues parses a comma separated list of KEY=VALUE pairs.
+func parseKeyValues(s string) (map[string]string, error) {
+	result := make(map[string]string)
+
+	for _, pair := range splitList(s) {
+		parts := strings.SplitN(pair, "=", 2)
+
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("unexpected format (%s), expected KEY=VALUE", pair)
+		}
+
+		result[parts[0]] = parts[1]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,43 @@
+package tfresource
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3control"
+)
+
+// DefaultPropagationTimeout is the maximum amount of time to wait for changes
+// to propagate in services without a registered propagation timeout.
+const DefaultPropagationTimeout = 2 * time.Minute
+
+// propagationTimeouts are the maximum amounts of time to wait for changes to
+// propagate, keyed by AWS Go SDK service name.
+// These timeouts should not be increased without strong consideration
+// as this will negatively impact user experience when configurations
+// have incorrect references or permissions.
+var propagationTimeouts = map[string]time.Duration{
+	ec2.ServiceName:   2 * time.Minute,
+	elbv2.ServiceName: 2 * time.Minute,
+	// Reference: https://docs.aws.amazon.com/IAM/latest/UserGuide/troubleshoot_general.html#troubleshoot_general_eventual-consistency
+	iam.ServiceName: 2 * time.Minute,
+	// Grants can take several minutes to become usable with newly created IAM roles.
+	kms.ServiceName: 3 * time.Minute,
+	// Function policies can take several minutes to become consistent.
+	lambda.ServiceName:    5 * time.Minute,
+	s3control.ServiceName: 5 * time.Minute,
+}
+
+// PropagationTimeout returns the maximum amount of time to wait for changes to
+// propagate in the service with the specified AWS Go SDK service name.
+func PropagationTimeout(service string) time.Duration {
+	if timeout, ok := propagationTimeouts[service]; ok {
+		return timeout
+	}
+
+	return DefaultPropagationTimeout
+}
@@ -0,0 +1,109 @@
+package tfresource
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Retryable is a function that returns true if an error is transient and the
+// call that returned it should be retried.
+type Retryable func(error) bool
+
+// RetryWhen retries the specified function for up to timeout while the error it
+// returns is retryable, then calls it one last time.
+// If the error from the last call is still retryable, a resource.TimeoutError
+// wrapping it is returned, so that running out of retries is reported the same
+// way by all callers.
+func RetryWhen(timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
+	var output interface{}
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+		output, err = f()
+
+		if err == nil {
+			return nil
+		}
+
+		if retryable(err) {
+			return resource.RetryableError(err)
+		}
+
+		return resource.NonRetryableError(err)
+	})
+
+	if TimedOut(err) || (err != nil && retryable(err)) {
+		output, err = f()
+	}
+
+	if err == nil {
+		return output, nil
+	}
+
+	if retryable(err) {
+		return nil, &resource.TimeoutError{
+			LastError:     err,
+			Timeout:       timeout,
+			ExpectedState: []string{"success"},
+		}
+	}
+
+	return nil, err
+}
+
+// RetryWhenAWSErrCodeEquals retries the specified function while it returns an
+// AWS error with one of the specified codes.
+func RetryWhenAWSErrCodeEquals(timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) {
+	return RetryWhen(timeout, f, func(err error) bool {
+		for _, code := range codes {
+			if tfawserr.ErrCodeEquals(err, code) {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// RetryWhenNotFound retries the specified function while it returns a
+// resource.NotFoundError, e.g. when reading a resource just after creating it.
+func RetryWhenNotFound(timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return RetryWhen(timeout, f, NotFound)
+}
+
+// RetryUntilFound retries the specified function until it returns a non-nil
+// result. Following the convention of finder functions, a nil result without
+// an error means that nothing was found, as does a resource.NotFoundError.
+func RetryUntilFound(timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return RetryWhenNotFound(timeout, func() (interface{}, error) {
+		output, err := f()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if isNil(output) {
+			return nil, &resource.NotFoundError{}
+		}
+
+		return output, nil
+	})
+}
+
+// isNil returns true if v is nil or a nil pointer, map or slice, as returned
+// by finder functions through an interface{}.
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return rv.IsNil()
+	}
+
+	return false
+}
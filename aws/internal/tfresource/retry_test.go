@@ -0,0 +1,158 @@
+package tfresource
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestRetryWhenAWSErrCodeEquals(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		F           func(calls int) (interface{}, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func(_ int) (interface{}, error) {
+				return "ok", nil
+			},
+		},
+		{
+			Name: "non-retryable error",
+			F: func(_ int) (interface{}, error) {
+				return nil, errors.New("test")
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable error then success",
+			F: func(calls int) (interface{}, error) {
+				if calls == 1 {
+					return nil, awserr.New("TestCode2", "test", nil)
+				}
+
+				return "ok", nil
+			},
+		},
+		{
+			Name: "retryable error timeout",
+			F: func(_ int) (interface{}, error) {
+				return nil, awserr.New("TestCode1", "test", nil)
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var calls int
+
+			output, err := RetryWhenAWSErrCodeEquals(2*time.Second, func() (interface{}, error) {
+				calls++
+				return testCase.F(calls)
+			}, "TestCode1", "TestCode2")
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectError {
+				return
+			}
+
+			if got, expected := output, "ok"; got != expected {
+				t.Errorf("got output %v, expected %v", got, expected)
+			}
+		})
+	}
+}
+
+func TestRetryWhenAWSErrCodeEquals_timeout(t *testing.T) {
+	lastErr := awserr.New("TestCode", "test", nil)
+
+	_, err := RetryWhenAWSErrCodeEquals(1*time.Second, func() (interface{}, error) {
+		return nil, lastErr
+	}, "TestCode")
+
+	var timeoutErr *resource.TimeoutError
+
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected resource.TimeoutError, got: %v", err)
+	}
+
+	if timeoutErr.LastError != lastErr {
+		t.Errorf("got last error %v, expected %v", timeoutErr.LastError, lastErr)
+	}
+}
+
+func TestRetryWhenNotFound(t *testing.T) {
+	var calls int
+
+	output, err := RetryWhenNotFound(5*time.Second, func() (interface{}, error) {
+		calls++
+
+		if calls < 3 {
+			return nil, &resource.NotFoundError{}
+		}
+
+		return "ok", nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if output != "ok" {
+		t.Errorf("got output %v, expected ok", output)
+	}
+
+	if calls != 3 {
+		t.Errorf("got %d calls, expected 3", calls)
+	}
+}
+
+func TestRetryUntilFound(t *testing.T) {
+	type thing struct{}
+
+	var calls int
+
+	output, err := RetryUntilFound(5*time.Second, func() (interface{}, error) {
+		calls++
+
+		if calls < 2 {
+			// A finder returning a typed nil pointer.
+			var v *thing
+			return v, nil
+		}
+
+		return &thing{}, nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := output.(*thing); !ok {
+		t.Errorf("got output %#v, expected *thing", output)
+	}
+
+	if calls != 2 {
+		t.Errorf("got %d calls, expected 2", calls)
+	}
+}
+
+func TestRetryUntilFound_timeout(t *testing.T) {
+	_, err := RetryUntilFound(1*time.Second, func() (interface{}, error) {
+		return nil, nil
+	})
+
+	if !NotFound(err) {
+		t.Errorf("expected wrapped resource.NotFoundError, got: %v", err)
+	}
+}
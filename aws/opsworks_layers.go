@@ -247,23 +247,23 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 
 	return &schema.Resource{
 		Read: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).opsworksconn()
 			ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 			return lt.Read(d, client, ignoreTagsConfig)
 		},
 		Create: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).opsworksconn()
 			return lt.Create(d, client, meta)
 		},
 		Update: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).opsworksconn()
 			ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 			return lt.Update(d, client, ignoreTagsConfig)
 		},
 		Delete: func(d *schema.ResourceData, meta interface{}) error {
-			client := meta.(*AWSClient).opsworksconn
+			client := meta.(*AWSClient).opsworksconn()
 			return lt.Delete(d, client)
 		},
 		Importer: &schema.ResourceImporter{
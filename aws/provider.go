@@ -2,9 +2,12 @@ package aws
 
 import (
 	"log"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/mutexkv"
 )
@@ -40,6 +43,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -72,6 +77,8 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry": retrySchema(),
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -88,6 +95,35 @@ func Provider() *schema.Provider {
 				Set:           schema.HashString,
 			},
 
+			"audit_log_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["audit_log_path"],
+			},
+
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to default resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags to default across all resources.",
+						},
+					},
+				},
+			},
+
+			"endpoint_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["endpoint_url"],
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -111,6 +147,19 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"key_values": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys and values to ignore across all resources. Tags are only ignored if both the key and the value match.",
+						},
 					},
 				},
 			},
@@ -122,6 +171,53 @@ func Provider() *schema.Provider {
 				Description: descriptions["insecure"],
 			},
 
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", nil),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["http_proxy"],
+			},
+
+			"https_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["https_proxy"],
+			},
+
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["no_proxy"],
+			},
+
+			"tls_min_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1.0", "1.1", "1.2", "1.3"}, false),
+				Description:  descriptions["tls_min_version"],
+			},
+
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["read_only"],
+			},
+
+			"read_only_allowed_operations": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: descriptions["read_only_allowed_operations"],
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -268,6 +364,8 @@ func Provider() *schema.Provider {
 			"aws_iam_instance_profile":                       dataSourceAwsIAMInstanceProfile(),
 			"aws_iam_policy":                                 dataSourceAwsIAMPolicy(),
 			"aws_iam_policy_document":                        dataSourceAwsIamPolicyDocument(),
+			"aws_iam_policy_document_split":                  dataSourceAwsIamPolicyDocumentSplit(),
+			"aws_iam_policy_evaluation":                      dataSourceAwsIamPolicyEvaluation(),
 			"aws_iam_role":                                   dataSourceAwsIAMRole(),
 			"aws_iam_server_certificate":                     dataSourceAwsIAMServerCertificate(),
 			"aws_iam_user":                                   dataSourceAwsIAMUser(),
@@ -924,10 +1022,21 @@ func Provider() *schema.Provider {
 			"aws_s3_access_point":                                     resourceAwsS3AccessPoint(),
 			"aws_s3_account_public_access_block":                      resourceAwsS3AccountPublicAccessBlock(),
 			"aws_s3_bucket":                                           resourceAwsS3Bucket(),
+			"aws_s3_bucket_acl":                                       resourceAwsS3BucketAcl(),
 			"aws_s3_bucket_analytics_configuration":                   resourceAwsS3BucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                        resourceAwsS3BucketCorsConfiguration(),
+			"aws_s3_bucket_lifecycle_configuration":                   resourceAwsS3BucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                                   resourceAwsS3BucketLogging(),
+			"aws_s3_bucket_object_lock_configuration":                 resourceAwsS3BucketObjectLockConfiguration(),
+			"aws_s3_bucket_replication_configuration":                 resourceAwsS3BucketReplicationConfiguration(),
+			"aws_s3_bucket_request_payment_configuration":             resourceAwsS3BucketRequestPaymentConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration":      resourceAwsS3BucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                                resourceAwsS3BucketVersioning(),
+			"aws_s3_bucket_website_configuration":                     resourceAwsS3BucketWebsiteConfiguration(),
 			"aws_s3_bucket_policy":                                    resourceAwsS3BucketPolicy(),
 			"aws_s3_bucket_public_access_block":                       resourceAwsS3BucketPublicAccessBlock(),
 			"aws_s3_bucket_object":                                    resourceAwsS3BucketObject(),
+			"aws_s3_bucket_objects_sync":                              resourceAwsS3BucketObjectsSync(),
 			"aws_s3_bucket_ownership_controls":                        resourceAwsS3BucketOwnershipControls(),
 			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
 			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
@@ -1101,6 +1210,23 @@ func Provider() *schema.Provider {
 	provider.DataSourcesMap["aws_serverlessapplicationrepository_application"] = dataSourceAwsServerlessApplicationRepositoryApplication()
 	provider.ResourcesMap["aws_serverlessapplicationrepository_cloudformation_stack"] = resourceAwsServerlessApplicationRepositoryCloudFormationStack()
 
+	// Generated single tag resources, see internal/keyvaluetags/generators/tagresources
+	for name, r := range tagResources() {
+		provider.ResourcesMap[name] = r
+	}
+
+	// Give each resource and data source an AWSClient that identifies its
+	// resource type in requests. Regional resources and data sources can also
+	// be managed in any region of the provider partition via their own region
+	// argument.
+	for name, r := range provider.DataSourcesMap {
+		wrapDataSource(name, r, isRegionalResource(name, r))
+	}
+
+	for name, r := range provider.ResourcesMap {
+		wrapResource(name, r, isRegionalResource(name, r))
+	}
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1143,9 +1269,37 @@ func init() {
 
 		"endpoint": "Use this to override the default service endpoint URL",
 
+		"endpoint_url": "Use this to override the default endpoint URL of all services, e.g. for\n" +
+			"AWS compatible solutions running locally. Endpoints configured in the endpoints\n" +
+			"configuration block take precedence.",
+
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 			"default value is `false`",
 
+		"custom_ca_bundle": "Path of a file of PEM encoded certificates trusted, in addition to the " +
+			"system certificates, when connecting to AWS APIs, e.g. those of an inspecting proxy. " +
+			"Can also be set with the AWS_CA_BUNDLE environment variable.",
+
+		"http_proxy": "URL of the proxy for HTTP requests to AWS APIs. If omitted, the HTTP_PROXY " +
+			"environment variable is used.",
+
+		"https_proxy": "URL of the proxy for HTTPS requests to AWS APIs. If omitted, the HTTPS_PROXY " +
+			"environment variable is used.",
+
+		"no_proxy": "Comma separated hosts, domains and CIDR blocks to connect to without a proxy. " +
+			"If omitted, the NO_PROXY environment variable is used.",
+
+		"tls_min_version": "Minimum TLS version of connections to AWS APIs. Valid values are 1.0, 1.1, 1.2 and 1.3.",
+
+		"audit_log_path": "Path of a file to which a JSON line is appended for every AWS API operation " +
+			"that is not a Describe, Get, Head or List operation.",
+
+		"read_only": "Reject any AWS API operation that is not a Describe, Get, Head or List operation. " +
+			"Used to guarantee that no resources are modified, e.g. during plan.",
+
+		"read_only_allowed_operations": "Additional AWS API operations to allow in read_only mode, " +
+			"in the form service:Operation, e.g. sts:GetCallerIdentity.",
+
 		"skip_credentials_validation": "Skip the credentials validation via STS API. " +
 			"Used for AWS API implementations that do not have STS available/implemented.",
 
@@ -1325,15 +1479,25 @@ func init() {
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	config := Config{
 		AccessKey:               d.Get("access_key").(string),
+		AuditLogPath:            d.Get("audit_log_path").(string),
 		SecretKey:               d.Get("secret_key").(string),
 		Profile:                 d.Get("profile").(string),
 		Token:                   d.Get("token").(string),
 		Region:                  d.Get("region").(string),
 		CredsFilename:           d.Get("shared_credentials_file").(string),
+		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
+		EndpointURL:             d.Get("endpoint_url").(string),
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
+		CustomCABundle:          d.Get("custom_ca_bundle").(string),
+		HTTPProxy:               d.Get("http_proxy").(string),
+		HTTPSProxy:              d.Get("https_proxy").(string),
+		NoProxy:                 d.Get("no_proxy").(string),
+		TLSMinVersion:           d.Get("tls_min_version").(string),
+		ReadOnly:                d.Get("read_only").(bool),
+		RetryConfig:             expandProviderRetry(d.Get("retry").([]interface{})),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
@@ -1407,6 +1571,8 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 	}
 
+	config.AssumeRoleWithWebIdentityConfig = expandProviderAssumeRoleWithWebIdentity(d.Get("assume_role_with_web_identity").([]interface{}))
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1416,6 +1582,12 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	if v, ok := d.GetOk("read_only_allowed_operations"); ok {
+		for _, operationRaw := range v.(*schema.Set).List() {
+			config.ReadOnlyAllowedOperations = append(config.ReadOnlyAllowedOperations, operationRaw.(string))
+		}
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -1489,6 +1661,53 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Duration of the assume role session, e.g. 1h. Between 15 minutes and 12 hours.",
+					ValidateFunc: validateAssumeRoleWithWebIdentityDuration,
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"role_arn": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Amazon Resource Name of an IAM Role to assume with the web identity token.",
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  "OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Path of a file containing an OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider. The file is read again each time the credentials are refreshed.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1510,6 +1729,64 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandProviderDefaultTags(l []interface{}) *keyvaluetags.DefaultConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	defaultConfig := &keyvaluetags.DefaultConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["tags"].(map[string]interface{}); ok {
+		defaultConfig.Tags = keyvaluetags.New(v)
+	}
+
+	return defaultConfig
+}
+
+func expandProviderAssumeRoleWithWebIdentity(l []interface{}) *AssumeRoleWithWebIdentityConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	config := &AssumeRoleWithWebIdentityConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["duration"].(string); ok && v != "" {
+		config.Duration, _ = time.ParseDuration(v)
+	}
+
+	if v, ok := m["policy_arns"].(*schema.Set); ok {
+		for _, policyARNRaw := range v.List() {
+			policyARN, ok := policyARNRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			config.PolicyARNs = append(config.PolicyARNs, policyARN)
+		}
+	}
+
+	if v, ok := m["role_arn"].(string); ok {
+		config.RoleARN = v
+	}
+
+	if v, ok := m["session_name"].(string); ok {
+		config.SessionName = v
+	}
+
+	if v, ok := m["web_identity_token"].(string); ok {
+		config.WebIdentityToken = v
+	}
+
+	if v, ok := m["web_identity_token_file"].(string); ok {
+		config.WebIdentityTokenFile = v
+	}
+
+	return config
+}
+
 func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1526,6 +1803,23 @@ func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = keyvaluetags.New(v.List())
 	}
 
+	if v, ok := m["key_regexes"].(*schema.Set); ok {
+		for _, keyRegexRaw := range v.List() {
+			keyRegex, ok := keyRegexRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			// Validated by the schema.
+			ignoreConfig.KeyRegexes = append(ignoreConfig.KeyRegexes, regexp.MustCompile(keyRegex))
+		}
+	}
+
+	if v, ok := m["key_values"].(map[string]interface{}); ok {
+		ignoreConfig.KeyValues = keyvaluetags.New(v)
+	}
+
 	return ignoreConfig
 }
 
@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// auditLogRedacted replaces the value of sensitive request parameters.
+const auditLogRedacted = "(redacted)"
+
+// auditLogSensitiveKeys lists case-insensitive substrings of request parameter
+// names whose values are redacted in the audit log, e.g. SecretString,
+// MasterUserPassword, Plaintext and PrivateKey.
+var auditLogSensitiveKeys = []string{
+	"authtoken",
+	"passphrase",
+	"password",
+	"plaintext",
+	"privatekey",
+	"secretaccesskey",
+	"secretbinary",
+	"secretstring",
+	"sessiontoken",
+}
+
+// auditLogSensitiveOperationParameters lists additional top-level request
+// parameters, by operation, whose values are redacted in the audit log.
+var auditLogSensitiveOperationParameters = map[string][]string{
+	"ssm:PutParameter": {"Value"},
+}
+
+// auditLogEntry is a single line of the audit log.
+type auditLogEntry struct {
+	Time         time.Time   `json:"time"`
+	Service      string      `json:"service"`
+	Operation    string      `json:"operation"`
+	Region       string      `json:"region"`
+	ResourceType string      `json:"resource_type,omitempty"`
+	RequestID    string      `json:"request_id,omitempty"`
+	HTTPStatus   int         `json:"http_status,omitempty"`
+	DurationMS   int64       `json:"duration_ms"`
+	RetryCount   int         `json:"retry_count"`
+	ErrorCode    string      `json:"error_code,omitempty"`
+	Parameters   interface{} `json:"parameters,omitempty"`
+}
+
+// auditLogger writes one JSON line for each completed non-read-only request.
+type auditLogger struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+// newAuditLogger returns an auditLogger appending to the file at path.
+func newAuditLogger(path string) (*auditLogger, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening audit log (%s): %w", path, err)
+	}
+
+	return &auditLogger{w: f}, nil
+}
+
+// handler returns a Complete handler that records the request in the audit log.
+func (l *auditLogger) handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform.AuditLogHandler",
+		Fn: func(r *request.Request) {
+			if r.Operation == nil || isReadOnlyOperation(r.Operation.Name) {
+				return
+			}
+
+			if err := l.write(newAuditLogEntry(r)); err != nil {
+				log.Printf("[WARN] Unable to write audit log entry for %s:%s: %s", r.ClientInfo.ServiceName, r.Operation.Name, err)
+			}
+		},
+	}
+}
+
+func (l *auditLogger) write(entry *auditLogEntry) error {
+	line, err := json.Marshal(entry)
+
+	if err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	_, err = l.w.Write(append(line, '\n'))
+
+	return err
+}
+
+func newAuditLogEntry(r *request.Request) *auditLogEntry {
+	entry := &auditLogEntry{
+		Time:         r.Time.UTC(),
+		Service:      r.ClientInfo.ServiceName,
+		Operation:    r.Operation.Name,
+		Region:       aws.StringValue(r.Config.Region),
+		ResourceType: requestResourceType(r),
+		RequestID:    r.RequestID,
+		DurationMS:   time.Since(r.Time).Milliseconds(),
+		RetryCount:   r.RetryCount,
+		Parameters:   sanitizeAuditLogParameters(r.Params, auditLogSensitiveOperationParameters[r.ClientInfo.ServiceName+":"+r.Operation.Name]),
+	}
+
+	if r.HTTPResponse != nil {
+		entry.HTTPStatus = r.HTTPResponse.StatusCode
+	}
+
+	if r.Error != nil {
+		if awsErr, ok := r.Error.(awserr.Error); ok {
+			entry.ErrorCode = awsErr.Code()
+		}
+
+		// Not all failures, e.g. HTTP errors without a response body, have an error code.
+		if entry.ErrorCode == "" {
+			entry.ErrorCode = "Unknown"
+		}
+	}
+
+	return entry
+}
+
+// sanitizeAuditLogParameters returns the generic JSON representation of the
+// request parameters with the values of sensitive parameters, and of the given
+// additional top-level parameters, redacted.
+func sanitizeAuditLogParameters(params interface{}, sensitiveKeys []string) interface{} {
+	if params == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(params)
+
+	if err != nil {
+		return nil
+	}
+
+	var v interface{}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil
+	}
+
+	if m, ok := v.(map[string]interface{}); ok {
+		for _, key := range sensitiveKeys {
+			if _, ok := m[key]; ok {
+				m[key] = auditLogRedacted
+			}
+		}
+	}
+
+	return redactAuditLogValue(v)
+}
+
+func redactAuditLogValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isAuditLogSensitiveKey(key) {
+				v[key] = auditLogRedacted
+				continue
+			}
+
+			v[key] = redactAuditLogValue(value)
+		}
+
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactAuditLogValue(value)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+func isAuditLogSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+
+	for _, sensitiveKey := range auditLogSensitiveKeys {
+		if strings.Contains(key, sensitiveKey) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestSanitizeAuditLogParameters(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Params        interface{}
+		SensitiveKeys []string
+		Expected      interface{}
+	}{
+		{
+			Name:     "nil",
+			Params:   nil,
+			Expected: nil,
+		},
+		{
+			Name: "secretsmanager",
+			Params: &secretsmanager.PutSecretValueInput{
+				SecretId:     aws.String("test"),
+				SecretString: aws.String("s3cr3t"),
+			},
+			Expected: map[string]interface{}{
+				"ClientRequestToken": nil,
+				"SecretBinary":       auditLogRedacted,
+				"SecretId":           "test",
+				"SecretString":       auditLogRedacted,
+				"VersionStages":      nil,
+			},
+		},
+		{
+			Name: "nested",
+			Params: map[string]interface{}{
+				"Users": []interface{}{
+					map[string]interface{}{
+						"Username": "test",
+						"Password": "s3cr3t",
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"Users": []interface{}{
+					map[string]interface{}{
+						"Username": "test",
+						"Password": auditLogRedacted,
+					},
+				},
+			},
+		},
+		{
+			Name: "operation sensitive keys",
+			Params: map[string]interface{}{
+				"Name":  "test",
+				"Value": "s3cr3t",
+			},
+			SensitiveKeys: []string{"Value"},
+			Expected: map[string]interface{}{
+				"Name":  "test",
+				"Value": auditLogRedacted,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := sanitizeAuditLogParameters(testCase.Params, testCase.SensitiveKeys)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+
+	params := sanitizeAuditLogParameters(&rds.CreateDBInstanceInput{MasterUserPassword: aws.String("s3cr3t")}, nil)
+
+	if got := params.(map[string]interface{})["MasterUserPassword"]; got != auditLogRedacted {
+		t.Errorf("got MasterUserPassword %v, expected %s", got, auditLogRedacted)
+	}
+}
+
+func TestAuditLogHandler(t *testing.T) {
+	ec2Endpoints := []*awsbase.MockEndpoint{
+		{
+			Request: &awsbase.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   "Action=DescribeAccountAttributes&AttributeName.1=supported-platforms&Version=2016-11-15",
+			},
+			Response: &awsbase.MockResponse{
+				StatusCode:  200,
+				Body:        test_ec2_describeAccountAttributes_response,
+				ContentType: "text/xml",
+			},
+		},
+	}
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("EC2", ec2Endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	var buf bytes.Buffer
+	logger := &auditLogger{w: &buf}
+	sess.Handlers.Complete.PushBackNamed(logger.handler())
+
+	client := &AWSClient{
+		endpoints: map[string]string{"ec2": aws.StringValue(sess.Config.Endpoint)},
+		partition: endpoints.AwsPartitionID,
+		region:    aws.StringValue(sess.Config.Region),
+		session:   sess,
+	}
+
+	if _, err := GetSupportedEC2Platforms(client.ec2conn()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if buf.Len() != 0 {
+		t.Fatalf("expected read-only operation not to be logged, got: %s", buf.String())
+	}
+
+	resourceClient, err := client.resourceClient("aws_ec2_tag", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The mocked API does not support this operation, but it is logged regardless.
+	_, _ = resourceClient.ec2conn().CreateTags(&ec2.CreateTagsInput{
+		Resources: aws.StringSlice([]string{"i-12345678"}),
+		Tags:      []*ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+	})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+
+	if got, expected := len(lines), 1; got != expected {
+		t.Fatalf("got %d audit log lines, expected %d", got, expected)
+	}
+
+	var entry auditLogEntry
+
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("error parsing audit log line: %s", err)
+	}
+
+	if got, expected := entry.Operation, "CreateTags"; got != expected {
+		t.Errorf("got operation %s, expected %s", got, expected)
+	}
+
+	if got, expected := entry.Service, ec2.ServiceName; got != expected {
+		t.Errorf("got service %s, expected %s", got, expected)
+	}
+
+	if got, expected := entry.Region, client.region; got != expected {
+		t.Errorf("got region %s, expected %s", got, expected)
+	}
+
+	if got, expected := entry.ResourceType, "aws_ec2_tag"; got != expected {
+		t.Errorf("got resource type %s, expected %s", got, expected)
+	}
+
+	if entry.ErrorCode == "" {
+		t.Errorf("expected error code")
+	}
+}
@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// credentialsExpiryWindow is how long before they expire assumed role
+// credentials are refreshed, so that requests are not signed with
+// credentials about to expire.
+const credentialsExpiryWindow = 5 * time.Minute
+
+// credentials returns the provider credentials, resolved by the provider
+// rather than by awsbase: the web identity role credentials if configured,
+// otherwise the awsbase credentials chain. If an assume_role role ARN is
+// configured, these credentials are used to assume that role. STS requests use
+// httpClient, if any. Assumed role credentials are refreshed automatically
+// before they expire.
+func (c *Config) credentials(awsbaseConfig *awsbase.Config, httpClient *http.Client) (*credentials.Credentials, error) {
+	sess, err := session.NewSession(&aws.Config{
+		// Replaced by the credentials below where requests must be signed.
+		Credentials: credentials.AnonymousCredentials,
+		Endpoint:    aws.String(c.endpoint("sts")),
+		HTTPClient:  cleanhttp.DefaultClient(),
+		MaxRetries:  aws.Int(c.MaxRetries),
+		Region:      aws.String(c.Region),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating credentials session: %w", err)
+	}
+
+	// Set after the session is created, as the AWS SDK otherwise replaces the
+	// root CAs of the client with the AWS_CA_BUNDLE environment variable bundle.
+	if httpClient != nil {
+		sess.Config.HTTPClient = httpClient
+	}
+
+	var creds *credentials.Credentials
+
+	if c.AssumeRoleWithWebIdentityConfig != nil {
+		creds, err = c.webIdentityCredentials(sess)
+	} else {
+		// The role is assumed below, with the provider HTTP client.
+		baseConfig := *awsbaseConfig
+		baseConfig.AssumeRoleARN = ""
+
+		creds, err = awsbase.GetCredentials(&baseConfig)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if c.AssumeRoleARN == "" {
+		return creds, nil
+	}
+
+	creds = credentials.NewCredentials(c.assumeRoleProvider(sess.Copy(&aws.Config{Credentials: creds})))
+
+	if _, err := creds.Get(); err != nil {
+		return nil, awsbaseConfig.NewCannotAssumeRoleError(err)
+	}
+
+	return creds, nil
+}
+
+// assumeRoleProvider returns a provider of credentials for the assume_role
+// configuration, assuming the role with sess.
+func (c *Config) assumeRoleProvider(sess *session.Session) *stscreds.AssumeRoleProvider {
+	assumeRoleProvider := &stscreds.AssumeRoleProvider{
+		Client:          sts.New(sess),
+		Duration:        time.Duration(c.AssumeRoleDurationSeconds) * time.Second,
+		ExpiryWindow:    credentialsExpiryWindow,
+		PolicyArns:      expandStsPolicyDescriptorTypes(c.AssumeRolePolicyARNs),
+		RoleARN:         c.AssumeRoleARN,
+		RoleSessionName: c.AssumeRoleSessionName,
+	}
+
+	if c.AssumeRoleExternalID != "" {
+		assumeRoleProvider.ExternalID = aws.String(c.AssumeRoleExternalID)
+	}
+
+	if c.AssumeRolePolicy != "" {
+		assumeRoleProvider.Policy = aws.String(c.AssumeRolePolicy)
+	}
+
+	for k, v := range c.AssumeRoleTags {
+		assumeRoleProvider.Tags = append(assumeRoleProvider.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	if len(c.AssumeRoleTransitiveTagKeys) > 0 {
+		assumeRoleProvider.TransitiveTagKeys = aws.StringSlice(c.AssumeRoleTransitiveTagKeys)
+	}
+
+	return assumeRoleProvider
+}
+
+// accountIDAndPartition validates the credentials of sess and returns the
+// account ID and partition, as awsbase.GetSessionWithAccountIDAndPartition
+// does for the credentials it resolves.
+func (c *Config) accountIDAndPartition(sess *session.Session) (string, string, error) {
+	var accountID, partition string
+	stsconn := sts.New(sess)
+
+	if !c.SkipCredsValidation {
+		var err error
+		accountID, partition, err = awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(stsconn)
+
+		if err != nil {
+			return "", "", fmt.Errorf("error validating provider credentials: %w", err)
+		}
+	} else if !c.SkipRequestingAccountId {
+		credentialsProviderName := ""
+
+		if credentialsValue, err := sess.Config.Credentials.Get(); err == nil {
+			credentialsProviderName = credentialsValue.ProviderName
+		}
+
+		var err error
+		accountID, partition, err = awsbase.GetAccountIDAndPartition(iam.New(sess), stsconn, credentialsProviderName)
+
+		if err != nil {
+			return "", "", fmt.Errorf(
+				"AWS account ID not previously found and failed retrieving via all available methods. "+
+					"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+					"Errors: %w", err)
+		}
+	}
+
+	// As with assume_role in awsbase, the account ID is otherwise that of the
+	// assumed role.
+	roleARN := c.AssumeRoleARN
+
+	if roleARN == "" && c.AssumeRoleWithWebIdentityConfig != nil {
+		roleARN = c.AssumeRoleWithWebIdentityConfig.RoleARN
+	}
+
+	if v, err := arn.Parse(roleARN); accountID == "" && err == nil {
+		accountID, partition = v.AccountID, v.Partition
+	}
+
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok && partition == "" {
+		partition = p.ID()
+	}
+
+	return accountID, partition, nil
+}
+
+func expandStsPolicyDescriptorTypes(policyARNs []string) []*sts.PolicyDescriptorType {
+	var policyDescriptorTypes []*sts.PolicyDescriptorType
+
+	for _, policyARN := range policyARNs {
+		policyDescriptorTypes = append(policyDescriptorTypes, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	return policyDescriptorTypes
+}
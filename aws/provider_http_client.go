@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// tlsVersions maps the provider tls_min_version values to TLS versions.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// testHTTPTransport, if set, wraps the transport of the HTTP client used for AWS
+// API requests. It is only set by acceptance tests, to record and replay
+// requests (see TF_AWS_RECORD).
+var testHTTPTransport func(http.RoundTripper) http.RoundTripper
+
+// hasHTTPClientConfig returns whether the configuration customizes the HTTP
+// client used for AWS API requests.
+func (c *Config) hasHTTPClientConfig() bool {
+	return testHTTPTransport != nil || c.HTTPProxy != "" || c.HTTPSProxy != "" || c.NoProxy != "" || c.CustomCABundle != "" || c.TLSMinVersion != ""
+}
+
+// httpClient returns an HTTP client using the configured proxies, CA bundle and
+// TLS settings. Proxies not configured are read from the environment.
+func (c *Config) httpClient() (*http.Client, error) {
+	client := cleanhttp.DefaultClient()
+	transport := client.Transport.(*http.Transport)
+
+	proxyConfig := httpproxy.FromEnvironment()
+
+	if c.HTTPProxy != "" {
+		proxyConfig.HTTPProxy = c.HTTPProxy
+	}
+
+	if c.HTTPSProxy != "" {
+		proxyConfig.HTTPSProxy = c.HTTPSProxy
+	}
+
+	if c.NoProxy != "" {
+		proxyConfig.NoProxy = c.NoProxy
+	}
+
+	proxyFunc := proxyConfig.ProxyFunc()
+	transport.Proxy = func(r *http.Request) (*url.URL, error) {
+		return proxyFunc(r.URL)
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if c.CustomCABundle != "" {
+		pem, err := ioutil.ReadFile(c.CustomCABundle)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom CA bundle (%s): %w", c.CustomCABundle, err)
+		}
+
+		// The bundle is added to the system certificates, so that endpoints
+		// not behind an inspecting proxy are still trusted.
+		certPool, err := x509.SystemCertPool()
+
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+
+		if !certPool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("error reading custom CA bundle (%s): no PEM encoded certificates found", c.CustomCABundle)
+		}
+
+		tlsConfig.RootCAs = certPool
+	}
+
+	if c.TLSMinVersion != "" {
+		tlsConfig.MinVersion = tlsVersions[c.TLSMinVersion]
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if testHTTPTransport != nil {
+		client.Transport = testHTTPTransport(transport)
+	}
+
+	return client, nil
+}
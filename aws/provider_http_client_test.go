@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestConfigHTTPClientProxy(t *testing.T) {
+	config := &Config{
+		HTTPProxy:  "http://http-proxy.example.com:3128",
+		HTTPSProxy: "http://https-proxy.example.com:3128",
+		NoProxy:    "localhost,.internal.example.com",
+	}
+
+	client, err := config.httpClient()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	proxy := client.Transport.(*http.Transport).Proxy
+
+	testCases := []struct {
+		URL      string
+		Expected string
+	}{
+		{
+			URL:      "http://ec2.us-west-2.amazonaws.com",
+			Expected: "http://http-proxy.example.com:3128",
+		},
+		{
+			URL:      "https://ec2.us-west-2.amazonaws.com",
+			Expected: "http://https-proxy.example.com:3128",
+		},
+		{
+			URL:      "https://s3.internal.example.com",
+			Expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.URL, func(t *testing.T) {
+			u, err := url.Parse(testCase.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := proxy(&http.Request{URL: u})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got == nil {
+				if testCase.Expected != "" {
+					t.Errorf("got no proxy, expected %s", testCase.Expected)
+				}
+			} else if got.String() != testCase.Expected {
+				t.Errorf("got proxy %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConfigHTTPClientTLS(t *testing.T) {
+	config := &Config{
+		Insecure:      true,
+		TLSMinVersion: "1.2",
+	}
+
+	client, err := config.httpClient()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tlsConfig := client.Transport.(*http.Transport).TLSClientConfig
+
+	if got, expected := tlsConfig.MinVersion, uint16(tls.VersionTLS12); got != expected {
+		t.Errorf("got minimum TLS version %x, expected %x", got, expected)
+	}
+
+	if !tlsConfig.InsecureSkipVerify {
+		t.Error("expected insecure TLS")
+	}
+}
+
+func TestConfigHTTPClientCustomCABundleError(t *testing.T) {
+	bundle, err := ioutil.TempFile("", "tf-acc-test-ca-bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(bundle.Name())
+
+	if _, err := bundle.WriteString("not a certificate"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := bundle.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, customCABundle := range []string{bundle.Name(), bundle.Name() + "-missing"} {
+		config := &Config{
+			CustomCABundle: customCABundle,
+		}
+
+		if _, err := config.httpClient(); err == nil {
+			t.Errorf("expected error for CA bundle %s", customCABundle)
+		}
+	}
+}
+
+func TestConfigClientCustomCABundle(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(awsbase.MockStsGetCallerIdentityValidResponseBody))
+	}))
+	defer ts.Close()
+
+	bundle, err := ioutil.TempFile("", "tf-acc-test-ca-bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(bundle.Name())
+
+	if err := pem.Encode(bundle, &pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := bundle.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &Config{
+		AccessKey:           "StaticAccessKey",
+		CustomCABundle:      bundle.Name(),
+		Endpoints:           map[string]string{"sts": ts.URL},
+		Region:              endpoints.UsEast1RegionID,
+		SecretKey:           "StaticSecretKey",
+		SkipGetEC2Platforms: true,
+	}
+
+	raw, err := config.Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	if got, expected := client.accountid, awsbase.MockStsGetCallerIdentityAccountID; got != expected {
+		t.Errorf("got account ID %s, expected %s", got, expected)
+	}
+
+	// Without the CA bundle the server certificate is not trusted.
+	config.CustomCABundle = ""
+	config.TLSMinVersion = "1.2"
+
+	if _, err := config.Client(); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// ErrCodeReadOnlyModeOperationBlocked is the error code of errors returned for
+	// operations blocked by the provider read_only mode.
+	ErrCodeReadOnlyModeOperationBlocked = "ReadOnlyModeOperationBlocked"
+)
+
+// readOnlyOperationPrefixes lists operation name prefixes that do not mutate
+// AWS resources and are allowed in read_only mode.
+var readOnlyOperationPrefixes = []string{
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+}
+
+// readOnlyHandler returns a Send handler that rejects any operation that is
+// not read-only unless it is in allowedOperations. Allowed operations are of the
+// form <service>:<operation>, e.g. sts:GetCallerIdentity, where the service is
+// either the SDK service name or the request signing name.
+func readOnlyHandler(allowedOperations []string) request.NamedHandler {
+	allowed := make(map[string]bool, len(allowedOperations))
+
+	for _, v := range allowedOperations {
+		allowed[strings.ToLower(v)] = true
+	}
+
+	return request.NamedHandler{
+		Name: "terraform.ReadOnlyHandler",
+		Fn: func(r *request.Request) {
+			if r.Operation == nil || isReadOnlyOperation(r.Operation.Name) {
+				return
+			}
+
+			for _, service := range []string{r.ClientInfo.ServiceName, r.ClientInfo.SigningName} {
+				if service != "" && allowed[strings.ToLower(service+":"+r.Operation.Name)] {
+					return
+				}
+			}
+
+			operation := fmt.Sprintf("%s:%s", r.ClientInfo.ServiceName, r.Operation.Name)
+			message := fmt.Sprintf("provider read_only mode blocked operation (%s)", operation)
+
+			if resourceType := requestResourceType(r); resourceType != "" {
+				message = fmt.Sprintf("provider read_only mode blocked operation (%s) for resource (%s)", operation, resourceType)
+			}
+
+			r.Error = awserr.New(ErrCodeReadOnlyModeOperationBlocked, message, nil)
+			r.Retryable = aws.Bool(false)
+		},
+	}
+}
+
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Expected bool
+	}{
+		{Name: "DescribeInstances", Expected: true},
+		{Name: "GetCallerIdentity", Expected: true},
+		{Name: "HeadObject", Expected: true},
+		{Name: "ListTagsForResource", Expected: true},
+		{Name: "CreateVpc", Expected: false},
+		{Name: "DeleteBucket", Expected: false},
+		{Name: "PutObject", Expected: false},
+		{Name: "RunInstances", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := isReadOnlyOperation(testCase.Name); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestReadOnlyHandler(t *testing.T) {
+	ec2Endpoints := []*awsbase.MockEndpoint{
+		{
+			Request: &awsbase.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   "Action=DescribeAccountAttributes&AttributeName.1=supported-platforms&Version=2016-11-15",
+			},
+			Response: &awsbase.MockResponse{
+				StatusCode:  200,
+				Body:        test_ec2_describeAccountAttributes_response,
+				ContentType: "text/xml",
+			},
+		},
+	}
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("EC2", ec2Endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	sess.Handlers.Send.AfterEachFn = request.HandlerListStopOnError
+	sess.Handlers.Send.PushFrontNamed(readOnlyHandler([]string{"ec2:CreateTags"}))
+
+	client := &AWSClient{
+		endpoints: map[string]string{"ec2": aws.StringValue(sess.Config.Endpoint)},
+		partition: endpoints.AwsPartitionID,
+		region:    aws.StringValue(sess.Config.Region),
+		session:   sess,
+	}
+
+	if _, err := GetSupportedEC2Platforms(client.ec2conn()); err != nil {
+		t.Errorf("expected read-only operation to be allowed, got: %s", err)
+	}
+
+	resourceClient, err := client.resourceClient("aws_instance", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = resourceClient.ec2conn().RunInstances(&ec2.RunInstancesInput{
+		MaxCount: aws.Int64(1),
+		MinCount: aws.Int64(1),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeReadOnlyModeOperationBlocked) {
+		t.Fatalf("expected %s error, got: %v", ErrCodeReadOnlyModeOperationBlocked, err)
+	}
+
+	for _, expected := range []string{"ec2:RunInstances", "aws_instance"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got: %s", expected, err)
+		}
+	}
+
+	_, err = client.ec2conn().CreateTags(&ec2.CreateTagsInput{
+		Resources: aws.StringSlice([]string{"i-12345678"}),
+		Tags:      []*ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeReadOnlyModeOperationBlocked) {
+		t.Errorf("expected allowed operation not to be blocked, got: %s", err)
+	}
+}
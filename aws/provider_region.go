@@ -0,0 +1,385 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// globalResourcePrefixes lists resource and data source name prefixes for
+// services whose resources are not scoped to a region. These do not receive
+// the per-resource region argument.
+var globalResourcePrefixes = []string{
+	"aws_budgets_",
+	"aws_cloudfront_",
+	"aws_cur_",
+	"aws_ecrpublic_",
+	"aws_globalaccelerator_",
+	"aws_iam_",
+	"aws_organizations_",
+	"aws_pricing_",
+	"aws_route53_",
+	"aws_shield_",
+	"aws_waf_",
+}
+
+// globalResourceNames lists individual resources and data sources that are
+// not scoped to a region.
+var globalResourceNames = map[string]bool{
+	"aws_billing_service_account": true,
+	"aws_caller_identity":         true,
+	"aws_canonical_user_id":       true,
+	"aws_ip_ranges":               true,
+	"aws_partition":               true,
+	"aws_region":                  true,
+	"aws_regions":                 true,
+}
+
+// regionalResourcePrefixes lists exceptions to globalResourcePrefixes.
+var regionalResourcePrefixes = []string{
+	"aws_route53_resolver_",
+}
+
+// isRegionalResource returns whether the per-resource region argument should
+// be added to the named resource or data source.
+func isRegionalResource(name string, r *schema.Resource) bool {
+	if _, ok := r.Schema["region"]; ok {
+		return false
+	}
+
+	if globalResourceNames[name] {
+		return false
+	}
+
+	for _, prefix := range regionalResourcePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	for _, prefix := range globalResourcePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// regionGetter is implemented by both schema.ResourceData and schema.ResourceDiff.
+type regionGetter interface {
+	Get(string) interface{}
+}
+
+// resourceMeta returns the AWSClient for the named resource type. For
+// regional resources this is in the region configured on the resource,
+// falling back to the provider region when it is not set.
+func resourceMeta(name string, regional bool, d regionGetter, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*AWSClient)
+
+	if !ok {
+		return meta, nil
+	}
+
+	var region string
+
+	if regional {
+		region, _ = d.Get("region").(string)
+	}
+
+	return client.resourceClient(name, region)
+}
+
+// setRegion records the region a regional resource was managed in, once it exists.
+func setRegion(d *schema.ResourceData, meta interface{}, regional bool) error {
+	client, ok := meta.(*AWSClient)
+
+	if !ok || !regional || d.Id() == "" {
+		return nil
+	}
+
+	if err := d.Set("region", client.region); err != nil {
+		return fmt.Errorf("error setting region: %w", err)
+	}
+
+	return nil
+}
+
+// wrapResource wraps the CRUD, CustomizeDiff and import functions of the
+// named resource so that they receive an AWSClient for that resource type in
+// place of the provider AWSClient. Regional resources also get an optional
+// region argument and the AWSClient operates in that region.
+func wrapResource(name string, r *schema.Resource, regional bool) {
+	if regional {
+		r.Schema["region"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		}
+	}
+
+	if f := r.Create; f != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := resourceMeta(name, regional, d, meta)
+
+			if err != nil {
+				return err
+			}
+
+			if err := f(d, meta); err != nil {
+				return err
+			}
+
+			return setRegion(d, meta, regional)
+		}
+	}
+
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			meta, err := resourceMeta(name, regional, d, meta)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			diags := f(ctx, d, meta)
+
+			if diags.HasError() {
+				return diags
+			}
+
+			return append(diags, diag.FromErr(setRegion(d, meta, regional))...)
+		}
+	}
+
+	wrapRead(name, r, regional)
+
+	if f := r.Update; f != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := resourceMeta(name, regional, d, meta)
+
+			if err != nil {
+				return err
+			}
+
+			return f(d, meta)
+		}
+	}
+
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			meta, err := resourceMeta(name, regional, d, meta)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			return f(ctx, d, meta)
+		}
+	}
+
+	if f := r.Delete; f != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := resourceMeta(name, regional, d, meta)
+
+			if err != nil {
+				return err
+			}
+
+			return f(d, meta)
+		}
+	}
+
+	if f := r.DeleteContext; f != nil {
+		r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			meta, err := resourceMeta(name, regional, d, meta)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			return f(ctx, d, meta)
+		}
+	}
+
+	if f := r.Exists; f != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			meta, err := resourceMeta(name, regional, d, meta)
+
+			if err != nil {
+				return false, err
+			}
+
+			return f(d, meta)
+		}
+	}
+
+	if customizeDiff := r.CustomizeDiff; regional || customizeDiff != nil {
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			meta, err := resourceMeta(name, regional, diff, meta)
+
+			if err != nil {
+				return err
+			}
+
+			// Show the region a new resource will be created in during plan.
+			if client, ok := meta.(*AWSClient); ok && regional && diff.Id() == "" && diff.Get("region").(string) == "" {
+				if err := diff.SetNew("region", client.region); err != nil {
+					return err
+				}
+			}
+
+			if customizeDiff == nil {
+				return nil
+			}
+
+			return customizeDiff(ctx, diff, meta)
+		}
+	}
+
+	if r.Importer != nil {
+		if f := r.Importer.State; f != nil {
+			r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				meta, err := regionalImportMeta(name, regional, d, meta)
+
+				if err != nil {
+					return nil, err
+				}
+
+				results, err := f(d, meta)
+
+				if err != nil || !regional {
+					return results, err
+				}
+
+				return setImportedRegion(results)
+			}
+		}
+
+		if f := r.Importer.StateContext; f != nil {
+			r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				meta, err := regionalImportMeta(name, regional, d, meta)
+
+				if err != nil {
+					return nil, err
+				}
+
+				results, err := f(ctx, d, meta)
+
+				if err != nil || !regional {
+					return results, err
+				}
+
+				return setImportedRegion(results)
+			}
+		}
+	}
+}
+
+// wrapDataSource wraps the Read function of the named data source so that it
+// receives an AWSClient for that data source type. Regional data sources also
+// get an optional region argument and the AWSClient operates in that region.
+func wrapDataSource(name string, r *schema.Resource, regional bool) {
+	if regional {
+		r.Schema["region"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		}
+	}
+
+	wrapRead(name, r, regional)
+}
+
+func wrapRead(name string, r *schema.Resource, regional bool) {
+	if f := r.Read; f != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := resourceMeta(name, regional, d, meta)
+
+			if err != nil {
+				return err
+			}
+
+			if err := f(d, meta); err != nil {
+				return err
+			}
+
+			return setRegion(d, meta, regional)
+		}
+	}
+
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			meta, err := resourceMeta(name, regional, d, meta)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			diags := f(ctx, d, meta)
+
+			if diags.HasError() {
+				return diags
+			}
+
+			return append(diags, diag.FromErr(setRegion(d, meta, regional))...)
+		}
+	}
+}
+
+// regionalImportMeta handles import IDs of the form <id>@<region>, stripping
+// the region suffix from the ID and recording it on the resource.
+// The suffix is only treated as a region if it is a known region of the
+// provider partition, as some resource IDs may legitimately contain '@'.
+func regionalImportMeta(name string, regional bool, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*AWSClient)
+
+	if !ok || !regional {
+		return resourceMeta(name, regional, d, meta)
+	}
+
+	id := d.Id()
+
+	if i := strings.LastIndex(id, "@"); i > 0 {
+		region := id[i+1:]
+
+		if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && p.ID() == client.partition {
+			if _, ok := p.Regions()[region]; ok {
+				d.SetId(id[:i])
+
+				if err := d.Set("region", region); err != nil {
+					return nil, fmt.Errorf("error setting region: %w", err)
+				}
+			}
+		}
+	}
+
+	return resourceMeta(name, regional, d, meta)
+}
+
+// setImportedRegion ensures that resources returned by an import function
+// keep the region recorded on the imported resource.
+func setImportedRegion(results []*schema.ResourceData) ([]*schema.ResourceData, error) {
+	if len(results) == 0 {
+		return results, nil
+	}
+
+	region := results[0].Get("region").(string)
+
+	for _, d := range results[1:] {
+		if d.Get("region").(string) != "" {
+			continue
+		}
+
+		if err := d.Set("region", region); err != nil {
+			return nil, fmt.Errorf("error setting region: %w", err)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestIsRegionalResource(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Resource *schema.Resource
+		Expected bool
+	}{
+		{
+			Name:     "aws_sqs_queue",
+			Resource: &schema.Resource{Schema: map[string]*schema.Schema{}},
+			Expected: true,
+		},
+		{
+			Name:     "aws_iam_role",
+			Resource: &schema.Resource{Schema: map[string]*schema.Schema{}},
+			Expected: false,
+		},
+		{
+			Name:     "aws_route53_zone",
+			Resource: &schema.Resource{Schema: map[string]*schema.Schema{}},
+			Expected: false,
+		},
+		{
+			Name:     "aws_route53_resolver_endpoint",
+			Resource: &schema.Resource{Schema: map[string]*schema.Schema{}},
+			Expected: true,
+		},
+		{
+			Name:     "aws_partition",
+			Resource: &schema.Resource{Schema: map[string]*schema.Schema{}},
+			Expected: false,
+		},
+		{
+			Name: "aws_s3_bucket",
+			Resource: &schema.Resource{Schema: map[string]*schema.Schema{
+				"region": {Type: schema.TypeString, Computed: true},
+			}},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := isRegionalResource(testCase.Name, testCase.Resource); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestWrapRegionalResource(t *testing.T) {
+	client := &AWSClient{
+		partition: endpoints.AwsPartitionID,
+		region:    endpoints.UsEast1RegionID,
+		session:   session.Must(session.NewSession(&aws.Config{Region: aws.String(endpoints.UsEast1RegionID)})),
+	}
+
+	var createRegion, readRegion string
+
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			createRegion = meta.(*AWSClient).region
+			d.SetId("test")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			readRegion = meta.(*AWSClient).region
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+
+	wrapResource("aws_test", r, true)
+
+	d := r.TestResourceData()
+
+	if err := r.Create(d, client); err != nil {
+		t.Fatalf("error creating: %s", err)
+	}
+
+	if got, expected := createRegion, endpoints.UsEast1RegionID; got != expected {
+		t.Errorf("got create region %s, expected %s", got, expected)
+	}
+
+	if got, expected := d.Get("region").(string), endpoints.UsEast1RegionID; got != expected {
+		t.Errorf("got region attribute %s, expected %s", got, expected)
+	}
+
+	d = r.TestResourceData()
+	d.SetId("test@" + endpoints.EuWest1RegionID)
+
+	results, err := r.Importer.State(d, client)
+
+	if err != nil {
+		t.Fatalf("error importing: %s", err)
+	}
+
+	if got, expected := results[0].Id(), "test"; got != expected {
+		t.Errorf("got imported ID %s, expected %s", got, expected)
+	}
+
+	if err := r.Read(results[0], client); err != nil {
+		t.Fatalf("error reading: %s", err)
+	}
+
+	if got, expected := readRegion, endpoints.EuWest1RegionID; got != expected {
+		t.Errorf("got read region %s, expected %s", got, expected)
+	}
+
+	d = r.TestResourceData()
+	d.SetId("user@example.com")
+
+	results, err = r.Importer.State(d, client)
+
+	if err != nil {
+		t.Fatalf("error importing: %s", err)
+	}
+
+	if got, expected := results[0].Id(), "user@example.com"; got != expected {
+		t.Errorf("got imported ID %s, expected %s", got, expected)
+	}
+}
@@ -0,0 +1,335 @@
+package aws
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
+)
+
+// RetryConfig is the provider retry configuration.
+type RetryConfig struct {
+	// MaxConcurrentRequests limits the number of concurrent AWS API requests
+	// made by the provider. Zero means no limit.
+	MaxConcurrentRequests int
+
+	// Default applies to all services without their own configuration.
+	Default ServiceRetryConfig
+
+	// Services holds per-service configuration, keyed by endpoints key.
+	// Unset values fall back to Default.
+	Services map[string]ServiceRetryConfig
+
+	limiters     map[string]*ratelimit.AdaptiveLimiter
+	limitersLock sync.Mutex
+}
+
+// ServiceRetryConfig is the retry configuration for one service.
+// Zero values mean unset.
+type ServiceRetryConfig struct {
+	MaxRetries        int
+	MinBackoff        time.Duration
+	MaxBackoff        time.Duration
+	RequestsPerSecond float64
+}
+
+// serviceConfig returns the configuration for the service with the given
+// endpoints key, merged with the default configuration.
+func (c *RetryConfig) serviceConfig(endpointKey string) ServiceRetryConfig {
+	config := c.Default
+	service, ok := c.Services[endpointKey]
+
+	if !ok {
+		return config
+	}
+
+	if service.MaxRetries != 0 {
+		config.MaxRetries = service.MaxRetries
+	}
+
+	if service.MinBackoff != 0 {
+		config.MinBackoff = service.MinBackoff
+	}
+
+	if service.MaxBackoff != 0 {
+		config.MaxBackoff = service.MaxBackoff
+	}
+
+	if service.RequestsPerSecond != 0 {
+		config.RequestsPerSecond = service.RequestsPerSecond
+	}
+
+	return config
+}
+
+// session returns a copy of sess merged with config, using a retryer and rate
+// limiter configured for the service with the given endpoints key.
+func (c *RetryConfig) session(sess *session.Session, endpointKey string, config *aws.Config) *session.Session {
+	serviceConfig := c.serviceConfig(endpointKey)
+
+	if serviceConfig.MaxRetries != 0 || serviceConfig.MinBackoff != 0 || serviceConfig.MaxBackoff != 0 {
+		maxRetries := aws.IntValue(sess.Config.MaxRetries)
+
+		if serviceConfig.MaxRetries != 0 {
+			maxRetries = serviceConfig.MaxRetries
+		}
+
+		// Zero delays are replaced by the SDK defaults.
+		config.Retryer = client.DefaultRetryer{
+			NumMaxRetries:    maxRetries,
+			MinRetryDelay:    serviceConfig.MinBackoff,
+			MinThrottleDelay: serviceConfig.MinBackoff,
+			MaxRetryDelay:    serviceConfig.MaxBackoff,
+			MaxThrottleDelay: serviceConfig.MaxBackoff,
+		}
+	}
+
+	sess = sess.Copy(config)
+
+	if serviceConfig.RequestsPerSecond > 0 {
+		// Service quotas are per region, so is the rate limit.
+		limiter := c.limiter(fmt.Sprintf("%s@%s", endpointKey, aws.StringValue(sess.Config.Region)), serviceConfig.RequestsPerSecond)
+
+		// Wait before each attempt is signed and sent.
+		sess.Handlers.Sign.PushFrontNamed(request.NamedHandler{
+			Name: "terraform.RateLimitHandler",
+			Fn: func(r *request.Request) {
+				if err := limiter.Wait(r.Context()); err != nil {
+					r.Error = awserr.New(request.CanceledErrorCode, "rate limit wait canceled", err)
+				}
+			},
+		})
+
+		sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+			Name: "terraform.AdaptiveRateLimitHandler",
+			Fn: func(r *request.Request) {
+				switch {
+				case r.IsErrorThrottle():
+					limiter.Throttled()
+				case r.Error == nil:
+					limiter.Succeeded()
+				}
+			},
+		})
+	}
+
+	return sess
+}
+
+// limiter returns the rate limiter with the given key, creating it the first
+// time it is requested.
+func (c *RetryConfig) limiter(key string, requestsPerSecond float64) *ratelimit.AdaptiveLimiter {
+	c.limitersLock.Lock()
+	defer c.limitersLock.Unlock()
+
+	if limiter, ok := c.limiters[key]; ok {
+		return limiter
+	}
+
+	if c.limiters == nil {
+		c.limiters = make(map[string]*ratelimit.AdaptiveLimiter)
+	}
+
+	limiter := ratelimit.NewAdaptiveLimiter(requestsPerSecond)
+	c.limiters[key] = limiter
+
+	return limiter
+}
+
+// concurrencyLimiter limits the number of concurrent requests. A slot is held
+// from when a request attempt is sent until the attempt completes.
+type concurrencyLimiter struct {
+	slots chan struct{}
+
+	held     map[*request.Request]struct{}
+	heldLock sync.Mutex
+}
+
+func newConcurrencyLimiter(limit int) *concurrencyLimiter {
+	return &concurrencyLimiter{
+		slots: make(chan struct{}, limit),
+		held:  make(map[*request.Request]struct{}),
+	}
+}
+
+// configure adds the limiter's handlers to handlers.
+func (l *concurrencyLimiter) configure(handlers *request.Handlers) {
+	handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "terraform.ConcurrencyLimitAcquireHandler",
+		Fn: func(r *request.Request) {
+			select {
+			case l.slots <- struct{}{}:
+			case <-r.Context().Done():
+				r.Error = awserr.New(request.CanceledErrorCode, "concurrency limit wait canceled", r.Context().Err())
+				return
+			}
+
+			l.heldLock.Lock()
+			l.held[r] = struct{}{}
+			l.heldLock.Unlock()
+		},
+	})
+
+	// CompleteAttempt also runs for attempts blocked before a slot was acquired.
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "terraform.ConcurrencyLimitReleaseHandler",
+		Fn: func(r *request.Request) {
+			l.heldLock.Lock()
+			_, ok := l.held[r]
+			delete(l.held, r)
+			l.heldLock.Unlock()
+
+			if ok {
+				<-l.slots
+			}
+		},
+	})
+}
+
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_concurrent_requests": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Maximum number of concurrent AWS API requests.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_backoff":         retryMaxBackoffSchema(),
+				"max_retries":         retryMaxRetriesSchema(),
+				"min_backoff":         retryMinBackoffSchema(),
+				"requests_per_second": retryRequestsPerSecondSchema(),
+				"service": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_backoff": retryMaxBackoffSchema(),
+							"max_retries": retryMaxRetriesSchema(),
+							"min_backoff": retryMinBackoffSchema(),
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Name of the service, as used in the endpoints configuration block.",
+								ValidateFunc: validation.StringInSlice(endpointServiceNames, false),
+							},
+							"requests_per_second": retryRequestsPerSecondSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func retryMaxBackoffSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Maximum delay between retries, e.g. 5m.",
+		ValidateFunc: validateRetryDuration,
+	}
+}
+
+func retryMaxRetriesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Maximum number of times an AWS API request is retried.",
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+func retryMinBackoffSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Minimum delay between retries, e.g. 500ms.",
+		ValidateFunc: validateRetryDuration,
+	}
+}
+
+func retryRequestsPerSecondSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeFloat,
+		Optional:     true,
+		Description:  "Maximum rate of AWS API requests per second. The rate is reduced while requests are throttled.",
+		ValidateFunc: validation.FloatAtLeast(0.01),
+	}
+}
+
+func validateRetryDuration(v interface{}, k string) (ws []string, errors []error) {
+	d, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+	} else if d <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+	}
+
+	return
+}
+
+func expandProviderRetry(l []interface{}) *RetryConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	config := &RetryConfig{
+		Default:  expandProviderServiceRetry(m),
+		Services: make(map[string]ServiceRetryConfig),
+	}
+
+	if v, ok := m["max_concurrent_requests"].(int); ok {
+		config.MaxConcurrentRequests = v
+	}
+
+	if v, ok := m["service"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			config.Services[tfMap["name"].(string)] = expandProviderServiceRetry(tfMap)
+		}
+	}
+
+	return config
+}
+
+func expandProviderServiceRetry(m map[string]interface{}) ServiceRetryConfig {
+	config := ServiceRetryConfig{}
+
+	if v, ok := m["max_backoff"].(string); ok && v != "" {
+		config.MaxBackoff, _ = time.ParseDuration(v)
+	}
+
+	if v, ok := m["max_retries"].(int); ok {
+		config.MaxRetries = v
+	}
+
+	if v, ok := m["min_backoff"].(string); ok && v != "" {
+		config.MinBackoff, _ = time.ParseDuration(v)
+	}
+
+	if v, ok := m["requests_per_second"].(float64); ok {
+		config.RequestsPerSecond = v
+	}
+
+	return config
+}
@@ -0,0 +1,230 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandProviderRetry(t *testing.T) {
+	serviceSchema := retrySchema().Elem.(*schema.Resource).Schema["service"]
+
+	testCases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected *RetryConfig
+	}{
+		{
+			Name:     "empty",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name: "default and service",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_backoff":             "",
+					"max_concurrent_requests": 10,
+					"max_retries":             5,
+					"min_backoff":             "100ms",
+					"requests_per_second":     0.0,
+					"service": schema.NewSet(schema.HashResource(serviceSchema.Elem.(*schema.Resource)), []interface{}{
+						map[string]interface{}{
+							"max_backoff":         "1m",
+							"max_retries":         0,
+							"min_backoff":         "",
+							"name":                "route53",
+							"requests_per_second": 5.0,
+						},
+					}),
+				},
+			},
+			Expected: &RetryConfig{
+				MaxConcurrentRequests: 10,
+				Default: ServiceRetryConfig{
+					MaxRetries: 5,
+					MinBackoff: 100 * time.Millisecond,
+				},
+				Services: map[string]ServiceRetryConfig{
+					"route53": {
+						MaxBackoff:        time.Minute,
+						RequestsPerSecond: 5,
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := expandProviderRetry(testCase.Input)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRetryConfigServiceConfig(t *testing.T) {
+	config := &RetryConfig{
+		Default: ServiceRetryConfig{
+			MaxRetries: 5,
+			MinBackoff: time.Second,
+		},
+		Services: map[string]ServiceRetryConfig{
+			"route53": {
+				MaxRetries:        10,
+				RequestsPerSecond: 5,
+			},
+		},
+	}
+
+	testCases := []struct {
+		EndpointKey string
+		Expected    ServiceRetryConfig
+	}{
+		{
+			EndpointKey: "ec2",
+			Expected: ServiceRetryConfig{
+				MaxRetries: 5,
+				MinBackoff: time.Second,
+			},
+		},
+		{
+			EndpointKey: "route53",
+			Expected: ServiceRetryConfig{
+				MaxRetries:        10,
+				MinBackoff:        time.Second,
+				RequestsPerSecond: 5,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.EndpointKey, func(t *testing.T) {
+			if got := config.serviceConfig(testCase.EndpointKey); got != testCase.Expected {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRetryConfigSession(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{
+		MaxRetries: aws.Int(25),
+		Region:     aws.String(endpoints.UsWest2RegionID),
+	}))
+	config := &RetryConfig{
+		Services: map[string]ServiceRetryConfig{
+			"route53": {
+				MaxBackoff:        time.Minute,
+				RequestsPerSecond: 5,
+			},
+		},
+	}
+
+	ec2Sess := config.session(sess, "ec2", &aws.Config{})
+
+	if ec2Sess.Config.Retryer != nil {
+		t.Errorf("got retryer %#v, expected none", ec2Sess.Config.Retryer)
+	}
+
+	if got, expected := ec2Sess.Handlers.Sign.Len(), sess.Handlers.Sign.Len(); got != expected {
+		t.Errorf("got %d sign handlers, expected %d", got, expected)
+	}
+
+	route53Sess := config.session(sess, "route53", &aws.Config{})
+
+	expectedRetryer := client.DefaultRetryer{
+		NumMaxRetries:    25,
+		MaxRetryDelay:    time.Minute,
+		MaxThrottleDelay: time.Minute,
+	}
+
+	if got := route53Sess.Config.Retryer; !reflect.DeepEqual(got, expectedRetryer) {
+		t.Errorf("got retryer %#v, expected %#v", got, expectedRetryer)
+	}
+
+	if got, expected := route53Sess.Handlers.Sign.Len(), sess.Handlers.Sign.Len()+1; got != expected {
+		t.Errorf("got %d sign handlers, expected %d", got, expected)
+	}
+
+	// Sessions for the same service and region share a rate limiter.
+	config.session(sess, "route53", &aws.Config{})
+	config.session(sess, "route53", &aws.Config{Region: aws.String(endpoints.UsEast1RegionID)})
+
+	if got, expected := len(config.limiters), 2; got != expected {
+		t.Errorf("got %d rate limiters, expected %d", got, expected)
+	}
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	ec2Endpoints := []*awsbase.MockEndpoint{
+		{
+			Request: &awsbase.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   "Action=DescribeAccountAttributes&AttributeName.1=supported-platforms&Version=2016-11-15",
+			},
+			Response: &awsbase.MockResponse{
+				StatusCode:  200,
+				Body:        test_ec2_describeAccountAttributes_response,
+				ContentType: "text/xml",
+			},
+		},
+	}
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("EC2", ec2Endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	limiter := newConcurrencyLimiter(1)
+
+	sess.Handlers.Send.AfterEachFn = request.HandlerListStopOnError
+	limiter.configure(&sess.Handlers)
+	sess.Handlers.Send.PushFrontNamed(readOnlyHandler(nil))
+
+	client := &AWSClient{
+		endpoints: map[string]string{"ec2": aws.StringValue(sess.Config.Endpoint)},
+		partition: endpoints.AwsPartitionID,
+		region:    aws.StringValue(sess.Config.Region),
+		session:   sess,
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := GetSupportedEC2Platforms(client.ec2conn()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got := len(limiter.slots); got != 0 {
+		t.Fatalf("got %d slots held after request, expected none", got)
+	}
+
+	// Requests blocked before a slot is acquired do not release a slot.
+	limiter.slots <- struct{}{}
+
+	_, err = client.ec2conn().CreateTags(&ec2.CreateTagsInput{
+		Resources: aws.StringSlice([]string{"i-12345678"}),
+		Tags:      []*ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, expected := len(limiter.slots), 1; got != expected {
+		t.Errorf("got %d slots held, expected %d", got, expected)
+	}
+}
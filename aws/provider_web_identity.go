@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// AssumeRoleWithWebIdentityConfig is the provider assume_role_with_web_identity
+// configuration.
+type AssumeRoleWithWebIdentityConfig struct {
+	Duration             time.Duration
+	PolicyARNs           []string
+	RoleARN              string
+	SessionName          string
+	WebIdentityToken     string
+	WebIdentityTokenFile string
+}
+
+// webIdentityToken is a stscreds.TokenFetcher returning a token given in the
+// provider configuration.
+type webIdentityToken string
+
+func (t webIdentityToken) FetchToken(credentials.Context) ([]byte, error) {
+	return []byte(t), nil
+}
+
+// webIdentityCredentials returns credentials for the role in the
+// assume_role_with_web_identity configuration, assuming the role with sess.
+// The credentials are refreshed automatically before they expire, the token
+// file being read again on each refresh.
+func (c *Config) webIdentityCredentials(sess *session.Session) (*credentials.Credentials, error) {
+	config := c.AssumeRoleWithWebIdentityConfig
+
+	var tokenFetcher stscreds.TokenFetcher = stscreds.FetchTokenPath(config.WebIdentityTokenFile)
+
+	if config.WebIdentityToken != "" {
+		tokenFetcher = webIdentityToken(config.WebIdentityToken)
+	}
+
+	// AssumeRoleWithWebIdentity requests are not signed.
+	webIdentityRoleProvider := stscreds.NewWebIdentityRoleProviderWithToken(sts.New(sess), config.RoleARN, config.SessionName, tokenFetcher)
+	webIdentityRoleProvider.Duration = config.Duration
+	webIdentityRoleProvider.ExpiryWindow = credentialsExpiryWindow
+	webIdentityRoleProvider.PolicyArns = expandStsPolicyDescriptorTypes(config.PolicyARNs)
+
+	creds := credentials.NewCredentials(webIdentityRoleProvider)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("error assuming role (%s) with web identity: %w", config.RoleARN, err)
+	}
+
+	return creds, nil
+}
+
+func validateAssumeRoleWithWebIdentityDuration(v interface{}, k string) (ws []string, errors []error) {
+	d, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+	} else if d < 15*time.Minute || d > 12*time.Hour {
+		errors = append(errors, fmt.Errorf("%q must be between 15 minutes and 12 hours", k))
+	}
+
+	return
+}
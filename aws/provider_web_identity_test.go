@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestConfigWebIdentityCredentials(t *testing.T) {
+	tokenFile, err := ioutil.TempFile("", "tf-acc-test-web-identity-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tokenFile.Name())
+
+	if _, err := tokenFile.WriteString(awsbase.MockWebIdentityToken); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tokenFile.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name                 string
+		Config               *Config
+		ExpectedProviderName string
+		ExpectedAccessKeyID  string
+	}{
+		{
+			Name: "token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityConfig: &AssumeRoleWithWebIdentityConfig{
+					RoleARN:          awsbase.MockStsAssumeRoleWithWebIdentityArn,
+					SessionName:      awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+					WebIdentityToken: awsbase.MockWebIdentityToken,
+				},
+			},
+			ExpectedProviderName: stscreds.WebIdentityProviderName,
+			ExpectedAccessKeyID:  awsbase.MockStsAssumeRoleWithWebIdentityAccessKey,
+		},
+		{
+			Name: "token file",
+			Config: &Config{
+				AssumeRoleWithWebIdentityConfig: &AssumeRoleWithWebIdentityConfig{
+					RoleARN:              awsbase.MockStsAssumeRoleWithWebIdentityArn,
+					SessionName:          awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+					WebIdentityTokenFile: tokenFile.Name(),
+				},
+			},
+			ExpectedProviderName: stscreds.WebIdentityProviderName,
+			ExpectedAccessKeyID:  awsbase.MockStsAssumeRoleWithWebIdentityAccessKey,
+		},
+		{
+			Name: "assume role",
+			Config: &Config{
+				AssumeRoleARN:         awsbase.MockStsAssumeRoleArn,
+				AssumeRoleSessionName: awsbase.MockStsAssumeRoleSessionName,
+				AssumeRoleWithWebIdentityConfig: &AssumeRoleWithWebIdentityConfig{
+					RoleARN:          awsbase.MockStsAssumeRoleWithWebIdentityArn,
+					SessionName:      awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+					WebIdentityToken: awsbase.MockWebIdentityToken,
+				},
+			},
+			ExpectedProviderName: stscreds.ProviderName,
+			ExpectedAccessKeyID:  awsbase.MockStsAssumeRoleAccessKey,
+		},
+	}
+
+	ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleValidEndpoint,
+		awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+	})
+	defer ts.Close()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Config.Endpoints = map[string]string{"sts": ts.URL}
+			testCase.Config.Region = endpoints.UsEast1RegionID
+
+			creds, err := testCase.Config.credentials(&awsbase.Config{}, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			value, err := creds.Get()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := value.ProviderName, testCase.ExpectedProviderName; got != expected {
+				t.Errorf("got provider %s, expected %s", got, expected)
+			}
+
+			if got, expected := value.AccessKeyID, testCase.ExpectedAccessKeyID; got != expected {
+				t.Errorf("got access key ID %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigWebIdentityCredentialsError(t *testing.T) {
+	ts := awsbase.MockAwsApiServer("STS", nil)
+	defer ts.Close()
+
+	config := &Config{
+		AssumeRoleWithWebIdentityConfig: &AssumeRoleWithWebIdentityConfig{
+			RoleARN:          awsbase.MockStsAssumeRoleWithWebIdentityArn,
+			WebIdentityToken: awsbase.MockWebIdentityToken,
+		},
+		Endpoints: map[string]string{"sts": ts.URL},
+		Region:    endpoints.UsEast1RegionID,
+	}
+
+	if _, err := config.credentials(&awsbase.Config{}, nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"log"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/recorder"
+)
+
+// testAccRecorderCassettesDir is the directory of the cassettes recorded by
+// acceptance tests.
+const testAccRecorderCassettesDir = "testdata/cassettes"
+
+// testAccRecorder records or replays the AWS API requests of acceptance tests,
+// if the TF_AWS_RECORD environment variable is set.
+var testAccRecorder *recorder.Recorder
+
+func init() {
+	v := os.Getenv(envvar.TfAwsRecord)
+
+	if v == "" {
+		return
+	}
+
+	mode, err := recorder.ParseMode(v)
+
+	if err != nil {
+		log.Fatalf("error parsing %s: %s", envvar.TfAwsRecord, err)
+	}
+
+	testAccRecorder = recorder.New(mode, testAccRecorderCassettesDir)
+	testHTTPTransport = testAccRecorder.Transport
+}
+
+// testAccRecorderStart activates the cassette of the test, if requests are
+// recorded or replayed. The provider is configured again, so that the
+// cassette includes the requests made while configuring it.
+func testAccRecorderStart(t *testing.T) {
+	if testAccRecorder == nil || testAccRecorder.Active() == t.Name() {
+		return
+	}
+
+	if err := testAccRecorder.Start(t.Name()); err != nil {
+		t.Fatal(err)
+	}
+
+	testAccProviderConfigure = sync.Once{}
+
+	t.Cleanup(func() {
+		if err := testAccRecorder.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+}
@@ -15,21 +15,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_acm_certificate", &resource.Sweeper{
-		Name: "aws_acm_certificate",
-		F:    testSweepAcmCertificates,
+	sweep.AddTestSweepers("aws_acm_certificate", &sweep.Sweeper{
+		Name:    "aws_acm_certificate",
+		Service: "acm",
+		List:    testSweepAcmCertificates,
 	})
 }
 
-func testSweepAcmCertificates(region string) error {
+func testSweepAcmCertificates(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).acmconn
+	conn := client.(*AWSClient).acmconn()
+	var sweepables []sweep.Sweepable
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListCertificatesPages(&acm.ListCertificatesInput{}, func(page *acm.ListCertificatesOutput, isLast bool) bool {
@@ -55,30 +58,26 @@ func testSweepAcmCertificates(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting ACM certificate: %s", arn)
-			_, err = conn.DeleteCertificate(&acm.DeleteCertificateInput{
-				CertificateArn: aws.String(arn),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting ACM certificate (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(arn, func() error {
+				_, err := conn.DeleteCertificate(&acm.DeleteCertificateInput{
+					CertificateArn: aws.String(arn),
+				})
+
+				return err
+			}).WithName(aws.StringValue(certificate.DomainName)))
 		}
 
 		return !isLast
 	})
-
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping ACM certificate sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return sweepables, sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 		}
-		return fmt.Errorf("error retrieving ACM certificates: %s", err)
+		return nil, fmt.Errorf("error retrieving ACM certificates: %s", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func testAccAwsAcmCertificateDomainFromEnv(t *testing.T) string {
@@ -612,7 +611,7 @@ func TestAccAWSAcmCertificate_tags(t *testing.T) {
 	})
 }
 
-//lintignore:AT002
+// lintignore:AT002
 func TestAccAWSAcmCertificate_imported_DomainName(t *testing.T) {
 	resourceName := "aws_acm_certificate.test"
 
@@ -665,7 +664,7 @@ func TestAccAWSAcmCertificate_imported_DomainName(t *testing.T) {
 	})
 }
 
-//lintignore:AT002
+// lintignore:AT002
 func TestAccAWSAcmCertificate_imported_IpAddress(t *testing.T) { // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/7103
 	resourceName := "aws_acm_certificate.test"
 
@@ -846,7 +845,7 @@ resource "aws_acm_certificate" "cert" {
 }
 
 func testAccCheckAcmCertificateDestroy(s *terraform.State) error {
-	acmconn := testAccProvider.Meta().(*AWSClient).acmconn
+	acmconn := testAccProvider.Meta().(*AWSClient).acmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_acm_certificate" {
@@ -42,7 +42,7 @@ func resourceAwsAcmCertificateValidation() *schema.Resource {
 func resourceAwsAcmCertificateValidationCreate(d *schema.ResourceData, meta interface{}) error {
 	certificate_arn := d.Get("certificate_arn").(string)
 
-	acmconn := meta.(*AWSClient).acmconn
+	acmconn := meta.(*AWSClient).acmconn()
 	params := &acm.DescribeCertificateInput{
 		CertificateArn: aws.String(certificate_arn),
 	}
@@ -169,7 +169,7 @@ func resourceAwsAcmCertificateCheckValidationRecords(validationRecordFqdns []int
 }
 
 func resourceAwsAcmCertificateValidationRead(d *schema.ResourceData, meta interface{}) error {
-	acmconn := meta.(*AWSClient).acmconn
+	acmconn := meta.(*AWSClient).acmconn()
 
 	params := &acm.DescribeCertificateInput{
 		CertificateArn: aws.String(d.Get("certificate_arn").(string)),
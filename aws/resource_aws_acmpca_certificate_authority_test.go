@@ -8,78 +8,70 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acmpca"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_acmpca_certificate_authority", &resource.Sweeper{
-		Name: "aws_acmpca_certificate_authority",
-		F:    testSweepAcmpcaCertificateAuthorities,
+	sweep.AddTestSweepers("aws_acmpca_certificate_authority", &sweep.Sweeper{
+		Name:    "aws_acmpca_certificate_authority",
+		Service: "acmpca",
+		List:    testSweepAcmpcaCertificateAuthorities,
 	})
 }
 
-func testSweepAcmpcaCertificateAuthorities(region string) error {
+func testSweepAcmpcaCertificateAuthorities(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).acmpcaconn
+	conn := client.(*AWSClient).acmpcaconn()
 
 	certificateAuthorities, err := listAcmpcaCertificateAuthorities(conn)
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping ACMPCA Certificate Authorities sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving ACMPCA Certificate Authorities: %w", err)
-	}
-	if len(certificateAuthorities) == 0 {
-		log.Print("[DEBUG] No ACMPCA Certificate Authorities to sweep")
-		return nil
+		return nil, fmt.Errorf("Error retrieving ACMPCA Certificate Authorities: %w", err)
 	}
 
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for _, certificateAuthority := range certificateAuthorities {
 		arn := aws.StringValue(certificateAuthority.Arn)
+		status := aws.StringValue(certificateAuthority.Status)
+
+		sweepables = append(sweepables, sweep.NewResource(arn, func() error {
+			if status == acmpca.CertificateAuthorityStatusActive {
+				log.Printf("[INFO] Disabling ACMPCA Certificate Authority: %s", arn)
+				_, err := conn.UpdateCertificateAuthority(&acmpca.UpdateCertificateAuthorityInput{
+					CertificateAuthorityArn: aws.String(arn),
+					Status:                  aws.String(acmpca.CertificateAuthorityStatusDisabled),
+				})
+				if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+					return nil
+				}
+				if err != nil {
+					return fmt.Errorf("error disabling ACMPCA Certificate Authority: %w", err)
+				}
+			}
 
-		if aws.StringValue(certificateAuthority.Status) == acmpca.CertificateAuthorityStatusActive {
-			log.Printf("[INFO] Disabling ACMPCA Certificate Authority: %s", arn)
-			_, err := conn.UpdateCertificateAuthority(&acmpca.UpdateCertificateAuthorityInput{
-				CertificateAuthorityArn: aws.String(arn),
-				Status:                  aws.String(acmpca.CertificateAuthorityStatusDisabled),
+			_, err := conn.DeleteCertificateAuthority(&acmpca.DeleteCertificateAuthorityInput{
+				CertificateAuthorityArn:     aws.String(arn),
+				PermanentDeletionTimeInDays: aws.Int64(int64(7)),
 			})
 			if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error disabling ACMPCA Certificate Authority (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
+				return nil
 			}
-		}
 
-		log.Printf("[INFO] Deleting ACMPCA Certificate Authority: %s", arn)
-		_, err := conn.DeleteCertificateAuthority(&acmpca.DeleteCertificateAuthorityInput{
-			CertificateAuthorityArn:     aws.String(arn),
-			PermanentDeletionTimeInDays: aws.Int64(int64(7)),
-		})
-		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting ACMPCA Certificate Authority (%s): %w", arn, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+			return err
+		}))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsAcmpcaCertificateAuthority_basic(t *testing.T) {
@@ -463,7 +455,7 @@ func TestAccAwsAcmpcaCertificateAuthority_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsAcmpcaCertificateAuthorityDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+	conn := testAccProvider.Meta().(*AWSClient).acmpcaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_acmpca_certificate_authority" {
@@ -499,7 +491,7 @@ func testAccCheckAwsAcmpcaCertificateAuthorityExists(resourceName string, certif
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn()
 		input := &acmpca.DescribeCertificateAuthorityInput{
 			CertificateAuthorityArn: aws.String(rs.Primary.ID),
 		}
@@ -522,7 +514,7 @@ func testAccCheckAwsAcmpcaCertificateAuthorityExists(resourceName string, certif
 
 func testAccCheckAwsAcmpcaCertificateAuthorityActivateCA(certificateAuthority *acmpca.CertificateAuthority) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn()
 
 		arn := aws.StringValue(certificateAuthority.Arn)
 
@@ -579,7 +571,7 @@ func testAccCheckAwsAcmpcaCertificateAuthorityActivateCA(certificateAuthority *a
 
 func testAccCheckAwsAcmpcaCertificateAuthorityDisableCA(certificateAuthority *acmpca.CertificateAuthority) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn()
 
 		_, err := conn.UpdateCertificateAuthority(&acmpca.UpdateCertificateAuthorityInput{
 			CertificateAuthorityArn: certificateAuthority.Arn,
@@ -13,55 +13,56 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
-		Name: "aws_api_gateway_rest_api",
-		F:    testSweepAPIGatewayRestApis,
+	sweep.AddTestSweepers("aws_api_gateway_rest_api", &sweep.Sweeper{
+		Name:    "aws_api_gateway_rest_api",
+		Service: "apigateway",
+		List:    testSweepAPIGatewayRestApis,
 	})
 }
 
-func testSweepAPIGatewayRestApis(region string) error {
+func testSweepAPIGatewayRestApis(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).apigatewayconn
+	conn := client.(*AWSClient).apigatewayconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.GetRestApisPages(&apigateway.GetRestApisInput{}, func(page *apigateway.GetRestApisOutput, lastPage bool) bool {
 		for _, item := range page.Items {
 			input := &apigateway.DeleteRestApiInput{
 				RestApiId: item.Id,
 			}
-			log.Printf("[INFO] Deleting API Gateway REST API: %s", input)
-			// TooManyRequestsException: Too Many Requests can take over a minute to resolve itself
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteRestApi(input)
-				if err != nil {
-					if isAWSErr(err, apigateway.ErrCodeTooManyRequestsException, "") {
-						return resource.RetryableError(err)
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(item.Id), func() error {
+				// TooManyRequestsException: Too Many Requests can take over a minute to resolve itself
+				return resource.Retry(2*time.Minute, func() *resource.RetryError {
+					_, err := conn.DeleteRestApi(input)
+					if err != nil {
+						if isAWSErr(err, apigateway.ErrCodeTooManyRequestsException, "") {
+							return resource.RetryableError(err)
+						}
+						return resource.NonRetryableError(err)
 					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete API Gateway REST API %s: %s", *item.Name, err)
-				continue
-			}
+					return nil
+				})
+			}).WithName(aws.StringValue(item.Name)))
 		}
 		return !lastPage
 	})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping API Gateway REST API sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving API Gateway REST APIs: %s", err)
+		return nil, fmt.Errorf("Error retrieving API Gateway REST APIs: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSAPIGatewayRestApi_basic(t *testing.T) {
@@ -215,7 +216,7 @@ func TestAccAWSAPIGatewayRestApi_EndpointConfiguration(t *testing.T) {
 					// This can eventually be moved to a PreCheck function
 					// If the region does not support EDGE endpoint type, this test will either show
 					// SKIP (if REGIONAL passed) or FAIL (if REGIONAL failed)
-					conn := testAccProvider.Meta().(*AWSClient).apigatewayconn
+					conn := testAccProvider.Meta().(*AWSClient).apigatewayconn()
 					output, err := conn.CreateRestApi(&apigateway.CreateRestApiInput{
 						Name: aws.String(acctest.RandomWithPrefix("tf-acc-test-edge-endpoint-precheck")),
 						EndpointConfiguration: &apigateway.EndpointConfiguration{
@@ -263,7 +264,7 @@ func TestAccAWSAPIGatewayRestApi_EndpointConfiguration_Private(t *testing.T) {
 				PreConfig: func() {
 					// Ensure region supports PRIVATE endpoint
 					// This can eventually be moved to a PreCheck function
-					conn := testAccProvider.Meta().(*AWSClient).apigatewayconn
+					conn := testAccProvider.Meta().(*AWSClient).apigatewayconn()
 					output, err := conn.CreateRestApi(&apigateway.CreateRestApiInput{
 						Name: aws.String(acctest.RandomWithPrefix("tf-acc-test-private-endpoint-precheck")),
 						EndpointConfiguration: &apigateway.EndpointConfiguration{
@@ -1207,7 +1208,7 @@ func TestAccAWSAPIGatewayRestApi_Policy_SetByBody(t *testing.T) {
 
 func testAccCheckAWSAPIGatewayRestAPIRoutes(conf *apigateway.RestApi, routes []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayconn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayconn()
 
 		resp, err := conn.GetResources(&apigateway.GetResourcesInput{
 			RestApiId: conf.Id,
@@ -1247,7 +1248,7 @@ func testAccCheckAWSAPIGatewayRestAPIExists(n string, res *apigateway.RestApi) r
 			return fmt.Errorf("No API Gateway ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayconn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayconn()
 
 		req := &apigateway.GetRestApiInput{
 			RestApiId: aws.String(rs.Primary.ID),
@@ -1268,7 +1269,7 @@ func testAccCheckAWSAPIGatewayRestAPIExists(n string, res *apigateway.RestApi) r
 }
 
 func testAccCheckAWSAPIGatewayRestAPIDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).apigatewayconn
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_api_gateway_rest_api" {
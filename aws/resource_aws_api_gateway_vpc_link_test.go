@@ -11,21 +11,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
-		Name: "aws_api_gateway_vpc_link",
-		F:    testSweepAPIGatewayVpcLinks,
+	sweep.AddTestSweepers("aws_api_gateway_vpc_link", &sweep.Sweeper{
+		Name:    "aws_api_gateway_vpc_link",
+		Service: "apigateway",
+		List:    testSweepAPIGatewayVpcLinks,
 	})
 }
 
-func testSweepAPIGatewayVpcLinks(region string) error {
+func testSweepAPIGatewayVpcLinks(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).apigatewayconn
+	conn := client.(*AWSClient).apigatewayconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.GetVpcLinksPages(&apigateway.GetVpcLinksInput{}, func(page *apigateway.GetVpcLinksOutput, lastPage bool) bool {
 		for _, item := range page.Items {
@@ -34,29 +37,30 @@ func testSweepAPIGatewayVpcLinks(region string) error {
 			}
 			id := aws.StringValue(item.Id)
 
-			log.Printf("[INFO] Deleting API Gateway VPC Link: %s", id)
-			_, err := conn.DeleteVpcLink(input)
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteVpcLink(input)
+				if err != nil {
+					return err
+				}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete API Gateway VPC Link %s: %s", id, err)
-				continue
-			}
+				if err := waitForApiGatewayVpcLinkDeletion(conn, id); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
 
-			if err := waitForApiGatewayVpcLinkDeletion(conn, id); err != nil {
-				log.Printf("[ERROR] Error waiting for API Gateway VPC Link (%s) deletion: %s", id, err)
-			}
+				return nil
+			}).WithName(aws.StringValue(item.Name)))
 		}
 		return !lastPage
 	})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping API Gateway VPC Link sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving API Gateway VPC Links: %s", err)
+		return nil, fmt.Errorf("Error retrieving API Gateway VPC Links: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSAPIGatewayVpcLink_basic(t *testing.T) {
@@ -175,7 +179,7 @@ func TestAccAWSAPIGatewayVpcLink_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsAPIGatewayVpcLinkDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).apigatewayconn
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_api_gateway_vpc_link" {
@@ -207,7 +211,7 @@ func testAccCheckAwsAPIGatewayVpcLinkExists(name string) resource.TestCheckFunc
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayconn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayconn()
 
 		input := &apigateway.GetVpcLinkInput{
 			VpcLinkId: aws.String(rs.Primary.ID),
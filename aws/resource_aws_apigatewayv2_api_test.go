@@ -8,55 +8,55 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apigatewayv2_api", &resource.Sweeper{
-		Name: "aws_apigatewayv2_api",
-		F:    testSweepAPIGatewayV2Apis,
+	sweep.AddTestSweepers("aws_apigatewayv2_api", &sweep.Sweeper{
+		Name:    "aws_apigatewayv2_api",
+		Service: "apigatewayv2",
+		List:    testSweepAPIGatewayV2Apis,
 		Dependencies: []string{
 			"aws_apigatewayv2_domain_name",
 		},
 	})
 }
 
-func testSweepAPIGatewayV2Apis(region string) error {
+func testSweepAPIGatewayV2Apis(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).apigatewayv2conn
+	conn := client.(*AWSClient).apigatewayv2conn()
 	input := &apigatewayv2.GetApisInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.GetApis(input)
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping API Gateway v2 API sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
 		if err != nil {
-			return fmt.Errorf("error retrieving API Gateway v2 APIs: %s", err)
+			return nil, fmt.Errorf("error retrieving API Gateway v2 APIs: %s", err)
 		}
 
 		for _, api := range output.Items {
-			log.Printf("[INFO] Deleting API Gateway v2 API: %s", aws.StringValue(api.ApiId))
-			_, err := conn.DeleteApi(&apigatewayv2.DeleteApiInput{
-				ApiId: api.ApiId,
-			})
-			if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting API Gateway v2 API (%s): %w", aws.StringValue(api.ApiId), err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			id := api.ApiId
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(id), func() error {
+				_, err := conn.DeleteApi(&apigatewayv2.DeleteApiInput{
+					ApiId: id,
+				})
+				if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+					return nil
+				}
+
+				return err
+			}).WithName(aws.StringValue(api.Name)).WithTags(aws.StringValueMap(api.Tags)))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -65,7 +65,7 @@ func testSweepAPIGatewayV2Apis(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSAPIGatewayV2Api_basicWebSocket(t *testing.T) {
@@ -526,7 +526,7 @@ func TestAccAWSAPIGatewayV2Api_OpenapiWithMoreFields(t *testing.T) {
 
 func testAccCheckAWSAPIGatewayV2ApiRoutes(v *apigatewayv2.GetApiOutput, routes []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		resp, err := conn.GetRoutes(&apigatewayv2.GetRoutesInput{
 			ApiId: v.ApiId,
@@ -743,7 +743,7 @@ func TestAccAWSAPIGatewayV2Api_QuickCreate(t *testing.T) {
 }
 
 func testAccCheckAWSAPIGatewayV2ApiDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_apigatewayv2_api" {
@@ -777,7 +777,7 @@ func testAccCheckAWSAPIGatewayV2ApiExists(n string, v *apigatewayv2.GetApiOutput
 			return fmt.Errorf("No API Gateway v2 API ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		resp, err := conn.GetApi(&apigatewayv2.GetApiInput{
 			ApiId: aws.String(rs.Primary.ID),
@@ -803,7 +803,7 @@ func testAccCheckAWSAPIGatewayV2ApiQuickCreateIntegration(n, expectedType, expec
 			return fmt.Errorf("No API Gateway v2 API ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		resp, err := conn.GetIntegrations(&apigatewayv2.GetIntegrationsInput{
 			ApiId: aws.String(rs.Primary.ID),
@@ -838,7 +838,7 @@ func testAccCheckAWSAPIGatewayV2ApiQuickCreateRoute(n, expectedRouteKey string)
 			return fmt.Errorf("No API Gateway v2 API ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		resp, err := conn.GetRoutes(&apigatewayv2.GetRoutesInput{
 			ApiId: aws.String(rs.Primary.ID),
@@ -870,7 +870,7 @@ func testAccCheckAWSAPIGatewayV2ApiQuickCreateStage(n, expectedName string) reso
 			return fmt.Errorf("No API Gateway v2 API ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		resp, err := conn.GetStages(&apigatewayv2.GetStagesInput{
 			ApiId: aws.String(rs.Primary.ID),
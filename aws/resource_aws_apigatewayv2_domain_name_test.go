@@ -8,52 +8,52 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
-		Name: "aws_apigatewayv2_domain_name",
-		F:    testSweepAPIGatewayV2DomainNames,
+	sweep.AddTestSweepers("aws_apigatewayv2_domain_name", &sweep.Sweeper{
+		Name:    "aws_apigatewayv2_domain_name",
+		Service: "apigatewayv2",
+		List:    testSweepAPIGatewayV2DomainNames,
 	})
 }
 
-func testSweepAPIGatewayV2DomainNames(region string) error {
+func testSweepAPIGatewayV2DomainNames(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).apigatewayv2conn
+	conn := client.(*AWSClient).apigatewayv2conn()
 	input := &apigatewayv2.GetDomainNamesInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.GetDomainNames(input)
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping API Gateway v2 domain names sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
 		if err != nil {
-			return fmt.Errorf("error retrieving API Gateway v2 domain names: %s", err)
+			return nil, fmt.Errorf("error retrieving API Gateway v2 domain names: %s", err)
 		}
 
 		for _, domainName := range output.Items {
-			log.Printf("[INFO] Deleting API Gateway v2 domain name: %s", aws.StringValue(domainName.DomainName))
-			_, err := conn.DeleteDomainName(&apigatewayv2.DeleteDomainNameInput{
-				DomainName: domainName.DomainName,
-			})
-			if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting API Gateway v2 domain name (%s): %s", aws.StringValue(domainName.DomainName), err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			name := domainName.DomainName
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(name), func() error {
+				_, err := conn.DeleteDomainName(&apigatewayv2.DeleteDomainNameInput{
+					DomainName: name,
+				})
+				if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+					return nil
+				}
+
+				return err
+			}).WithTags(aws.StringValueMap(domainName.Tags)))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -62,7 +62,7 @@ func testSweepAPIGatewayV2DomainNames(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSAPIGatewayV2DomainName_basic(t *testing.T) {
@@ -338,7 +338,7 @@ func TestAccAWSAPIGatewayV2DomainName_MutualTlsAuthentication(t *testing.T) {
 }
 
 func testAccCheckAWSAPIGatewayV2DomainNameDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_apigatewayv2_domain_name" {
@@ -372,7 +372,7 @@ func testAccCheckAWSAPIGatewayV2DomainNameExists(n string, v *apigatewayv2.GetDo
 			return fmt.Errorf("No API Gateway v2 domain name ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		resp, err := conn.GetDomainName(&apigatewayv2.GetDomainNameInput{
 			DomainName: aws.String(rs.Primary.ID),
@@ -84,7 +84,7 @@ func resourceAwsApiGatewayV2Route() *schema.Resource {
 }
 
 func resourceAwsApiGatewayV2RouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	req := &apigatewayv2.CreateRouteInput{
 		ApiId:             aws.String(d.Get("api_id").(string)),
@@ -126,7 +126,7 @@ func resourceAwsApiGatewayV2RouteCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsApiGatewayV2RouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	resp, err := conn.GetRoute(&apigatewayv2.GetRouteInput{
 		ApiId:   aws.String(d.Get("api_id").(string)),
@@ -160,7 +160,7 @@ func resourceAwsApiGatewayV2RouteRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsApiGatewayV2RouteUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	req := &apigatewayv2.UpdateRouteInput{
 		ApiId:   aws.String(d.Get("api_id").(string)),
@@ -207,7 +207,7 @@ func resourceAwsApiGatewayV2RouteUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsApiGatewayV2RouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	log.Printf("[DEBUG] Deleting API Gateway v2 route (%s)", d.Id())
 	_, err := conn.DeleteRoute(&apigatewayv2.DeleteRouteInput{
@@ -233,7 +233,7 @@ func resourceAwsApiGatewayV2RouteImport(d *schema.ResourceData, meta interface{}
 	apiId := parts[0]
 	routeId := parts[1]
 
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	resp, err := conn.GetRoute(&apigatewayv2.GetRouteInput{
 		ApiId:   aws.String(apiId),
@@ -8,64 +8,64 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/apigatewayv2/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
-		Name: "aws_apigatewayv2_vpc_link",
-		F:    testSweepAPIGatewayV2VpcLinks,
+	sweep.AddTestSweepers("aws_apigatewayv2_vpc_link", &sweep.Sweeper{
+		Name:    "aws_apigatewayv2_vpc_link",
+		Service: "apigatewayv2",
+		List:    testSweepAPIGatewayV2VpcLinks,
 	})
 }
 
-func testSweepAPIGatewayV2VpcLinks(region string) error {
+func testSweepAPIGatewayV2VpcLinks(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).apigatewayv2conn
+	conn := client.(*AWSClient).apigatewayv2conn()
 	input := &apigatewayv2.GetVpcLinksInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.GetVpcLinks(input)
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping API Gateway v2 VPC Link sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
 		if err != nil {
-			return fmt.Errorf("error retrieving API Gateway v2 VPC Links: %s", err)
+			return nil, fmt.Errorf("error retrieving API Gateway v2 VPC Links: %s", err)
 		}
 
 		for _, link := range output.Items {
-			log.Printf("[INFO] Deleting API Gateway v2 VPC Link: %s", aws.StringValue(link.VpcLinkId))
-			_, err := conn.DeleteVpcLink(&apigatewayv2.DeleteVpcLinkInput{
-				VpcLinkId: link.VpcLinkId,
-			})
-			if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting API Gateway v2 VPC Link (%s): %w", aws.StringValue(link.VpcLinkId), err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-
-			_, err = waiter.VpcLinkDeleted(conn, aws.StringValue(link.VpcLinkId))
-			if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error waiting for API Gateway v2 VPC Link (%s) deletion: %w", aws.StringValue(link.VpcLinkId), err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			id := aws.StringValue(link.VpcLinkId)
+
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteVpcLink(&apigatewayv2.DeleteVpcLinkInput{
+					VpcLinkId: aws.String(id),
+				})
+				if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+
+				_, err = waiter.VpcLinkDeleted(conn, id)
+				if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+					return nil
+				}
+				if err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}).WithName(aws.StringValue(link.Name)).WithTags(aws.StringValueMap(link.Tags)))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -74,7 +74,7 @@ func testSweepAPIGatewayV2VpcLinks(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSAPIGatewayV2VpcLink_basic(t *testing.T) {
@@ -182,7 +182,7 @@ func TestAccAWSAPIGatewayV2VpcLink_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSAPIGatewayV2VpcLinkDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_apigatewayv2_vpc_link" {
@@ -207,7 +207,7 @@ func testAccCheckAWSAPIGatewayV2VpcLinkDestroy(s *terraform.State) error {
 
 func testAccCheckAWSAPIGatewayV2VpcLinkDisappears(v *apigatewayv2.GetVpcLinkOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		if _, err := conn.DeleteVpcLink(&apigatewayv2.DeleteVpcLinkInput{
 			VpcLinkId: v.VpcLinkId,
@@ -238,7 +238,7 @@ func testAccCheckAWSAPIGatewayV2VpcLinkExists(n string, v *apigatewayv2.GetVpcLi
 			return fmt.Errorf("No API Gateway v2 VPC Link ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		resp, err := conn.GetVpcLink(&apigatewayv2.GetVpcLinkInput{
 			VpcLinkId: aws.String(rs.Primary.ID),
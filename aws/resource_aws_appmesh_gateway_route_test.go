@@ -12,22 +12,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appmesh/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
-		Name: "aws_appmesh_gateway_route",
-		F:    testSweepAppmeshGatewayRoutes,
+	sweep.AddTestSweepers("aws_appmesh_gateway_route", &sweep.Sweeper{
+		Name:    "aws_appmesh_gateway_route",
+		Service: "appmesh",
+		List:    testSweepAppmeshGatewayRoutes,
 	})
 }
 
-func testSweepAppmeshGatewayRoutes(region string) error {
+func testSweepAppmeshGatewayRoutes(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).appmeshconn
-
+	conn := client.(*AWSClient).appmeshconn()
+	var sweepables []sweep.Sweepable
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, isLast bool) bool {
@@ -54,25 +56,20 @@ func testSweepAppmeshGatewayRoutes(region string) error {
 						for _, gatewayRoute := range page.GatewayRoutes {
 							gatewayRouteName := aws.StringValue(gatewayRoute.GatewayRouteName)
 
-							log.Printf("[INFO] Deleting App Mesh service mesh (%s) virtual gateway (%s) gateway route: %s", meshName, virtualGatewayName, gatewayRouteName)
 							r := resourceAwsAppmeshGatewayRoute()
 							d := r.Data(nil)
 							d.SetId("????????????????") // ID not used in Delete.
 							d.Set("mesh_name", meshName)
 							d.Set("name", gatewayRouteName)
 							d.Set("virtual_gateway_name", virtualGatewayName)
-							err := r.Delete(d, client)
 
-							if err != nil {
-								log.Printf("[ERROR] %s", err)
-								sweeperErrs = multierror.Append(sweeperErrs, err)
-								continue
-							}
+							sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s/%s/%s", meshName, virtualGatewayName, gatewayRouteName), func() error {
+								return r.Delete(d, client)
+							}).WithName(gatewayRouteName))
 						}
 
 						return !isLast
 					})
-
 					if err != nil {
 						sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving App Mesh service mesh (%s) virtual gateway (%s) gateway routes: %w", meshName, virtualGatewayName, err))
 					}
@@ -80,7 +77,6 @@ func testSweepAppmeshGatewayRoutes(region string) error {
 
 				return !isLast
 			})
-
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving App Mesh service mesh (%s) virtual gateways: %w", meshName, err))
 			}
@@ -90,13 +86,13 @@ func testSweepAppmeshGatewayRoutes(region string) error {
 	})
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Appmesh virtual gateway sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 	if err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving App Mesh virtual gateways: %w", err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func testAccAwsAppmeshGatewayRoute_basic(t *testing.T) {
@@ -445,7 +441,7 @@ func testAccAwsAppmeshGatewayRouteImportStateIdFunc(resourceName string) resourc
 }
 
 func testAccCheckAppmeshGatewayRouteDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+	conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_gateway_route" {
@@ -467,7 +463,7 @@ func testAccCheckAppmeshGatewayRouteDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshGatewayRouteExists(name string, v *appmesh.GatewayRouteData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+		conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -11,12 +11,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
-		Name: "aws_appmesh_mesh",
-		F:    testSweepAppmeshMeshes,
+	sweep.AddTestSweepers("aws_appmesh_mesh", &sweep.Sweeper{
+		Name:    "aws_appmesh_mesh",
+		Service: "appmesh",
+		List:    testSweepAppmeshMeshes,
 		Dependencies: []string{
 			"aws_appmesh_virtual_service",
 			"aws_appmesh_virtual_router",
@@ -26,12 +28,13 @@ func init() {
 	})
 }
 
-func testSweepAppmeshMeshes(region string) error {
+func testSweepAppmeshMeshes(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).appmeshconn
+	conn := client.(*AWSClient).appmeshconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, isLast bool) bool {
 		if page == nil {
@@ -40,17 +43,15 @@ func testSweepAppmeshMeshes(region string) error {
 
 		for _, mesh := range page.Meshes {
 			name := aws.StringValue(mesh.MeshName)
-
 			input := &appmesh.DeleteMeshInput{
 				MeshName: aws.String(name),
 			}
 
-			log.Printf("[INFO] Deleting Appmesh Mesh: %s", name)
-			_, err := conn.DeleteMesh(input)
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteMesh(input)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting Appmesh Mesh (%s): %s", name, err)
-			}
+				return err
+			}))
 		}
 
 		return !isLast
@@ -58,12 +59,12 @@ func testSweepAppmeshMeshes(region string) error {
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Appmesh Mesh sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("error retrieving Appmesh Meshes: %s", err)
+		return nil, fmt.Errorf("error retrieving Appmesh Meshes: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func testAccAwsAppmeshMesh_basic(t *testing.T) {
@@ -181,7 +182,7 @@ func testAccAwsAppmeshMesh_tags(t *testing.T) {
 }
 
 func testAccCheckAppmeshMeshDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+	conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_mesh" {
@@ -205,7 +206,7 @@ func testAccCheckAppmeshMeshDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshMeshExists(name string, v *appmesh.MeshData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+		conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -10,21 +10,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
-		Name: "aws_appmesh_route",
-		F:    testSweepAppmeshRoutes,
+	sweep.AddTestSweepers("aws_appmesh_route", &sweep.Sweeper{
+		Name:    "aws_appmesh_route",
+		Service: "appmesh",
+		List:    testSweepAppmeshRoutes,
 	})
 }
 
-func testSweepAppmeshRoutes(region string) error {
+func testSweepAppmeshRoutes(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).appmeshconn
+	conn := client.(*AWSClient).appmeshconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, isLast bool) bool {
 		if page == nil {
@@ -62,17 +65,15 @@ func testSweepAppmeshRoutes(region string) error {
 							}
 							routeName := aws.StringValue(route.RouteName)
 
-							log.Printf("[INFO] Deleting Appmesh Mesh (%s) Virtual Router (%s) Route: %s", meshName, virtualRouterName, routeName)
-							_, err := conn.DeleteRoute(input)
+							sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s/%s/%s", meshName, virtualRouterName, routeName), func() error {
+								_, err := conn.DeleteRoute(input)
 
-							if err != nil {
-								log.Printf("[ERROR] Error deleting Appmesh Mesh (%s) Virtual Router (%s) Route (%s): %s", meshName, virtualRouterName, routeName, err)
-							}
+								return err
+							}).WithName(routeName))
 						}
 
 						return !isLast
 					})
-
 					if err != nil {
 						log.Printf("[ERROR] Error retrieving Appmesh Mesh (%s) Virtual Router (%s) Routes: %s", meshName, virtualRouterName, err)
 					}
@@ -80,7 +81,6 @@ func testSweepAppmeshRoutes(region string) error {
 
 				return !isLast
 			})
-
 			if err != nil {
 				log.Printf("[ERROR] Error retrieving Appmesh Mesh (%s) Virtual Routers: %s", meshName, err)
 			}
@@ -91,12 +91,12 @@ func testSweepAppmeshRoutes(region string) error {
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Appmesh Mesh sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("error retrieving Appmesh Meshes: %s", err)
+		return nil, fmt.Errorf("error retrieving Appmesh Meshes: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func testAccAwsAppmeshRoute_grpcRoute(t *testing.T) {
@@ -1352,7 +1352,7 @@ func testAccAwsAppmeshRouteImportStateIdFunc(resourceName string) resource.Impor
 }
 
 func testAccCheckAppmeshRouteDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+	conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_route" {
@@ -1378,7 +1378,7 @@ func testAccCheckAppmeshRouteDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshRouteExists(name string, v *appmesh.RouteData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+		conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -13,25 +13,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appmesh/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
-		Name: "aws_appmesh_virtual_gateway",
-		F:    testSweepAppmeshVirtualGateways,
+	sweep.AddTestSweepers("aws_appmesh_virtual_gateway", &sweep.Sweeper{
+		Name:    "aws_appmesh_virtual_gateway",
+		Service: "appmesh",
+		List:    testSweepAppmeshVirtualGateways,
 		Dependencies: []string{
 			"aws_appmesh_gateway_route",
 		},
 	})
 }
 
-func testSweepAppmeshVirtualGateways(region string) error {
+func testSweepAppmeshVirtualGateways(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).appmeshconn
-
+	conn := client.(*AWSClient).appmeshconn()
+	var sweepables []sweep.Sweepable
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, isLast bool) bool {
@@ -50,24 +52,19 @@ func testSweepAppmeshVirtualGateways(region string) error {
 				for _, virtualGateway := range page.VirtualGateways {
 					virtualGatewayName := aws.StringValue(virtualGateway.VirtualGatewayName)
 
-					log.Printf("[INFO] Deleting App Mesh service mesh (%s) virtual gateway: %s", meshName, virtualGatewayName)
 					r := resourceAwsAppmeshVirtualGateway()
 					d := r.Data(nil)
 					d.SetId("????????????????") // ID not used in Delete.
 					d.Set("mesh_name", meshName)
 					d.Set("name", virtualGatewayName)
-					err := r.Delete(d, client)
 
-					if err != nil {
-						log.Printf("[ERROR] %s", err)
-						sweeperErrs = multierror.Append(sweeperErrs, err)
-						continue
-					}
+					sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s/%s", meshName, virtualGatewayName), func() error {
+						return r.Delete(d, client)
+					}).WithName(virtualGatewayName))
 				}
 
 				return !isLast
 			})
-
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving App Mesh service mesh (%s) virtual gateways: %w", meshName, err))
 			}
@@ -77,13 +74,13 @@ func testSweepAppmeshVirtualGateways(region string) error {
 	})
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Appmesh virtual gateway sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 	if err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving App Mesh virtual gateways: %w", err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func testAccAwsAppmeshVirtualGateway_basic(t *testing.T) {
@@ -618,7 +615,7 @@ func testAccAwsAppmeshVirtualGateway_Tags(t *testing.T) {
 }
 
 func testAccCheckAppmeshVirtualGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+	conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_virtual_node" {
@@ -640,7 +637,7 @@ func testAccCheckAppmeshVirtualGatewayDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshVirtualGatewayExists(name string, v *appmesh.VirtualGatewayData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+		conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
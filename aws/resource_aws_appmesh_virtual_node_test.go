@@ -8,27 +8,27 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acmpca"
 	"github.com/aws/aws-sdk-go/service/appmesh"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
-		Name: "aws_appmesh_virtual_node",
-		F:    testSweepAppmeshVirtualNodes,
+	sweep.AddTestSweepers("aws_appmesh_virtual_node", &sweep.Sweeper{
+		Name:    "aws_appmesh_virtual_node",
+		Service: "appmesh",
+		List:    testSweepAppmeshVirtualNodes,
 	})
 }
 
-func testSweepAppmeshVirtualNodes(region string) error {
+func testSweepAppmeshVirtualNodes(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).appmeshconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).appmeshconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, isLast bool) bool {
 		if page == nil {
@@ -53,20 +53,15 @@ func testSweepAppmeshVirtualNodes(region string) error {
 					}
 					virtualNodeName := aws.StringValue(virtualNode.VirtualNodeName)
 
-					log.Printf("[INFO] Deleting Appmesh Mesh (%s) Virtual Node: %s", meshName, virtualNodeName)
-					_, err := conn.DeleteVirtualNode(input)
+					sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s/%s", meshName, virtualNodeName), func() error {
+						_, err := conn.DeleteVirtualNode(input)
 
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting Appmesh Mesh (%s) Virtual Node (%s): %w", meshName, virtualNodeName, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
-					}
+						return err
+					}).WithName(virtualNodeName))
 				}
 
 				return !isLast
 			})
-
 			if err != nil {
 				log.Printf("[ERROR] Error retrieving Appmesh Mesh (%s) Virtual Nodes: %s", meshName, err)
 			}
@@ -77,12 +72,12 @@ func testSweepAppmeshVirtualNodes(region string) error {
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Appmesh Virtual Node sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("error retrieving Appmesh Virtual Nodes: %w", err)
+		return nil, fmt.Errorf("error retrieving Appmesh Virtual Nodes: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func testAccAwsAppmeshVirtualNode_basic(t *testing.T) {
@@ -1069,7 +1064,7 @@ func testAccAwsAppmeshVirtualNode_tags(t *testing.T) {
 }
 
 func testAccCheckAppmeshVirtualNodeDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+	conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_virtual_node" {
@@ -1094,7 +1089,7 @@ func testAccCheckAppmeshVirtualNodeDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshVirtualNodeExists(name string, v *appmesh.VirtualNodeData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+		conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
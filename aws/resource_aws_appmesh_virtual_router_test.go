@@ -10,24 +10,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
-		Name: "aws_appmesh_virtual_router",
-		F:    testSweepAppmeshVirtualRouters,
+	sweep.AddTestSweepers("aws_appmesh_virtual_router", &sweep.Sweeper{
+		Name:    "aws_appmesh_virtual_router",
+		Service: "appmesh",
+		List:    testSweepAppmeshVirtualRouters,
 		Dependencies: []string{
 			"aws_appmesh_route",
 		},
 	})
 }
 
-func testSweepAppmeshVirtualRouters(region string) error {
+func testSweepAppmeshVirtualRouters(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).appmeshconn
+	conn := client.(*AWSClient).appmeshconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, isLast bool) bool {
 		if page == nil {
@@ -52,17 +55,15 @@ func testSweepAppmeshVirtualRouters(region string) error {
 					}
 					virtualRouterName := aws.StringValue(virtualRouter.VirtualRouterName)
 
-					log.Printf("[INFO] Deleting Appmesh Mesh (%s) Virtual Router: %s", meshName, virtualRouterName)
-					_, err := conn.DeleteVirtualRouter(input)
+					sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s/%s", meshName, virtualRouterName), func() error {
+						_, err := conn.DeleteVirtualRouter(input)
 
-					if err != nil {
-						log.Printf("[ERROR] Error deleting Appmesh Mesh (%s) Virtual Router (%s): %s", meshName, virtualRouterName, err)
-					}
+						return err
+					}).WithName(virtualRouterName))
 				}
 
 				return !isLast
 			})
-
 			if err != nil {
 				log.Printf("[ERROR] Error retrieving Appmesh Mesh (%s) Virtual Routers: %s", meshName, err)
 			}
@@ -73,12 +74,12 @@ func testSweepAppmeshVirtualRouters(region string) error {
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Appmesh Virtual Router sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("error retrieving Appmesh Virtual Routers: %s", err)
+		return nil, fmt.Errorf("error retrieving Appmesh Virtual Routers: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func testAccAwsAppmeshVirtualRouter_basic(t *testing.T) {
@@ -191,7 +192,7 @@ func testAccAwsAppmeshVirtualRouter_tags(t *testing.T) {
 }
 
 func testAccCheckAppmeshVirtualRouterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+	conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_virtual_router" {
@@ -216,7 +217,7 @@ func testAccCheckAppmeshVirtualRouterDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshVirtualRouterExists(name string, v *appmesh.VirtualRouterData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+		conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
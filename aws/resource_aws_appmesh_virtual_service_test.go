@@ -10,21 +10,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
-		Name: "aws_appmesh_virtual_service",
-		F:    testSweepAppmeshVirtualServices,
+	sweep.AddTestSweepers("aws_appmesh_virtual_service", &sweep.Sweeper{
+		Name:    "aws_appmesh_virtual_service",
+		Service: "appmesh",
+		List:    testSweepAppmeshVirtualServices,
 	})
 }
 
-func testSweepAppmeshVirtualServices(region string) error {
+func testSweepAppmeshVirtualServices(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).appmeshconn
+	conn := client.(*AWSClient).appmeshconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, isLast bool) bool {
 		if page == nil {
@@ -49,17 +52,15 @@ func testSweepAppmeshVirtualServices(region string) error {
 					}
 					virtualServiceName := aws.StringValue(virtualService.VirtualServiceName)
 
-					log.Printf("[INFO] Deleting Appmesh Mesh (%s) Virtual Service: %s", meshName, virtualServiceName)
-					_, err := conn.DeleteVirtualService(input)
+					sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s/%s", meshName, virtualServiceName), func() error {
+						_, err := conn.DeleteVirtualService(input)
 
-					if err != nil {
-						log.Printf("[ERROR] Error deleting Appmesh Mesh (%s) Virtual Service (%s): %s", meshName, virtualServiceName, err)
-					}
+						return err
+					}).WithName(virtualServiceName))
 				}
 
 				return !isLast
 			})
-
 			if err != nil {
 				log.Printf("[ERROR] Error retrieving Appmesh Mesh (%s) Virtual Services: %s", meshName, err)
 			}
@@ -70,12 +71,12 @@ func testSweepAppmeshVirtualServices(region string) error {
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Appmesh Virtual Service sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("error retrieving Appmesh Virtual Services: %s", err)
+		return nil, fmt.Errorf("error retrieving Appmesh Virtual Services: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func testAccAwsAppmeshVirtualService_virtualNode(t *testing.T) {
@@ -233,7 +234,7 @@ func testAccAwsAppmeshVirtualService_tags(t *testing.T) {
 }
 
 func testAccCheckAppmeshVirtualServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+	conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_virtual_service" {
@@ -258,7 +259,7 @@ func testAccCheckAppmeshVirtualServiceDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshVirtualServiceExists(name string, v *appmesh.VirtualServiceData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).appmeshconn
+		conn := testAccProvider.Meta().(*AWSClient).appmeshconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -55,7 +55,7 @@ func resourceAwsAppsyncApiKey() *schema.Resource {
 }
 
 func resourceAwsAppsyncApiKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	apiID := d.Get("api_id").(string)
 
@@ -77,7 +77,7 @@ func resourceAwsAppsyncApiKeyCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsAppsyncApiKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	apiID, keyID, err := decodeAppSyncApiKeyId(d.Id())
 	if err != nil {
@@ -102,7 +102,7 @@ func resourceAwsAppsyncApiKeyRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsAppsyncApiKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	apiID, keyID, err := decodeAppSyncApiKeyId(d.Id())
 	if err != nil {
@@ -131,7 +131,7 @@ func resourceAwsAppsyncApiKeyUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsAppsyncApiKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	apiID, keyID, err := decodeAppSyncApiKeyId(d.Id())
 	if err != nil {
@@ -135,7 +135,7 @@ func TestAccAwsAppsyncFunction_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsAppsyncFunctionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_function" {
 			continue
@@ -169,7 +169,7 @@ func testAccCheckAwsAppsyncFunctionExists(name string, config *appsync.FunctionC
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 
 		apiID, functionID, err := decodeAppsyncFunctionID(rs.Primary.ID)
 		if err != nil {
@@ -11,33 +11,34 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appsync_graphql_api", &resource.Sweeper{
-		Name: "aws_appsync_graphql_api",
-		F:    testSweepAppsyncGraphqlApis,
+	sweep.AddTestSweepers("aws_appsync_graphql_api", &sweep.Sweeper{
+		Name:    "aws_appsync_graphql_api",
+		Service: "appsync",
+		List:    testSweepAppsyncGraphqlApis,
 	})
 }
 
-func testSweepAppsyncGraphqlApis(region string) error {
+func testSweepAppsyncGraphqlApis(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %s", err)
+		return nil, fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).appsyncconn
-
+	conn := client.(*AWSClient).appsyncconn()
 	input := &appsync.ListGraphqlApisInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.ListGraphqlApis(input)
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping AppSync GraphQL API sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving AppSync GraphQL APIs: %s", err)
+			return nil, fmt.Errorf("Error retrieving AppSync GraphQL APIs: %s", err)
 		}
 
 		for _, graphAPI := range output.GraphqlApis {
@@ -46,22 +47,20 @@ func testSweepAppsyncGraphqlApis(region string) error {
 				ApiId: graphAPI.ApiId,
 			}
 
-			log.Printf("[INFO] Deleting AppSync GraphQL API %s", id)
-			_, err := conn.DeleteGraphqlApi(input)
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteGraphqlApi(input)
 
-			if err != nil {
-				return fmt.Errorf("error deleting AppSync GraphQL API (%s): %s", id, err)
-			}
+				return err
+			}).WithName(aws.StringValue(graphAPI.Name)).WithTags(aws.StringValueMap(graphAPI.Tags)))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
 			break
 		}
-
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSAppsyncGraphqlApi_basic(t *testing.T) {
@@ -943,7 +942,7 @@ func TestAccAWSAppsyncGraphqlApi_XrayEnabled(t *testing.T) {
 }
 
 func testAccCheckAwsAppsyncGraphqlApiDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_graphql_api" {
 			continue
@@ -971,7 +970,7 @@ func testAccCheckAwsAppsyncGraphqlApiExists(name string, api *appsync.GraphqlApi
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 
 		input := &appsync.GetGraphqlApiInput{
 			ApiId: aws.String(rs.Primary.ID),
@@ -996,7 +995,7 @@ func testAccCheckAwsAppsyncTypeExists(name, typeName string) resource.TestCheckF
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 
 		input := &appsync.GetTypeInput{
 			ApiId:    aws.String(rs.Primary.ID),
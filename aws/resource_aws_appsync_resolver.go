@@ -107,7 +107,7 @@ func resourceAwsAppsyncResolver() *schema.Resource {
 }
 
 func resourceAwsAppsyncResolverCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	input := &appsync.CreateResolverInput{
 		ApiId:                   aws.String(d.Get("api_id").(string)),
@@ -151,7 +151,7 @@ func resourceAwsAppsyncResolverCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsAppsyncResolverRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	apiID, typeName, fieldName, err := decodeAppsyncResolverID(d.Id())
 
@@ -198,7 +198,7 @@ func resourceAwsAppsyncResolverRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsAppsyncResolverUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	input := &appsync.UpdateResolverInput{
 		ApiId:                   aws.String(d.Get("api_id").(string)),
@@ -240,7 +240,7 @@ func resourceAwsAppsyncResolverUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsAppsyncResolverDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appsyncconn
+	conn := meta.(*AWSClient).appsyncconn()
 
 	apiID, typeName, fieldName, err := decodeAppsyncResolverID(d.Id())
 
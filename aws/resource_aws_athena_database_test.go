@@ -128,8 +128,8 @@ func TestAccAWSAthenaDatabase_forceDestroyAlwaysSucceeds(t *testing.T) {
 // StartQueryExecution requires OutputLocation but terraform destroy deleted S3 bucket as well.
 // So temporary S3 bucket as OutputLocation is created to confirm whether the database is actually deleted.
 func testAccCheckAWSAthenaDatabaseDestroy(s *terraform.State) error {
-	athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn
-	s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+	athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn()
+	s3conn := testAccProvider.Meta().(*AWSClient).s3conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_athena_database" {
 			continue
@@ -230,7 +230,7 @@ func testAccAWSAthenaDatabaseCreateTables(dbName string) resource.TestCheckFunc
 			return err
 		}
 
-		athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn
+		athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn()
 
 		input := &athena.StartQueryExecutionInput{
 			QueryExecutionContext: &athena.QueryExecutionContext{
@@ -260,7 +260,7 @@ func testAccAWSAthenaDatabaseDestroyTables(dbName string) resource.TestCheckFunc
 			return err
 		}
 
-		athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn
+		athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn()
 
 		input := &athena.StartQueryExecutionInput{
 			QueryExecutionContext: &athena.QueryExecutionContext{
@@ -289,7 +289,7 @@ func testAccCheckAWSAthenaDatabaseDropFails(dbName string) resource.TestCheckFun
 			return err
 		}
 
-		athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn
+		athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn()
 
 		input := &athena.StartQueryExecutionInput{
 			QueryExecutionContext: &athena.QueryExecutionContext{
@@ -18,65 +18,66 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_autoscaling_group", &resource.Sweeper{
-		Name: "aws_autoscaling_group",
-		F:    testSweepAutoscalingGroups,
+	sweep.AddTestSweepers("aws_autoscaling_group", &sweep.Sweeper{
+		Name:    "aws_autoscaling_group",
+		Service: "autoscaling",
+		List:    testSweepAutoscalingGroups,
 	})
 }
 
-func testSweepAutoscalingGroups(region string) error {
+func testSweepAutoscalingGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).autoscalingconn
+	conn := client.(*AWSClient).autoscalingconn()
 
 	resp, err := conn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Auto Scaling Group sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving Auto Scaling Groups in Sweeper: %s", err)
+		return nil, fmt.Errorf("Error retrieving Auto Scaling Groups in Sweeper: %s", err)
 	}
 
-	if len(resp.AutoScalingGroups) == 0 {
-		log.Print("[DEBUG] No Auto Scaling Groups to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, asg := range resp.AutoScalingGroups {
 		deleteopts := autoscaling.DeleteAutoScalingGroupInput{
 			AutoScalingGroupName: asg.AutoScalingGroupName,
 			ForceDelete:          aws.Bool(true),
 		}
+		tags := make(map[string]string)
+		for _, tag := range asg.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
 
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			if _, err := conn.DeleteAutoScalingGroup(&deleteopts); err != nil {
-				if awserr, ok := err.(awserr.Error); ok {
-					switch awserr.Code() {
-					case "InvalidGroup.NotFound":
-						return nil
-					case "ResourceInUse", "ScalingActivityInProgress":
-						return resource.RetryableError(awserr)
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(asg.AutoScalingGroupName), func() error {
+			return resource.Retry(5*time.Minute, func() *resource.RetryError {
+				if _, err := conn.DeleteAutoScalingGroup(&deleteopts); err != nil {
+					if awserr, ok := err.(awserr.Error); ok {
+						switch awserr.Code() {
+						case "InvalidGroup.NotFound":
+							return nil
+						case "ResourceInUse", "ScalingActivityInProgress":
+							return resource.RetryableError(awserr)
+						}
 					}
+					// Didn't recognize the error, so shouldn't retry.
+					return resource.NonRetryableError(err)
 				}
-
-				// Didn't recognize the error, so shouldn't retry.
-				return resource.NonRetryableError(err)
-			}
-			// Successful delete
-			return nil
-		})
-		if err != nil {
-			return err
-		}
+				// Successful delete
+				return nil
+			})
+		}).WithTags(tags))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSAutoScalingGroup_basic(t *testing.T) {
@@ -1126,7 +1127,7 @@ func testAccCheckAWSAutoScalingGroupExists(n string, group *autoscaling.Group) r
 			return fmt.Errorf("No Auto Scaling Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 
 		describeGroups, err := conn.DescribeAutoScalingGroups(
 			&autoscaling.DescribeAutoScalingGroupsInput{
@@ -1149,7 +1150,7 @@ func testAccCheckAWSAutoScalingGroupExists(n string, group *autoscaling.Group) r
 }
 
 func testAccCheckAWSAutoScalingGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscaling_group" {
@@ -1358,7 +1359,7 @@ func autoscalingTagDescriptionsToMap(ts *[]*autoscaling.TagDescription) map[stri
 // sure that all instances in it are healthy.
 func testAccCheckAWSALBTargetGroupHealthy(res *elbv2.TargetGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 		resp, err := conn.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
 			TargetGroupArn: res.TargetGroupArn,
@@ -4563,7 +4564,7 @@ resource "aws_launch_configuration" "test" {
 
 func testAccCheckAutoScalingInstanceRefreshCount(group *autoscaling.Group, expected int) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 
 		input := autoscaling.DescribeInstanceRefreshesInput{
 			AutoScalingGroupName: group.AutoScalingGroupName,
@@ -4582,7 +4583,7 @@ func testAccCheckAutoScalingInstanceRefreshCount(group *autoscaling.Group, expec
 
 func testAccCheckAutoScalingInstanceRefreshStatus(group *autoscaling.Group, offset int, expected ...string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 
 		input := autoscaling.DescribeInstanceRefreshesInput{
 			AutoScalingGroupName: group.AutoScalingGroupName,
@@ -317,7 +317,7 @@ func resourceAwsAutoScalingPlansScalingPlan() *schema.Resource {
 }
 
 func resourceAwsAutoScalingPlansScalingPlanCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingplansconn
+	conn := meta.(*AWSClient).autoscalingplansconn()
 
 	scalingPlanName := d.Get("name").(string)
 
@@ -346,7 +346,7 @@ func resourceAwsAutoScalingPlansScalingPlanCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsAutoScalingPlansScalingPlanRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingplansconn
+	conn := meta.(*AWSClient).autoscalingplansconn()
 
 	scalingPlan, err := finder.ScalingPlan(conn, d.Get("name").(string), d.Get("scaling_plan_version").(int))
 	if err != nil {
@@ -373,7 +373,7 @@ func resourceAwsAutoScalingPlansScalingPlanRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsAutoScalingPlansScalingPlanUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingplansconn
+	conn := meta.(*AWSClient).autoscalingplansconn()
 
 	scalingPlanName := d.Get("name").(string)
 	scalingPlanVersion := d.Get("scaling_plan_version").(int)
@@ -400,7 +400,7 @@ func resourceAwsAutoScalingPlansScalingPlanUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsAutoScalingPlansScalingPlanDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingplansconn
+	conn := meta.(*AWSClient).autoscalingplansconn()
 
 	scalingPlanName := d.Get("name").(string)
 	scalingPlanVersion := d.Get("scaling_plan_version").(int)
@@ -696,9 +696,9 @@ func flattenAutoScalingPlansScalingInstructions(scalingInstructions []*autoscali
 
 	for _, scalingInstruction := range scalingInstructions {
 		mScalingInstruction := map[string]interface{}{
-			"disable_dynamic_scaling":                  aws.BoolValue(scalingInstruction.DisableDynamicScaling),
-			"max_capacity":                             int(aws.Int64Value(scalingInstruction.MaxCapacity)),
-			"min_capacity":                             int(aws.Int64Value(scalingInstruction.MinCapacity)),
+			"disable_dynamic_scaling": aws.BoolValue(scalingInstruction.DisableDynamicScaling),
+			"max_capacity":            int(aws.Int64Value(scalingInstruction.MaxCapacity)),
+			"min_capacity":            int(aws.Int64Value(scalingInstruction.MinCapacity)),
 			"predictive_scaling_max_capacity_behavior": aws.StringValue(scalingInstruction.PredictiveScalingMaxCapacityBehavior),
 			"predictive_scaling_max_capacity_buffer":   int(aws.Int64Value(scalingInstruction.PredictiveScalingMaxCapacityBuffer)),
 			"predictive_scaling_mode":                  aws.StringValue(scalingInstruction.PredictiveScalingMode),
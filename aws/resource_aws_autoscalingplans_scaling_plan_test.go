@@ -10,38 +10,38 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscalingplans"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/autoscalingplans/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_autoscalingplans_scaling_plan", &resource.Sweeper{
-		Name: "aws_autoscalingplans_scaling_plan",
-		F:    testSweepAutoScalingPlansScalingPlans,
+	sweep.AddTestSweepers("aws_autoscalingplans_scaling_plan", &sweep.Sweeper{
+		Name:    "aws_autoscalingplans_scaling_plan",
+		Service: "autoscalingplans",
+		List:    testSweepAutoScalingPlansScalingPlans,
 	})
 }
 
-func testSweepAutoScalingPlansScalingPlans(region string) error {
+func testSweepAutoScalingPlansScalingPlans(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).autoscalingplansconn
+	conn := client.(*AWSClient).autoscalingplansconn()
 	input := &autoscalingplans.DescribeScalingPlansInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.DescribeScalingPlans(input)
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Auto Scaling Scaling Plans sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return sweepables, nil // In case we have completed some pages
 		}
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Auto Scaling Scaling Plans: %w", err))
-			return sweeperErrs.ErrorOrNil()
+			return sweepables, fmt.Errorf("error listing Auto Scaling Scaling Plans: %w", err)
 		}
 
 		for _, scalingPlan := range output.ScalingPlans {
@@ -53,13 +53,10 @@ func testSweepAutoScalingPlansScalingPlans(region string) error {
 			d.SetId("????????????????") // ID not used in Delete.
 			d.Set("name", scalingPlanName)
 			d.Set("scaling_plan_version", scalingPlanVersion)
-			err = r.Delete(d, client)
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s/%d", scalingPlanName, scalingPlanVersion), func() error {
+				return r.Delete(d, client)
+			}).WithName(scalingPlanName))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -68,7 +65,7 @@ func testSweepAutoScalingPlansScalingPlans(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsAutoScalingPlansScalingPlan_basicDynamicScaling(t *testing.T) {
@@ -277,7 +274,7 @@ func TestAccAwsAutoScalingPlansScalingPlan_disappears(t *testing.T) {
 }
 
 func testAccCheckAutoScalingPlansScalingPlanDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).autoscalingplansconn
+	conn := testAccProvider.Meta().(*AWSClient).autoscalingplansconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscalingplans_scaling_plan" {
@@ -304,7 +301,7 @@ func testAccCheckAutoScalingPlansScalingPlanDestroy(s *terraform.State) error {
 
 func testAccCheckAutoScalingPlansScalingPlanExists(name string, v *autoscalingplans.ScalingPlan) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingplansconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingplansconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -29,7 +29,7 @@ func resourceAwsBackupRegionSettings() *schema.Resource {
 }
 
 func resourceAwsBackupRegionSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	prefrences := d.Get("resource_type_opt_in_preference").(map[string]interface{})
 	list := make(map[string]*bool, len(prefrences))
@@ -52,7 +52,7 @@ func resourceAwsBackupRegionSettingsUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsBackupRegionSettingsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	resp, err := conn.DescribeRegionSettings(&backup.DescribeRegionSettingsInput{})
 	if err != nil {
@@ -7,27 +7,27 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
-		Name: "aws_backup_vault_notifications",
-		F:    testSweepBackupVaultNotifications,
+	sweep.AddTestSweepers("aws_backup_vault_notifications", &sweep.Sweeper{
+		Name:    "aws_backup_vault_notifications",
+		Service: "backup",
+		List:    testSweepBackupVaultNotifications,
 	})
 }
 
-func testSweepBackupVaultNotifications(region string) error {
+func testSweepBackupVaultNotifications(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %w", err)
+		return nil, fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).backupconn
-	var sweeperErrs *multierror.Error
-
+	conn := client.(*AWSClient).backupconn()
+	var sweepables []sweep.Sweepable
 	input := &backup.ListBackupVaultsInput{}
 
 	for {
@@ -35,30 +35,21 @@ func testSweepBackupVaultNotifications(region string) error {
 		if err != nil {
 			if testSweepSkipSweepError(err) {
 				log.Printf("[WARN] Skipping Backup Vault Notifications sweep for %s: %s", region, err)
-				return nil
+				return nil, nil
 			}
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Backup Vault Notifications: %w", err))
-			return sweeperErrs.ErrorOrNil()
-		}
-
-		if len(output.BackupVaultList) == 0 {
-			log.Print("[DEBUG] No Backup Vault Notifications to sweep")
-			return nil
+			return nil, fmt.Errorf("error retrieving Backup Vault Notifications: %w", err)
 		}
 
 		for _, rule := range output.BackupVaultList {
 			name := aws.StringValue(rule.BackupVaultName)
 
-			log.Printf("[INFO] Deleting Backup Vault Notifications %s", name)
-			_, err := conn.DeleteBackupVaultNotifications(&backup.DeleteBackupVaultNotificationsInput{
-				BackupVaultName: aws.String(name),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Backup Vault Notifications %s: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteBackupVaultNotifications(&backup.DeleteBackupVaultNotificationsInput{
+					BackupVaultName: aws.String(name),
+				})
+
+				return err
+			}))
 		}
 
 		if output.NextToken == nil {
@@ -67,7 +58,7 @@ func testSweepBackupVaultNotifications(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsBackupVaultNotification_basic(t *testing.T) {
@@ -119,7 +110,7 @@ func TestAccAwsBackupVaultNotification_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsBackupVaultNotificationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).backupconn
+	conn := testAccProvider.Meta().(*AWSClient).backupconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_backup_vault_notifications" {
 			continue
@@ -148,7 +139,7 @@ func testAccCheckAwsBackupVaultNotificationExists(name string, vault *backup.Get
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).backupconn
+		conn := testAccProvider.Meta().(*AWSClient).backupconn()
 		params := &backup.GetBackupVaultNotificationsInput{
 			BackupVaultName: aws.String(rs.Primary.ID),
 		}
@@ -41,7 +41,7 @@ func resourceAwsBackupVaultPolicy() *schema.Resource {
 }
 
 func resourceAwsBackupVaultPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.PutBackupVaultAccessPolicyInput{
 		BackupVaultName: aws.String(d.Get("backup_vault_name").(string)),
@@ -59,7 +59,7 @@ func resourceAwsBackupVaultPolicyPut(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsBackupVaultPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.GetBackupVaultAccessPolicyInput{
 		BackupVaultName: aws.String(d.Id()),
@@ -83,7 +83,7 @@ func resourceAwsBackupVaultPolicyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsBackupVaultPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.DeleteBackupVaultAccessPolicyInput{
 		BackupVaultName: aws.String(d.Id()),
@@ -8,27 +8,27 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
-		Name: "aws_backup_vault_policy",
-		F:    testSweepBackupVaultPolicies,
+	sweep.AddTestSweepers("aws_backup_vault_policy", &sweep.Sweeper{
+		Name:    "aws_backup_vault_policy",
+		Service: "backup",
+		List:    testSweepBackupVaultPolicies,
 	})
 }
 
-func testSweepBackupVaultPolicies(region string) error {
+func testSweepBackupVaultPolicies(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %w", err)
+		return nil, fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).backupconn
-	var sweeperErrs *multierror.Error
-
+	conn := client.(*AWSClient).backupconn()
+	var sweepables []sweep.Sweepable
 	input := &backup.ListBackupVaultsInput{}
 
 	for {
@@ -36,30 +36,21 @@ func testSweepBackupVaultPolicies(region string) error {
 		if err != nil {
 			if testSweepSkipSweepError(err) {
 				log.Printf("[WARN] Skipping Backup Vault Policies sweep for %s: %s", region, err)
-				return nil
+				return nil, nil
 			}
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Backup Vault Policies: %w", err))
-			return sweeperErrs.ErrorOrNil()
-		}
-
-		if len(output.BackupVaultList) == 0 {
-			log.Print("[DEBUG] No Backup Vault Policies to sweep")
-			return nil
+			return nil, fmt.Errorf("error retrieving Backup Vault Policies: %w", err)
 		}
 
 		for _, rule := range output.BackupVaultList {
 			name := aws.StringValue(rule.BackupVaultName)
 
-			log.Printf("[INFO] Deleting Backup Vault Policies %s", name)
-			_, err := conn.DeleteBackupVaultAccessPolicy(&backup.DeleteBackupVaultAccessPolicyInput{
-				BackupVaultName: aws.String(name),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Backup Vault Policies %s: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteBackupVaultAccessPolicy(&backup.DeleteBackupVaultAccessPolicyInput{
+					BackupVaultName: aws.String(name),
+				})
+
+				return err
+			}))
 		}
 
 		if output.NextToken == nil {
@@ -68,7 +59,7 @@ func testSweepBackupVaultPolicies(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsBackupVaultPolicy_basic(t *testing.T) {
@@ -127,7 +118,7 @@ func TestAccAwsBackupVaultPolicy_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsBackupVaultPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).backupconn
+	conn := testAccProvider.Meta().(*AWSClient).backupconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_backup_vault_policy" {
 			continue
@@ -156,7 +147,7 @@ func testAccCheckAwsBackupVaultPolicyExists(name string, vault *backup.GetBackup
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).backupconn
+		conn := testAccProvider.Meta().(*AWSClient).backupconn()
 		params := &backup.GetBackupVaultAccessPolicyInput{
 			BackupVaultName: aws.String(rs.Primary.ID),
 		}
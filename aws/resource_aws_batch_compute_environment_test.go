@@ -11,34 +11,31 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/batch"
 	"github.com/aws/aws-sdk-go/service/iam"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
-		Name: "aws_batch_compute_environment",
+	sweep.AddTestSweepers("aws_batch_compute_environment", &sweep.Sweeper{
+		Name:    "aws_batch_compute_environment",
+		Service: "batch",
 		Dependencies: []string{
 			"aws_batch_job_queue",
 		},
-		F: testSweepBatchComputeEnvironments,
+		List: testSweepBatchComputeEnvironments,
 	})
 }
 
-func testSweepBatchComputeEnvironments(region string) error {
+func testSweepBatchComputeEnvironments(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-
-	conn := client.(*AWSClient).batchconn
-	iamconn := client.(*AWSClient).iamconn
-
-	var sweeperErrs *multierror.Error
-
+	conn := client.(*AWSClient).batchconn()
+	iamconn := client.(*AWSClient).iamconn()
+	var sweepables []sweep.Sweepable
 	input := &batch.DescribeComputeEnvironmentsInput{}
 	r := resourceAwsBatchComputeEnvironment()
 
@@ -48,107 +45,90 @@ func testSweepBatchComputeEnvironments(region string) error {
 		}
 
 		for _, computeEnvironment := range page.ComputeEnvironments {
+			computeEnvironment := computeEnvironment
 			name := aws.StringValue(computeEnvironment.ComputeEnvironmentName)
 
 			d := r.Data(nil)
 			d.SetId(name)
 			d.Set("compute_environment_name", name)
 
-			// Reference: https://aws.amazon.com/premiumsupport/knowledge-center/batch-invalid-compute-environment/
-			//
-			// When a Compute Environment becomes INVALID, it is typically because the associated
-			// IAM Role has disappeared. There is no automatic resolution via the API, except to
-			// associate a new IAM Role that is valid, then delete the Compute Environment.
-			//
-			// We avoid doing this in the resource because it would be very unexpected behavior
-			// for the resource and this issue should be fixed in the API (e.g. Service Linked Role).
-			//
-			// To save writing much more logic around IAM Role deletion, we allow the
-			// aws_iam_role sweeper to handle cleaning these up.
-			if aws.StringValue(computeEnvironment.Status) == batch.CEStatusInvalid {
-				// Reusing the IAM Role name to prevent collisions and inventing a naming scheme
-				serviceRoleARN, err := arn.Parse(aws.StringValue(computeEnvironment.ServiceRole))
-
-				if err != nil {
-					sweeperErr := fmt.Errorf("error parsing Batch Compute Environment (%s) Service Role ARN (%s): %w", name, aws.StringValue(computeEnvironment.ServiceRole), err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				// Reference: https://aws.amazon.com/premiumsupport/knowledge-center/batch-invalid-compute-environment/
+				//
+				// When a Compute Environment becomes INVALID, it is typically because the associated
+				// IAM Role has disappeared. There is no automatic resolution via the API, except to
+				// associate a new IAM Role that is valid, then delete the Compute Environment.
+				//
+				// We avoid doing this in the resource because it would be very unexpected behavior
+				// for the resource and this issue should be fixed in the API (e.g. Service Linked Role).
+				//
+				// To save writing much more logic around IAM Role deletion, we allow the
+				// aws_iam_role sweeper to handle cleaning these up.
+				if aws.StringValue(computeEnvironment.Status) == batch.CEStatusInvalid {
+					// Reusing the IAM Role name to prevent collisions and inventing a naming scheme
+					serviceRoleARN, err := arn.Parse(aws.StringValue(computeEnvironment.ServiceRole))
+
+					if err != nil {
+						return fmt.Errorf("error parsing Service Role ARN (%s): %w", aws.StringValue(computeEnvironment.ServiceRole), err)
+					}
+
+					servicePrincipal := fmt.Sprintf("%s.%s", batch.EndpointsID, testAccGetPartitionDNSSuffix())
+					serviceRoleName := strings.TrimPrefix(serviceRoleARN.Resource, "role/")
+					serviceRolePolicyARN := arn.ARN{
+						AccountID: "aws",
+						Partition: testAccGetPartition(),
+						Resource:  "policy/service-role/AWSBatchServiceRole",
+						Service:   iam.ServiceName,
+					}.String()
+
+					iamCreateRoleInput := &iam.CreateRoleInput{
+						AssumeRolePolicyDocument: aws.String(fmt.Sprintf("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\": \"%s\"},\"Action\":\"sts:AssumeRole\"}]}", servicePrincipal)),
+						RoleName:                 aws.String(serviceRoleName),
+					}
+
+					_, err = iamconn.CreateRole(iamCreateRoleInput)
+
+					if err != nil {
+						return fmt.Errorf("error creating IAM Role (%s) for INVALID Batch Compute Environment: %w", serviceRoleName, err)
+					}
+
+					iamGetRoleInput := &iam.GetRoleInput{
+						RoleName: aws.String(serviceRoleName),
+					}
+
+					err = iamconn.WaitUntilRoleExists(iamGetRoleInput)
+
+					if err != nil {
+						return fmt.Errorf("error waiting for IAM Role (%s) creation for INVALID Batch Compute Environment: %w", serviceRoleName, err)
+					}
+
+					iamAttachRolePolicyInput := &iam.AttachRolePolicyInput{
+						PolicyArn: aws.String(serviceRolePolicyARN),
+						RoleName:  aws.String(serviceRoleName),
+					}
+
+					_, err = iamconn.AttachRolePolicy(iamAttachRolePolicyInput)
+
+					if err != nil {
+						return fmt.Errorf("error attaching Batch IAM Policy (%s) to IAM Role (%s) for INVALID Batch Compute Environment: %w", serviceRolePolicyARN, serviceRoleName, err)
+					}
 				}
 
-				servicePrincipal := fmt.Sprintf("%s.%s", batch.EndpointsID, testAccGetPartitionDNSSuffix())
-				serviceRoleName := strings.TrimPrefix(serviceRoleARN.Resource, "role/")
-				serviceRolePolicyARN := arn.ARN{
-					AccountID: "aws",
-					Partition: testAccGetPartition(),
-					Resource:  "policy/service-role/AWSBatchServiceRole",
-					Service:   iam.ServiceName,
-				}.String()
-
-				iamCreateRoleInput := &iam.CreateRoleInput{
-					AssumeRolePolicyDocument: aws.String(fmt.Sprintf("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\": \"%s\"},\"Action\":\"sts:AssumeRole\"}]}", servicePrincipal)),
-					RoleName:                 aws.String(serviceRoleName),
-				}
-
-				_, err = iamconn.CreateRole(iamCreateRoleInput)
-
-				if err != nil {
-					sweeperErr := fmt.Errorf("error creating IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
-
-				iamGetRoleInput := &iam.GetRoleInput{
-					RoleName: aws.String(serviceRoleName),
-				}
-
-				err = iamconn.WaitUntilRoleExists(iamGetRoleInput)
-
-				if err != nil {
-					sweeperErr := fmt.Errorf("error waiting for IAM Role (%s) creation for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
-
-				iamAttachRolePolicyInput := &iam.AttachRolePolicyInput{
-					PolicyArn: aws.String(serviceRolePolicyARN),
-					RoleName:  aws.String(serviceRoleName),
-				}
-
-				_, err = iamconn.AttachRolePolicy(iamAttachRolePolicyInput)
-
-				if err != nil {
-					sweeperErr := fmt.Errorf("error attaching Batch IAM Policy (%s) to IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRolePolicyARN, serviceRoleName, name, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
-			}
-
-			err := r.Delete(d, client)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Batch Compute Environment (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return r.Delete(d, client)
+			}).WithTags(aws.StringValueMap(computeEnvironment.Tags)))
 		}
 
 		return !lastPage
 	})
-
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Batch Compute Environment sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Batch Compute Environments: %w", err))
+		return sweepables, fmt.Errorf("error listing Batch Compute Environments: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSBatchComputeEnvironment_disappears(t *testing.T) {
@@ -662,7 +642,7 @@ func TestAccAWSBatchComputeEnvironment_Tags(t *testing.T) {
 }
 
 func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).batchconn
+	conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_batch_compute_environment" {
@@ -689,7 +669,7 @@ func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
 
 func testAccCheckAwsBatchComputeEnvironmentExists() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_batch_compute_environment" {
@@ -717,7 +697,7 @@ func testAccCheckAwsBatchComputeEnvironmentExists() resource.TestCheckFunc {
 }
 
 func testAccPreCheckAWSBatch(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).batchconn
+	conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 	input := &batch.DescribeComputeEnvironmentsInput{}
 
@@ -8,32 +8,33 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/batch"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
-		Name: "aws_batch_job_definition",
-		F:    testSweepBatchJobDefinitions,
+	sweep.AddTestSweepers("aws_batch_job_definition", &sweep.Sweeper{
+		Name:    "aws_batch_job_definition",
+		Service: "batch",
+		List:    testSweepBatchJobDefinitions,
 		Dependencies: []string{
 			"aws_batch_job_queue",
 		},
 	})
 }
 
-func testSweepBatchJobDefinitions(region string) error {
+func testSweepBatchJobDefinitions(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).batchconn
+	conn := client.(*AWSClient).batchconn()
 	input := &batch.DescribeJobDefinitionsInput{
 		Status: aws.String("ACTIVE"),
 	}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeJobDefinitionsPages(input, func(page *batch.DescribeJobDefinitionsOutput, isLast bool) bool {
 		if page == nil {
@@ -43,29 +44,26 @@ func testSweepBatchJobDefinitions(region string) error {
 		for _, jobDefinition := range page.JobDefinitions {
 			arn := aws.StringValue(jobDefinition.JobDefinitionArn)
 
-			log.Printf("[INFO] Deleting Batch Job Definition: %s", arn)
-			_, err := conn.DeregisterJobDefinition(&batch.DeregisterJobDefinitionInput{
-				JobDefinition: aws.String(arn),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Batch Job Definition (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(arn, func() error {
+				_, err := conn.DeregisterJobDefinition(&batch.DeregisterJobDefinitionInput{
+					JobDefinition: aws.String(arn),
+				})
+
+				return err
+			}).WithName(aws.StringValue(jobDefinition.JobDefinitionName)).WithTags(aws.StringValueMap(jobDefinition.Tags)))
 		}
 
 		return !isLast
 	})
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Batch Job Definitions sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Batch Job Definitions: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Batch Job Definitions: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSBatchJobDefinition_basic(t *testing.T) {
@@ -243,7 +241,7 @@ func testAccCheckBatchJobDefinitionExists(n string, jd *batch.JobDefinition) res
 			return fmt.Errorf("No Batch Job Queue ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 		arn := rs.Primary.Attributes["arn"]
 		def, err := getJobDefinition(conn, arn)
 		if err != nil {
@@ -298,7 +296,7 @@ func testAccCheckBatchJobDefinitionDestroy(s *terraform.State) error {
 		if rs.Type != "aws_batch_job_definition" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 		js, err := getJobDefinition(conn, rs.Primary.Attributes["arn"])
 		if err == nil && js != nil {
 			if *js.Status == "ACTIVE" {
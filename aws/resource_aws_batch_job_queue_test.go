@@ -10,48 +10,48 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
-		Name: "aws_batch_job_queue",
-		F:    testSweepBatchJobQueues,
+	sweep.AddTestSweepers("aws_batch_job_queue", &sweep.Sweeper{
+		Name:    "aws_batch_job_queue",
+		Service: "batch",
+		List:    testSweepBatchJobQueues,
 	})
 }
 
-func testSweepBatchJobQueues(region string) error {
+func testSweepBatchJobQueues(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).batchconn
+	conn := client.(*AWSClient).batchconn()
 
 	out, err := conn.DescribeJobQueues(&batch.DescribeJobQueuesInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Batch Job Queue sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving Batch Job Queues: %s", err)
+		return nil, fmt.Errorf("Error retrieving Batch Job Queues: %s", err)
 	}
+
+	var sweepables []sweep.Sweepable
+
 	for _, jobQueue := range out.JobQueues {
-		name := jobQueue.JobQueueName
+		name := aws.StringValue(jobQueue.JobQueueName)
 
-		log.Printf("[INFO] Disabling Batch Job Queue: %s", *name)
-		err := disableBatchJobQueue(*name, conn)
-		if err != nil {
-			log.Printf("[ERROR] Failed to disable Batch Job Queue %s: %s", *name, err)
-			continue
-		}
+		sweepables = append(sweepables, sweep.NewResource(name, func() error {
+			if err := disableBatchJobQueue(name, conn); err != nil {
+				return fmt.Errorf("error disabling: %w", err)
+			}
 
-		log.Printf("[INFO] Deleting Batch Job Queue: %s", *name)
-		err = deleteBatchJobQueue(*name, conn)
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete Batch Job Queue %s: %s", *name, err)
-		}
+			return deleteBatchJobQueue(name, conn)
+		}).WithTags(aws.StringValueMap(jobQueue.Tags)))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSBatchJobQueue_basic(t *testing.T) {
@@ -256,7 +256,7 @@ func testAccCheckBatchJobQueueExists(n string, jq *batch.JobQueueDetail) resourc
 			return fmt.Errorf("No Batch Job Queue ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 		name := rs.Primary.Attributes["name"]
 		queue, err := getJobQueue(conn, name)
 		if err != nil {
@@ -276,7 +276,7 @@ func testAccCheckBatchJobQueueDestroy(s *terraform.State) error {
 		if rs.Type != "aws_batch_job_queue" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 		jq, err := getJobQueue(conn, rs.Primary.Attributes["name"])
 		if err == nil {
 			if jq != nil {
@@ -290,7 +290,7 @@ func testAccCheckBatchJobQueueDestroy(s *terraform.State) error {
 
 func testAccCheckBatchJobQueueDisappears(jobQueue *batch.JobQueueDetail) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 		name := aws.StringValue(jobQueue.JobQueueName)
 
 		err := disableBatchJobQueue(name, conn)
@@ -308,7 +308,7 @@ func testAccCheckBatchJobQueueDisappears(jobQueue *batch.JobQueueDetail) resourc
 // For example, Terraform may set a single Compute Environment with Order 0, but the console updates it to 1.
 func testAccCheckBatchJobQueueComputeEnvironmentOrderUpdate(jobQueue *batch.JobQueueDetail) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 		input := &batch.UpdateJobQueueInput{
 			ComputeEnvironmentOrder: jobQueue.ComputeEnvironmentOrder,
@@ -12,59 +12,56 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/budgets"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_budgets_budget", &resource.Sweeper{
-		Name: "aws_budgets_budget",
-		F:    testSweepBudgetsBudgets,
+	sweep.AddTestSweepers("aws_budgets_budget", &sweep.Sweeper{
+		Name:    "aws_budgets_budget",
+		Service: "budget",
+		List:    testSweepBudgetsBudgets,
 	})
 }
 
-func testSweepBudgetsBudgets(region string) error {
+func testSweepBudgetsBudgets(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).budgetconn
+	conn := client.(*AWSClient).budgetconn()
 	accountID := client.(*AWSClient).accountid
 	input := &budgets.DescribeBudgetsInput{
 		AccountId: aws.String(accountID),
 	}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.DescribeBudgets(input)
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Budgets sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return sweepables, nil // In case we have completed some pages
 		}
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Budgets: %w", err))
-			return sweeperErrs
+			return sweepables, fmt.Errorf("error retrieving Budgets: %w", err)
 		}
 
 		for _, budget := range output.Budgets {
 			name := aws.StringValue(budget.BudgetName)
 
-			log.Printf("[INFO] Deleting Budget: %s", name)
-			_, err := conn.DeleteBudget(&budgets.DeleteBudgetInput{
-				AccountId:  aws.String(accountID),
-				BudgetName: aws.String(name),
-			})
-			if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Budget (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteBudget(&budgets.DeleteBudgetInput{
+					AccountId:  aws.String(accountID),
+					BudgetName: aws.String(name),
+				})
+				if isAWSErr(err, budgets.ErrCodeNotFoundException, "") {
+					return nil
+				}
+
+				return err
+			}))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -73,7 +70,7 @@ func testSweepBudgetsBudgets(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSBudgetsBudget_basic(t *testing.T) {
@@ -312,7 +309,7 @@ func testAccAWSBudgetsBudgetExists(resourceName string, config budgets.Budget) r
 			return fmt.Errorf("failed decoding ID: %v", err)
 		}
 
-		client := testAccProvider.Meta().(*AWSClient).budgetconn
+		client := testAccProvider.Meta().(*AWSClient).budgetconn()
 		b, err := client.DescribeBudget(&budgets.DescribeBudgetInput{
 			BudgetName: &budgetName,
 			AccountId:  &accountID,
@@ -401,7 +398,7 @@ func testAccAWSBudgetsBudgetCheckCostTypes(config budgets.Budget, costTypes budg
 
 func testAccAWSBudgetsBudgetDestroy(s *terraform.State) error {
 	meta := testAccProvider.Meta()
-	client := meta.(*AWSClient).budgetconn
+	client := meta.(*AWSClient).budgetconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_budgets_budget" {
 			continue
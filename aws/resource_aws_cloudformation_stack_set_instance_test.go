@@ -12,40 +12,40 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudformation_stack_set_instance", &resource.Sweeper{
-		Name: "aws_cloudformation_stack_set_instance",
-		F:    testSweepCloudformationStackSetInstances,
+	sweep.AddTestSweepers("aws_cloudformation_stack_set_instance", &sweep.Sweeper{
+		Name:    "aws_cloudformation_stack_set_instance",
+		Service: "cf",
+		List:    testSweepCloudformationStackSetInstances,
 	})
 }
 
-func testSweepCloudformationStackSetInstances(region string) error {
+func testSweepCloudformationStackSetInstances(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
+	conn := client.(*AWSClient).cfconn()
 
-	conn := client.(*AWSClient).cfconn
 	stackSets, err := listCloudFormationStackSets(conn)
-
 	if testSweepSkipSweepError(err) || isAWSErr(err, "ValidationError", "AWS CloudFormation StackSets is not supported") {
 		log.Printf("[WARN] Skipping CloudFormation StackSet Instance sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
-
 	if err != nil {
-		return fmt.Errorf("error listing CloudFormation StackSets: %w", err)
+		return nil, fmt.Errorf("error listing CloudFormation StackSets: %w", err)
 	}
 
+	var sweepables []sweep.Sweepable
 	var sweeperErrs *multierror.Error
 
 	for _, stackSet := range stackSets {
 		stackSetName := aws.StringValue(stackSet.StackSetName)
-		instances, err := listCloudFormationStackSetInstances(conn, stackSetName)
 
+		instances, err := listCloudFormationStackSetInstances(conn, stackSetName)
 		if err != nil {
 			sweeperErr := fmt.Errorf("error listing CloudFormation StackSet (%s) Instances: %w", stackSetName, err)
 			log.Printf("[ERROR] %s", sweeperErr)
@@ -57,7 +57,6 @@ func testSweepCloudformationStackSetInstances(region string) error {
 			accountID := aws.StringValue(instance.Account)
 			region := aws.StringValue(instance.Region)
 			id := fmt.Sprintf("%s / %s / %s", stackSetName, accountID, region)
-
 			input := &cloudformation.DeleteStackInstancesInput{
 				Accounts:     aws.StringSlice([]string{accountID}),
 				OperationId:  aws.String(resource.UniqueId()),
@@ -66,35 +65,28 @@ func testSweepCloudformationStackSetInstances(region string) error {
 				StackSetName: aws.String(stackSetName),
 			}
 
-			log.Printf("[INFO] Deleting CloudFormation StackSet Instance: %s", id)
-			output, err := conn.DeleteStackInstances(input)
-
-			if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") {
-				continue
-			}
-
-			if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
-				continue
-			}
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CloudFormation StackSet Instance (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-
-			if err := waiter.StackSetOperationSucceeded(conn, stackSetName, aws.StringValue(output.OperationId), waiter.StackSetInstanceDeletedDefaultTimeout); err != nil {
-				sweeperErr := fmt.Errorf("error waiting for CloudFormation StackSet Instance (%s) deletion: %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				output, err := conn.DeleteStackInstances(input)
+				if isAWSErr(err, cloudformation.ErrCodeStackInstanceNotFoundException, "") {
+					return nil
+				}
+				if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+
+				if err := waiter.StackSetOperationSucceeded(conn, stackSetName, aws.StringValue(output.OperationId), waiter.StackSetInstanceDeletedDefaultTimeout); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}).WithName(stackSetName))
 		}
-
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSCloudFormationStackSetInstance_basic(t *testing.T) {
@@ -239,9 +231,9 @@ func TestAccAWSCloudFormationStackSetInstance_ParameterOverrides(t *testing.T) {
 
 // TestAccAWSCloudFrontDistribution_RetainStack verifies retain_stack = true
 // This acceptance test performs the following steps:
-//  * Trigger a Terraform destroy of the resource, which should only remove the instance from the StackSet
-//  * Check it still exists outside Terraform
-//  * Destroy for real outside Terraform
+//   - Trigger a Terraform destroy of the resource, which should only remove the instance from the StackSet
+//   - Check it still exists outside Terraform
+//   - Destroy for real outside Terraform
 func TestAccAWSCloudFormationStackSetInstance_RetainStack(t *testing.T) {
 	var stack1 cloudformation.Stack
 	var stackInstance1, stackInstance2, stackInstance3 cloudformation.StackInstance
@@ -304,7 +296,7 @@ func testAccCheckCloudFormationStackSetInstanceExists(resourceName string, stack
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(rs.Primary.ID)
 
@@ -336,7 +328,7 @@ func testAccCheckCloudFormationStackSetInstanceExists(resourceName string, stack
 
 func testAccCheckCloudFormationStackSetInstanceStackExists(stackInstance *cloudformation.StackInstance, stack *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		input := &cloudformation.DescribeStacksInput{
 			StackName: stackInstance.StackId,
@@ -359,7 +351,7 @@ func testAccCheckCloudFormationStackSetInstanceStackExists(stackInstance *cloudf
 }
 
 func testAccCheckAWSCloudFormationStackSetInstanceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cfconn
+	conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudformation_stack_set_instance" {
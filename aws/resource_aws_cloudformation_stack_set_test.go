@@ -8,65 +8,57 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudformation_stack_set", &resource.Sweeper{
-		Name: "aws_cloudformation_stack_set",
+	sweep.AddTestSweepers("aws_cloudformation_stack_set", &sweep.Sweeper{
+		Name:    "aws_cloudformation_stack_set",
+		Service: "cf",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
 		},
-		F: testSweepCloudformationStackSets,
+		List: testSweepCloudformationStackSets,
 	})
 }
 
-func testSweepCloudformationStackSets(region string) error {
+func testSweepCloudformationStackSets(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
+	conn := client.(*AWSClient).cfconn()
 
-	conn := client.(*AWSClient).cfconn
 	stackSets, err := listCloudFormationStackSets(conn)
-
 	if testSweepSkipSweepError(err) || isAWSErr(err, "ValidationError", "AWS CloudFormation StackSets is not supported") {
 		log.Printf("[WARN] Skipping CloudFormation StackSet sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
-
 	if err != nil {
-		return fmt.Errorf("error listing CloudFormation StackSets: %w", err)
+		return nil, fmt.Errorf("error listing CloudFormation StackSets: %w", err)
 	}
 
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for _, stackSet := range stackSets {
 		input := &cloudformation.DeleteStackSetInput{
 			StackSetName: stackSet.StackSetName,
 		}
-		name := aws.StringValue(stackSet.StackSetName)
-
-		log.Printf("[INFO] Deleting CloudFormation StackSet: %s", name)
-		_, err := conn.DeleteStackSet(input)
 
-		if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
-			continue
-		}
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(stackSet.StackSetName), func() error {
+			_, err := conn.DeleteStackSet(input)
+			if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+				return nil
+			}
 
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting CloudFormation StackSet (%s): %w", name, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+			return err
+		}))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSCloudFormationStackSet_basic(t *testing.T) {
@@ -586,7 +578,7 @@ func testAccCheckCloudFormationStackSetExists(resourceName string, stackSet *clo
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		input := &cloudformation.DescribeStackSetInput{
 			StackSetName: aws.String(rs.Primary.ID),
@@ -609,7 +601,7 @@ func testAccCheckCloudFormationStackSetExists(resourceName string, stackSet *clo
 }
 
 func testAccCheckAWSCloudFormationStackSetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cfconn
+	conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudformation_stack_set" {
@@ -659,7 +651,7 @@ func testAccCheckCloudFormationStackSetRecreated(i, j *cloudformation.StackSet)
 }
 
 func testAccPreCheckAWSCloudFormationStackSet(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).cfconn
+	conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 	input := &cloudformation.ListStackSetsInput{}
 	_, err := conn.ListStackSets(input)
@@ -8,30 +8,29 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudformation_stack", &resource.Sweeper{
-		Name: "aws_cloudformation_stack",
+	sweep.AddTestSweepers("aws_cloudformation_stack", &sweep.Sweeper{
+		Name:    "aws_cloudformation_stack",
+		Service: "cf",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
 		},
-		F: testSweepCloudformationStacks,
+		List: testSweepCloudformationStacks,
 	})
 }
 
-func testSweepCloudformationStacks(region string) error {
+func testSweepCloudformationStacks(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*AWSClient).cfconn
+	conn := client.(*AWSClient).cfconn()
 	input := &cloudformation.ListStacksInput{
 		StackStatusFilter: aws.StringSlice([]string{
 			cloudformation.StackStatusCreateComplete,
@@ -40,39 +39,32 @@ func testSweepCloudformationStacks(region string) error {
 			cloudformation.StackStatusUpdateComplete,
 		}),
 	}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListStacksPages(input, func(page *cloudformation.ListStacksOutput, lastPage bool) bool {
 		for _, stack := range page.StackSummaries {
 			input := &cloudformation.DeleteStackInput{
 				StackName: stack.StackName,
 			}
-			name := aws.StringValue(stack.StackName)
 
-			log.Printf("[INFO] Deleting CloudFormation Stack: %s", name)
-			_, err := conn.DeleteStack(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(stack.StackName), func() error {
+				_, err := conn.DeleteStack(input)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CloudFormation Stack (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return err
+			}))
 		}
 
 		return !lastPage
 	})
-
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping CloudFormation Stack sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
-
 	if err != nil {
-		return fmt.Errorf("error listing CloudFormation Stacks: %s", err)
+		return nil, fmt.Errorf("error listing CloudFormation Stacks: %s", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSCloudFormationStack_basic(t *testing.T) {
@@ -472,7 +464,7 @@ func testAccCheckCloudFormationStackExists(n string, stack *cloudformation.Stack
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 		params := &cloudformation.DescribeStacksInput{
 			StackName: aws.String(rs.Primary.ID),
 		}
@@ -491,7 +483,7 @@ func testAccCheckCloudFormationStackExists(n string, stack *cloudformation.Stack
 }
 
 func testAccCheckAWSCloudFormationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cfconn
+	conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudformation_stack" {
@@ -530,7 +522,7 @@ func testAccCheckCloudFormationStackNotRecreated(i, j *cloudformation.Stack) res
 
 func testAccCheckCloudFormationStackDisappears(stack *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		input := &cloudformation.DeleteStackInput{
 			StackName: stack.StackName,
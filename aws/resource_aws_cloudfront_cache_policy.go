@@ -155,7 +155,7 @@ func resourceAwsCloudFrontCachePolicy() *schema.Resource {
 }
 
 func resourceAwsCloudFrontCachePolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	request := &cloudfront.CreateCachePolicyInput{
 		CachePolicyConfig: expandCloudFrontCachePolicyConfig(d),
@@ -173,7 +173,7 @@ func resourceAwsCloudFrontCachePolicyCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCloudFrontCachePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 	request := &cloudfront.GetCachePolicyInput{
 		Id: aws.String(d.Id()),
 	}
@@ -197,7 +197,7 @@ func resourceAwsCloudFrontCachePolicyRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCloudFrontCachePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	request := &cloudfront.UpdateCachePolicyInput{
 		CachePolicyConfig: expandCloudFrontCachePolicyConfig(d),
@@ -214,7 +214,7 @@ func resourceAwsCloudFrontCachePolicyUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCloudFrontCachePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	request := &cloudfront.DeleteCachePolicyInput{
 		Id:      aws.String(d.Id()),
@@ -13,22 +13,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
-		Name: "aws_cloudfront_distribution",
-		F:    testSweepCloudFrontDistributions,
+	sweep.AddTestSweepers("aws_cloudfront_distribution", &sweep.Sweeper{
+		Name:    "aws_cloudfront_distribution",
+		Service: "cloudfront",
+		List:    testSweepCloudFrontDistributions,
 	})
 }
 
-func testSweepCloudFrontDistributions(region string) error {
+func testSweepCloudFrontDistributions(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).cloudfrontconn
-
+	conn := client.(*AWSClient).cloudfrontconn()
 	distributionSummaries := make([]*cloudfront.DistributionSummary, 0)
 
 	input := &cloudfront.ListDistributionsInput{}
@@ -39,41 +40,39 @@ func testSweepCloudFrontDistributions(region string) error {
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping CloudFront Distribution sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error listing CloudFront Distributions: %s", err)
+		return nil, fmt.Errorf("Error listing CloudFront Distributions: %s", err)
 	}
 
-	if len(distributionSummaries) == 0 {
-		log.Print("[DEBUG] No CloudFront Distributions to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, distributionSummary := range distributionSummaries {
-		distributionID := *distributionSummary.Id
+		distributionID := aws.StringValue(distributionSummary.Id)
 
-		if *distributionSummary.Enabled {
+		if aws.BoolValue(distributionSummary.Enabled) {
 			log.Printf("[WARN] Skipping deletion of enabled CloudFront Distribution: %s", distributionID)
 			continue
 		}
 
-		output, err := conn.GetDistribution(&cloudfront.GetDistributionInput{
-			Id: aws.String(distributionID),
-		})
-		if err != nil {
-			return fmt.Errorf("Error reading CloudFront Distribution %s: %s", distributionID, err)
-		}
+		sweepables = append(sweepables, sweep.NewResource(distributionID, func() error {
+			output, err := conn.GetDistribution(&cloudfront.GetDistributionInput{
+				Id: aws.String(distributionID),
+			})
+			if err != nil {
+				return fmt.Errorf("error reading: %w", err)
+			}
 
-		_, err = conn.DeleteDistribution(&cloudfront.DeleteDistributionInput{
-			Id:      aws.String(distributionID),
-			IfMatch: output.ETag,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting CloudFront Distribution %s: %s", distributionID, err)
-		}
+			_, err = conn.DeleteDistribution(&cloudfront.DeleteDistributionInput{
+				Id:      aws.String(distributionID),
+				IfMatch: output.ETag,
+			})
+
+			return err
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSCloudFrontDistribution_disappears(t *testing.T) {
@@ -780,9 +779,9 @@ func TestAccAWSCloudFrontDistribution_Enabled(t *testing.T) {
 
 // TestAccAWSCloudFrontDistribution_RetainOnDelete verifies retain_on_delete = true
 // This acceptance test performs the following steps:
-//  * Trigger a Terraform destroy of the resource, which should only disable the distribution
-//  * Check it still exists and is disabled outside Terraform
-//  * Destroy for real outside Terraform
+//   - Trigger a Terraform destroy of the resource, which should only disable the distribution
+//   - Check it still exists and is disabled outside Terraform
+//   - Destroy for real outside Terraform
 func TestAccAWSCloudFrontDistribution_RetainOnDelete(t *testing.T) {
 	var distribution cloudfront.Distribution
 	resourceName := "aws_cloudfront_distribution.test"
@@ -1005,7 +1004,7 @@ func TestAccAWSCloudFrontDistribution_WaitForDeployment(t *testing.T) {
 }
 
 func testAccCheckCloudFrontDistributionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudfront_distribution" {
@@ -1050,7 +1049,7 @@ func testAccCheckCloudFrontDistributionExists(resourceName string, distribution
 			return fmt.Errorf("Resource ID not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 		input := &cloudfront.GetDistributionInput{
 			Id: aws.String(rs.Primary.ID),
@@ -1070,7 +1069,7 @@ func testAccCheckCloudFrontDistributionExists(resourceName string, distribution
 
 func testAccCheckCloudFrontDistributionExistsAPIOnly(distribution *cloudfront.Distribution) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 		input := &cloudfront.GetDistributionInput{
 			Id: distribution.Id,
@@ -1134,7 +1133,7 @@ func testAccCheckCloudFrontDistributionDisabled(distribution *cloudfront.Distrib
 // This requires the CloudFront Distribution to previously be disabled and fetches latest ETag automatically.
 func testAccCheckCloudFrontDistributionDisappears(distribution *cloudfront.Distribution) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 		getDistributionInput := &cloudfront.GetDistributionInput{
 			Id: distribution.Id,
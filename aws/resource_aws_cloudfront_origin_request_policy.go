@@ -123,7 +123,7 @@ func resourceAwsCloudFrontOriginRequestPolicy() *schema.Resource {
 }
 
 func resourceAwsCloudFrontOriginRequestPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	request := &cloudfront.CreateOriginRequestPolicyInput{
 		OriginRequestPolicyConfig: expandCloudFrontOriginRequestPolicyConfig(d),
@@ -141,7 +141,7 @@ func resourceAwsCloudFrontOriginRequestPolicyCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsCloudFrontOriginRequestPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 	request := &cloudfront.GetOriginRequestPolicyInput{
 		Id: aws.String(d.Id()),
 	}
@@ -169,7 +169,7 @@ func resourceAwsCloudFrontOriginRequestPolicyRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsCloudFrontOriginRequestPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	request := &cloudfront.UpdateOriginRequestPolicyInput{
 		OriginRequestPolicyConfig: expandCloudFrontOriginRequestPolicyConfig(d),
@@ -186,7 +186,7 @@ func resourceAwsCloudFrontOriginRequestPolicyUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsCloudFrontOriginRequestPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	request := &cloudfront.DeleteOriginRequestPolicyInput{
 		Id:      aws.String(d.Id()),
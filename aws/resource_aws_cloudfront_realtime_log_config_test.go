@@ -8,56 +8,47 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudfront_realtime_log_config", &resource.Sweeper{
-		Name: "aws_cloudfront_realtime_log_config",
-		F:    testSweepCloudFrontRealtimeLogConfigs,
+	sweep.AddTestSweepers("aws_cloudfront_realtime_log_config", &sweep.Sweeper{
+		Name:    "aws_cloudfront_realtime_log_config",
+		Service: "cloudfront",
+		List:    testSweepCloudFrontRealtimeLogConfigs,
 	})
 }
 
-func testSweepCloudFrontRealtimeLogConfigs(region string) error {
+func testSweepCloudFrontRealtimeLogConfigs(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).cloudfrontconn
+	conn := client.(*AWSClient).cloudfrontconn()
 	input := &cloudfront.ListRealtimeLogConfigsInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.ListRealtimeLogConfigs(input)
-
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping CloudFront Real-time Log Configs sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return sweepables, nil // In case we have completed some pages
 		}
-
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving CloudFront Real-time Log Configs: %w", err))
-			return sweeperErrs
+			return sweepables, fmt.Errorf("error retrieving CloudFront Real-time Log Configs: %w", err)
 		}
 
 		for _, config := range output.RealtimeLogConfigs.Items {
-			id := aws.StringValue(config.ARN)
-
-			log.Printf("[INFO] Deleting CloudFront Real-time Log Config: %s", id)
 			r := resourceAwsCloudFrontRealtimeLogConfig()
 			d := r.Data(nil)
-			d.SetId(id)
-			err := r.Delete(d, client)
-
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			d.SetId(aws.StringValue(config.ARN))
+			d.Set("name", config.Name)
+
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		if aws.StringValue(output.RealtimeLogConfigs.NextMarker) == "" {
@@ -66,7 +57,7 @@ func testSweepCloudFrontRealtimeLogConfigs(region string) error {
 		input.Marker = output.RealtimeLogConfigs.NextMarker
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSCloudFrontRealtimeLogConfig_basic(t *testing.T) {
@@ -192,7 +183,7 @@ func TestAccAWSCloudFrontRealtimeLogConfig_updates(t *testing.T) {
 }
 
 func testAccCheckCloudFrontRealtimeLogConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudfront_realtime_log_config" {
@@ -225,7 +216,7 @@ func testAccCheckCloudFrontRealtimeLogConfigExists(n string, v *cloudfront.Realt
 			return fmt.Errorf("No CloudFront Real-time Log Config ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn()
 		out, err := finder.RealtimeLogConfigByARN(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -10,77 +10,77 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudhsm_v2_cluster", &resource.Sweeper{
-		Name: "aws_cloudhsm_v2_cluster",
-		F:    testSweepCloudhsmv2Clusters,
+	sweep.AddTestSweepers("aws_cloudhsm_v2_cluster", &sweep.Sweeper{
+		Name:    "aws_cloudhsm_v2_cluster",
+		Service: "cloudhsmv2",
+		List:    testSweepCloudhsmv2Clusters,
 	})
 }
 
-func testSweepCloudhsmv2Clusters(region string) error {
+func testSweepCloudhsmv2Clusters(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).cloudhsmv2conn
-
+	conn := client.(*AWSClient).cloudhsmv2conn()
 	input := &cloudhsmv2.DescribeClustersInput{}
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeClustersPages(input, func(page *cloudhsmv2.DescribeClustersOutput, lastPage bool) bool {
 		for _, cluster := range page.Clusters {
+			cluster := cluster
 			clusterID := aws.StringValue(cluster.ClusterId)
 			input := &cloudhsmv2.DeleteClusterInput{
 				ClusterId: cluster.ClusterId,
 			}
 
-			for _, hsm := range cluster.Hsms {
-				hsmID := aws.StringValue(hsm.HsmId)
-				input := &cloudhsmv2.DeleteHsmInput{
-					ClusterId: cluster.ClusterId,
-					HsmId:     hsm.HsmId,
+			sweepables = append(sweepables, sweep.NewResource(clusterID, func() error {
+				for _, hsm := range cluster.Hsms {
+					hsmID := aws.StringValue(hsm.HsmId)
+					input := &cloudhsmv2.DeleteHsmInput{
+						ClusterId: cluster.ClusterId,
+						HsmId:     hsm.HsmId,
+					}
+
+					log.Printf("[INFO] Deleting CloudHSMv2 Cluster (%s) HSM: %s", clusterID, hsmID)
+					_, err := conn.DeleteHsm(input)
+					if err != nil {
+						return fmt.Errorf("error deleting HSM (%s): %w", hsmID, err)
+					}
+
+					if err := waitForCloudhsmv2HsmDeletion(conn, hsmID, 120*time.Minute); err != nil {
+						return fmt.Errorf("error waiting for HSM (%s) deletion: %w", hsmID, err)
+					}
 				}
 
-				log.Printf("[INFO] Deleting CloudHSMv2 Cluster (%s) HSM: %s", clusterID, hsmID)
-				_, err := conn.DeleteHsm(input)
-
+				_, err := conn.DeleteCluster(input)
 				if err != nil {
-					log.Printf("[ERROR] Error deleting CloudHSMv2 Cluster (%s) HSM (%s): %s", clusterID, hsmID, err)
-					continue
+					return err
 				}
 
-				if err := waitForCloudhsmv2HsmDeletion(conn, hsmID, 120*time.Minute); err != nil {
-					log.Printf("[ERROR] Error waiting for CloudHSMv2 Cluster (%s) HSM (%s) deletion: %s", clusterID, hsmID, err)
+				if err := waitForCloudhsmv2ClusterDeletion(conn, clusterID, 120*time.Minute); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
 				}
-			}
-
-			log.Printf("[INFO] Deleting CloudHSMv2 Cluster: %s", clusterID)
-			_, err := conn.DeleteCluster(input)
-
-			if err != nil {
-				log.Printf("[ERROR] Error deleting CloudHSMv2 Cluster (%s): %s", clusterID, err)
-				continue
-			}
 
-			if err := waitForCloudhsmv2ClusterDeletion(conn, clusterID, 120*time.Minute); err != nil {
-				log.Printf("[ERROR] Error waiting for CloudHSMv2 Cluster (%s) deletion: %s", clusterID, err)
-			}
+				return nil
+			}))
 		}
 
 		return !lastPage
 	})
-
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping CloudHSMv2 Cluster sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
-
 	if err != nil {
-		return fmt.Errorf("error describing CloudHSMv2 Clusters: %s", err)
+		return nil, fmt.Errorf("error describing CloudHSMv2 Clusters: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSCloudHsmV2Cluster_basic(t *testing.T) {
@@ -235,7 +235,7 @@ func testAccCheckAWSCloudHsmV2ClusterDestroy(s *terraform.State) error {
 		if rs.Type != "aws_cloudhsm_v2_cluster" {
 			continue
 		}
-		cluster, err := describeCloudHsmV2Cluster(testAccProvider.Meta().(*AWSClient).cloudhsmv2conn, rs.Primary.ID)
+		cluster, err := describeCloudHsmV2Cluster(testAccProvider.Meta().(*AWSClient).cloudhsmv2conn(), rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -251,7 +251,7 @@ func testAccCheckAWSCloudHsmV2ClusterDestroy(s *terraform.State) error {
 
 func testAccCheckAWSCloudHsmV2ClusterExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn
+		conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn()
 		it, ok := s.RootModule().Resources[name]
 		if !ok {
 			return fmt.Errorf("Not found: %s", name)
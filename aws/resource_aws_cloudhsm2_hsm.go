@@ -115,7 +115,7 @@ func resourceAwsCloudHsmV2HsmRefreshFunc(conn *cloudhsmv2.CloudHSMV2, id string)
 }
 
 func resourceAwsCloudHsmV2HsmCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudhsmv2conn
+	conn := meta.(*AWSClient).cloudhsmv2conn()
 
 	clusterId := d.Get("cluster_id").(string)
 
@@ -181,7 +181,7 @@ func resourceAwsCloudHsmV2HsmCreate(d *schema.ResourceData, meta interface{}) er
 
 func resourceAwsCloudHsmV2HsmRead(d *schema.ResourceData, meta interface{}) error {
 
-	hsm, err := describeHsm(meta.(*AWSClient).cloudhsmv2conn, d.Id())
+	hsm, err := describeHsm(meta.(*AWSClient).cloudhsmv2conn(), d.Id())
 
 	if hsm == nil {
 		log.Printf("[WARN] CloudHSMv2 HSM (%s) not found", d.Id())
@@ -203,7 +203,7 @@ func resourceAwsCloudHsmV2HsmRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsCloudHsmV2HsmDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudhsmv2conn
+	conn := meta.(*AWSClient).cloudhsmv2conn()
 	clusterId := d.Get("cluster_id").(string)
 
 	log.Printf("[DEBUG] CloudHSMv2 HSM delete %s %s", clusterId, d.Id())
@@ -10,37 +10,33 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatch/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_composite_alarm", &resource.Sweeper{
-		Name: "aws_cloudwatch_composite_alarm",
-		F:    testSweepCloudWatchCompositeAlarms,
+	sweep.AddTestSweepers("aws_cloudwatch_composite_alarm", &sweep.Sweeper{
+		Name:    "aws_cloudwatch_composite_alarm",
+		Service: "cloudwatch",
+		List:    testSweepCloudWatchCompositeAlarms,
 	})
 }
 
-func testSweepCloudWatchCompositeAlarms(region string) error {
+func testSweepCloudWatchCompositeAlarms(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-
-	conn := client.(*AWSClient).cloudwatchconn
-	ctx := context.Background()
-
+	conn := client.(*AWSClient).cloudwatchconn()
 	input := &cloudwatch.DescribeAlarmsInput{
 		AlarmTypes: aws.StringSlice([]string{cloudwatch.AlarmTypeCompositeAlarm}),
 	}
+	var sweepables []sweep.Sweepable
 
-	var sweeperErrs *multierror.Error
-
-	err = conn.DescribeAlarmsPagesWithContext(ctx, input, func(page *cloudwatch.DescribeAlarmsOutput, isLast bool) bool {
+	err = conn.DescribeAlarmsPages(input, func(page *cloudwatch.DescribeAlarmsOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
 		}
@@ -50,37 +46,24 @@ func testSweepCloudWatchCompositeAlarms(region string) error {
 				continue
 			}
 
-			name := aws.StringValue(compositeAlarm.AlarmName)
-
-			log.Printf("[INFO] Deleting CloudWatch Composite Alarm: %s", name)
-
 			r := resourceAwsCloudWatchCompositeAlarm()
 			d := r.Data(nil)
-			d.SetId(name)
-
-			diags := r.DeleteContext(ctx, d, client)
+			d.SetId(aws.StringValue(compositeAlarm.AlarmName))
 
-			for i := range diags {
-				if diags[i].Severity == diag.Error {
-					log.Printf("[ERROR] %s", diags[i].Summary)
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf(diags[i].Summary))
-					continue
-				}
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !isLast
 	})
-
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping CloudWatch Composite Alarms sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving CloudWatch Composite Alarms: %w", err))
+		return sweepables, fmt.Errorf("error retrieving CloudWatch Composite Alarms: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsCloudWatchCompositeAlarm_basic(t *testing.T) {
@@ -436,7 +419,7 @@ func TestAccAwsCloudWatchCompositeAlarm_updateAlarmRule(t *testing.T) {
 }
 
 func testAccCheckAwsCloudWatchCompositeAlarmDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_composite_alarm" {
@@ -471,7 +454,7 @@ func testAccCheckAwsCloudWatchCompositeAlarmExists(resourceName string) resource
 			return fmt.Errorf("resource %s has not set its id", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn()
 
 		alarm, err := finder.CompositeAlarmByName(context.Background(), conn, rs.Primary.ID)
 
@@ -10,40 +10,37 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
-		Name: "aws_cloudwatch_event_archive",
-		F:    testSweepCloudWatchEventArchives,
+	sweep.AddTestSweepers("aws_cloudwatch_event_archive", &sweep.Sweeper{
+		Name:    "aws_cloudwatch_event_archive",
+		Service: "cloudwatchevents",
+		List:    testSweepCloudWatchEventArchives,
 		Dependencies: []string{
 			"aws_cloudwatch_event_bus",
 		},
 	})
 }
 
-func testSweepCloudWatchEventArchives(region string) error {
+func testSweepCloudWatchEventArchives(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %w", err)
+		return nil, fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).cloudwatcheventsconn
-
+	conn := client.(*AWSClient).cloudwatcheventsconn()
 	input := &events.ListArchivesInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.ListArchives(input)
 		if err != nil {
 			if testSweepSkipSweepError(err) {
 				log.Printf("[WARN] Skipping CloudWatch Events archive sweep for %s: %s", region, err)
-				return nil
+				return nil, nil
 			}
-			return fmt.Errorf("Error retrieving CloudWatch Events archive: %w", err)
-		}
-
-		if len(output.Archives) == 0 {
-			log.Print("[DEBUG] No CloudWatch Events archives to sweep")
-			return nil
+			return nil, fmt.Errorf("Error retrieving CloudWatch Events archive: %w", err)
 		}
 
 		for _, archive := range output.Archives {
@@ -52,13 +49,13 @@ func testSweepCloudWatchEventArchives(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting CloudWatch Events archive (%s)", name)
-			_, err := conn.DeleteArchive(&events.DeleteArchiveInput{
-				ArchiveName: aws.String(name),
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting CloudWatch Events archive (%s): %w", name, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteArchive(&events.DeleteArchiveInput{
+					ArchiveName: aws.String(name),
+				})
+
+				return err
+			}))
 		}
 
 		if output.NextToken == nil {
@@ -67,7 +64,7 @@ func testSweepCloudWatchEventArchives(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSCloudWatchArchive_basic(t *testing.T) {
@@ -152,7 +149,7 @@ func TestAccAWSCloudWatchEventArchive_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSCloudWatchArchiveDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_archive" {
@@ -180,7 +177,7 @@ func testAccCheckCloudWatchArchiveExists(n string, v *events.DescribeArchiveOutp
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 		params := events.DescribeArchiveInput{
 			ArchiveName: aws.String(rs.Primary.ID),
 		}
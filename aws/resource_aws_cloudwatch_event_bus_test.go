@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,12 +12,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
-		Name: "aws_cloudwatch_event_bus",
-		F:    testSweepCloudWatchEventBuses,
+	sweep.AddTestSweepers("aws_cloudwatch_event_bus", &sweep.Sweeper{
+		Name:    "aws_cloudwatch_event_bus",
+		Service: "cloudwatchevents",
+		List:    testSweepCloudWatchEventBuses,
 		Dependencies: []string{
 			"aws_cloudwatch_event_rule",
 			"aws_cloudwatch_event_target",
@@ -24,52 +28,40 @@ func init() {
 	})
 }
 
-func testSweepCloudWatchEventBuses(region string) error {
+func testSweepCloudWatchEventBuses(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %w", err)
+		return nil, fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).cloudwatcheventsconn
-
+	conn := client.(*AWSClient).cloudwatcheventsconn()
 	input := &events.ListEventBusesInput{}
+	var sweepables []sweep.Sweepable
 
-	for {
-		output, err := conn.ListEventBuses(input)
-		if err != nil {
-			if testSweepSkipSweepError(err) {
-				log.Printf("[WARN] Skipping CloudWatch Events event bus sweep for %s: %s", region, err)
-				return nil
-			}
-			return fmt.Errorf("Error retrieving CloudWatch Events event bus: %w", err)
-		}
-
-		if len(output.EventBuses) == 0 {
-			log.Print("[DEBUG] No CloudWatch Events event buses to sweep")
-			return nil
+	err = lister.ListEventBusesItems(context.Background(), conn, input, func(eventBus *events.EventBus) bool {
+		name := aws.StringValue(eventBus.Name)
+		if name == "default" {
+			return true
 		}
 
-		for _, eventBus := range output.EventBuses {
-			name := aws.StringValue(eventBus.Name)
-			if name == "default" {
-				continue
-			}
-
-			log.Printf("[INFO] Deleting CloudWatch Events event bus (%s)", name)
+		sweepables = append(sweepables, sweep.NewResource(name, func() error {
 			_, err := conn.DeleteEventBus(&events.DeleteEventBusInput{
 				Name: aws.String(name),
 			})
-			if err != nil {
-				return fmt.Errorf("Error deleting CloudWatch Events event bus (%s): %w", name, err)
-			}
-		}
 
-		if output.NextToken == nil {
-			break
-		}
-		input.NextToken = output.NextToken
+			return err
+		}))
+
+		return true
+	})
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping CloudWatch Events event bus sweep for %s: %s", region, err)
+		return sweepables, nil // In case we have completed some pages
+	}
+	if err != nil {
+		return sweepables, fmt.Errorf("Error retrieving CloudWatch Events event bus: %w", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSCloudWatchEventBus_basic(t *testing.T) {
@@ -214,7 +206,7 @@ func TestAccAWSCloudWatchEventBus_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSCloudWatchEventBusDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_bus" {
@@ -242,7 +234,7 @@ func testAccCheckCloudWatchEventBusExists(n string, v *events.DescribeEventBusOu
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 		params := events.DescribeEventBusInput{
 			Name: aws.String(rs.Primary.ID),
 		}
@@ -14,57 +14,61 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	tfevents "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
-		Name: "aws_cloudwatch_event_permission",
-		F:    testSweepCloudWatchEventPermissions,
+	sweep.AddTestSweepers("aws_cloudwatch_event_permission", &sweep.Sweeper{
+		Name:    "aws_cloudwatch_event_permission",
+		Service: "cloudwatchevents",
+		List:    testSweepCloudWatchEventPermissions,
 	})
 }
 
-func testSweepCloudWatchEventPermissions(region string) error {
+func testSweepCloudWatchEventPermissions(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %w", err)
+		return nil, fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).cloudwatcheventsconn
+	conn := client.(*AWSClient).cloudwatcheventsconn()
 
 	output, err := conn.DescribeEventBus(&events.DescribeEventBusInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping CloudWatch Event Permission sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving CloudWatch Event Permissions: %w", err)
+		return nil, fmt.Errorf("Error retrieving CloudWatch Event Permissions: %w", err)
 	}
 
 	policy := aws.StringValue(output.Policy)
 
 	if policy == "" {
 		log.Print("[DEBUG] No CloudWatch Event Permissions to sweep")
-		return nil
+		return nil, nil
 	}
 
 	var policyDoc CloudWatchEventPermissionPolicyDoc
 	err = json.Unmarshal([]byte(policy), &policyDoc)
 	if err != nil {
-		return fmt.Errorf("Parsing CloudWatch Event Permissions policy %q failed: %w", policy, err)
+		return nil, fmt.Errorf("Parsing CloudWatch Event Permissions policy %q failed: %w", policy, err)
 	}
 
+	var sweepables []sweep.Sweepable
+
 	for _, statement := range policyDoc.Statements {
 		sid := statement.Sid
 
-		log.Printf("[INFO] Deleting CloudWatch Event Permission %s", sid)
-		_, err := conn.RemovePermission(&events.RemovePermissionInput{
-			StatementId: aws.String(sid),
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting CloudWatch Event Permission %s: %w", sid, err)
-		}
+		sweepables = append(sweepables, sweep.NewResource(sid, func() error {
+			_, err := conn.RemovePermission(&events.RemovePermissionInput{
+				StatementId: aws.String(sid),
+			})
+
+			return err
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSCloudWatchEventPermission_basic(t *testing.T) {
@@ -309,7 +313,7 @@ func TestAccAWSCloudWatchEventPermission_Disappears(t *testing.T) {
 
 func testAccCheckCloudWatchEventPermissionExists(pr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 		rs, ok := s.RootModule().Resources[pr]
 		if !ok {
 			return fmt.Errorf("Not found: %s", pr)
@@ -347,7 +351,7 @@ func testAccCheckCloudWatchEventPermissionExists(pr string) resource.TestCheckFu
 }
 
 func testAccCheckCloudWatchEventPermissionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_permission" {
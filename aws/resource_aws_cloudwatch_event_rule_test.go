@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,72 +9,58 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
-		Name: "aws_cloudwatch_event_rule",
-		F:    testSweepCloudWatchEventRules,
+	sweep.AddTestSweepers("aws_cloudwatch_event_rule", &sweep.Sweeper{
+		Name:    "aws_cloudwatch_event_rule",
+		Service: "cloudwatchevents",
+		List:    testSweepCloudWatchEventRules,
 		Dependencies: []string{
 			"aws_cloudwatch_event_target",
 		},
 	})
 }
 
-func testSweepCloudWatchEventRules(region string) error {
+func testSweepCloudWatchEventRules(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %w", err)
+		return nil, fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).cloudwatcheventsconn
-
-	var sweeperErrs *multierror.Error
-	var count int
+	conn := client.(*AWSClient).cloudwatcheventsconn()
+	var sweepables []sweep.Sweepable
 
 	rulesInput := &events.ListRulesInput{}
+	err = lister.ListRulesItems(context.Background(), conn, rulesInput, func(rule *events.Rule) bool {
+		name := aws.StringValue(rule.Name)
 
-	err = lister.ListRulesPages(conn, rulesInput, func(rulesPage *events.ListRulesOutput, lastRulesPage bool) bool {
-		if rulesPage == nil {
-			return !lastRulesPage
-		}
-
-		for _, rule := range rulesPage.Rules {
-			count++
-			name := aws.StringValue(rule.Name)
-
-			log.Printf("[INFO] Deleting CloudWatch Events rule (%s)", name)
+		sweepables = append(sweepables, sweep.NewResource(name, func() error {
 			_, err := conn.DeleteRule(&events.DeleteRuleInput{
 				Name:  aws.String(name),
 				Force: aws.Bool(true), // Required for AWS-managed rules, ignored otherwise
 			})
-			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting CloudWatch Events rule (%s): %w", name, err))
-				continue
-			}
-		}
 
-		return !lastRulesPage
-	})
+			return err
+		}))
 
+		return true
+	})
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping CloudWatch Events rule sweeper for %q: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
-
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing CloudWatch Events rules: %w", err))
+		return sweepables, fmt.Errorf("error listing CloudWatch Events rules: %w", err)
 	}
 
-	log.Printf("[INFO] Deleted %d CloudWatch Events rules", count)
-
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSCloudWatchEventRule_basic(t *testing.T) {
@@ -476,7 +463,7 @@ func testAccCheckCloudWatchEventRuleExists(n string, rule *events.DescribeRuleOu
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 
 		resp, err := finder.RuleByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -499,7 +486,7 @@ func testAccCheckCloudWatchEventRuleEnabled(n string, desired string) resource.T
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 
 		resp, err := finder.RuleByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -514,7 +501,7 @@ func testAccCheckCloudWatchEventRuleEnabled(n string, desired string) resource.T
 }
 
 func testAccCheckAWSCloudWatchEventRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_rule" {
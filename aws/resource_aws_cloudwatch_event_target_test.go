@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -17,92 +18,69 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
-		Name: "aws_cloudwatch_event_target",
-		F:    testSweepCloudWatchEventTargets,
+	sweep.AddTestSweepers("aws_cloudwatch_event_target", &sweep.Sweeper{
+		Name:    "aws_cloudwatch_event_target",
+		Service: "cloudwatchevents",
+		List:    testSweepCloudWatchEventTargets,
 	})
 }
 
-func testSweepCloudWatchEventTargets(region string) error {
+func testSweepCloudWatchEventTargets(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %w", err)
+		return nil, fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).cloudwatcheventsconn
-
+	conn := client.(*AWSClient).cloudwatcheventsconn()
+	var sweepables []sweep.Sweepable
 	var sweeperErrs *multierror.Error
-	var rulesCount, targetsCount int
 
 	rulesInput := &events.ListRulesInput{}
-
-	err = lister.ListRulesPages(conn, rulesInput, func(rulesPage *events.ListRulesOutput, lastRulesPage bool) bool {
-		if rulesPage == nil {
-			return !lastRulesPage
+	err = lister.ListRulesItems(context.Background(), conn, rulesInput, func(rule *events.Rule) bool {
+		ruleName := aws.StringValue(rule.Name)
+		targetsInput := &events.ListTargetsByRuleInput{
+			Rule:  rule.Name,
+			Limit: aws.Int64(100), // Set limit to allowed maximum to prevent API throttling
 		}
 
-		for _, rule := range rulesPage.Rules {
-			rulesCount++
-			ruleName := aws.StringValue(rule.Name)
-
-			log.Printf("[INFO] Deleting CloudWatch Events targets for rule (%s)", ruleName)
-			targetsInput := &events.ListTargetsByRuleInput{
+		err := lister.ListTargetsByRuleItems(context.Background(), conn, targetsInput, func(target *events.Target) bool {
+			removeTargetsInput := &events.RemoveTargetsInput{
+				Ids:   []*string{target.Id},
 				Rule:  rule.Name,
-				Limit: aws.Int64(100), // Set limit to allowed maximum to prevent API throttling
+				Force: aws.Bool(true), // Required for AWS-managed rules, ignored otherwise
 			}
+			targetID := aws.StringValue(target.Id)
 
-			err := lister.ListTargetsByRulePages(conn, targetsInput, func(targetsPage *events.ListTargetsByRuleOutput, lastTargetsPage bool) bool {
-				if targetsPage == nil {
-					return !lastTargetsPage
-				}
-
-				for _, target := range targetsPage.Targets {
-					targetsCount++
-					removeTargetsInput := &events.RemoveTargetsInput{
-						Ids:   []*string{target.Id},
-						Rule:  rule.Name,
-						Force: aws.Bool(true), // Required for AWS-managed rules, ignored otherwise
-					}
-					targetID := aws.StringValue(target.Id)
-
-					log.Printf("[INFO] Deleting CloudWatch Events target (%s/%s)", ruleName, targetID)
-					_, err := conn.RemoveTargets(removeTargetsInput)
-
-					if err != nil {
-						sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error deleting CloudWatch Events target (%s/%s): %w", ruleName, targetID, err))
-						continue
-					}
-				}
-
-				return !lastTargetsPage
-			})
-
-			if testSweepSkipSweepError(err) {
-				log.Printf("[WARN] Skipping CloudWatch Events target sweeper for %q: %s", region, err)
-				return false
-			}
-			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing CloudWatch Events targets for rule (%s): %w", ruleName, err))
-			}
+			sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s/%s", ruleName, targetID), func() error {
+				_, err := conn.RemoveTargets(removeTargetsInput)
+
+				return err
+			}).WithName(ruleName))
+
+			return true
+		})
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Events target sweeper for %q: %s", region, err)
+			return false
+		}
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing CloudWatch Events targets for rule (%s): %w", ruleName, err))
 		}
 
-		return !lastRulesPage
+		return true
 	})
-
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping CloudWatch Events rule target sweeper for %q: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
-
 	if err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing CloudWatch Events rules: %w", err))
 	}
 
-	log.Printf("[INFO] Deleted %d CloudWatch Events targets across %d CloudWatch Events rules", targetsCount, rulesCount)
-
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSCloudWatchEventTarget_basic(t *testing.T) {
@@ -622,7 +600,7 @@ func testAccCheckCloudWatchEventTargetExists(n string, rule *events.Target) reso
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 		t, err := finder.Target(conn, rs.Primary.Attributes["event_bus_name"], rs.Primary.Attributes["rule"], rs.Primary.Attributes["target_id"])
 		if err != nil {
 			return fmt.Errorf("Event Target not found: %w", err)
@@ -635,7 +613,7 @@ func testAccCheckCloudWatchEventTargetExists(n string, rule *events.Target) reso
 }
 
 func testAccCheckAWSCloudWatchEventTargetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_target" {
@@ -8,16 +8,17 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
-		Name: "aws_cloudwatch_log_group",
-		F:    testSweepCloudwatchLogGroups,
+	sweep.AddTestSweepers("aws_cloudwatch_log_group", &sweep.Sweeper{
+		Name:    "aws_cloudwatch_log_group",
+		Service: "cloudwatchlogs",
+		List:    testSweepCloudwatchLogGroups,
 		Dependencies: []string{
 			"aws_api_gateway_rest_api",
 			"aws_cloudhsm_v2_cluster",
@@ -43,16 +44,15 @@ func init() {
 	})
 }
 
-func testSweepCloudwatchLogGroups(region string) error {
+func testSweepCloudwatchLogGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).cloudwatchlogsconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).cloudwatchlogsconn()
+	var sweepables []sweep.Sweepable
 
 	input := &cloudwatchlogs.DescribeLogGroupsInput{}
-
 	err = conn.DescribeLogGroupsPages(input, func(page *cloudwatchlogs.DescribeLogGroupsOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -66,32 +66,25 @@ func testSweepCloudwatchLogGroups(region string) error {
 			input := &cloudwatchlogs.DeleteLogGroupInput{
 				LogGroupName: logGroup.LogGroupName,
 			}
-			name := aws.StringValue(logGroup.LogGroupName)
 
-			log.Printf("[INFO] Deleting CloudWatch Log Group: %s", name)
-			_, err := conn.DeleteLogGroup(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(logGroup.LogGroupName), func() error {
+				_, err := conn.DeleteLogGroup(input)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CloudWatch Log Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return err
+			}))
 		}
 
 		return !isLast
 	})
-
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping CloudWatch Log Groups sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
-
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving CloudWatch Log Groups: %w", err))
+		return sweepables, fmt.Errorf("error retrieving CloudWatch Log Groups: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSCloudWatchLogGroup_basic(t *testing.T) {
@@ -395,7 +388,7 @@ func TestAccAWSCloudWatchLogGroup_kmsKey(t *testing.T) {
 
 func testAccCheckCloudWatchLogGroupDisappears(lg *cloudwatchlogs.LogGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn()
 		opts := &cloudwatchlogs.DeleteLogGroupInput{
 			LogGroupName: lg.LogGroupName,
 		}
@@ -411,7 +404,7 @@ func testAccCheckCloudWatchLogGroupExists(n string, lg *cloudwatchlogs.LogGroup)
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn()
 		logGroup, err := lookupCloudWatchLogGroup(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -427,7 +420,7 @@ func testAccCheckCloudWatchLogGroupExists(n string, lg *cloudwatchlogs.LogGroup)
 }
 
 func testAccCheckAWSCloudWatchLogGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_log_group" {
@@ -10,56 +10,55 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
-		Name: "aws_cloudwatch_log_resource_policy",
-		F:    testSweepCloudWatchLogResourcePolicies,
+	sweep.AddTestSweepers("aws_cloudwatch_log_resource_policy", &sweep.Sweeper{
+		Name:    "aws_cloudwatch_log_resource_policy",
+		Service: "cloudwatchlogs",
+		List:    testSweepCloudWatchLogResourcePolicies,
 	})
 }
 
-func testSweepCloudWatchLogResourcePolicies(region string) error {
+func testSweepCloudWatchLogResourcePolicies(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).cloudwatchlogsconn
-
+	conn := client.(*AWSClient).cloudwatchlogsconn()
 	input := &cloudwatchlogs.DescribeResourcePoliciesInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.DescribeResourcePolicies(input)
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping CloudWatchLog Resource Policy sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-
 		if err != nil {
-			return fmt.Errorf("error describing CloudWatchLog Resource Policy: %s", err)
+			return nil, fmt.Errorf("error describing CloudWatchLog Resource Policy: %s", err)
 		}
 
 		for _, resourcePolicy := range output.ResourcePolicies {
-			policyName := aws.StringValue(resourcePolicy.PolicyName)
 			deleteInput := &cloudwatchlogs.DeleteResourcePolicyInput{
 				PolicyName: resourcePolicy.PolicyName,
 			}
 
-			log.Printf("[INFO] Deleting CloudWatch Log Resource Policy: %s", policyName)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(resourcePolicy.PolicyName), func() error {
+				_, err := conn.DeleteResourcePolicy(deleteInput)
 
-			if _, err := conn.DeleteResourcePolicy(deleteInput); err != nil {
-				return fmt.Errorf("error deleting CloudWatch log resource policy (%s): %s", policyName, err)
-			}
+				return err
+			}))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
 			break
 		}
-
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSCloudWatchLogResourcePolicy_basic(t *testing.T) {
@@ -99,7 +98,7 @@ func TestAccAWSCloudWatchLogResourcePolicy_basic(t *testing.T) {
 
 func testAccCheckCloudWatchLogResourcePolicy(pr string, resourcePolicy *cloudwatchlogs.ResourcePolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn()
 		rs, ok := s.RootModule().Resources[pr]
 		if !ok {
 			return fmt.Errorf("Not found: %s", pr)
@@ -124,7 +123,7 @@ func testAccCheckCloudWatchLogResourcePolicy(pr string, resourcePolicy *cloudwat
 }
 
 func testAccCheckCloudWatchLogResourcePolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_log_resource_policy" {
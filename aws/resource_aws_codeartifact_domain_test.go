@@ -8,27 +8,28 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codeartifact"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codeartifact_domain", &resource.Sweeper{
-		Name: "aws_codeartifact_domain",
-		F:    testSweepCodeArtifactDomains,
+	sweep.AddTestSweepers("aws_codeartifact_domain", &sweep.Sweeper{
+		Name:    "aws_codeartifact_domain",
+		Service: "codeartifact",
+		List:    testSweepCodeArtifactDomains,
 	})
 }
 
-func testSweepCodeArtifactDomains(region string) error {
+func testSweepCodeArtifactDomains(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).codeartifactconn
+	conn := client.(*AWSClient).codeartifactconn()
 	input := &codeartifact.ListDomainsInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListDomainsPages(input, func(page *codeartifact.ListDomainsOutput, lastPage bool) bool {
 		for _, domainPtr := range page.Domains {
@@ -36,35 +37,28 @@ func testSweepCodeArtifactDomains(region string) error {
 				continue
 			}
 
-			domain := aws.StringValue(domainPtr.Name)
 			input := &codeartifact.DeleteDomainInput{
 				Domain: domainPtr.Name,
 			}
 
-			log.Printf("[INFO] Deleting CodeArtifact Domain: %s", domain)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(domainPtr.Name), func() error {
+				_, err := conn.DeleteDomain(input)
 
-			_, err := conn.DeleteDomain(input)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CodeArtifact Domain (%s): %w", domain, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+				return err
+			}))
 		}
 
 		return !lastPage
 	})
-
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping CodeArtifact Domain sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
-
 	if err != nil {
-		return fmt.Errorf("error listing CodeArtifact Domains: %w", err)
+		return nil, fmt.Errorf("error listing CodeArtifact Domains: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSCodeArtifactDomain_basic(t *testing.T) {
@@ -204,7 +198,7 @@ func testAccCheckAWSCodeArtifactDomainExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("no CodeArtifact domain set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codeartifactconn
+		conn := testAccProvider.Meta().(*AWSClient).codeartifactconn()
 
 		domainOwner, domainName, err := decodeCodeArtifactDomainID(rs.Primary.ID)
 		if err != nil {
@@ -226,7 +220,7 @@ func testAccCheckAWSCodeArtifactDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codeartifactconn
+		conn := testAccProvider.Meta().(*AWSClient).codeartifactconn()
 
 		domainOwner, domainName, err := decodeCodeArtifactDomainID(rs.Primary.ID)
 		if err != nil {
@@ -7,27 +7,28 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codeartifact"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codeartifact_repository", &resource.Sweeper{
-		Name: "aws_codeartifact_repository",
-		F:    testSweepCodeArtifactRepositories,
+	sweep.AddTestSweepers("aws_codeartifact_repository", &sweep.Sweeper{
+		Name:    "aws_codeartifact_repository",
+		Service: "codeartifact",
+		List:    testSweepCodeArtifactRepositories,
 	})
 }
 
-func testSweepCodeArtifactRepositories(region string) error {
+func testSweepCodeArtifactRepositories(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).codeartifactconn
+	conn := client.(*AWSClient).codeartifactconn()
 	input := &codeartifact.ListRepositoriesInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListRepositoriesPages(input, func(page *codeartifact.ListRepositoriesOutput, lastPage bool) bool {
 		for _, repositoryPtr := range page.Repositories {
@@ -42,30 +43,24 @@ func testSweepCodeArtifactRepositories(region string) error {
 				DomainOwner: repositoryPtr.DomainOwner,
 			}
 
-			log.Printf("[INFO] Deleting CodeArtifact Repository: %s", repository)
+			sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s/%s", aws.StringValue(repositoryPtr.DomainName), repository), func() error {
+				_, err := conn.DeleteRepository(input)
 
-			_, err := conn.DeleteRepository(input)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CodeArtifact Repository (%s): %w", repository, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+				return err
+			}).WithName(repository))
 		}
 
 		return !lastPage
 	})
-
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping CodeArtifact Repository sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
-
 	if err != nil {
-		return fmt.Errorf("error listing CodeArtifact Repositories: %w", err)
+		return nil, fmt.Errorf("error listing CodeArtifact Repositories: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSCodeArtifactRepository_basic(t *testing.T) {
@@ -327,7 +322,7 @@ func testAccCheckAWSCodeArtifactRepositoryExists(n string) resource.TestCheckFun
 			return fmt.Errorf("no CodeArtifact repository set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codeartifactconn
+		conn := testAccProvider.Meta().(*AWSClient).codeartifactconn()
 		owner, domain, repo, err := decodeCodeArtifactRepositoryID(rs.Primary.ID)
 		if err != nil {
 			return err
@@ -352,7 +347,7 @@ func testAccCheckAWSCodeArtifactRepositoryDestroy(s *terraform.State) error {
 		if err != nil {
 			return err
 		}
-		conn := testAccProvider.Meta().(*AWSClient).codeartifactconn
+		conn := testAccProvider.Meta().(*AWSClient).codeartifactconn()
 		resp, err := conn.DescribeRepository(&codeartifact.DescribeRepositoryInput{
 			Repository:  aws.String(repo),
 			Domain:      aws.String(domain),
@@ -7,30 +7,29 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/codebuild/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codebuild_report_group", &resource.Sweeper{
-		Name: "aws_codebuild_report_group",
-		F:    testSweepCodeBuildReportGroups,
+	sweep.AddTestSweepers("aws_codebuild_report_group", &sweep.Sweeper{
+		Name:    "aws_codebuild_report_group",
+		Service: "codebuild",
+		List:    testSweepCodeBuildReportGroups,
 	})
 }
 
-func testSweepCodeBuildReportGroups(region string) error {
+func testSweepCodeBuildReportGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-
-	conn := client.(*AWSClient).codebuildconn
+	conn := client.(*AWSClient).codebuildconn()
 	input := &codebuild.ListReportGroupsInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListReportGroupsPages(input, func(page *codebuild.ListReportGroupsOutput, isLast bool) bool {
 		if page == nil {
@@ -38,34 +37,25 @@ func testSweepCodeBuildReportGroups(region string) error {
 		}
 
 		for _, arn := range page.ReportGroups {
-			id := aws.StringValue(arn)
 			r := resourceAwsCodeBuildReportGroup()
 			d := r.Data(nil)
-			d.SetId(id)
+			d.SetId(aws.StringValue(arn))
 			d.Set("delete_reports", true)
 
-			err := r.Delete(d, client)
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CodeBuild Report Group (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !isLast
 	})
-
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping CodeBuild Report Group sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+		return sweepables, nil
 	}
-
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving CodeBuild ReportGroups: %w", err))
+		return sweepables, fmt.Errorf("error retrieving CodeBuild ReportGroups: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSCodeBuildReportGroup_basic(t *testing.T) {
@@ -243,7 +233,7 @@ func TestAccAWSCodeBuildReportGroup_disappears(t *testing.T) {
 }
 
 func testAccPreCheckAWSCodeBuildReportGroup(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+	conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 	input := &codebuild.ListReportGroupsInput{}
 
@@ -259,7 +249,7 @@ func testAccPreCheckAWSCodeBuildReportGroup(t *testing.T) {
 }
 
 func testAccCheckAWSCodeBuildReportGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+	conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codebuild_report_group" {
@@ -286,7 +276,7 @@ func testAccCheckAWSCodeBuildReportGroupExists(name string, reportGroup *codebui
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+		conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 		resp, err := finder.ReportGroupByArn(conn, rs.Primary.ID)
 		if err != nil {
@@ -88,7 +88,7 @@ func TestAccAWSCodeBuildSourceCredential_BasicAuth(t *testing.T) {
 }
 
 func testAccCheckAWSCodeBuildSourceCredentialDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+	conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codebuild_source_credential" {
@@ -120,7 +120,7 @@ func testAccCheckAWSCodeBuildSourceCredentialExists(name string, sourceCredentia
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+		conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 		resp, err := conn.ListSourceCredentials(&codebuild.ListSourceCredentialsInput{})
 		if err != nil {
@@ -111,7 +111,7 @@ func testAccCheckAWSCognitoResourceServerExists(n string, resourceServer *cognit
 			return errors.New("No Cognito Resource Server ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 		userPoolID, identifier, err := decodeCognitoResourceServerID(rs.Primary.ID)
 		if err != nil {
@@ -138,7 +138,7 @@ func testAccCheckAWSCognitoResourceServerExists(n string, resourceServer *cognit
 }
 
 func testAccCheckAWSCognitoResourceServerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_resource_server" {
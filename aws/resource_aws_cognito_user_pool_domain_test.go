@@ -9,68 +9,69 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cognito_user_pool_domain", &resource.Sweeper{
-		Name: "aws_cognito_user_pool_domain",
-		F:    testSweepCognitoUserPoolDomains,
+	sweep.AddTestSweepers("aws_cognito_user_pool_domain", &sweep.Sweeper{
+		Name:    "aws_cognito_user_pool_domain",
+		Service: "cognitoidp",
+		List:    testSweepCognitoUserPoolDomains,
 	})
 }
 
-func testSweepCognitoUserPoolDomains(region string) error {
+func testSweepCognitoUserPoolDomains(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %s", err)
+		return nil, fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).cognitoidpconn
+	conn := client.(*AWSClient).cognitoidpconn()
+	var sweepables []sweep.Sweepable
+	var sweeperErrs *multierror.Error
 
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(int64(50)),
 	}
 
 	err = conn.ListUserPoolsPages(input, func(resp *cognitoidentityprovider.ListUserPoolsOutput, isLast bool) bool {
-		if len(resp.UserPools) == 0 {
-			log.Print("[DEBUG] No Cognito user pools (i.e. domains) to sweep")
-			return false
-		}
-
 		for _, u := range resp.UserPools {
 			output, err := conn.DescribeUserPool(&cognitoidentityprovider.DescribeUserPoolInput{
 				UserPoolId: u.Id,
 			})
 			if err != nil {
-				log.Printf("[ERROR] Failed describing Cognito user pool (%s): %s", aws.StringValue(u.Name), err)
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing Cognito user pool (%s): %w", aws.StringValue(u.Name), err))
 				continue
 			}
 			if output.UserPool != nil && output.UserPool.Domain != nil {
-				domain := aws.StringValue(output.UserPool.Domain)
-
-				log.Printf("[INFO] Deleting Cognito user pool domain: %s", domain)
-				_, err := conn.DeleteUserPoolDomain(&cognitoidentityprovider.DeleteUserPoolDomainInput{
+				input := &cognitoidentityprovider.DeleteUserPoolDomainInput{
 					Domain:     output.UserPool.Domain,
 					UserPoolId: u.Id,
-				})
-				if err != nil {
-					log.Printf("[ERROR] Failed deleting Cognito user pool domain (%s): %s", domain, err)
 				}
+
+				sweepables = append(sweepables, sweep.NewResource(aws.StringValue(output.UserPool.Domain), func() error {
+					_, err := conn.DeleteUserPoolDomain(input)
+
+					return err
+				}))
 			}
 		}
 		return !isLast
 	})
 
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Cognito User Pool Domain sweep for %s: %s", region, err)
+		return sweepables, sweeperErrs.ErrorOrNil()
+	}
+
 	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping Cognito User Pool Domain sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Cognito User Pools: %s", err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error retrieving Cognito User Pools: %s", err))
 	}
 
-	return nil
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSCognitoUserPoolDomain_basic(t *testing.T) {
@@ -173,7 +174,7 @@ func testAccCheckAWSCognitoUserPoolDomainExists(n string) resource.TestCheckFunc
 			return errors.New("No Cognito User Pool Domain ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 		_, err := conn.DescribeUserPoolDomain(&cognitoidentityprovider.DescribeUserPoolDomainInput{
 			Domain: aws.String(rs.Primary.ID),
@@ -184,7 +185,7 @@ func testAccCheckAWSCognitoUserPoolDomainExists(n string) resource.TestCheckFunc
 }
 
 func testAccCheckAWSCognitoUserPoolDomainDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_user_pool_domain" {
@@ -13,58 +13,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cognito_user_pool", &resource.Sweeper{
-		Name: "aws_cognito_user_pool",
-		F:    testSweepCognitoUserPools,
+	sweep.AddTestSweepers("aws_cognito_user_pool", &sweep.Sweeper{
+		Name:    "aws_cognito_user_pool",
+		Service: "cognitoidp",
+		List:    testSweepCognitoUserPools,
 		Dependencies: []string{
 			"aws_cognito_user_pool_domain",
 		},
 	})
 }
 
-func testSweepCognitoUserPools(region string) error {
+func testSweepCognitoUserPools(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).cognitoidpconn
+	conn := client.(*AWSClient).cognitoidpconn()
+	var sweepables []sweep.Sweepable
 
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(int64(50)),
 	}
 
 	err = conn.ListUserPoolsPages(input, func(resp *cognitoidentityprovider.ListUserPoolsOutput, isLast bool) bool {
-		if len(resp.UserPools) == 0 {
-			log.Print("[DEBUG] No Cognito User Pools to sweep")
-			return false
-		}
-
 		for _, userPool := range resp.UserPools {
-			name := aws.StringValue(userPool.Name)
-
-			log.Printf("[INFO] Deleting Cognito User Pool: %s", name)
-			_, err := conn.DeleteUserPool(&cognitoidentityprovider.DeleteUserPoolInput{
+			input := &cognitoidentityprovider.DeleteUserPoolInput{
 				UserPoolId: userPool.Id,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed deleting Cognito User Pool (%s): %s", name, err)
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(userPool.Id), func() error {
+				_, err := conn.DeleteUserPool(input)
+
+				return err
+			}).WithName(aws.StringValue(userPool.Name)))
 		}
 		return !isLast
 	})
 
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Cognito User Pool sweep for %s: %s", region, err)
+		return sweepables, nil
+	}
+
 	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping Cognito User Pool sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Cognito User Pools: %w", err)
+		return sweepables, fmt.Errorf("Error retrieving Cognito User Pools: %w", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSCognitoUserPool_basic(t *testing.T) {
@@ -1220,7 +1219,7 @@ func TestAccAWSCognitoUserPool_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSCognitoUserPoolDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_user_pool" {
@@ -1255,7 +1254,7 @@ func testAccCheckAWSCognitoUserPoolExists(name string) resource.TestCheckFunc {
 			return errors.New("No Cognito User Pool ID set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 		params := &cognitoidentityprovider.DescribeUserPoolInput{
 			UserPoolId: aws.String(rs.Primary.ID),
@@ -1268,7 +1267,7 @@ func testAccCheckAWSCognitoUserPoolExists(name string) resource.TestCheckFunc {
 }
 
 func testAccPreCheckAWSCognitoIdentityProvider(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(int64(1)),
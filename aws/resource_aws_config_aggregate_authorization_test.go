@@ -11,50 +11,49 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
-		Name: "aws_config_aggregate_authorization",
-		F:    testSweepConfigAggregateAuthorizations,
+	sweep.AddTestSweepers("aws_config_aggregate_authorization", &sweep.Sweeper{
+		Name:    "aws_config_aggregate_authorization",
+		Service: "config",
+		List:    testSweepConfigAggregateAuthorizations,
 	})
 }
 
-func testSweepConfigAggregateAuthorizations(region string) error {
+func testSweepConfigAggregateAuthorizations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %s", err)
+		return nil, fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).configconn
+	conn := client.(*AWSClient).configconn()
 
 	aggregateAuthorizations, err := describeConfigAggregateAuthorizations(conn)
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Config Aggregate Authorizations sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving config aggregate authorizations: %s", err)
+		return nil, fmt.Errorf("Error retrieving config aggregate authorizations: %s", err)
 	}
 
-	if len(aggregateAuthorizations) == 0 {
-		log.Print("[DEBUG] No config aggregate authorizations to sweep")
-		return nil
-	}
-
-	log.Printf("[INFO] Found %d config aggregate authorizations", len(aggregateAuthorizations))
+	var sweepables []sweep.Sweepable
 
 	for _, auth := range aggregateAuthorizations {
-		log.Printf("[INFO] Deleting config authorization %s", *auth.AggregationAuthorizationArn)
-		_, err := conn.DeleteAggregationAuthorization(&configservice.DeleteAggregationAuthorizationInput{
+		input := &configservice.DeleteAggregationAuthorizationInput{
 			AuthorizedAccountId: auth.AuthorizedAccountId,
 			AuthorizedAwsRegion: auth.AuthorizedAwsRegion,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting config aggregate authorization %s: %s", *auth.AggregationAuthorizationArn, err)
 		}
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(auth.AggregationAuthorizationArn), func() error {
+			_, err := conn.DeleteAggregationAuthorization(input)
+
+			return err
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSConfigAggregateAuthorization_basic(t *testing.T) {
@@ -127,7 +126,7 @@ func TestAccAWSConfigAggregateAuthorization_tags(t *testing.T) {
 }
 
 func testAccCheckAWSConfigAggregateAuthorizationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).configconn
+	conn := testAccProvider.Meta().(*AWSClient).configconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_aggregate_authorization" {
@@ -10,50 +10,48 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
-		Name: "aws_config_configuration_aggregator",
-		F:    testSweepConfigConfigurationAggregators,
+	sweep.AddTestSweepers("aws_config_configuration_aggregator", &sweep.Sweeper{
+		Name:    "aws_config_configuration_aggregator",
+		Service: "config",
+		List:    testSweepConfigConfigurationAggregators,
 	})
 }
 
-func testSweepConfigConfigurationAggregators(region string) error {
+func testSweepConfigConfigurationAggregators(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %s", err)
+		return nil, fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).configconn
+	conn := client.(*AWSClient).configconn()
 
 	resp, err := conn.DescribeConfigurationAggregators(&configservice.DescribeConfigurationAggregatorsInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Config Configuration Aggregators sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving config configuration aggregators: %s", err)
+		return nil, fmt.Errorf("Error retrieving config configuration aggregators: %s", err)
 	}
 
-	if len(resp.ConfigurationAggregators) == 0 {
-		log.Print("[DEBUG] No config configuration aggregators to sweep")
-		return nil
-	}
-
-	log.Printf("[INFO] Found %d config configuration aggregators", len(resp.ConfigurationAggregators))
+	var sweepables []sweep.Sweepable
 
 	for _, agg := range resp.ConfigurationAggregators {
-		log.Printf("[INFO] Deleting config configuration aggregator %s", *agg.ConfigurationAggregatorName)
-		_, err := conn.DeleteConfigurationAggregator(&configservice.DeleteConfigurationAggregatorInput{
+		input := &configservice.DeleteConfigurationAggregatorInput{
 			ConfigurationAggregatorName: agg.ConfigurationAggregatorName,
-		})
-
-		if err != nil {
-			return fmt.Errorf("Error deleting config configuration aggregator %s: %s", *agg.ConfigurationAggregatorName, err)
 		}
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(agg.ConfigurationAggregatorName), func() error {
+			_, err := conn.DeleteConfigurationAggregator(input)
+
+			return err
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSConfigConfigurationAggregator_account(t *testing.T) {
@@ -218,7 +216,7 @@ func testAccCheckAWSConfigConfigurationAggregatorExists(n string, obj *configser
 			return fmt.Errorf("No config configuration aggregator ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).configconn
+		conn := testAccProvider.Meta().(*AWSClient).configconn()
 		out, err := conn.DescribeConfigurationAggregators(&configservice.DescribeConfigurationAggregatorsInput{
 			ConfigurationAggregatorNames: []*string{aws.String(rs.Primary.Attributes["name"])},
 		})
@@ -237,7 +235,7 @@ func testAccCheckAWSConfigConfigurationAggregatorExists(n string, obj *configser
 }
 
 func testAccCheckAWSConfigConfigurationAggregatorDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).configconn
+	conn := testAccProvider.Meta().(*AWSClient).configconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_configuration_aggregator" {
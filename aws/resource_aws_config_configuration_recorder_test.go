@@ -10,56 +10,56 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
-		Name: "aws_config_configuration_recorder",
-		F:    testSweepConfigConfigurationRecorder,
+	sweep.AddTestSweepers("aws_config_configuration_recorder", &sweep.Sweeper{
+		Name:    "aws_config_configuration_recorder",
+		Service: "config",
+		List:    testSweepConfigConfigurationRecorder,
 	})
 }
 
-func testSweepConfigConfigurationRecorder(region string) error {
+func testSweepConfigConfigurationRecorder(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).configconn
+	conn := client.(*AWSClient).configconn()
 
 	req := &configservice.DescribeConfigurationRecordersInput{}
 	resp, err := conn.DescribeConfigurationRecorders(req)
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Config Configuration Recorders sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error describing Configuration Recorders: %s", err)
+		return nil, fmt.Errorf("Error describing Configuration Recorders: %s", err)
 	}
 
-	if len(resp.ConfigurationRecorders) == 0 {
-		log.Print("[DEBUG] No AWS Config Configuration Recorder to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, cr := range resp.ConfigurationRecorders {
-		_, err := conn.StopConfigurationRecorder(&configservice.StopConfigurationRecorderInput{
-			ConfigurationRecorderName: cr.Name,
-		})
-		if err != nil {
-			return err
-		}
+		name := cr.Name
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(name), func() error {
+			_, err := conn.StopConfigurationRecorder(&configservice.StopConfigurationRecorderInput{
+				ConfigurationRecorderName: name,
+			})
+			if err != nil {
+				return err
+			}
 
-		_, err = conn.DeleteConfigurationRecorder(&configservice.DeleteConfigurationRecorderInput{
-			ConfigurationRecorderName: cr.Name,
-		})
-		if err != nil {
-			return fmt.Errorf(
-				"Error deleting Configuration Recorder (%s): %s",
-				*cr.Name, err)
-		}
+			_, err = conn.DeleteConfigurationRecorder(&configservice.DeleteConfigurationRecorderInput{
+				ConfigurationRecorderName: name,
+			})
+
+			return err
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func testAccConfigConfigurationRecorder_basic(t *testing.T) {
@@ -167,7 +167,7 @@ func testAccCheckConfigConfigurationRecorderExists(n string, obj *configservice.
 			return fmt.Errorf("No configuration recorder ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).configconn
+		conn := testAccProvider.Meta().(*AWSClient).configconn()
 		out, err := conn.DescribeConfigurationRecorders(&configservice.DescribeConfigurationRecordersInput{
 			ConfigurationRecorderNames: []*string{aws.String(rs.Primary.Attributes["name"])},
 		})
@@ -186,7 +186,7 @@ func testAccCheckConfigConfigurationRecorderExists(n string, obj *configservice.
 }
 
 func testAccCheckConfigConfigurationRecorderDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).configconn
+	conn := testAccProvider.Meta().(*AWSClient).configconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_configuration_recorder_status" {
@@ -11,24 +11,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
-		Name: "aws_config_delivery_channel",
+	sweep.AddTestSweepers("aws_config_delivery_channel", &sweep.Sweeper{
+		Name:    "aws_config_delivery_channel",
+		Service: "config",
 		Dependencies: []string{
 			"aws_config_configuration_recorder",
 		},
-		F: testSweepConfigDeliveryChannels,
+		List: testSweepConfigDeliveryChannels,
 	})
 }
 
-func testSweepConfigDeliveryChannels(region string) error {
+func testSweepConfigDeliveryChannels(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).configconn
+	conn := client.(*AWSClient).configconn()
 
 	req := &configservice.DescribeDeliveryChannelsInput{}
 	var resp *configservice.DescribeDeliveryChannelsOutput
@@ -47,28 +49,26 @@ func testSweepConfigDeliveryChannels(region string) error {
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Config Delivery Channels sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error describing Delivery Channels: %s", err)
+		return nil, fmt.Errorf("Error describing Delivery Channels: %s", err)
 	}
 
-	if len(resp.DeliveryChannels) == 0 {
-		log.Print("[DEBUG] No AWS Config Delivery Channel to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, dc := range resp.DeliveryChannels {
-		_, err := conn.DeleteDeliveryChannel(&configservice.DeleteDeliveryChannelInput{
+		input := &configservice.DeleteDeliveryChannelInput{
 			DeliveryChannelName: dc.Name,
-		})
-		if err != nil {
-			return fmt.Errorf(
-				"Error deleting Delivery Channel (%s): %s",
-				*dc.Name, err)
 		}
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(dc.Name), func() error {
+			_, err := conn.DeleteDeliveryChannel(input)
+
+			return err
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func testAccConfigDeliveryChannel_basic(t *testing.T) {
@@ -169,7 +169,7 @@ func testAccCheckConfigDeliveryChannelExists(n string, obj *configservice.Delive
 			return fmt.Errorf("No delivery channel ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).configconn
+		conn := testAccProvider.Meta().(*AWSClient).configconn()
 		out, err := conn.DescribeDeliveryChannels(&configservice.DescribeDeliveryChannelsInput{
 			DeliveryChannelNames: []*string{aws.String(rs.Primary.Attributes["name"])},
 		})
@@ -188,7 +188,7 @@ func testAccCheckConfigDeliveryChannelExists(n string, obj *configservice.Delive
 }
 
 func testAccCheckConfigDeliveryChannelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).configconn
+	conn := testAccProvider.Meta().(*AWSClient).configconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_delivery_channel" {
@@ -133,7 +133,7 @@ func flattenRemediationConfigurationParameters(parameters map[string]*configserv
 }
 
 func resourceAwsConfigRemediationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	name := d.Get("config_rule_name").(string)
 	remediationConfigurationInput := configservice.RemediationConfiguration{
@@ -177,7 +177,7 @@ func resourceAwsConfigRemediationConfigurationPut(d *schema.ResourceData, meta i
 }
 
 func resourceAwsConfigRemediationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 	out, err := conn.DescribeRemediationConfigurations(&configservice.DescribeRemediationConfigurationsInput{
 		ConfigRuleNames: []*string{aws.String(d.Id())},
 	})
@@ -213,7 +213,7 @@ func resourceAwsConfigRemediationConfigurationRead(d *schema.ResourceData, meta
 }
 
 func resourceAwsConfigRemediationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	name := d.Get("config_rule_name").(string)
 
@@ -12,57 +12,52 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
-		Name: "aws_datasync_agent",
-		F:    testSweepDataSyncAgents,
+	sweep.AddTestSweepers("aws_datasync_agent", &sweep.Sweeper{
+		Name:    "aws_datasync_agent",
+		Service: "datasync",
+		List:    testSweepDataSyncAgents,
 	})
 }
 
-func testSweepDataSyncAgents(region string) error {
+func testSweepDataSyncAgents(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).datasyncconn
-
+	conn := client.(*AWSClient).datasyncconn()
 	input := &datasync.ListAgentsInput{}
+	var sweepables []sweep.Sweepable
+
 	for {
 		output, err := conn.ListAgents(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping DataSync Agent sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving DataSync Agents: %s", err)
-		}
-
-		if len(output.Agents) == 0 {
-			log.Print("[DEBUG] No DataSync Agents to sweep")
-			return nil
+			return sweepables, fmt.Errorf("Error retrieving DataSync Agents: %s", err)
 		}
 
 		for _, agent := range output.Agents {
-			name := aws.StringValue(agent.Name)
-
-			log.Printf("[INFO] Deleting DataSync Agent: %s", name)
 			input := &datasync.DeleteAgentInput{
 				AgentArn: agent.AgentArn,
 			}
 
-			_, err := conn.DeleteAgent(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(agent.AgentArn), func() error {
+				_, err := conn.DeleteAgent(input)
 
-			if isAWSErr(err, "InvalidRequestException", "does not exist") {
-				continue
-			}
+				if isAWSErr(err, datasync.ErrCodeInvalidRequestException, "does not exist") {
+					return nil
+				}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Agent (%s): %s", name, err)
-			}
+				return err
+			}).WithName(aws.StringValue(agent.Name)))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -72,7 +67,7 @@ func testSweepDataSyncAgents(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDataSyncAgent_basic(t *testing.T) {
@@ -206,7 +201,7 @@ func TestAccAWSDataSyncAgent_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSDataSyncAgentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_agent" {
@@ -238,7 +233,7 @@ func testAccCheckAWSDataSyncAgentExists(resourceName string, agent *datasync.Des
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 		input := &datasync.DescribeAgentInput{
 			AgentArn: aws.String(rs.Primary.ID),
 		}
@@ -261,7 +256,7 @@ func testAccCheckAWSDataSyncAgentExists(resourceName string, agent *datasync.Des
 
 func testAccCheckAWSDataSyncAgentDisappears(agent *datasync.DescribeAgentOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 		input := &datasync.DeleteAgentInput{
 			AgentArn: agent.AgentArn,
@@ -12,60 +12,57 @@ import (
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_location_efs", &resource.Sweeper{
-		Name: "aws_datasync_location_efs",
-		F:    testSweepDataSyncLocationEfss,
+	sweep.AddTestSweepers("aws_datasync_location_efs", &sweep.Sweeper{
+		Name:    "aws_datasync_location_efs",
+		Service: "datasync",
+		List:    testSweepDataSyncLocationEfss,
 	})
 }
 
-func testSweepDataSyncLocationEfss(region string) error {
+func testSweepDataSyncLocationEfss(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).datasyncconn
-
+	conn := client.(*AWSClient).datasyncconn()
 	input := &datasync.ListLocationsInput{}
+	var sweepables []sweep.Sweepable
+
 	for {
 		output, err := conn.ListLocations(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping DataSync Location EFS sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving DataSync Location EFSs: %s", err)
-		}
-
-		if len(output.Locations) == 0 {
-			log.Print("[DEBUG] No DataSync Location EFSs to sweep")
-			return nil
+			return sweepables, fmt.Errorf("Error retrieving DataSync Location EFSs: %s", err)
 		}
 
 		for _, location := range output.Locations {
 			uri := aws.StringValue(location.LocationUri)
 			if !strings.HasPrefix(uri, "efs://") {
-				log.Printf("[INFO] Skipping DataSync Location EFS: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location EFS: %s", uri)
+
 			input := &datasync.DeleteLocationInput{
 				LocationArn: location.LocationArn,
 			}
 
-			_, err := conn.DeleteLocation(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(location.LocationArn), func() error {
+				_, err := conn.DeleteLocation(input)
 
-			if isAWSErr(err, "InvalidRequestException", "not found") {
-				continue
-			}
+				if isAWSErr(err, datasync.ErrCodeInvalidRequestException, "not found") {
+					return nil
+				}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location EFS (%s): %s", uri, err)
-			}
+				return err
+			}).WithName(uri))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -75,7 +72,7 @@ func testSweepDataSyncLocationEfss(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDataSyncLocationEfs_basic(t *testing.T) {
@@ -207,7 +204,7 @@ func TestAccAWSDataSyncLocationEfs_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSDataSyncLocationEfsDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_location_efs" {
@@ -239,7 +236,7 @@ func testAccCheckAWSDataSyncLocationEfsExists(resourceName string, locationEfs *
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 		input := &datasync.DescribeLocationEfsInput{
 			LocationArn: aws.String(rs.Primary.ID),
 		}
@@ -262,7 +259,7 @@ func testAccCheckAWSDataSyncLocationEfsExists(resourceName string, locationEfs *
 
 func testAccCheckAWSDataSyncLocationEfsDisappears(location *datasync.DescribeLocationEfsOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 		input := &datasync.DeleteLocationInput{
 			LocationArn: location.LocationArn,
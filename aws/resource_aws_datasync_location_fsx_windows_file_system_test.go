@@ -12,60 +12,57 @@ import (
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_location_fsx_windows_file_system", &resource.Sweeper{
-		Name: "aws_datasync_location_fsx_windows_file_system",
-		F:    testSweepDataSyncLocationFsxWindows,
+	sweep.AddTestSweepers("aws_datasync_location_fsx_windows_file_system", &sweep.Sweeper{
+		Name:    "aws_datasync_location_fsx_windows_file_system",
+		Service: "datasync",
+		List:    testSweepDataSyncLocationFsxWindows,
 	})
 }
 
-func testSweepDataSyncLocationFsxWindows(region string) error {
+func testSweepDataSyncLocationFsxWindows(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).datasyncconn
-
+	conn := client.(*AWSClient).datasyncconn()
 	input := &datasync.ListLocationsInput{}
+	var sweepables []sweep.Sweepable
+
 	for {
 		output, err := conn.ListLocations(input)
 
 		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping DataSync Location FSX Windows sweep for %s: %s", region, err)
-			return nil
+			log.Printf("[WARN] Skipping DataSync Location FSx Windows File System sweep for %s: %s", region, err)
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("error retrieving DataSync Location FSX Windows: %w", err)
-		}
-
-		if len(output.Locations) == 0 {
-			log.Print("[DEBUG] No DataSync Location FSX Windows File System to sweep")
-			return nil
+			return sweepables, fmt.Errorf("Error retrieving DataSync Location FSx Windows File Systems: %s", err)
 		}
 
 		for _, location := range output.Locations {
 			uri := aws.StringValue(location.LocationUri)
 			if !strings.HasPrefix(uri, "fsxw://") {
-				log.Printf("[INFO] Skipping DataSync Location FSX Windows File System: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location FSX Windows File System: %s", uri)
+
 			input := &datasync.DeleteLocationInput{
 				LocationArn: location.LocationArn,
 			}
 
-			_, err := conn.DeleteLocation(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(location.LocationArn), func() error {
+				_, err := conn.DeleteLocation(input)
 
-			if isAWSErr(err, datasync.ErrCodeInvalidRequestException, "not found") {
-				continue
-			}
+				if isAWSErr(err, datasync.ErrCodeInvalidRequestException, "not found") {
+					return nil
+				}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location FSX Windows (%s): %s", uri, err)
-			}
+				return err
+			}).WithName(uri))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -75,7 +72,7 @@ func testSweepDataSyncLocationFsxWindows(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDataSyncLocationFsxWindows_basic(t *testing.T) {
@@ -221,7 +218,7 @@ func TestAccAWSDataSyncLocationFsxWindows_tags(t *testing.T) {
 }
 
 func testAccCheckAWSDataSyncLocationFsxWindowsDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_location_fsx_windows_file_system" {
@@ -253,7 +250,7 @@ func testAccCheckAWSDataSyncLocationFsxWindowsExists(resourceName string, locati
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 		input := &datasync.DescribeLocationFsxWindowsInput{
 			LocationArn: aws.String(rs.Primary.ID),
 		}
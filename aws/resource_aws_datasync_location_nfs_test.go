@@ -13,60 +13,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_location_nfs", &resource.Sweeper{
-		Name: "aws_datasync_location_nfs",
-		F:    testSweepDataSyncLocationNfss,
+	sweep.AddTestSweepers("aws_datasync_location_nfs", &sweep.Sweeper{
+		Name:    "aws_datasync_location_nfs",
+		Service: "datasync",
+		List:    testSweepDataSyncLocationNfss,
 	})
 }
 
-func testSweepDataSyncLocationNfss(region string) error {
+func testSweepDataSyncLocationNfss(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).datasyncconn
-
+	conn := client.(*AWSClient).datasyncconn()
 	input := &datasync.ListLocationsInput{}
+	var sweepables []sweep.Sweepable
+
 	for {
 		output, err := conn.ListLocations(input)
 
 		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping DataSync Location EFS sweep for %s: %s", region, err)
-			return nil
+			log.Printf("[WARN] Skipping DataSync Location NFS sweep for %s: %s", region, err)
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving DataSync Location EFSs: %s", err)
-		}
-
-		if len(output.Locations) == 0 {
-			log.Print("[DEBUG] No DataSync Location EFSs to sweep")
-			return nil
+			return sweepables, fmt.Errorf("Error retrieving DataSync Location NFSs: %s", err)
 		}
 
 		for _, location := range output.Locations {
 			uri := aws.StringValue(location.LocationUri)
 			if !strings.HasPrefix(uri, "nfs://") {
-				log.Printf("[INFO] Skipping DataSync Location EFS: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location EFS: %s", uri)
+
 			input := &datasync.DeleteLocationInput{
 				LocationArn: location.LocationArn,
 			}
 
-			_, err := conn.DeleteLocation(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(location.LocationArn), func() error {
+				_, err := conn.DeleteLocation(input)
 
-			if isAWSErr(err, "InvalidRequestException", "not found") {
-				continue
-			}
+				if isAWSErr(err, datasync.ErrCodeInvalidRequestException, "not found") {
+					return nil
+				}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location EFS (%s): %s", uri, err)
-			}
+				return err
+			}).WithName(uri))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -76,7 +73,7 @@ func testSweepDataSyncLocationNfss(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDataSyncLocationNfs_basic(t *testing.T) {
@@ -237,7 +234,7 @@ func TestAccAWSDataSyncLocationNfs_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSDataSyncLocationNfsDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_location_nfs" {
@@ -269,7 +266,7 @@ func testAccCheckAWSDataSyncLocationNfsExists(resourceName string, locationNfs *
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 		input := &datasync.DescribeLocationNfsInput{
 			LocationArn: aws.String(rs.Primary.ID),
 		}
@@ -292,7 +289,7 @@ func testAccCheckAWSDataSyncLocationNfsExists(resourceName string, locationNfs *
 
 func testAccCheckAWSDataSyncLocationNfsDisappears(location *datasync.DescribeLocationNfsOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 		input := &datasync.DeleteLocationInput{
 			LocationArn: location.LocationArn,
@@ -13,60 +13,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_location_s3", &resource.Sweeper{
-		Name: "aws_datasync_location_s3",
-		F:    testSweepDataSyncLocationS3s,
+	sweep.AddTestSweepers("aws_datasync_location_s3", &sweep.Sweeper{
+		Name:    "aws_datasync_location_s3",
+		Service: "datasync",
+		List:    testSweepDataSyncLocationS3s,
 	})
 }
 
-func testSweepDataSyncLocationS3s(region string) error {
+func testSweepDataSyncLocationS3s(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).datasyncconn
-
+	conn := client.(*AWSClient).datasyncconn()
 	input := &datasync.ListLocationsInput{}
+	var sweepables []sweep.Sweepable
+
 	for {
 		output, err := conn.ListLocations(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping DataSync Location S3 sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving DataSync Location S3s: %s", err)
-		}
-
-		if len(output.Locations) == 0 {
-			log.Print("[DEBUG] No DataSync Location S3s to sweep")
-			return nil
+			return sweepables, fmt.Errorf("Error retrieving DataSync Location S3s: %s", err)
 		}
 
 		for _, location := range output.Locations {
 			uri := aws.StringValue(location.LocationUri)
 			if !strings.HasPrefix(uri, "s3://") {
-				log.Printf("[INFO] Skipping DataSync Location S3: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location S3: %s", uri)
+
 			input := &datasync.DeleteLocationInput{
 				LocationArn: location.LocationArn,
 			}
 
-			_, err := conn.DeleteLocation(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(location.LocationArn), func() error {
+				_, err := conn.DeleteLocation(input)
 
-			if isAWSErr(err, "InvalidRequestException", "not found") {
-				continue
-			}
+				if isAWSErr(err, datasync.ErrCodeInvalidRequestException, "not found") {
+					return nil
+				}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location S3 (%s): %s", uri, err)
-			}
+				return err
+			}).WithName(uri))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -76,7 +73,7 @@ func testSweepDataSyncLocationS3s(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDataSyncLocationS3_basic(t *testing.T) {
@@ -184,7 +181,7 @@ func TestAccAWSDataSyncLocationS3_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSDataSyncLocationS3Destroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_location_s3" {
@@ -216,7 +213,7 @@ func testAccCheckAWSDataSyncLocationS3Exists(resourceName string, locationS3 *da
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 		input := &datasync.DescribeLocationS3Input{
 			LocationArn: aws.String(rs.Primary.ID),
 		}
@@ -239,7 +236,7 @@ func testAccCheckAWSDataSyncLocationS3Exists(resourceName string, locationS3 *da
 
 func testAccCheckAWSDataSyncLocationS3Disappears(location *datasync.DescribeLocationS3Output) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 		input := &datasync.DeleteLocationInput{
 			LocationArn: location.LocationArn,
@@ -13,60 +13,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_location_smb", &resource.Sweeper{
-		Name: "aws_datasync_location_smb",
-		F:    testSweepDataSyncLocationSmbs,
+	sweep.AddTestSweepers("aws_datasync_location_smb", &sweep.Sweeper{
+		Name:    "aws_datasync_location_smb",
+		Service: "datasync",
+		List:    testSweepDataSyncLocationSmbs,
 	})
 }
 
-func testSweepDataSyncLocationSmbs(region string) error {
+func testSweepDataSyncLocationSmbs(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).datasyncconn
-
+	conn := client.(*AWSClient).datasyncconn()
 	input := &datasync.ListLocationsInput{}
+	var sweepables []sweep.Sweepable
+
 	for {
 		output, err := conn.ListLocations(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping DataSync Location SMB sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving DataSync Location SMBs: %s", err)
-		}
-
-		if len(output.Locations) == 0 {
-			log.Print("[DEBUG] No DataSync Location SMBs to sweep")
-			return nil
+			return sweepables, fmt.Errorf("Error retrieving DataSync Location SMBs: %s", err)
 		}
 
 		for _, location := range output.Locations {
 			uri := aws.StringValue(location.LocationUri)
 			if !strings.HasPrefix(uri, "smb://") {
-				log.Printf("[INFO] Skipping DataSync Location SMB: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location SMB: %s", uri)
+
 			input := &datasync.DeleteLocationInput{
 				LocationArn: location.LocationArn,
 			}
 
-			_, err := conn.DeleteLocation(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(location.LocationArn), func() error {
+				_, err := conn.DeleteLocation(input)
 
-			if isAWSErr(err, "InvalidRequestException", "not found") {
-				continue
-			}
+				if isAWSErr(err, datasync.ErrCodeInvalidRequestException, "not found") {
+					return nil
+				}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location SMB (%s): %s", uri, err)
-			}
+				return err
+			}).WithName(uri))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -76,7 +73,7 @@ func testSweepDataSyncLocationSmbs(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDataSyncLocationSmb_basic(t *testing.T) {
@@ -184,7 +181,7 @@ func TestAccAWSDataSyncLocationSmb_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSDataSyncLocationSmbDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_location_smb" {
@@ -216,7 +213,7 @@ func testAccCheckAWSDataSyncLocationSmbExists(resourceName string, locationSmb *
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 		input := &datasync.DescribeLocationSmbInput{
 			LocationArn: aws.String(rs.Primary.ID),
 		}
@@ -239,7 +236,7 @@ func testAccCheckAWSDataSyncLocationSmbExists(resourceName string, locationSmb *
 
 func testAccCheckAWSDataSyncLocationSmbDisappears(location *datasync.DescribeLocationSmbOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 		input := &datasync.DeleteLocationInput{
 			LocationArn: location.LocationArn,
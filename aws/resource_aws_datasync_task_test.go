@@ -12,57 +12,52 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
-		Name: "aws_datasync_task",
-		F:    testSweepDataSyncTasks,
+	sweep.AddTestSweepers("aws_datasync_task", &sweep.Sweeper{
+		Name:    "aws_datasync_task",
+		Service: "datasync",
+		List:    testSweepDataSyncTasks,
 	})
 }
 
-func testSweepDataSyncTasks(region string) error {
+func testSweepDataSyncTasks(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).datasyncconn
-
+	conn := client.(*AWSClient).datasyncconn()
 	input := &datasync.ListTasksInput{}
+	var sweepables []sweep.Sweepable
+
 	for {
 		output, err := conn.ListTasks(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping DataSync Task sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving DataSync Tasks: %s", err)
-		}
-
-		if len(output.Tasks) == 0 {
-			log.Print("[DEBUG] No DataSync Tasks to sweep")
-			return nil
+			return sweepables, fmt.Errorf("Error retrieving DataSync Tasks: %s", err)
 		}
 
 		for _, task := range output.Tasks {
-			name := aws.StringValue(task.Name)
-
-			log.Printf("[INFO] Deleting DataSync Task: %s", name)
 			input := &datasync.DeleteTaskInput{
 				TaskArn: task.TaskArn,
 			}
 
-			_, err := conn.DeleteTask(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(task.TaskArn), func() error {
+				_, err := conn.DeleteTask(input)
 
-			if isAWSErr(err, "InvalidRequestException", "not found") {
-				continue
-			}
+				if isAWSErr(err, datasync.ErrCodeInvalidRequestException, "not found") {
+					return nil
+				}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Task (%s): %s", name, err)
-			}
+				return err
+			}).WithName(aws.StringValue(task.Name)))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -72,7 +67,7 @@ func testSweepDataSyncTasks(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDataSyncTask_basic(t *testing.T) {
@@ -513,7 +508,7 @@ func TestAccAWSDataSyncTask_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSDataSyncTaskDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_task" {
@@ -545,7 +540,7 @@ func testAccCheckAWSDataSyncTaskExists(resourceName string, task *datasync.Descr
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 		input := &datasync.DescribeTaskInput{
 			TaskArn: aws.String(rs.Primary.ID),
 		}
@@ -573,7 +568,7 @@ func testAccCheckAWSDataSyncTaskExists(resourceName string, task *datasync.Descr
 
 func testAccCheckAWSDataSyncTaskDisappears(location *datasync.DescribeTaskOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 		input := &datasync.DeleteTaskInput{
 			TaskArn: location.TaskArn,
@@ -596,7 +591,7 @@ func testAccCheckAWSDataSyncTaskNotRecreated(i, j *datasync.DescribeTaskOutput)
 }
 
 func testAccPreCheckAWSDataSync(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	input := &datasync.ListTasksInput{
 		MaxResults: aws.Int64(1),
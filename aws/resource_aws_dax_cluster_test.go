@@ -11,21 +11,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dax_cluster", &resource.Sweeper{
-		Name: "aws_dax_cluster",
-		F:    testSweepDAXClusters,
+	sweep.AddTestSweepers("aws_dax_cluster", &sweep.Sweeper{
+		Name:    "aws_dax_cluster",
+		Service: "dax",
+		List:    testSweepDAXClusters,
 	})
 }
 
-func testSweepDAXClusters(region string) error {
+func testSweepDAXClusters(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %s", err)
+		return nil, fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).daxconn
+	conn := client.(*AWSClient).daxconn()
 
 	resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{})
 	if err != nil {
@@ -33,29 +35,26 @@ func testSweepDAXClusters(region string) error {
 		// InvalidParameterValueException: Access Denied to API Version: DAX_V3
 		if testSweepSkipSweepError(err) || isAWSErr(err, "InvalidParameterValueException", "Access Denied to API Version: DAX_V3") {
 			log.Printf("[WARN] Skipping DAX Cluster sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving DAX clusters: %s", err)
+		return nil, fmt.Errorf("Error retrieving DAX clusters: %s", err)
 	}
 
-	if len(resp.Clusters) == 0 {
-		log.Print("[DEBUG] No DAX clusters to sweep")
-		return nil
-	}
-
-	log.Printf("[INFO] Found %d DAX clusters", len(resp.Clusters))
+	var sweepables []sweep.Sweepable
 
 	for _, cluster := range resp.Clusters {
-		log.Printf("[INFO] Deleting DAX cluster %s", *cluster.ClusterName)
-		_, err := conn.DeleteCluster(&dax.DeleteClusterInput{
+		input := &dax.DeleteClusterInput{
 			ClusterName: cluster.ClusterName,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting DAX cluster %s: %s", *cluster.ClusterName, err)
 		}
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(cluster.ClusterName), func() error {
+			_, err := conn.DeleteCluster(input)
+
+			return err
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDAXCluster_basic(t *testing.T) {
@@ -222,7 +221,7 @@ func TestAccAWSDAXCluster_encryption_enabled(t *testing.T) {
 }
 
 func testAccCheckAWSDAXClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).daxconn
+	conn := testAccProvider.Meta().(*AWSClient).daxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dax_cluster" {
@@ -256,7 +255,7 @@ func testAccCheckAWSDAXClusterExists(n string, v *dax.Cluster) resource.TestChec
 			return fmt.Errorf("No DAX cluster ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).daxconn
+		conn := testAccProvider.Meta().(*AWSClient).daxconn()
 		resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{
 			ClusterNames: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -275,7 +274,7 @@ func testAccCheckAWSDAXClusterExists(n string, v *dax.Cluster) resource.TestChec
 }
 
 func testAccPreCheckAWSDax(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).daxconn
+	conn := testAccProvider.Meta().(*AWSClient).daxconn()
 
 	input := &dax.DescribeClustersInput{}
 
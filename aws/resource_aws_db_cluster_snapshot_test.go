@@ -8,25 +8,26 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_cluster_snapshot", &resource.Sweeper{
-		Name: "aws_db_cluster_snapshot",
-		F:    testSweepDbClusterSnapshots,
+	sweep.AddTestSweepers("aws_db_cluster_snapshot", &sweep.Sweeper{
+		Name:    "aws_db_cluster_snapshot",
+		Service: "rds",
+		List:    testSweepDbClusterSnapshots,
 	})
 }
 
-func testSweepDbClusterSnapshots(region string) error {
+func testSweepDbClusterSnapshots(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).rdsconn
+	conn := client.(*AWSClient).rdsconn()
 	input := &rds.DescribeDBClusterSnapshotsInput{
 		// "InvalidDBClusterSnapshotStateFault: Only manual snapshots may be deleted."
 		Filters: []*rds.Filter{{
@@ -34,44 +35,44 @@ func testSweepDbClusterSnapshots(region string) error {
 			Values: aws.StringSlice([]string{"manual"}),
 		}},
 	}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.DescribeDBClusterSnapshots(input)
+
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping RDS DB Cluster Snapshots sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return sweepables, nil // In case we have completed some pages
 		}
+
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving RDS DB Cluster Snapshots: %w", err))
-			return sweeperErrs
+			return sweepables, fmt.Errorf("error retrieving RDS DB Cluster Snapshots: %w", err)
 		}
 
 		for _, dbClusterSnapshot := range output.DBClusterSnapshots {
 			id := aws.StringValue(dbClusterSnapshot.DBClusterSnapshotIdentifier)
 
-			log.Printf("[INFO] Deleting RDS DB Cluster Snapshot: %s", id)
-			_, err := conn.DeleteDBClusterSnapshot(&rds.DeleteDBClusterSnapshotInput{
-				DBClusterSnapshotIdentifier: aws.String(id),
-			})
-			if isAWSErr(err, rds.ErrCodeDBClusterSnapshotNotFoundFault, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting RDS DB Cluster Snapshot (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteDBClusterSnapshot(&rds.DeleteDBClusterSnapshotInput{
+					DBClusterSnapshotIdentifier: aws.String(id),
+				})
+
+				if isAWSErr(err, rds.ErrCodeDBClusterSnapshotNotFoundFault, "") {
+					return nil
+				}
+
+				return err
+			}))
 		}
 
 		if aws.StringValue(output.Marker) == "" {
 			break
 		}
+
 		input.Marker = output.Marker
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSDBClusterSnapshot_basic(t *testing.T) {
@@ -165,7 +166,7 @@ func TestAccAWSDBClusterSnapshot_Tags(t *testing.T) {
 }
 
 func testAccCheckDbClusterSnapshotDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_cluster_snapshot" {
@@ -203,7 +204,7 @@ func testAccCheckDbClusterSnapshotExists(resourceName string, dbClusterSnapshot
 			return fmt.Errorf("No ID is set for %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		request := &rds.DescribeDBClusterSnapshotsInput{
 			DBClusterSnapshotIdentifier: aws.String(rs.Primary.ID),
@@ -9,27 +9,28 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_event_subscription", &resource.Sweeper{
-		Name: "aws_db_event_subscription",
-		F:    testSweepDbEventSubscriptions,
+	sweep.AddTestSweepers("aws_db_event_subscription", &sweep.Sweeper{
+		Name:    "aws_db_event_subscription",
+		Service: "rds",
+		List:    testSweepDbEventSubscriptions,
 	})
 }
 
-func testSweepDbEventSubscriptions(region string) error {
+func testSweepDbEventSubscriptions(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).rdsconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).rdsconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeEventSubscriptionsPages(&rds.DescribeEventSubscriptionsInput{}, func(page *rds.DescribeEventSubscriptionsOutput, isLast bool) bool {
 		if page == nil {
@@ -39,43 +40,46 @@ func testSweepDbEventSubscriptions(region string) error {
 		for _, eventSubscription := range page.EventSubscriptionsList {
 			name := aws.StringValue(eventSubscription.CustSubscriptionId)
 
-			log.Printf("[INFO] Deleting RDS Event Subscription: %s", name)
-			_, err = conn.DeleteEventSubscription(&rds.DeleteEventSubscriptionInput{
-				SubscriptionName: aws.String(name),
-			})
-			if isAWSErr(err, rds.ErrCodeSubscriptionNotFoundFault, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting RDS Event Subscription (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-
-			_, err = waiter.EventSubscriptionDeleted(conn, name)
-			if isAWSErr(err, rds.ErrCodeSubscriptionNotFoundFault, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error waiting for RDS Event Subscription (%s) deletion: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteEventSubscription(&rds.DeleteEventSubscriptionInput{
+					SubscriptionName: aws.String(name),
+				})
+
+				if isAWSErr(err, rds.ErrCodeSubscriptionNotFoundFault, "") {
+					return nil
+				}
+
+				if err != nil {
+					return err
+				}
+
+				_, err = waiter.EventSubscriptionDeleted(conn, name)
+
+				if isAWSErr(err, rds.ErrCodeSubscriptionNotFoundFault, "") {
+					return nil
+				}
+
+				if err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}))
 		}
 
 		return !isLast
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping RDS Event Subscriptions sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving RDS Event Subscriptions: %w", err))
+		return sweepables, fmt.Errorf("error retrieving RDS Event Subscriptions: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSDBEventSubscription_basicUpdate(t *testing.T) {
@@ -258,7 +262,7 @@ func testAccCheckAWSDBEventSubscriptionExists(n string, v *rds.EventSubscription
 			return fmt.Errorf("No RDS Event Subscription is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		eventSubscription, err := resourceAwsDbEventSubscriptionRetrieve(rs.Primary.ID, conn)
 
@@ -277,7 +281,7 @@ func testAccCheckAWSDBEventSubscriptionExists(n string, v *rds.EventSubscription
 }
 
 func testAccCheckAWSDBEventSubscriptionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_event_subscription" {
@@ -304,7 +308,7 @@ func testAccCheckAWSDBEventSubscriptionDestroy(s *terraform.State) error {
 
 func testAccCheckAWSDBEventSubscriptionDisappears(eventSubscription *rds.EventSubscription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		input := &rds.DeleteEventSubscriptionInput{
 			SubscriptionName: eventSubscription.CustSubscriptionId,
@@ -14,61 +14,61 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
-		Name: "aws_db_option_group",
-		F:    testSweepDbOptionGroups,
+	sweep.AddTestSweepers("aws_db_option_group", &sweep.Sweeper{
+		Name:    "aws_db_option_group",
+		Service: "rds",
+		List:    testSweepDbOptionGroups,
 	})
 }
 
-func testSweepDbOptionGroups(region string) error {
+func testSweepDbOptionGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*AWSClient).rdsconn
+	conn := client.(*AWSClient).rdsconn()
 
 	opts := rds.DescribeOptionGroupsInput{}
 	resp, err := conn.DescribeOptionGroups(&opts)
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping RDS DB Option Group sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("error describing DB Option Groups in Sweeper: %s", err)
+		return nil, fmt.Errorf("error describing DB Option Groups in Sweeper: %s", err)
 	}
 
+	var sweepables []sweep.Sweepable
+
 	for _, og := range resp.OptionGroupsList {
 		if strings.HasPrefix(aws.StringValue(og.OptionGroupName), "default") {
 			continue
 		}
 
-		log.Printf("[INFO] Deleting RDS Option Group: %s", aws.StringValue(og.OptionGroupName))
-
 		deleteOpts := &rds.DeleteOptionGroupInput{
 			OptionGroupName: og.OptionGroupName,
 		}
 
-		ret := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.DeleteOptionGroup(deleteOpts)
-			if err != nil {
-				if isAWSErr(err, rds.ErrCodeInvalidOptionGroupStateFault, "") {
-					log.Printf("[DEBUG] AWS believes the RDS Option Group is still in use, retrying")
-					return resource.RetryableError(err)
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(og.OptionGroupName), func() error {
+			return resource.Retry(1*time.Minute, func() *resource.RetryError {
+				_, err := conn.DeleteOptionGroup(deleteOpts)
+				if err != nil {
+					if isAWSErr(err, rds.ErrCodeInvalidOptionGroupStateFault, "") {
+						log.Printf("[DEBUG] AWS believes the RDS Option Group is still in use, retrying")
+						return resource.RetryableError(err)
+					}
+					return resource.NonRetryableError(err)
 				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if ret != nil {
-			return fmt.Errorf("Error Deleting DB Option Group (%s) in Sweeper: %s", *og.OptionGroupName, ret)
-		}
+				return nil
+			})
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDBOptionGroup_basic(t *testing.T) {
@@ -602,7 +602,7 @@ func testAccCheckAWSDBOptionGroupExists(n string, v *rds.OptionGroup) resource.T
 			return fmt.Errorf("No DB Option Group Name is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		opts := rds.DescribeOptionGroupsInput{
 			OptionGroupName: aws.String(rs.Primary.ID),
@@ -626,7 +626,7 @@ func testAccCheckAWSDBOptionGroupExists(n string, v *rds.OptionGroup) resource.T
 }
 
 func testAccCheckAWSDBOptionGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_option_group" {
@@ -13,24 +13,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
-		Name: "aws_db_parameter_group",
-		F:    testSweepRdsDbParameterGroups,
+	sweep.AddTestSweepers("aws_db_parameter_group", &sweep.Sweeper{
+		Name:    "aws_db_parameter_group",
+		Service: "rds",
+		List:    testSweepRdsDbParameterGroups,
 		Dependencies: []string{
 			"aws_db_instance",
 		},
 	})
 }
 
-func testSweepRdsDbParameterGroups(region string) error {
+func testSweepRdsDbParameterGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).rdsconn
+	conn := client.(*AWSClient).rdsconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeDBParameterGroupsPages(&rds.DescribeDBParameterGroupsInput{}, func(out *rds.DescribeDBParameterGroupsOutput, lastPage bool) bool {
 		for _, dbpg := range out.DBParameterGroups {
@@ -38,39 +41,33 @@ func testSweepRdsDbParameterGroups(region string) error {
 				continue
 			}
 
-			input := &rds.DeleteDBParameterGroupInput{
-				DBParameterGroupName: dbpg.DBParameterGroupName,
-			}
-			name := aws.StringValue(dbpg.DBParameterGroupName)
-
-			if strings.HasPrefix(name, "default.") {
-				log.Printf("[INFO] Skipping DB Parameter Group: %s", name)
+			if strings.HasPrefix(aws.StringValue(dbpg.DBParameterGroupName), "default.") {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting DB Parameter Group: %s", name)
+			input := &rds.DeleteDBParameterGroupInput{
+				DBParameterGroupName: dbpg.DBParameterGroupName,
+			}
 
-			_, err := conn.DeleteDBParameterGroup(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(dbpg.DBParameterGroupName), func() error {
+				_, err := conn.DeleteDBParameterGroup(input)
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DB Parameter Group %s: %s", name, err)
-				continue
-			}
+				return err
+			}))
 		}
-
 		return !lastPage
 	})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping RDS DB Parameter Group sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error retrieving DB Parameter Groups: %s", err)
+		return sweepables, fmt.Errorf("error retrieving DB Parameter Groups: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDBParameterGroup_basic(t *testing.T) {
@@ -748,7 +745,7 @@ func TestAccAWSDBParameterGroup_updateParameters(t *testing.T) {
 
 func testAccCheckAWSDbParamaterGroupDisappears(v *rds.DBParameterGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_db_parameter_group" {
@@ -764,7 +761,7 @@ func testAccCheckAWSDbParamaterGroupDisappears(v *rds.DBParameterGroup) resource
 }
 
 func testAccCheckAWSDBParameterGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_parameter_group" {
@@ -823,7 +820,7 @@ func testAccCheckAWSDBParameterGroupExists(n string, v *rds.DBParameterGroup) re
 			return fmt.Errorf("No DB Parameter Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		opts := rds.DescribeDBParameterGroupsInput{
 			DBParameterGroupName: aws.String(rs.Primary.ID),
@@ -857,7 +854,7 @@ func testAccCheckAWSDBParameterNotUserDefined(n, paramName string) resource.Test
 			return fmt.Errorf("No DB Parameter Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		opts := rds.DescribeDBParametersInput{
 			DBParameterGroupName: aws.String(rs.Primary.ID),
@@ -11,21 +11,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_proxy", &resource.Sweeper{
-		Name: "aws_db_proxy",
-		F:    testSweepRdsDbProxies,
+	sweep.AddTestSweepers("aws_db_proxy", &sweep.Sweeper{
+		Name:    "aws_db_proxy",
+		Service: "rds",
+		List:    testSweepRdsDbProxies,
 	})
 }
 
-func testSweepRdsDbProxies(region string) error {
+func testSweepRdsDbProxies(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %s", err)
+		return nil, fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).rdsconn
+	conn := client.(*AWSClient).rdsconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeDBProxiesPages(&rds.DescribeDBProxiesInput{}, func(out *rds.DescribeDBProxiesOutput, lastPage bool) bool {
 		for _, dbpg := range out.DBProxies {
@@ -36,31 +39,26 @@ func testSweepRdsDbProxies(region string) error {
 			input := &rds.DeleteDBProxyInput{
 				DBProxyName: dbpg.DBProxyName,
 			}
-			name := aws.StringValue(dbpg.DBProxyName)
 
-			log.Printf("[INFO] Deleting DB Proxy: %s", name)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(dbpg.DBProxyName), func() error {
+				_, err := conn.DeleteDBProxy(input)
 
-			_, err := conn.DeleteDBProxy(input)
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DB Proxy %s: %s", name, err)
-				continue
-			}
+				return err
+			}))
 		}
-
 		return !lastPage
 	})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping RDS DB Proxy sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving DB Proxies: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving DB Proxies: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDBProxy_basic(t *testing.T) {
@@ -467,7 +465,7 @@ func TestAccAWSDBProxy_disappears(t *testing.T) {
 
 // testAccDBProxyPreCheck checks if a call to describe db proxies errors out meaning feature not supported
 func testAccDBProxyPreCheck(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	input := &rds.DescribeDBProxiesInput{}
 	_, err := conn.DescribeDBProxies(input)
@@ -482,7 +480,7 @@ func testAccDBProxyPreCheck(t *testing.T) {
 }
 
 func testAccCheckAWSDBProxyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_proxy" {
@@ -521,7 +519,7 @@ func testAccCheckAWSDBProxyExists(n string, v *rds.DBProxy) resource.TestCheckFu
 			return fmt.Errorf("No DB Proxy ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		opts := rds.DescribeDBProxiesInput{
 			DBProxyName: aws.String(rs.Primary.ID),
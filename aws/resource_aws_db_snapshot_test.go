@@ -9,32 +9,31 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_snapshot", &resource.Sweeper{
-		Name: "aws_db_snapshot",
-		F:    testSweepDbSnapshots,
+	sweep.AddTestSweepers("aws_db_snapshot", &sweep.Sweeper{
+		Name:    "aws_db_snapshot",
+		Service: "rds",
+		List:    testSweepDbSnapshots,
 		Dependencies: []string{
 			"aws_db_instance",
 		},
 	})
 }
 
-func testSweepDbSnapshots(region string) error {
+func testSweepDbSnapshots(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*AWSClient).rdsconn
+	conn := client.(*AWSClient).rdsconn()
 	input := &rds.DescribeDBSnapshotsInput{}
-	var sweeperErrs error
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeDBSnapshotsPages(input, func(out *rds.DescribeDBSnapshotsOutput, lastPage bool) bool {
 		if out == nil {
@@ -52,36 +51,33 @@ func testSweepDbSnapshots(region string) error {
 			}
 
 			if strings.HasPrefix(id, "rds:") {
-				log.Printf("[INFO] Skipping RDS Automated DB Snapshot: %s", id)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting RDS DB Snapshot: %s", id)
-			_, err := conn.DeleteDBSnapshot(input)
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteDBSnapshot(input)
 
-			if isAWSErr(err, rds.ErrCodeDBSnapshotNotFoundFault, "") {
-				continue
-			}
+				if isAWSErr(err, rds.ErrCodeDBSnapshotNotFoundFault, "") {
+					return nil
+				}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting RDS DB Snapshot (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+				return err
+			}))
 		}
+
 		return !lastPage
 	})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping RDS DB Snapshot sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error describing RDS DB Snapshots: %s", err)
+		return sweepables, fmt.Errorf("error describing RDS DB Snapshots: %s", err)
 	}
 
-	return sweeperErrs
+	return sweepables, nil
 }
 
 func TestAccAWSDBSnapshot_basic(t *testing.T) {
@@ -178,7 +174,7 @@ func TestAccAWSDBSnapshot_disappears(t *testing.T) {
 }
 
 func testAccCheckDbSnapshotDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_snapshot" {
@@ -220,7 +216,7 @@ func testAccCheckDbSnapshotExists(n string, v *rds.DBSnapshot) resource.TestChec
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		request := &rds.DescribeDBSnapshotsInput{
 			DBSnapshotIdentifier: aws.String(rs.Primary.ID),
@@ -239,7 +235,7 @@ func testAccCheckDbSnapshotExists(n string, v *rds.DBSnapshot) resource.TestChec
 
 func testAccCheckDbSnapshotDisappears(snapshot *rds.DBSnapshot) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		if _, err := conn.DeleteDBSnapshot(&rds.DeleteDBSnapshotInput{
 			DBSnapshotIdentifier: snapshot.DBSnapshotIdentifier,
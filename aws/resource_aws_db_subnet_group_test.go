@@ -12,56 +12,54 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
-		Name: "aws_db_subnet_group",
-		F:    testSweepRdsDbSubnetGroups,
+	sweep.AddTestSweepers("aws_db_subnet_group", &sweep.Sweeper{
+		Name:    "aws_db_subnet_group",
+		Service: "rds",
+		List:    testSweepRdsDbSubnetGroups,
 		Dependencies: []string{
 			"aws_db_instance",
 		},
 	})
 }
 
-func testSweepRdsDbSubnetGroups(region string) error {
+func testSweepRdsDbSubnetGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*AWSClient).rdsconn
+	conn := client.(*AWSClient).rdsconn()
 	input := &rds.DescribeDBSubnetGroupsInput{}
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeDBSubnetGroupsPages(input, func(out *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
 		for _, dbSubnetGroup := range out.DBSubnetGroups {
-			name := aws.StringValue(dbSubnetGroup.DBSubnetGroupName)
 			input := &rds.DeleteDBSubnetGroupInput{
 				DBSubnetGroupName: dbSubnetGroup.DBSubnetGroupName,
 			}
 
-			log.Printf("[INFO] Deleting RDS DB Subnet Group: %s", name)
-
-			_, err := conn.DeleteDBSubnetGroup(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(dbSubnetGroup.DBSubnetGroupName), func() error {
+				_, err := conn.DeleteDBSubnetGroup(input)
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete RDS DB Subnet Group (%s): %s", name, err)
-			}
+				return err
+			}))
 		}
 		return !lastPage
 	})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping RDS DB Subnet Group sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error retrieving RDS DB Subnet Groups: %s", err)
+		return sweepables, fmt.Errorf("error retrieving RDS DB Subnet Groups: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDBSubnetGroup_basic(t *testing.T) {
@@ -203,7 +201,7 @@ func TestAccAWSDBSubnetGroup_updateDescription(t *testing.T) {
 }
 
 func testAccCheckDBSubnetGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_db_subnet_group" {
@@ -245,7 +243,7 @@ func testAccCheckDBSubnetGroupExists(n string, v *rds.DBSubnetGroup) resource.Te
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 		resp, err := conn.DescribeDBSubnetGroups(
 			&rds.DescribeDBSubnetGroupsInput{DBSubnetGroupName: aws.String(rs.Primary.ID)})
 		if err != nil {
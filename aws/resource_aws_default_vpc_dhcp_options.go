@@ -37,7 +37,7 @@ func resourceAwsDefaultVpcDhcpOptions() *schema.Resource {
 }
 
 func resourceAwsDefaultVpcDhcpOptionsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeDhcpOptionsInput{
 		Filters: []*ec2.Filter{
@@ -8,17 +8,18 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/directoryservice/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_directory_service_directory", &resource.Sweeper{
-		Name: "aws_directory_service_directory",
-		F:    testSweepDirectoryServiceDirectories,
+	sweep.AddTestSweepers("aws_directory_service_directory", &sweep.Sweeper{
+		Name:    "aws_directory_service_directory",
+		Service: "ds",
+		List:    testSweepDirectoryServiceDirectories,
 		Dependencies: []string{
 			"aws_db_instance",
 			"aws_fsx_windows_file_system",
@@ -27,39 +28,26 @@ func init() {
 	})
 }
 
-func testSweepDirectoryServiceDirectories(region string) error {
+func testSweepDirectoryServiceDirectories(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-
-	conn := client.(*AWSClient).dsconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).dsconn()
+	var sweepables []sweep.Sweepable
 
 	input := &directoryservice.DescribeDirectoriesInput{}
-
 	err = lister.DescribeDirectoriesPagesWithContext(context.TODO(), conn, input, func(page *directoryservice.DescribeDirectoriesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
 		for _, directory := range page.DirectoryDescriptions {
-			id := aws.StringValue(directory.DirectoryId)
-
 			r := resourceAwsDirectoryServiceDirectory()
 			d := r.Data(nil)
-			d.SetId(id)
-
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(directory.DirectoryId))
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Directory Service Directory (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !lastPage
@@ -67,16 +55,14 @@ func testSweepDirectoryServiceDirectories(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Directory Service Directory sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+		return sweepables, nil
 	}
 
 	if err != nil {
-		sweeperErr := fmt.Errorf("error listing Directory Service Directories: %w", err)
-		log.Printf("[ERROR] %s", sweeperErr)
-		sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+		return sweepables, fmt.Errorf("error listing Directory Service Directories: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSDirectoryServiceDirectory_basic(t *testing.T) {
@@ -303,7 +289,7 @@ func TestAccAWSDirectoryServiceDirectory_withAliasAndSso(t *testing.T) {
 }
 
 func testAccCheckDirectoryServiceDirectoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dsconn
+	conn := testAccProvider.Meta().(*AWSClient).dsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_directory_service_directory" {
@@ -367,7 +353,7 @@ func testAccCheckServiceDirectoryExists(name string, ds *directoryservice.Direct
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dsconn
+		conn := testAccProvider.Meta().(*AWSClient).dsconn()
 		out, err := conn.DescribeDirectories(&directoryservice.DescribeDirectoriesInput{
 			DirectoryIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -402,7 +388,7 @@ func testAccCheckServiceDirectoryAlias(name, alias string) resource.TestCheckFun
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dsconn
+		conn := testAccProvider.Meta().(*AWSClient).dsconn()
 		out, err := conn.DescribeDirectories(&directoryservice.DescribeDirectoriesInput{
 			DirectoryIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -431,7 +417,7 @@ func testAccCheckServiceDirectorySso(name string, ssoEnabled bool) resource.Test
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dsconn
+		conn := testAccProvider.Meta().(*AWSClient).dsconn()
 		out, err := conn.DescribeDirectories(&directoryservice.DescribeDirectoriesInput{
 			DirectoryIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -450,7 +436,7 @@ func testAccCheckServiceDirectorySso(name string, ssoEnabled bool) resource.Test
 }
 
 func testAccPreCheckAWSDirectoryService(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).dsconn
+	conn := testAccProvider.Meta().(*AWSClient).dsconn()
 
 	input := &directoryservice.DescribeDirectoriesInput{}
 
@@ -469,7 +455,7 @@ func testAccPreCheckAWSDirectoryService(t *testing.T) {
 // and we do not have a good read-only way to determine this situation. Here we
 // opt to perform a creation that will fail so we can determine Simple AD support.
 func testAccPreCheckAWSDirectoryServiceSimpleDirectory(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).dsconn
+	conn := testAccProvider.Meta().(*AWSClient).dsconn()
 
 	input := &directoryservice.CreateDirectoryInput{
 		Name:     aws.String("corp.example.com"),
@@ -141,7 +141,7 @@ func TestAccAWSDlmLifecyclePolicy_Tags(t *testing.T) {
 }
 
 func dlmLifecyclePolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dlmconn
+	conn := testAccProvider.Meta().(*AWSClient).dlmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dlm_lifecycle_policy" {
@@ -177,7 +177,7 @@ func checkDlmLifecyclePolicyExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dlmconn
+		conn := testAccProvider.Meta().(*AWSClient).dlmconn()
 
 		input := dlm.GetLifecyclePolicyInput{
 			PolicyId: aws.String(rs.Primary.ID),
@@ -194,7 +194,7 @@ func checkDlmLifecyclePolicyExists(name string) resource.TestCheckFunc {
 }
 
 func testAccPreCheckAWSDlm(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).dlmconn
+	conn := testAccProvider.Meta().(*AWSClient).dlmconn()
 
 	input := &dlm.GetLifecyclePoliciesInput{}
 
@@ -13,68 +13,72 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
-		Name: "aws_dms_replication_instance",
-		F:    testSweepDmsReplicationInstances,
+	sweep.AddTestSweepers("aws_dms_replication_instance", &sweep.Sweeper{
+		Name:    "aws_dms_replication_instance",
+		Service: "dms",
+		List:    testSweepDmsReplicationInstances,
 	})
 }
 
-func testSweepDmsReplicationInstances(region string) error {
+func testSweepDmsReplicationInstances(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).dmsconn
+	conn := client.(*AWSClient).dmsconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeReplicationInstancesPages(&dms.DescribeReplicationInstancesInput{}, func(page *dms.DescribeReplicationInstancesOutput, lastPage bool) bool {
 		for _, instance := range page.ReplicationInstances {
-			arn := aws.StringValue(instance.ReplicationInstanceArn)
+			identifier := aws.StringValue(instance.ReplicationInstanceIdentifier)
 			input := &dms.DeleteReplicationInstanceInput{
 				ReplicationInstanceArn: instance.ReplicationInstanceArn,
 			}
 
-			log.Printf("[INFO] Deleting DMS Replication Instance: %s", arn)
-			_, err := conn.DeleteReplicationInstance(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(instance.ReplicationInstanceArn), func() error {
+				_, err := conn.DeleteReplicationInstance(input)
 
-			if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") {
-				continue
-			}
+				if isAWSErr(err, dms.ErrCodeResourceNotFoundFault, "") {
+					return nil
+				}
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting DMS Replication Instance (%s): %s", arn, err)
-				continue
-			}
+				if err != nil {
+					return err
+				}
 
-			stateConf := &resource.StateChangeConf{
-				Pending:    []string{"deleting"},
-				Target:     []string{},
-				Refresh:    resourceAwsDmsReplicationInstanceStateRefreshFunc(conn, aws.StringValue(instance.ReplicationInstanceIdentifier)),
-				Timeout:    30 * time.Minute,
-				MinTimeout: 10 * time.Second,
-				Delay:      30 * time.Second,
-			}
+				stateConf := &resource.StateChangeConf{
+					Pending:    []string{"deleting"},
+					Target:     []string{},
+					Refresh:    resourceAwsDmsReplicationInstanceStateRefreshFunc(conn, identifier),
+					Timeout:    30 * time.Minute,
+					MinTimeout: 10 * time.Second,
+					Delay:      30 * time.Second,
+				}
 
-			if _, err := stateConf.WaitForState(); err != nil {
-				log.Printf("[ERROR] Error waiting for DMS Replication Instance (%s) deletion: %s", arn, err)
-			}
-		}
+				if _, err := stateConf.WaitForState(); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
 
+				return nil
+			}).WithName(identifier))
+		}
 		return !lastPage
 	})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping DMS Replication Instance sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving DMS Replication Instances: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving DMS Replication Instances: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDmsReplicationInstance_basic(t *testing.T) {
@@ -537,7 +541,7 @@ func testAccCheckAWSDmsReplicationInstanceExists(n string) resource.TestCheckFun
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No ID is set")
 		}
-		conn := testAccProvider.Meta().(*AWSClient).dmsconn
+		conn := testAccProvider.Meta().(*AWSClient).dmsconn()
 		resp, err := conn.DescribeReplicationInstances(&dms.DescribeReplicationInstancesInput{
 			Filters: []*dms.Filter{
 				{
@@ -564,7 +568,7 @@ func testAccCheckAWSDmsReplicationInstanceDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dmsconn
+		conn := testAccProvider.Meta().(*AWSClient).dmsconn()
 
 		resp, err := conn.DescribeReplicationInstances(&dms.DescribeReplicationInstancesInput{
 			Filters: []*dms.Filter{
@@ -597,7 +601,7 @@ func testAccCheckAWSDmsReplicationInstanceDestroy(s *terraform.State) error {
 
 // Ensure at least two engine versions of the replication instance class are available
 func testAccAWSDmsReplicationInstanceEngineVersionsPreCheck(t *testing.T) []string {
-	conn := testAccProvider.Meta().(*AWSClient).dmsconn
+	conn := testAccProvider.Meta().(*AWSClient).dmsconn()
 
 	// Gather all orderable DMS replication instances of the instance class
 	// used in the acceptance testing. Not currently available as an input
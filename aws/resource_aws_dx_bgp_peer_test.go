@@ -38,7 +38,7 @@ func TestAccAwsDxBgpPeer_basic(t *testing.T) {
 }
 
 func testAccCheckAwsDxBgpPeerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_bgp_peer" {
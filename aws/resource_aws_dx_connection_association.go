@@ -32,7 +32,7 @@ func resourceAwsDxConnectionAssociation() *schema.Resource {
 }
 
 func resourceAwsDxConnectionAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	input := &directconnect.AssociateConnectionWithLagInput{
 		ConnectionId: aws.String(d.Get("connection_id").(string)),
@@ -48,7 +48,7 @@ func resourceAwsDxConnectionAssociationCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsDxConnectionAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	input := &directconnect.DescribeConnectionsInput{
 		ConnectionId: aws.String(d.Id()),
@@ -74,7 +74,7 @@ func resourceAwsDxConnectionAssociationRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsDxConnectionAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	input := &directconnect.DisassociateConnectionFromLagInput{
 		ConnectionId: aws.String(d.Id()),
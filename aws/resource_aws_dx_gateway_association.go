@@ -105,7 +105,7 @@ func resourceAwsDxGatewayAssociation() *schema.Resource {
 }
 
 func resourceAwsDxGatewayAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	dxgwId := d.Get("dx_gateway_id").(string)
 	gwIdRaw, gwIdOk := d.GetOk("associated_gateway_id")
@@ -168,7 +168,7 @@ func resourceAwsDxGatewayAssociationCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsDxGatewayAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	associationId := d.Get("dx_gateway_association_id").(string)
 	assocRaw, state, err := dxGatewayAssociationStateRefresh(conn, associationId)()
@@ -199,7 +199,7 @@ func resourceAwsDxGatewayAssociationRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsDxGatewayAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	if d.HasChange("allowed_prefixes") {
 		associationId := d.Get("dx_gateway_association_id").(string)
@@ -231,7 +231,7 @@ func resourceAwsDxGatewayAssociationUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsDxGatewayAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	associationId := d.Get("dx_gateway_association_id").(string)
 
@@ -264,7 +264,7 @@ func resourceAwsDxGatewayAssociationImport(d *schema.ResourceData, meta interfac
 	id := dxGatewayAssociationId(dxgwId, gwId)
 	log.Printf("[DEBUG] Importing Direct Connect gateway association %s/%s", dxgwId, gwId)
 
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	resp, err := conn.DescribeDirectConnectGatewayAssociations(&directconnect.DescribeDirectConnectGatewayAssociationsInput{
 		AssociatedGatewayId:    aws.String(gwId),
@@ -11,47 +11,44 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
-		Name: "aws_dx_gateway_association_proposal",
-		F:    testSweepDirectConnectGatewayAssociationProposals,
+	sweep.AddTestSweepers("aws_dx_gateway_association_proposal", &sweep.Sweeper{
+		Name:    "aws_dx_gateway_association_proposal",
+		Service: "dx",
+		List:    testSweepDirectConnectGatewayAssociationProposals,
 	})
 }
 
-func testSweepDirectConnectGatewayAssociationProposals(region string) error {
+func testSweepDirectConnectGatewayAssociationProposals(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*AWSClient).dxconn
+	conn := client.(*AWSClient).dxconn()
 	input := &directconnect.DescribeDirectConnectGatewayAssociationProposalsInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.DescribeDirectConnectGatewayAssociationProposals(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Direct Connect Gateway sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("error retrieving Direct Connect Gateway Association Proposals: %s", err)
+			return sweepables, fmt.Errorf("error retrieving Direct Connect Gateway Association Proposals: %s", err)
 		}
 
 		for _, gatewayAssociationProposal := range output.DirectConnectGatewayAssociationProposals {
-			proposalID := aws.StringValue(gatewayAssociationProposal.ProposalId)
-
 			if aws.StringValue(gatewayAssociationProposal.AssociatedGateway.Region) != region {
-				log.Printf("[INFO] Skipping Direct Connect Gateway Association Proposal (%s) in different home region: %s", proposalID, aws.StringValue(gatewayAssociationProposal.AssociatedGateway.Region))
 				continue
 			}
 
 			if aws.StringValue(gatewayAssociationProposal.ProposalState) != directconnect.GatewayAssociationProposalStateAccepted {
-				log.Printf("[INFO] Skipping Direct Connect Gateway Association Proposal (%s) in non-accepted (%s) state", proposalID, aws.StringValue(gatewayAssociationProposal.ProposalState))
 				continue
 			}
 
@@ -59,12 +56,11 @@ func testSweepDirectConnectGatewayAssociationProposals(region string) error {
 				ProposalId: gatewayAssociationProposal.ProposalId,
 			}
 
-			log.Printf("[INFO] Deleting Direct Connect Gateway Association Proposal: %s", proposalID)
-			_, err := conn.DeleteDirectConnectGatewayAssociationProposal(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(gatewayAssociationProposal.ProposalId), func() error {
+				_, err := conn.DeleteDirectConnectGatewayAssociationProposal(input)
 
-			if err != nil {
-				return fmt.Errorf("error deleting Direct Connect Gateway Association Proposal (%s): %s", proposalID, err)
-			}
+				return err
+			}))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -74,7 +70,7 @@ func testSweepDirectConnectGatewayAssociationProposals(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAwsDxGatewayAssociationProposal_basicVpnGateway(t *testing.T) {
@@ -223,7 +219,7 @@ func TestAccAwsDxGatewayAssociationProposal_AllowedPrefixes(t *testing.T) {
 }
 
 func testAccCheckAwsDxGatewayAssociationProposalDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_gateway_association_proposal" {
@@ -253,7 +249,7 @@ func testAccCheckAwsDxGatewayAssociationProposalExists(resourceName string, gate
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dxconn
+		conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 		proposal, err := describeDirectConnectGatewayAssociationProposal(conn, rs.Primary.ID)
 
@@ -273,7 +269,7 @@ func testAccCheckAwsDxGatewayAssociationProposalExists(resourceName string, gate
 
 func testAccCheckAwsDxGatewayAssociationProposalDisappears(proposal *directconnect.GatewayAssociationProposal) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).dxconn
+		conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 		input := &directconnect.DeleteDirectConnectGatewayAssociationProposalInput{
 			ProposalId: proposal.ProposalId,
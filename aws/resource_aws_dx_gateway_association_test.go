@@ -10,28 +10,60 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
-		Name: "aws_dx_gateway_association",
-		F:    testSweepDirectConnectGatewayAssociations,
+	sweep.AddTestSweepers("aws_dx_gateway_association", &sweep.Sweeper{
+		Name:    "aws_dx_gateway_association",
+		Service: "dx",
+		List:    testSweepDirectConnectGatewayAssociations,
 		Dependencies: []string{
 			"aws_dx_gateway_association_proposal",
 		},
 	})
 }
 
-func testSweepDirectConnectGatewayAssociations(region string) error {
+func testSweepDirectConnectGatewayAssociations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).dxconn
+	conn := client.(*AWSClient).dxconn()
+	var sweepables []sweep.Sweepable
+	var sweeperErrs *multierror.Error
+
+	// deleteAssociation returns the Sweepable deleting an association.
+	deleteAssociation := func(association *directconnect.GatewayAssociation, timeout time.Duration) sweep.Sweepable {
+		associationID := aws.StringValue(association.AssociationId)
+		input := &directconnect.DeleteDirectConnectGatewayAssociationInput{
+			AssociationId: association.AssociationId,
+		}
+
+		return sweep.NewResource(associationID, func() error {
+			_, err := conn.DeleteDirectConnectGatewayAssociation(input)
+
+			if isAWSErr(err, directconnect.ErrCodeClientException, "No association exists") {
+				return nil
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if err := waitForDirectConnectGatewayAssociationDeletion(conn, associationID, timeout); err != nil {
+				return fmt.Errorf("error waiting for deletion: %w", err)
+			}
+
+			return nil
+		})
+	}
+
 	gatewayInput := &directconnect.DescribeDirectConnectGatewaysInput{}
 
 	for {
@@ -39,16 +71,15 @@ func testSweepDirectConnectGatewayAssociations(region string) error {
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Direct Connect Gateway sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("error retrieving Direct Connect Gateways: %s", err)
+			return sweepables, fmt.Errorf("error retrieving Direct Connect Gateways: %s", err)
 		}
 
 		for _, gateway := range gatewayOutput.DirectConnectGateways {
 			directConnectGatewayID := aws.StringValue(gateway.DirectConnectGatewayId)
-
 			associationInput := &directconnect.DescribeDirectConnectGatewayAssociationsInput{
 				DirectConnectGatewayId: gateway.DirectConnectGatewayId,
 			}
@@ -57,40 +88,19 @@ func testSweepDirectConnectGatewayAssociations(region string) error {
 				associationOutput, err := conn.DescribeDirectConnectGatewayAssociations(associationInput)
 
 				if err != nil {
-					return fmt.Errorf("error retrieving Direct Connect Gateway (%s) Associations: %s", directConnectGatewayID, err)
+					return sweepables, fmt.Errorf("error retrieving Direct Connect Gateway (%s) Associations: %s", directConnectGatewayID, err)
 				}
 
 				for _, association := range associationOutput.DirectConnectGatewayAssociations {
-					gatewayID := aws.StringValue(association.AssociatedGateway.Id)
-
 					if aws.StringValue(association.AssociatedGateway.Region) != region {
-						log.Printf("[INFO] Skipping Direct Connect Gateway (%s) Association (%s) in different home region: %s", directConnectGatewayID, gatewayID, aws.StringValue(association.AssociatedGateway.Region))
 						continue
 					}
 
 					if aws.StringValue(association.AssociationState) != directconnect.GatewayAssociationStateAssociated {
-						log.Printf("[INFO] Skipping Direct Connect Gateway (%s) Association in non-available (%s) state: %s", directConnectGatewayID, aws.StringValue(association.AssociationState), gatewayID)
 						continue
 					}
 
-					input := &directconnect.DeleteDirectConnectGatewayAssociationInput{
-						AssociationId: association.AssociationId,
-					}
-
-					log.Printf("[INFO] Deleting Direct Connect Gateway (%s) Association: %s", directConnectGatewayID, gatewayID)
-					_, err := conn.DeleteDirectConnectGatewayAssociation(input)
-
-					if isAWSErr(err, directconnect.ErrCodeClientException, "No association exists") {
-						continue
-					}
-
-					if err != nil {
-						return fmt.Errorf("error deleting Direct Connect Gateway (%s) Association (%s): %s", directConnectGatewayID, gatewayID, err)
-					}
-
-					if err := waitForDirectConnectGatewayAssociationDeletion(conn, aws.StringValue(association.AssociationId), 20*time.Minute); err != nil {
-						return fmt.Errorf("error waiting for Direct Connect Gateway (%s) Association (%s) to be deleted: %s", directConnectGatewayID, gatewayID, err)
-					}
+					sweepables = append(sweepables, deleteAssociation(association, 20*time.Minute))
 				}
 
 				if aws.StringValue(associationOutput.NextToken) == "" {
@@ -113,7 +123,7 @@ func testSweepDirectConnectGatewayAssociations(region string) error {
 	// these within the service itself so they can only be found
 	// via AssociatedGatewayId of the EC2 Transit Gateway since the
 	// DirectConnectGatewayId lives in the other account.
-	ec2conn := client.(*AWSClient).ec2conn
+	ec2conn := client.(*AWSClient).ec2conn()
 
 	err = ec2conn.DescribeTransitGatewaysPages(&ec2.DescribeTransitGatewaysInput{}, func(page *ec2.DescribeTransitGatewaysOutput, lastPage bool) bool {
 		if page == nil {
@@ -133,37 +143,16 @@ func testSweepDirectConnectGatewayAssociations(region string) error {
 			associationOutput, err := conn.DescribeDirectConnectGatewayAssociations(associationInput)
 
 			if err != nil {
-				log.Printf("[ERROR] error retrieving EC2 Transit Gateway (%s) Direct Connect Gateway Associations: %s", transitGatewayID, err)
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving EC2 Transit Gateway (%s) Direct Connect Gateway Associations: %w", transitGatewayID, err))
 				continue
 			}
 
 			for _, association := range associationOutput.DirectConnectGatewayAssociations {
-				associationID := aws.StringValue(association.AssociationId)
-
 				if aws.StringValue(association.AssociationState) != directconnect.GatewayAssociationStateAssociated {
-					log.Printf("[INFO] Skipping EC2 Transit Gateway (%s) Direct Connect Gateway Association (%s) in non-available state: %s", transitGatewayID, associationID, aws.StringValue(association.AssociationState))
-					continue
-				}
-
-				input := &directconnect.DeleteDirectConnectGatewayAssociationInput{
-					AssociationId: association.AssociationId,
-				}
-
-				log.Printf("[INFO] Deleting EC2 Transit Gateway (%s) Direct Connect Gateway Association: %s", transitGatewayID, associationID)
-				_, err := conn.DeleteDirectConnectGatewayAssociation(input)
-
-				if isAWSErr(err, directconnect.ErrCodeClientException, "No association exists") {
-					continue
-				}
-
-				if err != nil {
-					log.Printf("[ERROR] error deleting EC2 Transit Gateway (%s) Direct Connect Gateway Association (%s): %s", transitGatewayID, associationID, err)
 					continue
 				}
 
-				if err := waitForDirectConnectGatewayAssociationDeletion(conn, associationID, 30*time.Minute); err != nil {
-					log.Printf("[ERROR] error waiting for EC2 Transit Gateway (%s) Direct Connect Gateway Association (%s) to be deleted: %s", transitGatewayID, associationID, err)
-				}
+				sweepables = append(sweepables, deleteAssociation(association, 30*time.Minute))
 			}
 		}
 
@@ -172,14 +161,14 @@ func testSweepDirectConnectGatewayAssociations(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 Transit Gateway Direct Connect Gateway Association sweep for %s: %s", region, err)
-		return nil
+		return sweepables, sweeperErrs.ErrorOrNil()
 	}
 
 	if err != nil {
-		return fmt.Errorf("error retrieving EC2 Transit Gateways: %s", err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving EC2 Transit Gateways: %w", err))
 	}
 
-	return nil
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 // V0 state upgrade testing must be done via acceptance testing due to API call
@@ -527,7 +516,7 @@ func testAccAwsDxGatewayAssociationImportStateIdFunc(resourceName string) resour
 }
 
 func testAccCheckAwsDxGatewayAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_gateway_association" {
@@ -560,7 +549,7 @@ func testAccCheckAwsDxGatewayAssociationExists(name string, ga *directconnect.Ga
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dxconn
+		conn := testAccProvider.Meta().(*AWSClient).dxconn()
 		resp, err := conn.DescribeDirectConnectGatewayAssociations(&directconnect.DescribeDirectConnectGatewayAssociationsInput{
 			AssociationId: aws.String(rs.Primary.Attributes["dx_gateway_association_id"]),
 		})
@@ -11,43 +11,45 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
-		Name: "aws_dx_gateway",
-		F:    testSweepDirectConnectGateways,
+	sweep.AddTestSweepers("aws_dx_gateway", &sweep.Sweeper{
+		Name:    "aws_dx_gateway",
+		Service: "dx",
+		List:    testSweepDirectConnectGateways,
 		Dependencies: []string{
 			"aws_dx_gateway_association",
 		},
 	})
 }
 
-func testSweepDirectConnectGateways(region string) error {
+func testSweepDirectConnectGateways(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).dxconn
+	conn := client.(*AWSClient).dxconn()
 	input := &directconnect.DescribeDirectConnectGatewaysInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.DescribeDirectConnectGateways(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Direct Connect Gateway sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("error retrieving Direct Connect Gateways: %s", err)
+			return sweepables, fmt.Errorf("error retrieving Direct Connect Gateways: %s", err)
 		}
 
 		for _, gateway := range output.DirectConnectGateways {
 			id := aws.StringValue(gateway.DirectConnectGatewayId)
 
 			if aws.StringValue(gateway.DirectConnectGatewayState) != directconnect.GatewayStateAvailable {
-				log.Printf("[INFO] Skipping Direct Connect Gateway in non-available (%s) state: %s", aws.StringValue(gateway.DirectConnectGatewayState), id)
 				continue
 			}
 
@@ -60,7 +62,7 @@ func testSweepDirectConnectGateways(region string) error {
 				associationOutput, err := conn.DescribeDirectConnectGatewayAssociations(associationInput)
 
 				if err != nil {
-					return fmt.Errorf("error retrieving Direct Connect Gateway (%s) Associations: %s", id, err)
+					return sweepables, fmt.Errorf("error retrieving Direct Connect Gateway (%s) Associations: %s", id, err)
 				}
 
 				// If associations still remain, its likely that our region is not the home
@@ -84,24 +86,25 @@ func testSweepDirectConnectGateways(region string) error {
 				continue
 			}
 
-			input := &directconnect.DeleteDirectConnectGatewayInput{
-				DirectConnectGatewayId: aws.String(id),
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteDirectConnectGateway(&directconnect.DeleteDirectConnectGatewayInput{
+					DirectConnectGatewayId: aws.String(id),
+				})
 
-			log.Printf("[INFO] Deleting Direct Connect Gateway: %s", id)
-			_, err := conn.DeleteDirectConnectGateway(input)
+				if isAWSErr(err, directconnect.ErrCodeClientException, "does not exist") {
+					return nil
+				}
 
-			if isAWSErr(err, directconnect.ErrCodeClientException, "does not exist") {
-				continue
-			}
+				if err != nil {
+					return err
+				}
 
-			if err != nil {
-				return fmt.Errorf("error deleting Direct Connect Gateway (%s): %s", id, err)
-			}
+				if err := waitForDirectConnectGatewayDeletion(conn, id, 20*time.Minute); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
 
-			if err := waitForDirectConnectGatewayDeletion(conn, id, 20*time.Minute); err != nil {
-				return fmt.Errorf("error waiting for Direct Connect Gateway (%s) to be deleted: %s", id, err)
-			}
+				return nil
+			}).WithName(aws.StringValue(gateway.DirectConnectGatewayName)))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -111,7 +114,7 @@ func testSweepDirectConnectGateways(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAwsDxGateway_basic(t *testing.T) {
@@ -165,7 +168,7 @@ func TestAccAwsDxGateway_complex(t *testing.T) {
 }
 
 func testAccCheckAwsDxGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_gateway" {
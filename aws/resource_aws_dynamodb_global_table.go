@@ -57,7 +57,7 @@ func resourceAwsDynamoDbGlobalTable() *schema.Resource {
 }
 
 func resourceAwsDynamoDbGlobalTableCreate(d *schema.ResourceData, meta interface{}) error {
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).dynamodbconn()
 
 	globalTableName := d.Get("name").(string)
 
@@ -112,7 +112,7 @@ func resourceAwsDynamoDbGlobalTableRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDynamoDbGlobalTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).dynamodbconn()
 
 	if d.HasChange("replica") {
 		o, n := d.GetChange("replica")
@@ -166,7 +166,7 @@ func resourceAwsDynamoDbGlobalTableUpdate(d *schema.ResourceData, meta interface
 
 // Deleting a DynamoDB Global Table is represented by removing all replicas.
 func resourceAwsDynamoDbGlobalTableDelete(d *schema.ResourceData, meta interface{}) error {
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).dynamodbconn()
 
 	input := &dynamodb.UpdateGlobalTableInput{
 		GlobalTableName: aws.String(d.Id()),
@@ -195,7 +195,7 @@ func resourceAwsDynamoDbGlobalTableDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsDynamoDbGlobalTableRetrieve(d *schema.ResourceData, meta interface{}) (*dynamodb.GlobalTableDescription, error) {
-	dynamodbconn := meta.(*AWSClient).dynamodbconn
+	dynamodbconn := meta.(*AWSClient).dynamodbconn()
 
 	input := &dynamodb.DescribeGlobalTableInput{
 		GlobalTableName: aws.String(d.Id()),
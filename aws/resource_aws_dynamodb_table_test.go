@@ -13,43 +13,44 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
-		Name: "aws_dynamodb_table",
-		F:    testSweepDynamoDbTables,
+	sweep.AddTestSweepers("aws_dynamodb_table", &sweep.Sweeper{
+		Name:    "aws_dynamodb_table",
+		Service: "dynamodb",
+		List:    testSweepDynamoDbTables,
 	})
 }
 
-func testSweepDynamoDbTables(region string) error {
+func testSweepDynamoDbTables(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).dynamodbconn
+	conn := client.(*AWSClient).dynamodbconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListTablesPages(&dynamodb.ListTablesInput{}, func(out *dynamodb.ListTablesOutput, lastPage bool) bool {
 		for _, tableName := range out.TableNames {
-			log.Printf("[INFO] Deleting DynamoDB Table: %s", *tableName)
+			name := aws.StringValue(tableName)
 
-			err := deleteAwsDynamoDbTable(*tableName, conn)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DynamoDB Table %s: %s", *tableName, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				return deleteAwsDynamoDbTable(name, conn)
+			}))
 		}
 		return !lastPage
 	})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping DynamoDB Table sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving DynamoDB Tables: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving DynamoDB Tables: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestDiffDynamoDbGSI(t *testing.T) {
@@ -1292,7 +1293,7 @@ func TestAccAWSDynamoDbTable_encryption(t *testing.T) {
 }
 
 func testAccCheckAWSDynamoDbTableDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dynamodb_table" {
@@ -1333,7 +1334,7 @@ func testAccCheckInitialAWSDynamoDbTableExists(n string, table *dynamodb.Describ
 			return fmt.Errorf("No DynamoDB table name specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 		params := &dynamodb.DescribeTableInput{
 			TableName: aws.String(rs.Primary.ID),
@@ -0,0 +1,27 @@
+package aws
+
+import (
+	"fmt"
+)
+
+func testAccDynamodbTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_tag" "test" {
+  resource_id = aws_dynamodb_table.test.arn
+  key         = %[2]q
+  value       = %[3]q
+}
+`, rName, key, value)
+}
@@ -27,7 +27,7 @@ func resourceAwsEbsEncryptionByDefault() *schema.Resource {
 }
 
 func resourceAwsEbsEncryptionByDefaultCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	enabled := d.Get("enabled").(bool)
 	if err := setEbsEncryptionByDefault(conn, enabled); err != nil {
@@ -41,7 +41,7 @@ func resourceAwsEbsEncryptionByDefaultCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsEbsEncryptionByDefaultRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	resp, err := conn.GetEbsEncryptionByDefault(&ec2.GetEbsEncryptionByDefaultInput{})
 	if err != nil {
@@ -54,7 +54,7 @@ func resourceAwsEbsEncryptionByDefaultRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsEbsEncryptionByDefaultUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	enabled := d.Get("enabled").(bool)
 	if err := setEbsEncryptionByDefault(conn, enabled); err != nil {
@@ -65,7 +65,7 @@ func resourceAwsEbsEncryptionByDefaultUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsEbsEncryptionByDefaultDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	// Removing the resource disables default encryption.
 	if err := setEbsEncryptionByDefault(conn, false); err != nil {
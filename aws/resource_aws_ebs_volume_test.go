@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,59 +12,58 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
-		Name: "aws_ebs_volume",
+	sweep.AddTestSweepers("aws_ebs_volume", &sweep.Sweeper{
+		Name:    "aws_ebs_volume",
+		Service: "ec2",
 		Dependencies: []string{
 			"aws_instance",
 		},
-		F: testSweepEbsVolumes,
+		List: testSweepEbsVolumes,
 	})
 }
 
-func testSweepEbsVolumes(region string) error {
+func testSweepEbsVolumes(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
+	var sweepables []sweep.Sweepable
 
-	err = conn.DescribeVolumesPages(&ec2.DescribeVolumesInput{}, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
-		for _, volume := range page.Volumes {
-			id := aws.StringValue(volume.VolumeId)
-
-			if aws.StringValue(volume.State) != ec2.VolumeStateAvailable {
-				log.Printf("[INFO] Skipping unavailable EC2 EBS Volume: %s", id)
-				continue
-			}
+	err = lister.DescribeVolumesItems(context.Background(), conn, &ec2.DescribeVolumesInput{}, func(volume *ec2.Volume) bool {
+		if aws.StringValue(volume.State) != ec2.VolumeStateAvailable {
+			return true
+		}
 
-			input := &ec2.DeleteVolumeInput{
-				VolumeId: aws.String(id),
-			}
+		input := &ec2.DeleteVolumeInput{
+			VolumeId: volume.VolumeId,
+		}
 
-			log.Printf("[INFO] Deleting EC2 EBS Volume: %s", id)
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(volume.VolumeId), func() error {
 			_, err := conn.DeleteVolume(input)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting EC2 EBS Volume (%s): %s", id, err)
-			}
-		}
+			return err
+		}).WithTags(keyvaluetags.Ec2KeyValueTags(volume.Tags).IgnoreAws().Map()))
 
-		return !lastPage
+		return true
 	})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 EBS Volume sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving EC2 EBS Volumes: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving EC2 EBS Volumes: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 // testAccErrorCheckSkipEBSVolume skips EBS volume tests that have error messages indicating unsupported features
@@ -779,7 +779,7 @@ func TestAccAWSEBSVolume_disappears(t *testing.T) {
 }
 
 func testAccCheckVolumeDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ebs_volume" {
@@ -821,7 +821,7 @@ func testAccCheckVolumeExists(n string, v *ec2.Volume) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		request := &ec2.DescribeVolumesInput{
 			VolumeIds: []*string{aws.String(rs.Primary.ID)},
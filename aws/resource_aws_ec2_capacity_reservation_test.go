@@ -11,57 +11,53 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ec2_capacity_reservation", &resource.Sweeper{
-		Name: "aws_ec2_capacity_reservation",
-		F:    testSweepEc2CapacityReservations,
+	sweep.AddTestSweepers("aws_ec2_capacity_reservation", &sweep.Sweeper{
+		Name:    "aws_ec2_capacity_reservation",
+		Service: "ec2",
+		List:    testSweepEc2CapacityReservations,
 	})
 }
 
-func testSweepEc2CapacityReservations(region string) error {
+func testSweepEc2CapacityReservations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 
 	resp, err := conn.DescribeCapacityReservations(&ec2.DescribeCapacityReservationsInput{})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 Capacity Reservation sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving EC2 Capacity Reservations: %s", err)
+		return nil, fmt.Errorf("Error retrieving EC2 Capacity Reservations: %s", err)
 	}
 
-	if len(resp.CapacityReservations) == 0 {
-		log.Print("[DEBUG] No EC2 Capacity Reservations to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, r := range resp.CapacityReservations {
 		if aws.StringValue(r.State) != ec2.CapacityReservationStateCancelled && aws.StringValue(r.State) != ec2.CapacityReservationStateExpired {
-			id := aws.StringValue(r.CapacityReservationId)
-
-			log.Printf("[INFO] Cancelling EC2 Capacity Reservation EC2 Instance: %s", id)
-
 			opts := &ec2.CancelCapacityReservationInput{
-				CapacityReservationId: aws.String(id),
+				CapacityReservationId: r.CapacityReservationId,
 			}
 
-			_, err := conn.CancelCapacityReservation(opts)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(r.CapacityReservationId), func() error {
+				_, err := conn.CancelCapacityReservation(opts)
 
-			if err != nil {
-				log.Printf("[ERROR] Error cancelling EC2 Capacity Reservation (%s): %s", id, err)
-			}
+				return err
+			}).WithTags(keyvaluetags.Ec2KeyValueTags(r.Tags).IgnoreAws().Map()))
 		}
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSEc2CapacityReservation_basic(t *testing.T) {
@@ -420,7 +416,7 @@ func testAccCheckEc2CapacityReservationExists(resourceName string, cr *ec2.Capac
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		resp, err := conn.DescribeCapacityReservations(&ec2.DescribeCapacityReservationsInput{
 			CapacityReservationIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -445,7 +441,7 @@ func testAccCheckEc2CapacityReservationExists(resourceName string, cr *ec2.Capac
 }
 
 func testAccCheckEc2CapacityReservationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_capacity_reservation" {
@@ -472,7 +468,7 @@ func testAccCheckEc2CapacityReservationDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckAWSEc2CapacityReservation(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeCapacityReservationsInput{
 		MaxResults: aws.Int64(1),
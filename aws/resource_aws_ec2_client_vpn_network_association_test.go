@@ -13,27 +13,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
-		Name: "aws_ec2_client_vpn_network_association",
-		F:    testSweepEc2ClientVpnNetworkAssociations,
+	sweep.AddTestSweepers("aws_ec2_client_vpn_network_association", &sweep.Sweeper{
+		Name:    "aws_ec2_client_vpn_network_association",
+		Service: "ec2",
+		List:    testSweepEc2ClientVpnNetworkAssociations,
 		Dependencies: []string{
 			"aws_directory_service_directory",
 		},
 	})
 }
 
-func testSweepEc2ClientVpnNetworkAssociations(region string) error {
+func testSweepEc2ClientVpnNetworkAssociations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-
-	conn := client.(*AWSClient).ec2conn
-
+	conn := client.(*AWSClient).ec2conn()
+	var sweepables []sweep.Sweepable
 	var sweeperErrs *multierror.Error
 
 	input := &ec2.DescribeClientVpnEndpointsInput{}
@@ -43,10 +43,10 @@ func testSweepEc2ClientVpnNetworkAssociations(region string) error {
 		}
 
 		for _, clientVpnEndpoint := range page.ClientVpnEndpoints {
-
 			input := &ec2.DescribeClientVpnTargetNetworksInput{
 				ClientVpnEndpointId: clientVpnEndpoint.ClientVpnEndpointId,
 			}
+
 			err := conn.DescribeClientVpnTargetNetworksPages(input, func(page *ec2.DescribeClientVpnTargetNetworksOutput, isLast bool) bool {
 				if page == nil {
 					return !isLast
@@ -56,14 +56,9 @@ func testSweepEc2ClientVpnNetworkAssociations(region string) error {
 					networkAssociationID := aws.StringValue(networkAssociation.AssociationId)
 					clientVpnEndpointID := aws.StringValue(networkAssociation.ClientVpnEndpointId)
 
-					log.Printf("[INFO] Deleting Client VPN network association (%s,%s)", clientVpnEndpointID, networkAssociationID)
-					err := deleteClientVpnNetworkAssociation(conn, networkAssociationID, clientVpnEndpointID)
-
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting Client VPN network association (%s,%s): %w", clientVpnEndpointID, networkAssociationID, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					}
+					sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s,%s", clientVpnEndpointID, networkAssociationID), func() error {
+						return deleteClientVpnNetworkAssociation(conn, networkAssociationID, clientVpnEndpointID)
+					}))
 				}
 
 				return !isLast
@@ -73,6 +68,7 @@ func testSweepEc2ClientVpnNetworkAssociations(region string) error {
 				log.Printf("[WARN] Skipping Client VPN network association sweeper for %q: %s", region, err)
 				return false
 			}
+
 			if err != nil {
 				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Client VPN network associations: %w", err))
 				return false
@@ -81,15 +77,17 @@ func testSweepEc2ClientVpnNetworkAssociations(region string) error {
 
 		return !isLast
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Client VPN network association sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
+
 	if err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Client VPN network associations: %w", err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func testAccAwsEc2ClientVpnNetworkAssociation_basic(t *testing.T) {
@@ -197,7 +195,7 @@ func testAccAwsEc2ClientVpnNetworkAssociation_securityGroups(t *testing.T) {
 }
 
 func testAccCheckAwsEc2ClientVpnNetworkAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_client_vpn_network_association" {
@@ -230,7 +228,7 @@ func testAccCheckAwsEc2ClientVpnNetworkAssociationExists(name string, assoc *ec2
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		resp, err := conn.DescribeClientVpnTargetNetworks(&ec2.DescribeClientVpnTargetNetworksInput{
 			ClientVpnEndpointId: aws.String(rs.Primary.Attributes["client_vpn_endpoint_id"]),
@@ -56,7 +56,7 @@ func resourceAwsEc2ClientVpnRoute() *schema.Resource {
 }
 
 func resourceAwsEc2ClientVpnRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	endpointID := d.Get("client_vpn_endpoint_id").(string)
 	targetSubnetID := d.Get("target_vpc_subnet_id").(string)
@@ -86,7 +86,7 @@ func resourceAwsEc2ClientVpnRouteCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsEc2ClientVpnRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	resp, err := finder.ClientVpnRoute(conn,
 		d.Get("client_vpn_endpoint_id").(string),
@@ -126,7 +126,7 @@ func resourceAwsEc2ClientVpnRouteRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEc2ClientVpnRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	err := deleteClientVpnRoute(conn, &ec2.DeleteClientVpnRouteInput{
 		ClientVpnEndpointId:  aws.String(d.Get("client_vpn_endpoint_id").(string)),
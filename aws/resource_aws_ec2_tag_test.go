@@ -92,7 +92,7 @@ func TestAccAWSEc2Tag_Value(t *testing.T) {
 }
 
 func testAccCheckEc2TagDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_tag" {
@@ -136,7 +136,7 @@ func testAccCheckEc2TagExists(n string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		exists, _, err := keyvaluetags.Ec2GetTag(conn, resourceID, key)
 
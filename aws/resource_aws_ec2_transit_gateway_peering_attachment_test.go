@@ -7,28 +7,30 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
-		Name: "aws_ec2_transit_gateway_peering_attachment",
-		F:    testSweepEc2TransitGatewayPeeringAttachments,
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &sweep.Sweeper{
+		Name:    "aws_ec2_transit_gateway_peering_attachment",
+		Service: "ec2",
+		List:    testSweepEc2TransitGatewayPeeringAttachments,
 	})
 }
 
-func testSweepEc2TransitGatewayPeeringAttachments(region string) error {
+func testSweepEc2TransitGatewayPeeringAttachments(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeTransitGatewayPeeringAttachmentsPages(input,
 		func(page *ec2.DescribeTransitGatewayPeeringAttachmentsOutput, lastPage bool) bool {
@@ -39,44 +41,39 @@ func testSweepEc2TransitGatewayPeeringAttachments(region string) error {
 
 				id := aws.StringValue(transitGatewayPeeringAttachment.TransitGatewayAttachmentId)
 
-				input := &ec2.DeleteTransitGatewayPeeringAttachmentInput{
-					TransitGatewayAttachmentId: aws.String(id),
-				}
+				sweepables = append(sweepables, sweep.NewResource(id, func() error {
+					_, err := conn.DeleteTransitGatewayPeeringAttachment(&ec2.DeleteTransitGatewayPeeringAttachmentInput{
+						TransitGatewayAttachmentId: aws.String(id),
+					})
 
-				log.Printf("[INFO] Deleting EC2 Transit Gateway Peering Attachment: %s", id)
-				_, err := conn.DeleteTransitGatewayPeeringAttachment(input)
+					if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
+						return nil
+					}
 
-				if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
-					continue
-				}
+					if err != nil {
+						return err
+					}
 
-				if err != nil {
-					sweeperErr := fmt.Errorf("error deleting EC2 Transit Gateway Peering Attachment (%s): %w", id, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
+					if err := waitForEc2TransitGatewayPeeringAttachmentDeletion(conn, id); err != nil {
+						return fmt.Errorf("error waiting for deletion: %w", err)
+					}
 
-				if err := waitForEc2TransitGatewayPeeringAttachmentDeletion(conn, id); err != nil {
-					sweeperErr := fmt.Errorf("error waiting for EC2 Transit Gateway Peering Attachment (%s) deletion: %w", id, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
+					return nil
+				}).WithTags(keyvaluetags.Ec2KeyValueTags(transitGatewayPeeringAttachment.Tags).IgnoreAws().Map()))
 			}
 			return !lastPage
 		})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 Transit Gateway Peering Attachment sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error retrieving EC2 Transit Gateway Peering Attachments: %s", err)
+		return sweepables, fmt.Errorf("error retrieving EC2 Transit Gateway Peering Attachments: %s", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSEc2TransitGatewayPeeringAttachment_basic(t *testing.T) {
@@ -251,7 +248,7 @@ func testAccCheckAWSEc2TransitGatewayPeeringAttachmentExists(resourceName string
 			return fmt.Errorf("No EC2 Transit Gateway Peering Attachment ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		attachment, err := ec2DescribeTransitGatewayPeeringAttachment(conn, rs.Primary.ID)
 
@@ -274,7 +271,7 @@ func testAccCheckAWSEc2TransitGatewayPeeringAttachmentExists(resourceName string
 }
 
 func testAccCheckAWSEc2TransitGatewayPeeringAttachmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_peering_attachment" {
@@ -305,7 +302,7 @@ func testAccCheckAWSEc2TransitGatewayPeeringAttachmentDestroy(s *terraform.State
 
 func testAccCheckAWSEc2TransitGatewayPeeringAttachmentDisappears(transitGatewayPeeringAttachment *ec2.TransitGatewayPeeringAttachment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		input := &ec2.DeleteTransitGatewayPeeringAttachmentInput{
 			TransitGatewayAttachmentId: transitGatewayPeeringAttachment.TransitGatewayAttachmentId,
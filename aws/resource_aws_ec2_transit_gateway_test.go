@@ -12,12 +12,15 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
-		Name: "aws_ec2_transit_gateway",
-		F:    testSweepEc2TransitGateways,
+	sweep.AddTestSweepers("aws_ec2_transit_gateway", &sweep.Sweeper{
+		Name:    "aws_ec2_transit_gateway",
+		Service: "ec2",
+		List:    testSweepEc2TransitGateways,
 		Dependencies: []string{
 			"aws_dx_gateway_association",
 			"aws_ec2_transit_gateway_vpc_attachment",
@@ -27,24 +30,25 @@ func init() {
 	})
 }
 
-func testSweepEc2TransitGateways(region string) error {
+func testSweepEc2TransitGateways(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 	input := &ec2.DescribeTransitGatewaysInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.DescribeTransitGateways(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping EC2 Transit Gateway sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("error retrieving EC2 Transit Gateways: %s", err)
+			return sweepables, fmt.Errorf("error retrieving EC2 Transit Gateways: %s", err)
 		}
 
 		for _, transitGateway := range output.TransitGateways {
@@ -53,49 +57,51 @@ func testSweepEc2TransitGateways(region string) error {
 			}
 
 			id := aws.StringValue(transitGateway.TransitGatewayId)
-
 			input := &ec2.DeleteTransitGatewayInput{
 				TransitGatewayId: aws.String(id),
 			}
 
-			log.Printf("[INFO] Deleting EC2 Transit Gateway: %s", id)
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteTransitGateway(input)
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+					_, err := conn.DeleteTransitGateway(input)
 
-				if isAWSErr(err, "IncorrectState", "has non-deleted Transit Gateway Attachments") {
-					return resource.RetryableError(err)
-				}
+					if isAWSErr(err, "IncorrectState", "has non-deleted Transit Gateway Attachments") {
+						return resource.RetryableError(err)
+					}
 
-				if isAWSErr(err, "IncorrectState", "has non-deleted DirectConnect Gateway Attachments") {
-					return resource.RetryableError(err)
-				}
+					if isAWSErr(err, "IncorrectState", "has non-deleted DirectConnect Gateway Attachments") {
+						return resource.RetryableError(err)
+					}
 
-				if isAWSErr(err, "IncorrectState", "has non-deleted VPN Attachments") {
-					return resource.RetryableError(err)
-				}
+					if isAWSErr(err, "IncorrectState", "has non-deleted VPN Attachments") {
+						return resource.RetryableError(err)
+					}
+
+					if isAWSErr(err, "InvalidTransitGatewayID.NotFound", "") {
+						return nil
+					}
+
+					if err != nil {
+						return resource.NonRetryableError(err)
+					}
 
-				if isAWSErr(err, "InvalidTransitGatewayID.NotFound", "") {
 					return nil
+				})
+
+				if isResourceTimeoutError(err) {
+					_, err = conn.DeleteTransitGateway(input)
 				}
 
 				if err != nil {
-					return resource.NonRetryableError(err)
+					return err
 				}
 
-				return nil
-			})
-
-			if isResourceTimeoutError(err) {
-				_, err = conn.DeleteTransitGateway(input)
-			}
-
-			if err != nil {
-				return fmt.Errorf("error deleting EC2 Transit Gateway (%s): %s", id, err)
-			}
+				if err := waitForEc2TransitGatewayDeletion(conn, id); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
 
-			if err := waitForEc2TransitGatewayDeletion(conn, id); err != nil {
-				return fmt.Errorf("error waiting for EC2 Transit Gateway (%s) deletion: %s", id, err)
-			}
+				return nil
+			}).WithTags(keyvaluetags.Ec2KeyValueTags(transitGateway.Tags).IgnoreAws().Map()))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -105,7 +111,7 @@ func testSweepEc2TransitGateways(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSEc2TransitGateway_basic(t *testing.T) {
@@ -494,7 +500,7 @@ func testAccCheckAWSEc2TransitGatewayExists(resourceName string, transitGateway
 			return fmt.Errorf("No EC2 Transit Gateway ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		gateway, err := ec2DescribeTransitGateway(conn, rs.Primary.ID)
 
@@ -517,7 +523,7 @@ func testAccCheckAWSEc2TransitGatewayExists(resourceName string, transitGateway
 }
 
 func testAccCheckAWSEc2TransitGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway" {
@@ -568,7 +574,7 @@ func testAccCheckAWSEc2TransitGatewayRecreated(i, j *ec2.TransitGateway) resourc
 
 func testAccCheckAWSEc2TransitGatewayAssociationDefaultRouteTableVpcAttachmentAssociated(transitGateway *ec2.TransitGateway, transitGatewayVpcAttachment *ec2.TransitGatewayVpcAttachment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		attachmentID := aws.StringValue(transitGatewayVpcAttachment.TransitGatewayAttachmentId)
 		routeTableID := aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId)
@@ -588,7 +594,7 @@ func testAccCheckAWSEc2TransitGatewayAssociationDefaultRouteTableVpcAttachmentAs
 
 func testAccCheckAWSEc2TransitGatewayAssociationDefaultRouteTableVpcAttachmentNotAssociated(transitGateway *ec2.TransitGateway, transitGatewayVpcAttachment *ec2.TransitGatewayVpcAttachment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		attachmentID := aws.StringValue(transitGatewayVpcAttachment.TransitGatewayAttachmentId)
 		routeTableID := aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId)
@@ -608,7 +614,7 @@ func testAccCheckAWSEc2TransitGatewayAssociationDefaultRouteTableVpcAttachmentNo
 
 func testAccCheckAWSEc2TransitGatewayPropagationDefaultRouteTableVpcAttachmentNotPropagated(transitGateway *ec2.TransitGateway, transitGatewayVpcAttachment *ec2.TransitGatewayVpcAttachment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		attachmentID := aws.StringValue(transitGatewayVpcAttachment.TransitGatewayAttachmentId)
 		routeTableID := aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId)
@@ -628,7 +634,7 @@ func testAccCheckAWSEc2TransitGatewayPropagationDefaultRouteTableVpcAttachmentNo
 
 func testAccCheckAWSEc2TransitGatewayPropagationDefaultRouteTableVpcAttachmentPropagated(transitGateway *ec2.TransitGateway, transitGatewayVpcAttachment *ec2.TransitGatewayVpcAttachment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		attachmentID := aws.StringValue(transitGatewayVpcAttachment.TransitGatewayAttachmentId)
 		routeTableID := aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId)
@@ -647,7 +653,7 @@ func testAccCheckAWSEc2TransitGatewayPropagationDefaultRouteTableVpcAttachmentPr
 }
 
 func testAccPreCheckAWSEc2TransitGateway(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeTransitGatewaysInput{
 		MaxResults: aws.Int64(5),
@@ -13,33 +13,37 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
-		Name: "aws_ec2_transit_gateway_vpc_attachment",
-		F:    testSweepEc2TransitGatewayVpcAttachments,
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &sweep.Sweeper{
+		Name:    "aws_ec2_transit_gateway_vpc_attachment",
+		Service: "ec2",
+		List:    testSweepEc2TransitGatewayVpcAttachments,
 	})
 }
 
-func testSweepEc2TransitGatewayVpcAttachments(region string) error {
+func testSweepEc2TransitGatewayVpcAttachments(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 	input := &ec2.DescribeTransitGatewayAttachmentsInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.DescribeTransitGatewayAttachments(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping EC2 Transit Gateway VPC Attachment sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("error retrieving EC2 Transit Gateway VPC Attachments: %s", err)
+			return sweepables, fmt.Errorf("error retrieving EC2 Transit Gateway VPC Attachments: %s", err)
 		}
 
 		for _, attachment := range output.TransitGatewayAttachments {
@@ -53,24 +57,25 @@ func testSweepEc2TransitGatewayVpcAttachments(region string) error {
 
 			id := aws.StringValue(attachment.TransitGatewayAttachmentId)
 
-			input := &ec2.DeleteTransitGatewayVpcAttachmentInput{
-				TransitGatewayAttachmentId: aws.String(id),
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteTransitGatewayVpcAttachment(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+					TransitGatewayAttachmentId: aws.String(id),
+				})
 
-			log.Printf("[INFO] Deleting EC2 Transit Gateway VPC Attachment: %s", id)
-			_, err := conn.DeleteTransitGatewayVpcAttachment(input)
+				if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
+					return nil
+				}
 
-			if isAWSErr(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
-				continue
-			}
+				if err != nil {
+					return err
+				}
 
-			if err != nil {
-				return fmt.Errorf("error deleting EC2 Transit Gateway VPC Attachment (%s): %s", id, err)
-			}
+				if err := waitForEc2TransitGatewayVpcAttachmentDeletion(conn, id); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
 
-			if err := waitForEc2TransitGatewayVpcAttachmentDeletion(conn, id); err != nil {
-				return fmt.Errorf("error waiting for EC2 Transit Gateway VPC Attachment (%s) deletion: %s", id, err)
-			}
+				return nil
+			}).WithTags(keyvaluetags.Ec2KeyValueTags(attachment.Tags).IgnoreAws().Map()))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -80,7 +85,7 @@ func testSweepEc2TransitGatewayVpcAttachments(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSEc2TransitGatewayVpcAttachment_basic(t *testing.T) {
@@ -504,7 +509,7 @@ func testAccCheckAWSEc2TransitGatewayVpcAttachmentExists(resourceName string, tr
 			return fmt.Errorf("No EC2 Transit Gateway VPC Attachment ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		attachment, err := ec2DescribeTransitGatewayVpcAttachment(conn, rs.Primary.ID)
 
@@ -527,7 +532,7 @@ func testAccCheckAWSEc2TransitGatewayVpcAttachmentExists(resourceName string, tr
 }
 
 func testAccCheckAWSEc2TransitGatewayVpcAttachmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_route_table" {
@@ -558,7 +563,7 @@ func testAccCheckAWSEc2TransitGatewayVpcAttachmentDestroy(s *terraform.State) er
 
 func testAccCheckAWSEc2TransitGatewayVpcAttachmentDisappears(transitGatewayVpcAttachment *ec2.TransitGatewayVpcAttachment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		input := &ec2.DeleteTransitGatewayVpcAttachmentInput{
 			TransitGatewayAttachmentId: transitGatewayVpcAttachment.TransitGatewayAttachmentId,
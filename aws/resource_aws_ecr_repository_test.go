@@ -8,63 +8,64 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecr_repository", &resource.Sweeper{
-		Name: "aws_ecr_repository",
-		F:    testSweepEcrRepositories,
+	sweep.AddTestSweepers("aws_ecr_repository", &sweep.Sweeper{
+		Name:    "aws_ecr_repository",
+		Service: "ecr",
+		List:    testSweepEcrRepositories,
 	})
 }
 
-func testSweepEcrRepositories(region string) error {
+func testSweepEcrRepositories(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).ecrconn
+	conn := client.(*AWSClient).ecrconn()
+	var sweepables []sweep.Sweepable
 
-	var errors error
 	err = conn.DescribeRepositoriesPages(&ecr.DescribeRepositoriesInput{}, func(page *ecr.DescribeRepositoriesOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
 		}
 
 		for _, repository := range page.Repositories {
-			repositoryName := aws.StringValue(repository.RepositoryName)
-			log.Printf("[INFO] Deleting ECR repository: %s", repositoryName)
-
-			_, err = conn.DeleteRepository(&ecr.DeleteRepositoryInput{
+			input := &ecr.DeleteRepositoryInput{
 				// We should probably sweep repositories even if there are images.
 				Force:          aws.Bool(true),
 				RegistryId:     repository.RegistryId,
 				RepositoryName: repository.RepositoryName,
-			})
-			if err != nil {
-				if !isAWSErr(err, ecr.ErrCodeRepositoryNotFoundException, "") {
-					sweeperErr := fmt.Errorf("Error deleting ECR repository (%s): %w", repositoryName, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					errors = multierror.Append(errors, sweeperErr)
-				}
-				continue
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(repository.RepositoryName), func() error {
+				_, err := conn.DeleteRepository(input)
+
+				if isAWSErr(err, ecr.ErrCodeRepositoryNotFoundException, "") {
+					return nil
+				}
+
+				return err
+			}))
 		}
 
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping ECR repository sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		errors = multierror.Append(errors, fmt.Errorf("Error retreiving ECR repositories: %w", err))
+		return sweepables, fmt.Errorf("Error retreiving ECR repositories: %w", err)
 	}
 
-	return errors
+	return sweepables, nil
 }
 
 func TestAccAWSEcrRepository_basic(t *testing.T) {
@@ -294,7 +295,7 @@ func TestAccAWSEcrRepository_encryption_aes256(t *testing.T) {
 }
 
 func testAccCheckAWSEcrRepositoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecrconn
+	conn := testAccProvider.Meta().(*AWSClient).ecrconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecr_repository" {
@@ -336,7 +337,7 @@ func testAccCheckAWSEcrRepositoryExists(name string, res *ecr.Repository) resour
 			return fmt.Errorf("No ECR repository ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecrconn
+		conn := testAccProvider.Meta().(*AWSClient).ecrconn()
 
 		output, err := conn.DescribeRepositories(&ecr.DescribeRepositoriesInput{
 			RepositoryNames: aws.StringSlice([]string{rs.Primary.ID}),
@@ -7,17 +7,19 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecs_capacity_provider", &resource.Sweeper{
-		Name: "aws_ecs_capacity_provider",
-		F:    testSweepEcsCapacityProviders,
+	sweep.AddTestSweepers("aws_ecs_capacity_provider", &sweep.Sweeper{
+		Name:    "aws_ecs_capacity_provider",
+		Service: "ecs",
+		List:    testSweepEcsCapacityProviders,
 		Dependencies: []string{
 			"aws_ecs_cluster",
 			"aws_ecs_service",
@@ -25,28 +27,25 @@ func init() {
 	})
 }
 
-func testSweepEcsCapacityProviders(region string) error {
+func testSweepEcsCapacityProviders(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-
-	conn := client.(*AWSClient).ecsconn
+	conn := client.(*AWSClient).ecsconn()
 	input := &ecs.DescribeCapacityProvidersInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.DescribeCapacityProviders(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping ECS Capacity Provider sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil()
+			return sweepables, nil
 		}
 
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving ECS Capacity Provider: %w", err))
-			return sweeperErrs
+			return sweepables, fmt.Errorf("error retrieving ECS Capacity Provider: %w", err)
 		}
 
 		for _, capacityProvider := range output.CapacityProviders {
@@ -60,31 +59,26 @@ func testSweepEcsCapacityProviders(region string) error {
 			}
 
 			if aws.StringValue(capacityProvider.Name) == "FARGATE" || aws.StringValue(capacityProvider.Name) == "FARGATE_SPOT" {
-				log.Printf("[INFO] Skipping AWS managed ECS Capacity Provider: %s", arn)
 				continue
 			}
 
 			if aws.StringValue(capacityProvider.Status) == ecs.CapacityProviderStatusInactive {
-				log.Printf("[INFO] Skipping ECS Capacity Provider with INACTIVE status: %s", arn)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting ECS Capacity Provider: %s", arn)
-			_, err := conn.DeleteCapacityProvider(input)
+			sweepables = append(sweepables, sweep.NewResource(arn, func() error {
+				_, err := conn.DeleteCapacityProvider(input)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting ECS Capacity Provider (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				if err != nil {
+					return err
+				}
 
-			if _, err := waiter.CapacityProviderInactive(conn, arn); err != nil {
-				sweeperErr := fmt.Errorf("error waiting for ECS Capacity Provider (%s) to delete: %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				if _, err := waiter.CapacityProviderInactive(conn, arn); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}).WithName(aws.StringValue(capacityProvider.Name)).WithTags(keyvaluetags.EcsKeyValueTags(capacityProvider.Tags).IgnoreAws().Map()))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -94,7 +88,7 @@ func testSweepEcsCapacityProviders(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSEcsCapacityProvider_basic(t *testing.T) {
@@ -272,7 +266,7 @@ func TestAccAWSEcsCapacityProvider_Tags(t *testing.T) {
 // TODO add an update test config - Reference: https://github.com/aws/containers-roadmap/issues/633
 
 func testAccCheckAWSEcsCapacityProviderDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+	conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecs_capacity_provider" {
@@ -306,7 +300,7 @@ func testAccCheckAWSEcsCapacityProviderExists(resourceName string, provider *ecs
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		input := &ecs.DescribeCapacityProvidersInput{
 			CapacityProviders: []*string{aws.String(rs.Primary.ID)},
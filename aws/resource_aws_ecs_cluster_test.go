@@ -10,24 +10,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
-		Name: "aws_ecs_cluster",
-		F:    testSweepEcsClusters,
+	sweep.AddTestSweepers("aws_ecs_cluster", &sweep.Sweeper{
+		Name:    "aws_ecs_cluster",
+		Service: "ecs",
+		List:    testSweepEcsClusters,
 		Dependencies: []string{
 			"aws_ecs_service",
 		},
 	})
 }
 
-func testSweepEcsClusters(region string) error {
+func testSweepEcsClusters(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ecsconn
+	conn := client.(*AWSClient).ecsconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListClustersPages(&ecs.ListClustersInput{}, func(page *ecs.ListClustersOutput, isLast bool) bool {
 		if page == nil {
@@ -38,27 +41,26 @@ func testSweepEcsClusters(region string) error {
 			input := &ecs.DeleteClusterInput{
 				Cluster: clusterARNPtr,
 			}
-			clusterARN := aws.StringValue(clusterARNPtr)
 
-			log.Printf("[INFO] Deleting ECS Cluster: %s", clusterARN)
-			_, err = conn.DeleteCluster(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(clusterARNPtr), func() error {
+				_, err := conn.DeleteCluster(input)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting ECS Cluster (%s): %s", clusterARN, err)
-			}
+				return err
+			}))
 		}
 
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping ECS Cluster sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("error retrieving ECS Clusters: %s", err)
+		return sweepables, fmt.Errorf("error retrieving ECS Clusters: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSEcsCluster_basic(t *testing.T) {
@@ -330,7 +332,7 @@ func TestAccAWSEcsCluster_containerInsights(t *testing.T) {
 }
 
 func testAccCheckAWSEcsClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+	conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecs_cluster" {
@@ -362,7 +364,7 @@ func testAccCheckAWSEcsClusterExists(resourceName string, cluster *ecs.Cluster)
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		input := &ecs.DescribeClustersInput{
 			Clusters: []*string{aws.String(rs.Primary.ID)},
@@ -388,7 +390,7 @@ func testAccCheckAWSEcsClusterExists(resourceName string, cluster *ecs.Cluster)
 
 func testAccCheckAWSEcsClusterDisappears(cluster *ecs.Cluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		input := &ecs.DeleteClusterInput{
 			Cluster: cluster.ClusterArn,
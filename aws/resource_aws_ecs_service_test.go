@@ -11,24 +11,30 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
-		Name: "aws_ecs_service",
-		F:    testSweepEcsServices,
+	sweep.AddTestSweepers("aws_ecs_service", &sweep.Sweeper{
+		Name:    "aws_ecs_service",
+		Service: "ecs",
+		List:    testSweepEcsServices,
 	})
 }
 
-func testSweepEcsServices(region string) error {
+func testSweepEcsServices(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ecsconn
+	conn := client.(*AWSClient).ecsconn()
+	var sweepables []sweep.Sweepable
+	var sweeperErrs *multierror.Error
 
 	err = conn.ListClustersPages(&ecs.ListClustersInput{}, func(page *ecs.ListClustersOutput, isLast bool) bool {
 		if page == nil {
@@ -40,7 +46,7 @@ func testSweepEcsServices(region string) error {
 				Cluster: clusterARNPtr,
 			}
 
-			err = conn.ListServicesPages(input, func(page *ecs.ListServicesOutput, isLast bool) bool {
+			err := conn.ListServicesPages(input, func(page *ecs.ListServicesOutput, isLast bool) bool {
 				if page == nil {
 					return !isLast
 				}
@@ -52,7 +58,6 @@ func testSweepEcsServices(region string) error {
 					}
 					serviceARN := aws.StringValue(serviceARNPtr)
 
-					log.Printf("[DEBUG] Describing ECS Service: %s", serviceARN)
 					describeServicesOutput, err := conn.DescribeServices(describeServicesInput)
 
 					if isAWSErr(err, ecs.ErrCodeServiceNotFoundException, "") {
@@ -60,7 +65,7 @@ func testSweepEcsServices(region string) error {
 					}
 
 					if err != nil {
-						log.Printf("[ERROR] Error describing ECS Service (%s): %s", serviceARN, err)
+						sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing ECS Service (%s): %w", serviceARN, err))
 						continue
 					}
 
@@ -80,29 +85,34 @@ func testSweepEcsServices(region string) error {
 						Service: service.ServiceArn,
 					}
 
-					log.Printf("[INFO] Deleting ECS Service: %s", serviceARN)
-					_, err = conn.DeleteService(deleteServiceInput)
+					sweepables = append(sweepables, sweep.NewResource(serviceARN, func() error {
+						_, err := conn.DeleteService(deleteServiceInput)
 
-					if err != nil {
-						log.Printf("[ERROR] Error deleting ECS Service (%s): %s", serviceARN, err)
-					}
+						return err
+					}).WithName(aws.StringValue(service.ServiceName)).WithTags(keyvaluetags.EcsKeyValueTags(service.Tags).IgnoreAws().Map()))
 				}
 
 				return !isLast
 			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing ECS Services for Cluster (%s): %w", aws.StringValue(clusterARNPtr), err))
+			}
 		}
 
 		return !isLast
 	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping ECS Service sweep for %s: %s", region, err)
+		return sweepables, sweeperErrs.ErrorOrNil()
+	}
+
 	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping ECS Service sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error retrieving ECS Services: %s", err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving ECS Services: %w", err))
 	}
 
-	return nil
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSEcsService_withARN(t *testing.T) {
@@ -1239,7 +1249,7 @@ func TestAccAWSEcsService_PropagateTags(t *testing.T) {
 }
 
 func testAccCheckAWSEcsServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+	conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecs_service" {
@@ -1281,7 +1291,7 @@ func testAccCheckAWSEcsServiceExists(name string, service *ecs.Service) resource
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		input := &ecs.DescribeServicesInput{
 			Cluster:  aws.String(rs.Primary.Attributes["cluster"]),
@@ -1321,7 +1331,7 @@ func testAccCheckAWSEcsServiceExists(name string, service *ecs.Service) resource
 
 func testAccCheckAWSEcsServiceDisappears(service *ecs.Service) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		input := &ecs.DeleteServiceInput{
 			Cluster: service.ClusterArn,
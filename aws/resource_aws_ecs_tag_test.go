@@ -0,0 +1,19 @@
+package aws
+
+import (
+	"fmt"
+)
+
+func testAccEcsTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_tag" "test" {
+  resource_id = aws_ecs_cluster.test.arn
+  key         = %[2]q
+  value       = %[3]q
+}
+`, rName, key, value)
+}
@@ -8,29 +8,30 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
-		Name: "aws_ecs_task_definition",
-		F:    testSweepEcsTaskDefinitions,
+	sweep.AddTestSweepers("aws_ecs_task_definition", &sweep.Sweeper{
+		Name:    "aws_ecs_task_definition",
+		Service: "ecs",
+		List:    testSweepEcsTaskDefinitions,
 		Dependencies: []string{
 			"aws_ecs_service",
 		},
 	})
 }
 
-func testSweepEcsTaskDefinitions(region string) error {
+func testSweepEcsTaskDefinitions(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ecsconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).ecsconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListTaskDefinitionsPages(&ecs.ListTaskDefinitionsInput{}, func(page *ecs.ListTaskDefinitionsOutput, isLast bool) bool {
 		if page == nil {
@@ -38,31 +39,30 @@ func testSweepEcsTaskDefinitions(region string) error {
 		}
 
 		for _, taskDefinitionArn := range page.TaskDefinitionArns {
-			arn := aws.StringValue(taskDefinitionArn)
-
-			log.Printf("[INFO] Deleting ECS Task Definition: %s", arn)
-			_, err := conn.DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{
-				TaskDefinition: aws.String(arn),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting ECS Task Definition (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
+			input := &ecs.DeregisterTaskDefinitionInput{
+				TaskDefinition: taskDefinitionArn,
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(taskDefinitionArn), func() error {
+				_, err := conn.DeregisterTaskDefinition(input)
+
+				return err
+			}))
 		}
 
 		return !isLast
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping ECS Task Definitions sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving ECS Task Definitions: %w", err))
+		return sweepables, fmt.Errorf("error retrieving ECS Task Definitions: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSEcsTaskDefinition_basic(t *testing.T) {
@@ -914,7 +914,7 @@ func TestValidateAwsEcsTaskDefinitionContainerDefinitions(t *testing.T) {
 }
 
 func testAccCheckAWSEcsTaskDefinitionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+	conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecs_task_definition" {
@@ -946,7 +946,7 @@ func testAccCheckAWSEcsTaskDefinitionExists(name string, def *ecs.TaskDefinition
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		out, err := conn.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
 			TaskDefinition: aws.String(rs.Primary.Attributes["arn"]),
@@ -12,74 +12,76 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_efs_access_point", &resource.Sweeper{
-		Name: "aws_efs_access_point",
-		F:    testSweepEfsAccessPoints,
+	sweep.AddTestSweepers("aws_efs_access_point", &sweep.Sweeper{
+		Name:    "aws_efs_access_point",
+		Service: "efs",
+		List:    testSweepEfsAccessPoints,
 	})
 }
 
-func testSweepEfsAccessPoints(region string) error {
+func testSweepEfsAccessPoints(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).efsconn
+	conn := client.(*AWSClient).efsconn()
+	var sweepables []sweep.Sweepable
 	var sweeperErrs *multierror.Error
 
-	var errors error
 	input := &efs.DescribeFileSystemsInput{}
 	err = conn.DescribeFileSystemsPages(input, func(page *efs.DescribeFileSystemsOutput, lastPage bool) bool {
 		for _, filesystem := range page.FileSystems {
 			id := aws.StringValue(filesystem.FileSystemId)
-			log.Printf("[INFO] Deleting access points for EFS File System: %s", id)
-
 			input := &efs.DescribeAccessPointsInput{
 				FileSystemId: filesystem.FileSystemId,
 			}
+
 			for {
 				out, err := conn.DescribeAccessPoints(input)
+
 				if err != nil {
-					errors = multierror.Append(errors, fmt.Errorf("error retrieving EFS access points on File System %q: %w", id, err))
+					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving EFS access points on File System %q: %w", id, err))
 					break
 				}
 
-				if out == nil || len(out.AccessPoints) == 0 {
-					log.Printf("[INFO] No EFS access points to sweep on File System %q", id)
+				if out == nil {
 					break
 				}
 
-				for _, AccessPoint := range out.AccessPoints {
-					id := aws.StringValue(AccessPoint.AccessPointId)
-
-					log.Printf("[INFO] Deleting EFS access point: %s", id)
+				for _, accessPoint := range out.AccessPoints {
 					r := resourceAwsEfsAccessPoint()
 					d := r.Data(nil)
-					d.SetId(id)
-					err := r.Delete(d, client)
-
-					if err != nil {
-						log.Printf("[ERROR] %s", err)
-						sweeperErrs = multierror.Append(sweeperErrs, err)
-						continue
-					}
+					d.SetId(aws.StringValue(accessPoint.AccessPointId))
+					d.Set("tags", keyvaluetags.EfsKeyValueTags(accessPoint.Tags).IgnoreAws().Map())
+
+					sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 				}
 
 				if out.NextToken == nil {
 					break
 				}
+
 				input.NextToken = out.NextToken
 			}
 		}
 		return true
 	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping EFS Access Point sweep for %s: %s", region, err)
+		return sweepables, sweeperErrs.ErrorOrNil()
+	}
+
 	if err != nil {
-		errors = multierror.Append(errors, fmt.Errorf("error retrieving EFS File Systems: %w", err))
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving EFS File Systems: %w", err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSEFSAccessPoint_basic(t *testing.T) {
@@ -299,7 +301,7 @@ func TestAccAWSEFSAccessPoint_disappears(t *testing.T) {
 }
 
 func testAccCheckEfsAccessPointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).efsconn
+	conn := testAccProvider.Meta().(*AWSClient).efsconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_efs_access_point" {
 			continue
@@ -338,7 +340,7 @@ func testAccCheckEfsAccessPointExists(resourceID string, mount *efs.AccessPointD
 			return fmt.Errorf("Not found: %s", resourceID)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 		mt, err := conn.DescribeAccessPoints(&efs.DescribeAccessPointsInput{
 			AccessPointId: aws.String(fs.Primary.ID),
 		})
@@ -9,16 +9,18 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/efs"
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_efs_file_system", &resource.Sweeper{
-		Name: "aws_efs_file_system",
-		F:    testSweepEfsFileSystems,
+	sweep.AddTestSweepers("aws_efs_file_system", &sweep.Sweeper{
+		Name:    "aws_efs_file_system",
+		Service: "efs",
+		List:    testSweepEfsFileSystems,
 		Dependencies: []string{
 			"aws_efs_mount_target",
 			"aws_efs_access_point",
@@ -26,42 +28,48 @@ func init() {
 	})
 }
 
-func testSweepEfsFileSystems(region string) error {
+func testSweepEfsFileSystems(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).efsconn
+	conn := client.(*AWSClient).efsconn()
+	var sweepables []sweep.Sweepable
 
-	var errors error
 	input := &efs.DescribeFileSystemsInput{}
 	err = conn.DescribeFileSystemsPages(input, func(page *efs.DescribeFileSystemsOutput, lastPage bool) bool {
 		for _, filesystem := range page.FileSystems {
 			id := aws.StringValue(filesystem.FileSystemId)
 
-			log.Printf("[INFO] Deleting EFS File System: %s", id)
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteFileSystem(&efs.DeleteFileSystemInput{
+					FileSystemId: aws.String(id),
+				})
 
-			_, err := conn.DeleteFileSystem(&efs.DeleteFileSystemInput{
-				FileSystemId: filesystem.FileSystemId,
-			})
-			if err != nil {
-				errors = multierror.Append(errors, fmt.Errorf("error deleting EFS File System %q: %w", id, err))
-				continue
-			}
+				if err != nil {
+					return err
+				}
 
-			err = waitForDeleteEfsFileSystem(conn, id, 10*time.Minute)
-			if err != nil {
-				errors = multierror.Append(fmt.Errorf("error waiting for EFS File System %q to delete: %w", id, err))
-				continue
-			}
+				if err := waitForDeleteEfsFileSystem(conn, id, 10*time.Minute); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}).WithName(aws.StringValue(filesystem.Name)).WithTags(keyvaluetags.EfsKeyValueTags(filesystem.Tags).IgnoreAws().Map()))
 		}
 		return true
 	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping EFS File System sweep for %s: %s", region, err)
+		return sweepables, nil
+	}
+
 	if err != nil {
-		errors = multierror.Append(errors, fmt.Errorf("error retrieving EFS File Systems: %w", err))
+		return sweepables, fmt.Errorf("error retrieving EFS File Systems: %w", err)
 	}
 
-	return errors
+	return sweepables, nil
 }
 
 func TestResourceAWSEFSFileSystem_hasEmptyFileSystems(t *testing.T) {
@@ -454,7 +462,7 @@ func TestAccAWSEFSFileSystem_disappears(t *testing.T) {
 }
 
 func testAccCheckEfsFileSystemDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).efsconn
+	conn := testAccProvider.Meta().(*AWSClient).efsconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_efs_file_system" {
 			continue
@@ -489,7 +497,7 @@ func testAccCheckEfsFileSystem(resourceID string, fDesc *efs.FileSystemDescripti
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 		fs, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
@@ -510,7 +518,7 @@ func testAccCheckEfsFileSystem(resourceID string, fDesc *efs.FileSystemDescripti
 
 func testAccCheckEfsFileSystemDisappears(fDesc *efs.FileSystemDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 
 		input := &efs.DeleteFileSystemInput{
 			FileSystemId: fDesc.FileSystemId,
@@ -533,7 +541,7 @@ func testAccCheckEfsCreationToken(resourceID string, expectedToken string) resou
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 		resp, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
@@ -559,7 +567,7 @@ func testAccCheckEfsFileSystemPerformanceMode(resourceID string, expectedMode st
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 		resp, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
@@ -589,7 +597,7 @@ func testAccCheckEfsFileSystemLifecyclePolicy(resourceID string, expectedVal str
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 		resp, err := conn.DescribeFileSystems(&efs.DescribeFileSystemsInput{
 			FileSystemId: aws.String(rs.Primary.ID),
 		})
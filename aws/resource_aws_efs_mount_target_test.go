@@ -13,77 +13,86 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_efs_mount_target", &resource.Sweeper{
-		Name: "aws_efs_mount_target",
-		F:    testSweepEfsMountTargets,
+	sweep.AddTestSweepers("aws_efs_mount_target", &sweep.Sweeper{
+		Name:    "aws_efs_mount_target",
+		Service: "efs",
+		List:    testSweepEfsMountTargets,
 	})
 }
 
-func testSweepEfsMountTargets(region string) error {
+func testSweepEfsMountTargets(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).efsconn
+	conn := client.(*AWSClient).efsconn()
+	var sweepables []sweep.Sweepable
+	var sweeperErrs *multierror.Error
 
-	var errors error
 	input := &efs.DescribeFileSystemsInput{}
 	err = conn.DescribeFileSystemsPages(input, func(page *efs.DescribeFileSystemsOutput, lastPage bool) bool {
 		for _, filesystem := range page.FileSystems {
 			id := aws.StringValue(filesystem.FileSystemId)
-			log.Printf("[INFO] Deleting Mount Targets for EFS File System: %s", id)
-
-			var errors error
 			input := &efs.DescribeMountTargetsInput{
 				FileSystemId: filesystem.FileSystemId,
 			}
+
 			for {
 				out, err := conn.DescribeMountTargets(input)
+
 				if err != nil {
-					errors = multierror.Append(errors, fmt.Errorf("error retrieving EFS Mount Targets on File System %q: %w", id, err))
+					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving EFS Mount Targets on File System %q: %w", id, err))
 					break
 				}
 
-				if out == nil || len(out.MountTargets) == 0 {
-					log.Printf("[INFO] No EFS Mount Targets to sweep on File System %q", id)
+				if out == nil {
 					break
 				}
 
 				for _, mounttarget := range out.MountTargets {
 					id := aws.StringValue(mounttarget.MountTargetId)
 
-					log.Printf("[INFO] Deleting EFS Mount Target: %s", id)
-					_, err := conn.DeleteMountTarget(&efs.DeleteMountTargetInput{
-						MountTargetId: mounttarget.MountTargetId,
-					})
-					if err != nil {
-						errors = multierror.Append(errors, fmt.Errorf("error deleting EFS Mount Target %q: %w", id, err))
-						continue
-					}
-
-					err = waitForDeleteEfsMountTarget(conn, id, 10*time.Minute)
-					if err != nil {
-						errors = multierror.Append(errors, fmt.Errorf("error waiting for EFS Mount Target %q to delete: %w", id, err))
-						continue
-					}
+					sweepables = append(sweepables, sweep.NewResource(id, func() error {
+						_, err := conn.DeleteMountTarget(&efs.DeleteMountTargetInput{
+							MountTargetId: aws.String(id),
+						})
+
+						if err != nil {
+							return err
+						}
+
+						if err := waitForDeleteEfsMountTarget(conn, id, 10*time.Minute); err != nil {
+							return fmt.Errorf("error waiting for deletion: %w", err)
+						}
+
+						return nil
+					}))
 				}
 
 				if out.NextMarker == nil {
 					break
 				}
+
 				input.Marker = out.NextMarker
 			}
 		}
 		return true
 	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping EFS Mount Target sweep for %s: %s", region, err)
+		return sweepables, sweeperErrs.ErrorOrNil()
+	}
+
 	if err != nil {
-		errors = multierror.Append(errors, fmt.Errorf("error retrieving EFS File Systems: %w", err))
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving EFS File Systems: %w", err))
 	}
 
-	return errors
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSEFSMountTarget_basic(t *testing.T) {
@@ -223,7 +232,7 @@ func TestResourceAWSEFSMountTarget_hasEmptyMountTargets(t *testing.T) {
 }
 
 func testAccCheckEfsMountTargetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).efsconn
+	conn := testAccProvider.Meta().(*AWSClient).efsconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_efs_mount_target" {
 			continue
@@ -263,7 +272,7 @@ func testAccCheckEfsMountTarget(resourceID string, mount *efs.MountTargetDescrip
 			return fmt.Errorf("Not found: %s", resourceID)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).efsconn
+		conn := testAccProvider.Meta().(*AWSClient).efsconn()
 		mt, err := conn.DescribeMountTargets(&efs.DescribeMountTargetsInput{
 			MountTargetId: aws.String(fs.Primary.ID),
 		})
@@ -9,41 +9,42 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
-		Name: "aws_egress_only_internet_gateway",
-		F:    testSweepEc2EgressOnlyInternetGateways,
+	sweep.AddTestSweepers("aws_egress_only_internet_gateway", &sweep.Sweeper{
+		Name:    "aws_egress_only_internet_gateway",
+		Service: "ec2",
+		List:    testSweepEc2EgressOnlyInternetGateways,
 	})
 }
 
-func testSweepEc2EgressOnlyInternetGateways(region string) error {
+func testSweepEc2EgressOnlyInternetGateways(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
-
+	conn := client.(*AWSClient).ec2conn()
 	input := &ec2.DescribeEgressOnlyInternetGatewaysInput{}
+	var sweepables []sweep.Sweepable
+
 	err = conn.DescribeEgressOnlyInternetGatewaysPages(input, func(page *ec2.DescribeEgressOnlyInternetGatewaysOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
 		for _, gateway := range page.EgressOnlyInternetGateways {
-			id := aws.StringValue(gateway.EgressOnlyInternetGatewayId)
 			input := &ec2.DeleteEgressOnlyInternetGatewayInput{
 				EgressOnlyInternetGatewayId: gateway.EgressOnlyInternetGatewayId,
 			}
 
-			log.Printf("[INFO] Deleting EC2 Egress Only Internet Gateway: %s", id)
-
-			_, err := conn.DeleteEgressOnlyInternetGateway(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(gateway.EgressOnlyInternetGatewayId), func() error {
+				_, err := conn.DeleteEgressOnlyInternetGateway(input)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting EC2 Egress Only Internet Gateway (%s): %s", id, err)
-			}
+				return err
+			}).WithTags(keyvaluetags.Ec2KeyValueTags(gateway.Tags).IgnoreAws().Map()))
 		}
 
 		return !lastPage
@@ -51,14 +52,14 @@ func testSweepEc2EgressOnlyInternetGateways(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 Egress Only Internet Gateway sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error describing EC2 Egress Only Internet Gateways: %s", err)
+		return sweepables, fmt.Errorf("Error describing EC2 Egress Only Internet Gateways: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSEgressOnlyInternetGateway_basic(t *testing.T) {
@@ -131,7 +132,7 @@ func TestAccAWSEgressOnlyInternetGateway_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSEgressOnlyInternetGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_egress_only_internet_gateway" {
@@ -166,7 +167,7 @@ func testAccCheckAWSEgressOnlyInternetGatewayExists(n string, igw *ec2.EgressOnl
 			return fmt.Errorf("No Egress Only IGW ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		resp, err := conn.DescribeEgressOnlyInternetGateways(&ec2.DescribeEgressOnlyInternetGatewaysInput{
 			EgressOnlyInternetGatewayIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -180,7 +180,7 @@ func testAccCheckAWSEIPAssociationExists(name string, res *ec2.Address) resource
 			return fmt.Errorf("No EIP Association ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		platforms := testAccProvider.Meta().(*AWSClient).supportedplatforms
 
 		request, err := describeAddressesById(rs.Primary.ID, platforms)
@@ -213,7 +213,7 @@ func testAccCheckAWSEIPAssociationEc2ClassicExists(name string, res *ec2.Address
 			return fmt.Errorf("No EIP Association ID is set")
 		}
 
-		conn := testAccProviderEc2Classic.Meta().(*AWSClient).ec2conn
+		conn := testAccProviderEc2Classic.Meta().(*AWSClient).ec2conn()
 		platforms := testAccProviderEc2Classic.Meta().(*AWSClient).supportedplatforms
 
 		request, err := describeAddressesById(rs.Primary.ID, platforms)
@@ -267,7 +267,7 @@ func testAccCheckAWSEIPAssociationDestroy(s *terraform.State) error {
 			return fmt.Errorf("No EIP Association ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		request := &ec2.DescribeAddressesInput{
 			Filters: []*ec2.Filter{
@@ -14,27 +14,30 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // This will currently skip EIPs with associations,
 // although we depend on aws_vpc to potentially have
 // the majority of those associations removed.
 func init() {
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
-		Name: "aws_eip",
+	sweep.AddTestSweepers("aws_eip", &sweep.Sweeper{
+		Name:    "aws_eip",
+		Service: "ec2",
 		Dependencies: []string{
 			"aws_vpc",
 		},
-		F: testSweepEc2Eips,
+		List: testSweepEc2Eips,
 	})
 }
 
-func testSweepEc2Eips(region string) error {
+func testSweepEc2Eips(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 
 	// There is currently no paginator or Marker/NextToken
 	input := &ec2.DescribeAddressesInput{}
@@ -43,23 +46,21 @@ func testSweepEc2Eips(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 EIP sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error describing EC2 EIPs: %s", err)
+		return nil, fmt.Errorf("error describing EC2 EIPs: %s", err)
 	}
 
-	if output == nil || len(output.Addresses) == 0 {
-		log.Print("[DEBUG] No EC2 EIPs to sweep")
-		return nil
+	if output == nil {
+		return nil, nil
 	}
 
-	for _, address := range output.Addresses {
-		publicIP := aws.StringValue(address.PublicIp)
+	var sweepables []sweep.Sweepable
 
+	for _, address := range output.Addresses {
 		if address.AssociationId != nil {
-			log.Printf("[INFO] Skipping EC2 EIP (%s) with association: %s", publicIP, aws.StringValue(address.AssociationId))
 			continue
 		}
 
@@ -73,16 +74,14 @@ func testSweepEc2Eips(region string) error {
 			input.PublicIp = address.PublicIp
 		}
 
-		log.Printf("[INFO] Releasing EC2 EIP: %s", publicIP)
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(address.PublicIp), func() error {
+			_, err := conn.ReleaseAddress(input)
 
-		_, err := conn.ReleaseAddress(input)
-
-		if err != nil {
-			return fmt.Errorf("error releasing EC2 EIP (%s): %s", publicIP, err)
-		}
+			return err
+		}).WithTags(keyvaluetags.Ec2KeyValueTags(address.Tags).IgnoreAws().Map()))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSEIP_Ec2Classic(t *testing.T) {
@@ -531,7 +530,7 @@ func TestAccAWSEIP_PublicIpv4Pool_custom(t *testing.T) {
 }
 
 func testAccCheckAWSEIPDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_eip" {
@@ -634,7 +633,7 @@ func testAccCheckAWSEIPExists(n string, res *ec2.Address) resource.TestCheckFunc
 			return fmt.Errorf("No EIP ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		if strings.Contains(rs.Primary.ID, "eipalloc") {
 			req := &ec2.DescribeAddressesInput{
@@ -682,7 +681,7 @@ func testAccCheckAWSEIPEc2ClassicExists(n string, res *ec2.Address) resource.Tes
 			return fmt.Errorf("No EIP ID is set")
 		}
 
-		conn := testAccProviderEc2Classic.Meta().(*AWSClient).ec2conn
+		conn := testAccProviderEc2Classic.Meta().(*AWSClient).ec2conn()
 
 		input := &ec2.DescribeAddressesInput{
 			PublicIps: []*string{aws.String(rs.Primary.ID)},
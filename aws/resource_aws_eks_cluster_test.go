@@ -11,16 +11,17 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_eks_cluster", &resource.Sweeper{
-		Name: "aws_eks_cluster",
-		F:    testSweepEksClusters,
+	sweep.AddTestSweepers("aws_eks_cluster", &sweep.Sweeper{
+		Name:    "aws_eks_cluster",
+		Service: "eks",
+		List:    testSweepEksClusters,
 		Dependencies: []string{
 			"aws_eks_fargate_profile",
 			"aws_eks_fargate_node_group",
@@ -28,42 +29,44 @@ func init() {
 	})
 }
 
-func testSweepEksClusters(region string) error {
+func testSweepEksClusters(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).eksconn
+	conn := client.(*AWSClient).eksconn()
+	var sweepables []sweep.Sweepable
 
-	var errors error
 	input := &eks.ListClustersInput{}
 	err = conn.ListClustersPages(input, func(page *eks.ListClustersOutput, lastPage bool) bool {
 		for _, cluster := range page.Clusters {
 			name := aws.StringValue(cluster)
 
-			log.Printf("[INFO] Deleting EKS Cluster: %s", name)
-			err := deleteEksCluster(conn, name)
-			if err != nil {
-				errors = multierror.Append(errors, fmt.Errorf("error deleting EKS Cluster %q: %w", name, err))
-				continue
-			}
-			err = waitForDeleteEksCluster(conn, name, 15*time.Minute)
-			if err != nil {
-				errors = multierror.Append(errors, fmt.Errorf("error waiting for EKS Cluster %q deletion: %w", name, err))
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				if err := deleteEksCluster(conn, name); err != nil {
+					return err
+				}
+
+				if err := waitForDeleteEksCluster(conn, name, 15*time.Minute); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}))
 		}
 		return true
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EKS Clusters sweep for %s: %s", region, err)
-		return errors // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		errors = multierror.Append(errors, fmt.Errorf("error retrieving EKS Clusters: %w", err))
+		return sweepables, fmt.Errorf("error retrieving EKS Clusters: %w", err)
 	}
 
-	return errors
+	return sweepables, nil
 }
 
 func TestAccAWSEksCluster_basic(t *testing.T) {
@@ -498,7 +501,7 @@ func testAccCheckAWSEksClusterExists(resourceName string, cluster *eks.Cluster)
 			return fmt.Errorf("No EKS Cluster ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 		output, err := conn.DescribeCluster(&eks.DescribeClusterInput{
 			Name: aws.String(rs.Primary.ID),
 		})
@@ -526,7 +529,7 @@ func testAccCheckAWSEksClusterDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		// Handle eventual consistency
 		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
@@ -575,7 +578,7 @@ func testAccCheckAWSEksClusterNotRecreated(i, j *eks.Cluster) resource.TestCheck
 }
 
 func testAccPreCheckAWSEks(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).eksconn
+	conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 	input := &eks.ListClustersInput{}
 
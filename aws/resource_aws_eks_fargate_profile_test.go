@@ -14,23 +14,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_eks_fargate_profile", &resource.Sweeper{
-		Name: "aws_eks_fargate_profile",
-		F:    testSweepEksFargateProfiles,
+	sweep.AddTestSweepers("aws_eks_fargate_profile", &sweep.Sweeper{
+		Name:    "aws_eks_fargate_profile",
+		Service: "eks",
+		List:    testSweepEksFargateProfiles,
 	})
 }
 
-func testSweepEksFargateProfiles(region string) error {
+func testSweepEksFargateProfiles(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).eksconn
+	conn := client.(*AWSClient).eksconn()
+	var sweepables []sweep.Sweepable
+	var sweeperErrs *multierror.Error
 
-	var errors error
 	input := &eks.ListClustersInput{}
 	err = conn.ListClustersPages(input, func(page *eks.ListClustersOutput, lastPage bool) bool {
 		for _, cluster := range page.Clusters {
@@ -38,44 +41,53 @@ func testSweepEksFargateProfiles(region string) error {
 			input := &eks.ListFargateProfilesInput{
 				ClusterName: cluster,
 			}
+
 			err := conn.ListFargateProfilesPages(input, func(page *eks.ListFargateProfilesOutput, lastPage bool) bool {
-				for _, profile := range page.FargateProfileNames {
-					profileName := aws.StringValue(profile)
-					log.Printf("[INFO] Deleting Fargate Profile %q", profileName)
+				for _, item := range page.FargateProfileNames {
+					name := aws.StringValue(item)
 					input := &eks.DeleteFargateProfileInput{
 						ClusterName:        cluster,
-						FargateProfileName: profile,
+						FargateProfileName: item,
 					}
-					_, err := conn.DeleteFargateProfile(input)
 
-					if err != nil && !isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
-						errors = multierror.Append(errors, fmt.Errorf("error deleting EKS Fargate Profile %q: %w", profileName, err))
-						continue
-					}
+					sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s:%s", clusterName, name), func() error {
+						_, err := conn.DeleteFargateProfile(input)
 
-					if err := waitForEksFargateProfileDeletion(conn, clusterName, profileName, 10*time.Minute); err != nil {
-						errors = multierror.Append(errors, fmt.Errorf("error waiting for EKS Fargate Profile %q deletion: %w", profileName, err))
-						continue
-					}
+						if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
+							return nil
+						}
+
+						if err != nil {
+							return err
+						}
+
+						if err := waitForEksFargateProfileDeletion(conn, clusterName, name, 10*time.Minute); err != nil {
+							return fmt.Errorf("error waiting for deletion: %w", err)
+						}
+
+						return nil
+					}).WithName(name))
 				}
 				return true
 			})
+
 			if err != nil {
-				errors = multierror.Append(errors, fmt.Errorf("error listing Fargate Profiles for EKS Cluster %s: %w", clusterName, err))
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Fargate Profiles for EKS Cluster %s: %w", clusterName, err))
 			}
 		}
-
 		return true
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EKS Clusters sweep for %s: %s", region, err)
-		return errors // In case we have completed some pages, but had errors
+		return sweepables, sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
+
 	if err != nil {
-		errors = multierror.Append(errors, fmt.Errorf("error retrieving EKS Clusters: %w", err))
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving EKS Clusters: %w", err))
 	}
 
-	return errors
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSEksFargateProfile_basic(t *testing.T) {
@@ -242,7 +254,7 @@ func testAccCheckAWSEksFargateProfileExists(resourceName string, fargateProfile
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		input := &eks.DescribeFargateProfileInput{
 			ClusterName:        aws.String(clusterName),
@@ -274,7 +286,7 @@ func testAccCheckAWSEksFargateProfileExists(resourceName string, fargateProfile
 }
 
 func testAccCheckAWSEksFargateProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).eksconn
+	conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_eks_fargate_profile" {
@@ -307,7 +319,7 @@ func testAccCheckAWSEksFargateProfileDestroy(s *terraform.State) error {
 
 func testAccCheckAWSEksFargateProfileDisappears(fargateProfile *eks.FargateProfile) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		input := &eks.DeleteFargateProfileInput{
 			ClusterName:        fargateProfile.ClusterName,
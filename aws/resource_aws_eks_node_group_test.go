@@ -13,23 +13,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_eks_fargate_node_group", &resource.Sweeper{
-		Name: "aws_eks_fargate_node_group",
-		F:    testSweepEksFargateNodegroups,
+	sweep.AddTestSweepers("aws_eks_fargate_node_group", &sweep.Sweeper{
+		Name:    "aws_eks_fargate_node_group",
+		Service: "eks",
+		List:    testSweepEksFargateNodegroups,
 	})
 }
 
-func testSweepEksFargateNodegroups(region string) error {
+func testSweepEksFargateNodegroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).eksconn
+	conn := client.(*AWSClient).eksconn()
+	var sweepables []sweep.Sweepable
+	var sweeperErrs *multierror.Error
 
-	var errors error
 	input := &eks.ListClustersInput{}
 	err = conn.ListClustersPages(input, func(page *eks.ListClustersOutput, lastPage bool) bool {
 		for _, cluster := range page.Clusters {
@@ -37,44 +40,53 @@ func testSweepEksFargateNodegroups(region string) error {
 			input := &eks.ListNodegroupsInput{
 				ClusterName: cluster,
 			}
+
 			err := conn.ListNodegroupsPages(input, func(page *eks.ListNodegroupsOutput, lastPage bool) bool {
-				for _, nodegroup := range page.Nodegroups {
-					nodegroupName := aws.StringValue(nodegroup)
-					log.Printf("[INFO] Deleting EKS Node Group %q", nodegroupName)
+				for _, item := range page.Nodegroups {
+					name := aws.StringValue(item)
 					input := &eks.DeleteNodegroupInput{
 						ClusterName:   cluster,
-						NodegroupName: nodegroup,
+						NodegroupName: item,
 					}
-					_, err := conn.DeleteNodegroup(input)
 
-					if err != nil && !isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
-						errors = multierror.Append(errors, fmt.Errorf("error deleting EKS Node Group %q: %w", nodegroupName, err))
-						continue
-					}
+					sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s:%s", clusterName, name), func() error {
+						_, err := conn.DeleteNodegroup(input)
 
-					if err := waitForEksNodeGroupDeletion(conn, clusterName, nodegroupName, 10*time.Minute); err != nil {
-						errors = multierror.Append(errors, fmt.Errorf("error waiting for EKS Node Group %q deletion: %w", nodegroupName, err))
-						continue
-					}
+						if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
+							return nil
+						}
+
+						if err != nil {
+							return err
+						}
+
+						if err := waitForEksNodeGroupDeletion(conn, clusterName, name, 10*time.Minute); err != nil {
+							return fmt.Errorf("error waiting for deletion: %w", err)
+						}
+
+						return nil
+					}).WithName(name))
 				}
 				return true
 			})
+
 			if err != nil {
-				errors = multierror.Append(errors, fmt.Errorf("error listing Node Groups for EKS Cluster %s: %w", clusterName, err))
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Node Groups for EKS Cluster %s: %w", clusterName, err))
 			}
 		}
-
 		return true
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EKS Clusters sweep for %s: %s", region, err)
-		return errors // In case we have completed some pages, but had errors
+		return sweepables, sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
+
 	if err != nil {
-		errors = multierror.Append(errors, fmt.Errorf("error retrieving EKS Clusters: %w", err))
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving EKS Clusters: %w", err))
 	}
 
-	return errors
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSEksNodeGroup_basic(t *testing.T) {
@@ -781,7 +793,7 @@ func testAccCheckAWSEksNodeGroupExists(resourceName string, nodeGroup *eks.Nodeg
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		input := &eks.DescribeNodegroupInput{
 			ClusterName:   aws.String(clusterName),
@@ -813,7 +825,7 @@ func testAccCheckAWSEksNodeGroupExists(resourceName string, nodeGroup *eks.Nodeg
 }
 
 func testAccCheckAWSEksNodeGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).eksconn
+	conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_eks_node_group" {
@@ -846,7 +858,7 @@ func testAccCheckAWSEksNodeGroupDestroy(s *terraform.State) error {
 
 func testAccCheckAWSEksNodeGroupDisappears(nodeGroup *eks.Nodegroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		input := &eks.DeleteNodegroupInput{
 			ClusterName:   nodeGroup.ClusterName,
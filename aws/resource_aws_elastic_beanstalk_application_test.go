@@ -7,59 +7,56 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elastic_beanstalk_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elastic_beanstalk_application", &sweep.Sweeper{
 		Name:         "aws_elastic_beanstalk_application",
+		Service:      "elasticbeanstalk",
 		Dependencies: []string{"aws_elastic_beanstalk_environment"},
-		F:            testSweepElasticBeanstalkApplications,
+		List:         testSweepElasticBeanstalkApplications,
 	})
 }
 
-func testSweepElasticBeanstalkApplications(region string) error {
+func testSweepElasticBeanstalkApplications(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	beanstalkconn := client.(*AWSClient).elasticbeanstalkconn
+	beanstalkconn := client.(*AWSClient).elasticbeanstalkconn()
 
 	resp, err := beanstalkconn.DescribeApplications(&elasticbeanstalk.DescribeApplicationsInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Elastic Beanstalk Application sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("error retrieving beanstalk application: %w", err)
+		return nil, fmt.Errorf("error retrieving beanstalk application: %w", err)
 	}
 
-	if len(resp.Applications) == 0 {
-		log.Print("[DEBUG] No aws beanstalk applications to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
-	var errors error
 	for _, bsa := range resp.Applications {
-		applicationName := aws.StringValue(bsa.ApplicationName)
-		_, err := beanstalkconn.DeleteApplication(
-			&elasticbeanstalk.DeleteApplicationInput{
-				ApplicationName: bsa.ApplicationName,
-			})
-		if err != nil {
+		input := &elasticbeanstalk.DeleteApplicationInput{
+			ApplicationName: bsa.ApplicationName,
+		}
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(bsa.ApplicationName), func() error {
+			_, err := beanstalkconn.DeleteApplication(input)
+
 			if isAWSErr(err, "InvalidConfiguration.NotFound", "") || isAWSErr(err, "ValidationError", "") {
-				log.Printf("[DEBUG] beanstalk application %q not found", applicationName)
-				continue
+				return nil
 			}
 
-			errors = multierror.Append(fmt.Errorf("error deleting Elastic Beanstalk Application %q: %w", applicationName, err))
-		}
+			return err
+		}))
 	}
 
-	return errors
+	return sweepables, nil
 }
 
 func TestAccAWSElasticBeanstalkApplication_basic(t *testing.T) {
@@ -228,7 +225,7 @@ func TestAccAWSBeanstalkApp_tags(t *testing.T) {
 }
 
 func testAccCheckBeanstalkAppDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elastic_beanstalk_application" {
@@ -266,7 +263,7 @@ func testAccCheckBeanstalkAppExists(n string, app *elasticbeanstalk.ApplicationD
 			return fmt.Errorf("Elastic Beanstalk app ID is not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticbeanstalkconn()
 		DescribeBeanstalkAppOpts := &elasticbeanstalk.DescribeApplicationsInput{
 			ApplicationNames: []*string{aws.String(rs.Primary.ID)},
 		}
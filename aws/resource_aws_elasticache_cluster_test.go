@@ -11,69 +11,67 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
-		Name: "aws_elasticache_cluster",
-		F:    testSweepElasticacheClusters,
+	sweep.AddTestSweepers("aws_elasticache_cluster", &sweep.Sweeper{
+		Name:    "aws_elasticache_cluster",
+		Service: "elasticache",
+		List:    testSweepElasticacheClusters,
 		Dependencies: []string{
 			"aws_elasticache_replication_group",
 		},
 	})
 }
 
-func testSweepElasticacheClusters(region string) error {
+func testSweepElasticacheClusters(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).elasticacheconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).elasticacheconn()
+	var sweepables []sweep.Sweepable
 
 	input := &elasticache.DescribeCacheClustersInput{
 		ShowCacheClustersNotInReplicationGroups: aws.Bool(true),
 	}
 	err = conn.DescribeCacheClustersPages(input, func(page *elasticache.DescribeCacheClustersOutput, isLast bool) bool {
-		if len(page.CacheClusters) == 0 {
-			log.Print("[DEBUG] No ElastiCache Replicaton Groups to sweep")
-			return false
-		}
-
 		for _, cluster := range page.CacheClusters {
 			id := aws.StringValue(cluster.CacheClusterId)
 
-			log.Printf("[INFO] Deleting ElastiCache Cluster: %s", id)
-			err := deleteElasticacheCacheCluster(conn, id, "")
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete ElastiCache Cache Cluster (%s): %s", id, err)
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): %w", id, err))
-			}
-			_, err = waiter.CacheClusterDeleted(conn, id, waiter.CacheClusterDeletedTimeout)
-			if err != nil {
-				log.Printf("[ERROR] Failed waiting for ElastiCache Cache Cluster (%s) to be deleted: %s", id, err)
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): waiting for completion: %w", id, err))
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				if err := deleteElasticacheCacheCluster(conn, id, ""); err != nil {
+					return err
+				}
+
+				if _, err := waiter.CacheClusterDeleted(conn, id, waiter.CacheClusterDeletedTimeout); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}))
 		}
 		return !isLast
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping ElastiCache Cluster sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error retrieving ElastiCache Clusters: %w", err))
+		return sweepables, fmt.Errorf("Error retrieving ElastiCache Clusters: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSElasticacheCluster_Engine_Memcached(t *testing.T) {
@@ -140,6 +138,36 @@ func TestAccAWSElasticacheCluster_Engine_Redis(t *testing.T) {
 	})
 }
 
+func TestAccAWSElasticacheCluster_ClusterIdPrefix(t *testing.T) {
+	var ec elasticache.CacheCluster
+	resourceName := "aws_elasticache_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheClusterConfig_ClusterIdPrefix("tf-acc-test-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheClusterExists(resourceName, &ec),
+					naming.TestCheckResourceAttrNameFromPrefix(resourceName, "cluster_id", "tf-acc-test-"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_id_prefix", "tf-acc-test-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+					"cluster_id_prefix",
+				},
+			},
+		},
+	})
+}
+
 func TestAccAWSElasticacheCluster_Port_Redis_Default(t *testing.T) {
 	var ec elasticache.CacheCluster
 	resource.ParallelTest(t, resource.TestCase{
@@ -786,7 +814,7 @@ func testAccCheckAWSElasticacheClusterRecreated(i, j *elasticache.CacheCluster)
 }
 
 func testAccCheckAWSElasticacheClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_cluster" {
@@ -815,7 +843,7 @@ func testAccCheckAWSElasticacheClusterExists(n string, v *elasticache.CacheClust
 			return fmt.Errorf("No cache cluster ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 		resp, err := conn.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{
 			CacheClusterId: aws.String(rs.Primary.ID),
 		})
@@ -844,7 +872,7 @@ func testAccCheckAWSElasticacheClusterEc2ClassicExists(n string, v *elasticache.
 			return fmt.Errorf("No cache cluster ID is set")
 		}
 
-		conn := testAccProviderEc2Classic.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProviderEc2Classic.Meta().(*AWSClient).elasticacheconn()
 
 		input := &elasticache.DescribeCacheClustersInput{
 			CacheClusterId: aws.String(rs.Primary.ID),
@@ -890,6 +918,17 @@ resource "aws_elasticache_cluster" "test" {
 `, rName)
 }
 
+func testAccAWSElasticacheClusterConfig_ClusterIdPrefix(clusterIdPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_cluster" "test" {
+  cluster_id_prefix = %[1]q
+  engine            = "redis"
+  node_type         = "cache.t3.small"
+  num_cache_nodes   = 1
+}
+`, clusterIdPrefix)
+}
+
 func testAccAWSElasticacheClusterConfig_ParameterGroupName(rName, engine, engineVersion, parameterGroupName string) string {
 	return fmt.Sprintf(`
 resource "aws_elasticache_cluster" "test" {
@@ -9,30 +9,30 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
-		Name: "aws_elasticache_global_replication_group",
-		F:    testSweepElasticacheGlobalReplicationGroups,
+	sweep.AddTestSweepers("aws_elasticache_global_replication_group", &sweep.Sweeper{
+		Name:    "aws_elasticache_global_replication_group",
+		Service: "elasticache",
+		List:    testSweepElasticacheGlobalReplicationGroups,
 	})
 }
 
-func testSweepElasticacheGlobalReplicationGroups(region string) error {
+func testSweepElasticacheGlobalReplicationGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).elasticacheconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).elasticacheconn()
+	var sweepables []sweep.Sweepable
 
 	input := &elasticache.DescribeGlobalReplicationGroupsInput{}
 	err = conn.DescribeGlobalReplicationGroupsPages(input, func(page *elasticache.DescribeGlobalReplicationGroupsOutput, lastPage bool) bool {
@@ -43,14 +43,9 @@ func testSweepElasticacheGlobalReplicationGroups(region string) error {
 		for _, globalReplicationGroup := range page.GlobalReplicationGroups {
 			id := aws.StringValue(globalReplicationGroup.GlobalReplicationGroupId)
 
-			log.Printf("[INFO] Deleting ElastiCache Global Replication Group: %s", id)
-			err := deleteElasticacheGlobalReplicationGroup(conn, id)
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting ElastiCache Global Replication Group (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				return deleteElasticacheGlobalReplicationGroup(conn, id)
+			}))
 		}
 
 		return !lastPage
@@ -58,14 +53,14 @@ func testSweepElasticacheGlobalReplicationGroups(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping ElastiCache Global Replication Group sweep for %q: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing ElastiCache Global Replication Groups: %w", err))
+		return sweepables, fmt.Errorf("error listing ElastiCache Global Replication Groups: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSElasticacheGlobalReplicationGroup_basic(t *testing.T) {
@@ -181,7 +176,7 @@ func testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName string,
 			return fmt.Errorf("No ElastiCache Global Replication Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 		grg, err := finder.GlobalReplicationGroupByID(conn, rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error retrieving ElastiCache Global Replication Group (%s): %w", rs.Primary.ID, err)
@@ -198,7 +193,7 @@ func testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName string,
 }
 
 func testAccCheckAWSElasticacheGlobalReplicationGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_global_replication_group" {
@@ -219,7 +214,7 @@ func testAccCheckAWSElasticacheGlobalReplicationGroupDestroy(s *terraform.State)
 }
 
 func testAccPreCheckAWSElasticacheGlobalReplicationGroup(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 	input := &elasticache.DescribeGlobalReplicationGroupsInput{}
 	_, err := conn.DescribeGlobalReplicationGroups(input)
@@ -14,12 +14,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
-		Name: "aws_elasticache_parameter_group",
-		F:    testSweepElasticacheParameterGroups,
+	sweep.AddTestSweepers("aws_elasticache_parameter_group", &sweep.Sweeper{
+		Name:    "aws_elasticache_parameter_group",
+		Service: "elasticache",
+		List:    testSweepElasticacheParameterGroups,
 		Dependencies: []string{
 			"aws_elasticache_cluster",
 			"aws_elasticache_replication_group",
@@ -27,45 +29,42 @@ func init() {
 	})
 }
 
-func testSweepElasticacheParameterGroups(region string) error {
+func testSweepElasticacheParameterGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).elasticacheconn
+	conn := client.(*AWSClient).elasticacheconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeCacheParameterGroupsPages(&elasticache.DescribeCacheParameterGroupsInput{}, func(page *elasticache.DescribeCacheParameterGroupsOutput, isLast bool) bool {
-		if len(page.CacheParameterGroups) == 0 {
-			log.Print("[DEBUG] No Elasticache Parameter Groups to sweep")
-			return false
-		}
-
 		for _, parameterGroup := range page.CacheParameterGroups {
 			name := aws.StringValue(parameterGroup.CacheParameterGroupName)
 
 			if strings.HasPrefix(name, "default.") {
-				log.Printf("[INFO] Skipping Elasticache Cache Parameter Group: %s", name)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Elasticache Parameter Group: %s", name)
-			_, err := conn.DeleteCacheParameterGroup(&elasticache.DeleteCacheParameterGroupInput{
-				CacheParameterGroupName: aws.String(name),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Elasticache Parameter Group (%s): %s", name, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteCacheParameterGroup(&elasticache.DeleteCacheParameterGroupInput{
+					CacheParameterGroupName: aws.String(name),
+				})
+
+				return err
+			}))
 		}
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Elasticache Parameter Group sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving Elasticache Parameter Group: %w", err)
+		return sweepables, fmt.Errorf("Error retrieving Elasticache Parameter Group: %w", err)
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAWSElasticacheParameterGroup_basic(t *testing.T) {
@@ -405,7 +404,7 @@ func TestAccAWSElasticacheParameterGroup_Description(t *testing.T) {
 }
 
 func testAccCheckAWSElasticacheParameterGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_parameter_group" {
@@ -461,7 +460,7 @@ func testAccCheckAWSElasticacheParameterGroupExists(n string, v *elasticache.Cac
 			return fmt.Errorf("No Cache Parameter Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 		opts := elasticache.DescribeCacheParameterGroupsInput{
 			CacheParameterGroupName: aws.String(rs.Primary.ID),
@@ -14,53 +14,52 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
-		Name: "aws_elasticache_replication_group",
-		F:    testSweepElasticacheReplicationGroups,
+	sweep.AddTestSweepers("aws_elasticache_replication_group", &sweep.Sweeper{
+		Name:    "aws_elasticache_replication_group",
+		Service: "elasticache",
+		List:    testSweepElasticacheReplicationGroups,
 		Dependencies: []string{
 			"aws_elasticache_global_replication_group",
 		},
 	})
 }
 
-func testSweepElasticacheReplicationGroups(region string) error {
+func testSweepElasticacheReplicationGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).elasticacheconn
+	conn := client.(*AWSClient).elasticacheconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeReplicationGroupsPages(&elasticache.DescribeReplicationGroupsInput{}, func(page *elasticache.DescribeReplicationGroupsOutput, isLast bool) bool {
-		if len(page.ReplicationGroups) == 0 {
-			log.Print("[DEBUG] No ElastiCache Replicaton Groups to sweep")
-			return false
-		}
-
 		for _, replicationGroup := range page.ReplicationGroups {
 			id := aws.StringValue(replicationGroup.ReplicationGroupId)
 
-			log.Printf("[INFO] Deleting ElastiCache Replication Group: %s", id)
-			err := deleteElasticacheReplicationGroup(id, conn, "", 40*time.Minute)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete ElastiCache Replication Group (%s): %s", id, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				return deleteElasticacheReplicationGroup(id, conn, "", 40*time.Minute)
+			}))
 		}
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping ElastiCache Replication Group sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving ElastiCache Replication Groups: %w", err)
+		return sweepables, fmt.Errorf("Error retrieving ElastiCache Replication Groups: %w", err)
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAWSElasticacheReplicationGroup_basic(t *testing.T) {
@@ -101,6 +100,33 @@ func TestAccAWSElasticacheReplicationGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSElasticacheReplicationGroup_ReplicationGroupIdPrefix(t *testing.T) {
+	var rg elasticache.ReplicationGroup
+	resourceName := "aws_elasticache_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheReplicationGroupConfig_ReplicationGroupIdPrefix("tf-acc-test-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheReplicationGroupExists(resourceName, &rg),
+					naming.TestCheckResourceAttrNameFromPrefix(resourceName, "replication_group_id", "tf-acc-test-"),
+					resource.TestCheckResourceAttr(resourceName, "replication_group_id_prefix", "tf-acc-test-"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately", "replication_group_id_prefix"},
+			},
+		},
+	})
+}
+
 func TestAccAWSElasticacheReplicationGroup_Uppercase(t *testing.T) {
 	var rg elasticache.ReplicationGroup
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -249,7 +275,7 @@ func TestAccAWSElasticacheReplicationGroup_updateNodeSize(t *testing.T) {
 	})
 }
 
-//This is a test to prove that we panic we get in https://github.com/hashicorp/terraform/issues/9097
+// This is a test to prove that we panic we get in https://github.com/hashicorp/terraform/issues/9097
 func TestAccAWSElasticacheReplicationGroup_updateParameterGroup(t *testing.T) {
 	var rg elasticache.ReplicationGroup
 	parameterGroupResourceName1 := "aws_elasticache_parameter_group.test.0"
@@ -961,7 +987,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_Failover_AutoFail
 			{
 				PreConfig: func() {
 					// Ensure that primary is on the node we are trying to delete
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					if err := resourceAwsElasticacheReplicationGroupSetPrimaryClusterID(conn, rName, formatReplicationGroupClusterID(rName, 3), timeout); err != nil {
@@ -1007,7 +1033,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_Failover_AutoFail
 			{
 				PreConfig: func() {
 					// Ensure that primary is on the node we are trying to delete
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					// Must disable automatic failover first
@@ -1064,7 +1090,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MultiAZEnabled(t
 			{
 				PreConfig: func() {
 					// Ensure that primary is on the node we are trying to delete
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					// Must disable automatic failover first
@@ -1116,7 +1142,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MemberClusterDisa
 			{
 				PreConfig: func() {
 					// Remove one of the Cache Clusters
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					cacheClusterID := formatReplicationGroupClusterID(rName, 2)
@@ -1161,7 +1187,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MemberClusterDisa
 			{
 				PreConfig: func() {
 					// Remove one of the Cache Clusters
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					cacheClusterID := formatReplicationGroupClusterID(rName, 2)
@@ -1206,7 +1232,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MemberClusterDisa
 			{
 				PreConfig: func() {
 					// Remove one of the Cache Clusters
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					cacheClusterID := formatReplicationGroupClusterID(rName, 2)
@@ -1251,7 +1277,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MemberClusterDisa
 			{
 				PreConfig: func() {
 					// Remove one of the Cache Clusters
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					cacheClusterID := formatReplicationGroupClusterID(rName, 2)
@@ -1352,7 +1378,7 @@ func testAccCheckAWSElasticacheReplicationGroupExists(n string, v *elasticache.R
 			return fmt.Errorf("No replication group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 		rg, err := finder.ReplicationGroupByID(conn, rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("ElastiCache error: %w", err)
@@ -1365,7 +1391,7 @@ func testAccCheckAWSElasticacheReplicationGroupExists(n string, v *elasticache.R
 }
 
 func testAccCheckAWSElasticacheReplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_replication_group" {
@@ -1399,6 +1425,18 @@ resource "aws_elasticache_replication_group" "test" {
 `, rName)
 }
 
+func testAccAWSElasticacheReplicationGroupConfig_ReplicationGroupIdPrefix(replicationGroupIdPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id_prefix   = %[1]q
+  replication_group_description = "test description"
+  node_type                     = "cache.t3.small"
+  number_cache_clusters         = 2
+  apply_immediately             = true
+}
+`, replicationGroupIdPrefix)
+}
+
 func testAccAWSElasticacheReplicationGroupConfig_Uppercase(rName string) string {
 	return fmt.Sprintf(`
 data "aws_availability_zones" "available" {
@@ -11,12 +11,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_security_group", &resource.Sweeper{
-		Name: "aws_elasticache_security_group",
-		F:    testSweepElasticacheCacheSecurityGroups,
+	sweep.AddTestSweepers("aws_elasticache_security_group", &sweep.Sweeper{
+		Name:    "aws_elasticache_security_group",
+		Service: "elasticache",
+		List:    testSweepElasticacheCacheSecurityGroups,
 		Dependencies: []string{
 			"aws_elasticache_cluster",
 			"aws_elasticache_replication_group",
@@ -24,45 +26,42 @@ func init() {
 	})
 }
 
-func testSweepElasticacheCacheSecurityGroups(region string) error {
+func testSweepElasticacheCacheSecurityGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).elasticacheconn
+	conn := client.(*AWSClient).elasticacheconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeCacheSecurityGroupsPages(&elasticache.DescribeCacheSecurityGroupsInput{}, func(page *elasticache.DescribeCacheSecurityGroupsOutput, isLast bool) bool {
-		if len(page.CacheSecurityGroups) == 0 {
-			log.Print("[DEBUG] No Elasticache Cache Security Groups to sweep")
-			return false
-		}
-
 		for _, securityGroup := range page.CacheSecurityGroups {
 			name := aws.StringValue(securityGroup.CacheSecurityGroupName)
 
 			if name == "default" {
-				log.Printf("[INFO] Skipping Elasticache Cache Security Group: %s", name)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Elasticache Cache Security Group: %s", name)
-			_, err := conn.DeleteCacheSecurityGroup(&elasticache.DeleteCacheSecurityGroupInput{
-				CacheSecurityGroupName: aws.String(name),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Elasticache Cache Security Group (%s): %s", name, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteCacheSecurityGroup(&elasticache.DeleteCacheSecurityGroupInput{
+					CacheSecurityGroupName: aws.String(name),
+				})
+
+				return err
+			}))
 		}
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Elasticache Cache Security Group sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving Elasticache Cache Security Groups: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving Elasticache Cache Security Groups: %s", err)
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAWSElasticacheSecurityGroup_basic(t *testing.T) {
@@ -91,7 +90,7 @@ func TestAccAWSElasticacheSecurityGroup_basic(t *testing.T) {
 }
 
 func testAccCheckAWSElasticacheSecurityGroupDestroy(s *terraform.State) error {
-	conn := testAccProviderEc2Classic.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProviderEc2Classic.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_security_group" {
@@ -123,7 +122,7 @@ func testAccCheckAWSElasticacheSecurityGroupExists(n string) resource.TestCheckF
 			return fmt.Errorf("No cache security group ID is set")
 		}
 
-		conn := testAccProviderEc2Classic.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProviderEc2Classic.Meta().(*AWSClient).elasticacheconn()
 		_, err := conn.DescribeCacheSecurityGroups(&elasticache.DescribeCacheSecurityGroupsInput{
 			CacheSecurityGroupName: aws.String(rs.Primary.ID),
 		})
@@ -11,12 +11,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
-		Name: "aws_elasticache_subnet_group",
-		F:    testSweepElasticacheSubnetGroups,
+	sweep.AddTestSweepers("aws_elasticache_subnet_group", &sweep.Sweeper{
+		Name:    "aws_elasticache_subnet_group",
+		Service: "elasticache",
+		List:    testSweepElasticacheSubnetGroups,
 		Dependencies: []string{
 			"aws_elasticache_cluster",
 			"aws_elasticache_replication_group",
@@ -24,40 +26,38 @@ func init() {
 	})
 }
 
-func testSweepElasticacheSubnetGroups(region string) error {
+func testSweepElasticacheSubnetGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).elasticacheconn
+	conn := client.(*AWSClient).elasticacheconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeCacheSubnetGroupsPages(&elasticache.DescribeCacheSubnetGroupsInput{}, func(page *elasticache.DescribeCacheSubnetGroupsOutput, isLast bool) bool {
-		if len(page.CacheSubnetGroups) == 0 {
-			log.Print("[DEBUG] No Elasticache Subnet Groups to sweep")
-			return false
-		}
-
 		for _, subnetGroup := range page.CacheSubnetGroups {
 			name := aws.StringValue(subnetGroup.CacheSubnetGroupName)
 
-			log.Printf("[INFO] Deleting Elasticache Subnet Group: %s", name)
-			_, err := conn.DeleteCacheSubnetGroup(&elasticache.DeleteCacheSubnetGroupInput{
-				CacheSubnetGroupName: aws.String(name),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Elasticache Subnet Group (%s): %s", name, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteCacheSubnetGroup(&elasticache.DeleteCacheSubnetGroupInput{
+					CacheSubnetGroupName: aws.String(name),
+				})
+
+				return err
+			}))
 		}
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Elasticache Subnet Group sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving Elasticache Subnet Groups: %w", err)
+		return sweepables, fmt.Errorf("Error retrieving Elasticache Subnet Groups: %w", err)
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAWSElasticacheSubnetGroup_basic(t *testing.T) {
@@ -124,7 +124,7 @@ func TestAccAWSElasticacheSubnetGroup_update(t *testing.T) {
 }
 
 func testAccCheckAWSElasticacheSubnetGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_subnet_group" {
@@ -158,7 +158,7 @@ func testAccCheckAWSElasticacheSubnetGroupExists(n string, csg *elasticache.Cach
 			return fmt.Errorf("No cache subnet group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 		resp, err := conn.DescribeCacheSubnetGroups(&elasticache.DescribeCacheSubnetGroupsInput{
 			CacheSubnetGroupName: aws.String(rs.Primary.ID),
 		})
@@ -15,22 +15,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticsearch_domain", &resource.Sweeper{
-		Name: "aws_elasticsearch_domain",
-		F:    testSweepElasticSearchDomains,
+	sweep.AddTestSweepers("aws_elasticsearch_domain", &sweep.Sweeper{
+		Name:    "aws_elasticsearch_domain",
+		Service: "es",
+		List:    testSweepElasticSearchDomains,
 	})
 }
 
-func testSweepElasticSearchDomains(region string) error {
+func testSweepElasticSearchDomains(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).esconn
-
+	conn := client.(*AWSClient).esconn()
+	var sweepables []sweep.Sweepable
 	var sweeperErrs *multierror.Error
 
 	input := &elasticsearch.ListDomainNamesInput{}
@@ -40,19 +42,16 @@ func testSweepElasticSearchDomains(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Elasticsearch Domain sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+		return nil, nil
 	}
 
 	if err != nil {
-		sweeperErr := fmt.Errorf("error listing Elasticsearch Domains: %w", err)
-		log.Printf("[ERROR] %s", sweeperErr)
-		sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-		return sweeperErrs.ErrorOrNil()
+		return nil, fmt.Errorf("error listing Elasticsearch Domains: %w", err)
 	}
 
 	if output == nil {
 		log.Printf("[WARN] Skipping Elasticsearch Domain sweep for %s: empty response", region)
-		return sweeperErrs.ErrorOrNil()
+		return nil, nil
 	}
 
 	for _, domainInfo := range output.DomainNames {
@@ -73,14 +72,11 @@ func testSweepElasticSearchDomains(region string) error {
 		output, err := conn.DescribeElasticsearchDomain(input)
 
 		if err != nil {
-			sweeperErr := fmt.Errorf("error describing Elasticsearch Domain (%s): %w", name, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing Elasticsearch Domain (%s): %w", name, err))
 			continue
 		}
 
 		if output != nil && output.DomainStatus != nil && aws.BoolValue(output.DomainStatus.Deleted) {
-			log.Printf("[INFO] Skipping Elasticsearch Domain (%s) with deleted status", name)
 			continue
 		}
 
@@ -89,17 +85,12 @@ func testSweepElasticSearchDomains(region string) error {
 		d.SetId(name)
 		d.Set("domain_name", name)
 
-		err = r.Delete(d, client)
-
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting Elasticsearch Domain (%s): %w", name, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+		sweepables = append(sweepables, sweep.NewResource(name, func() error {
+			return r.Delete(d, client)
+		}))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSElasticSearchDomain_basic(t *testing.T) {
@@ -329,7 +320,7 @@ func TestAccAWSElasticSearchDomain_duplicate(t *testing.T) {
 		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckIamServiceLinkedRoleEs(t) },
 		Providers: testAccProviders,
 		CheckDestroy: func(s *terraform.State) error {
-			conn := testAccProvider.Meta().(*AWSClient).esconn
+			conn := testAccProvider.Meta().(*AWSClient).esconn()
 			_, err := conn.DeleteElasticsearchDomain(&elasticsearch.DeleteElasticsearchDomainInput{
 				DomainName: aws.String(resourceId),
 			})
@@ -339,7 +330,7 @@ func TestAccAWSElasticSearchDomain_duplicate(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Create duplicate
-					conn := testAccProvider.Meta().(*AWSClient).esconn
+					conn := testAccProvider.Meta().(*AWSClient).esconn()
 					_, err := conn.CreateElasticsearchDomain(&elasticsearch.CreateElasticsearchDomainInput{
 						DomainName: aws.String(resourceId),
 						EBSOptions: &elasticsearch.EBSOptions{
@@ -1252,7 +1243,7 @@ func testAccCheckESDomainExists(n string, domain *elasticsearch.ElasticsearchDom
 			return fmt.Errorf("No ES Domain ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).esconn()
 		opts := &elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
 		}
@@ -1270,7 +1261,7 @@ func testAccCheckESDomainExists(n string, domain *elasticsearch.ElasticsearchDom
 
 func testAccCheckAWSESDomainNotRecreated(i, j *elasticsearch.ElasticsearchDomainStatus) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).esconn()
 
 		iConfig, err := conn.DescribeElasticsearchDomainConfig(&elasticsearch.DescribeElasticsearchDomainConfigInput{
 			DomainName: i.DomainName,
@@ -1299,7 +1290,7 @@ func testAccCheckESDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).esconn()
 		opts := &elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
 		}
@@ -1317,7 +1308,7 @@ func testAccCheckESDomainDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckIamServiceLinkedRoleEs(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 	dnsSuffix := testAccProvider.Meta().(*AWSClient).dnsSuffix
 
 	input := &iam.ListRolesInput{
@@ -34,7 +34,7 @@ func resourceAwsElbAttachment() *schema.Resource {
 }
 
 func resourceAwsElbAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 	elbName := d.Get("elb").(string)
 
 	instance := d.Get("instance").(string)
@@ -73,7 +73,7 @@ func resourceAwsElbAttachmentCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsElbAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 	elbName := d.Get("elb").(string)
 
 	// only add the instance that was previously defined for this resource
@@ -117,7 +117,7 @@ func resourceAwsElbAttachmentRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsElbAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 	elbName := d.Get("elb").(string)
 
 	instance := d.Get("instance").(string)
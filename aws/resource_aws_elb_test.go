@@ -15,53 +15,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elb", &resource.Sweeper{
-		Name: "aws_elb",
-		F:    testSweepELBs,
+	sweep.AddTestSweepers("aws_elb", &sweep.Sweeper{
+		Name:    "aws_elb",
+		Service: "elb",
+		List:    testSweepELBs,
 	})
 }
 
-func testSweepELBs(region string) error {
+func testSweepELBs(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).elbconn
+	conn := client.(*AWSClient).elbconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{}, func(out *elb.DescribeLoadBalancersOutput, isLast bool) bool {
-		if len(out.LoadBalancerDescriptions) == 0 {
-			log.Println("[INFO] No ELBs found for sweeping")
-			return false
-		}
-
 		for _, lb := range out.LoadBalancerDescriptions {
-			log.Printf("[INFO] Deleting ELB: %s", *lb.LoadBalancerName)
-
-			_, err := conn.DeleteLoadBalancer(&elb.DeleteLoadBalancerInput{
-				LoadBalancerName: lb.LoadBalancerName,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete ELB %s: %s", *lb.LoadBalancerName, err)
-				continue
-			}
-			err = cleanupELBNetworkInterfaces(client.(*AWSClient).ec2conn, *lb.LoadBalancerName)
-			if err != nil {
-				log.Printf("[WARN] Failed to cleanup ENIs for ELB %q: %s", *lb.LoadBalancerName, err)
-			}
+			name := aws.StringValue(lb.LoadBalancerName)
+
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteLoadBalancer(&elb.DeleteLoadBalancerInput{
+					LoadBalancerName: aws.String(name),
+				})
+
+				if err != nil {
+					return err
+				}
+
+				if err := cleanupELBNetworkInterfaces(client.(*AWSClient).ec2conn(), name); err != nil {
+					log.Printf("[WARN] Failed to cleanup ENIs for ELB %q: %s", name, err)
+				}
+
+				return nil
+			}))
 		}
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping ELB sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving ELBs: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving ELBs: %s", err)
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAWSELB_basic(t *testing.T) {
@@ -543,7 +547,7 @@ func TestAccAWSELB_listener(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Simulate out of band listener removal
-					conn := testAccProvider.Meta().(*AWSClient).elbconn
+					conn := testAccProvider.Meta().(*AWSClient).elbconn()
 					input := &elb.DeleteLoadBalancerListenersInput{
 						LoadBalancerName:  conf.LoadBalancerName,
 						LoadBalancerPorts: []*int64{aws.Int64(int64(80))},
@@ -567,7 +571,7 @@ func TestAccAWSELB_listener(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Simulate out of band listener addition
-					conn := testAccProvider.Meta().(*AWSClient).elbconn
+					conn := testAccProvider.Meta().(*AWSClient).elbconn()
 					input := &elb.CreateLoadBalancerListenersInput{
 						LoadBalancerName: conf.LoadBalancerName,
 						Listeners: []*elb.Listener{
@@ -985,7 +989,7 @@ func TestResourceAWSELB_validateHealthCheckTarget(t *testing.T) {
 }
 
 func testAccCheckAWSELBDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbconn
+	conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elb" {
@@ -1019,7 +1023,7 @@ func testAccCheckAWSELBDestroy(s *terraform.State) error {
 
 func testAccCheckAWSELBDisappears(loadBalancer *elb.LoadBalancerDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		input := elb.DeleteLoadBalancerInput{
 			LoadBalancerName: loadBalancer.LoadBalancerName,
@@ -1090,7 +1094,7 @@ func testAccCheckAWSELBExists(n string, res *elb.LoadBalancerDescription) resour
 			return fmt.Errorf("No ELB ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		describe, err := conn.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
 			LoadBalancerNames: []*string{aws.String(rs.Primary.ID)},
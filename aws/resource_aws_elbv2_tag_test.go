@@ -0,0 +1,20 @@
+package aws
+
+import (
+	"fmt"
+)
+
+func testAccElbv2TagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_lb_target_group" "test" {
+  name        = %[1]q
+  target_type = "lambda"
+}
+
+resource "aws_elbv2_tag" "test" {
+  resource_id = aws_lb_target_group.test.arn
+  key         = %[2]q
+  value       = %[3]q
+}
+`, rName, key, value)
+}
@@ -14,21 +14,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
-		Name: "aws_emr_cluster",
-		F:    testSweepEmrClusters,
+	sweep.AddTestSweepers("aws_emr_cluster", &sweep.Sweeper{
+		Name:    "aws_emr_cluster",
+		Service: "emr",
+		List:    testSweepEmrClusters,
 	})
 }
 
-func testSweepEmrClusters(region string) error {
+func testSweepEmrClusters(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).emrconn
+	conn := client.(*AWSClient).emrconn()
+	var sweepables []sweep.Sweepable
 
 	input := &emr.ListClustersInput{
 		ClusterStates: []*string{
@@ -50,31 +53,32 @@ func testSweepEmrClusters(region string) error {
 			terminateJobFlowsInput := &emr.TerminateJobFlowsInput{
 				JobFlowIds: []*string{cluster.Id},
 			}
-			id := aws.StringValue(cluster.Id)
 
-			log.Printf("[INFO] Deleting EMR Cluster: %s", id)
-			_, err = conn.TerminateJobFlows(terminateJobFlowsInput)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(cluster.Id), func() error {
+				if _, err := conn.TerminateJobFlows(terminateJobFlowsInput); err != nil {
+					return err
+				}
 
-			if err != nil {
-				log.Printf("[ERROR] Error terminating EMR Cluster (%s): %s", id, err)
-			}
+				if err := conn.WaitUntilClusterTerminated(describeClusterInput); err != nil {
+					return fmt.Errorf("error waiting for termination: %w", err)
+				}
 
-			if err := conn.WaitUntilClusterTerminated(describeClusterInput); err != nil {
-				log.Printf("[ERROR] Error waiting for EMR Cluster (%s) termination: %s", id, err)
-			}
+				return nil
+			}).WithName(aws.StringValue(cluster.Name)))
 		}
 
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping EMR Cluster sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("error retrieving EMR Clusters: %s", err)
+		return sweepables, fmt.Errorf("error retrieving EMR Clusters: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSEMRCluster_basic(t *testing.T) {
@@ -523,7 +527,7 @@ func TestAccAWSEMRCluster_Ec2Attributes_DefaultManagedSecurityGroups(t *testing.
 			},
 			{
 				PreConfig: func() {
-					conn := testAccProvider.Meta().(*AWSClient).ec2conn
+					conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 					err := testAccEmrDeleteManagedSecurityGroups(conn, &vpc)
 
@@ -1402,7 +1406,7 @@ func TestAccAWSEMRCluster_instance_fleet_master_only(t *testing.T) {
 }
 
 func testAccCheckAWSEmrDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).emrconn
+	conn := testAccProvider.Meta().(*AWSClient).emrconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_emr_cluster" {
@@ -1442,7 +1446,7 @@ func testAccCheckAWSEmrClusterExists(n string, v *emr.Cluster) resource.TestChec
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No cluster id set")
 		}
-		conn := testAccProvider.Meta().(*AWSClient).emrconn
+		conn := testAccProvider.Meta().(*AWSClient).emrconn()
 		describe, err := conn.DescribeCluster(&emr.DescribeClusterInput{
 			ClusterId: aws.String(rs.Primary.ID),
 		})
@@ -1469,7 +1473,7 @@ func testAccCheckAWSEmrClusterExists(n string, v *emr.Cluster) resource.TestChec
 
 func testAccCheckAWSEmrClusterDisappears(cluster *emr.Cluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).emrconn
+		conn := testAccProvider.Meta().(*AWSClient).emrconn()
 		id := aws.StringValue(cluster.Id)
 
 		terminateJobFlowsInput := &emr.TerminateJobFlowsInput{
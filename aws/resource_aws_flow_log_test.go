@@ -8,26 +8,28 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_flow_log", &resource.Sweeper{
-		Name: "aws_flow_log",
-		F:    testSweepFlowLogs,
+	sweep.AddTestSweepers("aws_flow_log", &sweep.Sweeper{
+		Name:    "aws_flow_log",
+		Service: "ec2",
+		List:    testSweepFlowLogs,
 	})
 }
 
-func testSweepFlowLogs(region string) error {
+func testSweepFlowLogs(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).ec2conn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).ec2conn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeFlowLogsPages(&ec2.DescribeFlowLogsInput{}, func(page *ec2.DescribeFlowLogsOutput, isLast bool) bool {
 		if page == nil {
@@ -37,32 +39,32 @@ func testSweepFlowLogs(region string) error {
 		for _, flowLog := range page.FlowLogs {
 			id := aws.StringValue(flowLog.FlowLogId)
 
-			log.Printf("[INFO] Deleting Flow Log: %s", id)
-			_, err := conn.DeleteFlowLogs(&ec2.DeleteFlowLogsInput{
-				FlowLogIds: aws.StringSlice([]string{id}),
-			})
-			if isAWSErr(err, "InvalidFlowLogId.NotFound", "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Flow Log (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteFlowLogs(&ec2.DeleteFlowLogsInput{
+					FlowLogIds: aws.StringSlice([]string{id}),
+				})
+
+				if isAWSErr(err, "InvalidFlowLogId.NotFound", "") {
+					return nil
+				}
+
+				return err
+			}).WithTags(keyvaluetags.Ec2KeyValueTags(flowLog.Tags).IgnoreAws().Map()))
 		}
 
 		return !isLast
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Flow Logs sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Flow Logs: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Flow Logs: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSFlowLog_VPCID(t *testing.T) {
@@ -358,7 +360,7 @@ func testAccCheckFlowLogExists(n string, flowLog *ec2.FlowLog) resource.TestChec
 			return fmt.Errorf("No Flow Log ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		describeOpts := &ec2.DescribeFlowLogsInput{
 			FlowLogIds: []*string{aws.String(rs.Primary.ID)},
 		}
@@ -34,7 +34,7 @@ func resourceAwsFmsAdminAccount() *schema.Resource {
 }
 
 func resourceAwsFmsAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fmsconn
+	conn := meta.(*AWSClient).fmsconn()
 
 	// Ensure there is not an existing FMS Admin Account
 	getAdminAccountOutput, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
@@ -99,7 +99,7 @@ func associateFmsAdminAccountRefreshFunc(conn *fms.FMS, accountId string) resour
 }
 
 func resourceAwsFmsAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fmsconn
+	conn := meta.(*AWSClient).fmsconn()
 
 	output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
 
@@ -125,7 +125,7 @@ func resourceAwsFmsAdminAccountRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsFmsAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fmsconn
+	conn := meta.(*AWSClient).fmsconn()
 
 	_, err := conn.DisassociateAdminAccount(&fms.DisassociateAdminAccountInput{})
 
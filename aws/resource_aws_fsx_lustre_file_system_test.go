@@ -13,22 +13,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_fsx_lustre_file_system", &resource.Sweeper{
-		Name: "aws_fsx_lustre_file_system",
-		F:    testSweepFSXLustreFileSystems,
+	sweep.AddTestSweepers("aws_fsx_lustre_file_system", &sweep.Sweeper{
+		Name:    "aws_fsx_lustre_file_system",
+		Service: "fsx",
+		List:    testSweepFSXLustreFileSystems,
 	})
 }
 
-func testSweepFSXLustreFileSystems(region string) error {
+func testSweepFSXLustreFileSystems(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).fsxconn
+	conn := client.(*AWSClient).fsxconn()
 	input := &fsx.DescribeFileSystemsInput{}
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeFileSystemsPages(input, func(page *fsx.DescribeFileSystemsOutput, lastPage bool) bool {
 		for _, fs := range page.FileSystems {
@@ -36,21 +40,22 @@ func testSweepFSXLustreFileSystems(region string) error {
 				continue
 			}
 
+			id := aws.StringValue(fs.FileSystemId)
 			input := &fsx.DeleteFileSystemInput{
 				FileSystemId: fs.FileSystemId,
 			}
 
-			log.Printf("[INFO] Deleting FSx lustre filesystem: %s", aws.StringValue(fs.FileSystemId))
-			_, err := conn.DeleteFileSystem(input)
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				if _, err := conn.DeleteFileSystem(input); err != nil {
+					return err
+				}
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting FSx filesystem: %s", err)
-				continue
-			}
+				if err := waitForFsxFileSystemDeletion(conn, id, 30*time.Minute); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
 
-			if err := waitForFsxFileSystemDeletion(conn, aws.StringValue(fs.FileSystemId), 30*time.Minute); err != nil {
-				log.Printf("[ERROR] Error waiting for filesystem (%s) to delete: %s", aws.StringValue(fs.FileSystemId), err)
-			}
+				return nil
+			}).WithTags(keyvaluetags.FsxKeyValueTags(fs.Tags).IgnoreAws().Map()))
 		}
 
 		return !lastPage
@@ -58,15 +63,14 @@ func testSweepFSXLustreFileSystems(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping FSx Lustre Filesystem sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing FSx Lustre Filesystems: %s", err)
+		return sweepables, fmt.Errorf("error listing FSx Lustre Filesystems: %s", err)
 	}
 
-	return nil
-
+	return sweepables, nil
 }
 
 func TestAccAWSFsxLustreFileSystem_basic(t *testing.T) {
@@ -689,7 +693,7 @@ func testAccCheckFsxLustreFileSystemExists(resourceName string, fs *fsx.FileSyst
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).fsxconn
+		conn := testAccProvider.Meta().(*AWSClient).fsxconn()
 
 		filesystem, err := describeFsxFileSystem(conn, rs.Primary.ID)
 
@@ -708,7 +712,7 @@ func testAccCheckFsxLustreFileSystemExists(resourceName string, fs *fsx.FileSyst
 }
 
 func testAccCheckFsxLustreFileSystemDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).fsxconn
+	conn := testAccProvider.Meta().(*AWSClient).fsxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fsx_lustre_file_system" {
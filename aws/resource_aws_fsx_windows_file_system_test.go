@@ -11,22 +11,26 @@ import (
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_fsx_windows_file_system", &resource.Sweeper{
-		Name: "aws_fsx_windows_file_system",
-		F:    testSweepFSXWindowsFileSystems,
+	sweep.AddTestSweepers("aws_fsx_windows_file_system", &sweep.Sweeper{
+		Name:    "aws_fsx_windows_file_system",
+		Service: "fsx",
+		List:    testSweepFSXWindowsFileSystems,
 	})
 }
 
-func testSweepFSXWindowsFileSystems(region string) error {
+func testSweepFSXWindowsFileSystems(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).fsxconn
+	conn := client.(*AWSClient).fsxconn()
 	input := &fsx.DescribeFileSystemsInput{}
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeFileSystemsPages(input, func(page *fsx.DescribeFileSystemsOutput, lastPage bool) bool {
 		for _, fs := range page.FileSystems {
@@ -34,6 +38,7 @@ func testSweepFSXWindowsFileSystems(region string) error {
 				continue
 			}
 
+			id := aws.StringValue(fs.FileSystemId)
 			input := &fsx.DeleteFileSystemInput{
 				ClientRequestToken: aws.String(resource.UniqueId()),
 				FileSystemId:       fs.FileSystemId,
@@ -42,17 +47,17 @@ func testSweepFSXWindowsFileSystems(region string) error {
 				},
 			}
 
-			log.Printf("[INFO] Deleting FSx windows filesystem: %s", aws.StringValue(fs.FileSystemId))
-			_, err := conn.DeleteFileSystem(input)
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				if _, err := conn.DeleteFileSystem(input); err != nil {
+					return err
+				}
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting FSx filesystem: %s", err)
-				continue
-			}
+				if err := waitForFsxFileSystemDeletion(conn, id, 30*time.Minute); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
 
-			if err := waitForFsxFileSystemDeletion(conn, aws.StringValue(fs.FileSystemId), 30*time.Minute); err != nil {
-				log.Printf("[ERROR] Error waiting for filesystem (%s) to delete: %s", aws.StringValue(fs.FileSystemId), err)
-			}
+				return nil
+			}).WithTags(keyvaluetags.FsxKeyValueTags(fs.Tags).IgnoreAws().Map()))
 		}
 
 		return !lastPage
@@ -60,15 +65,14 @@ func testSweepFSXWindowsFileSystems(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping FSx Windows Filesystem sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing FSx Windows Filesystems: %s", err)
+		return sweepables, fmt.Errorf("error listing FSx Windows Filesystems: %s", err)
 	}
 
-	return nil
-
+	return sweepables, nil
 }
 
 func TestAccAWSFsxWindowsFileSystem_basic(t *testing.T) {
@@ -695,7 +699,7 @@ func testAccCheckFsxWindowsFileSystemExists(resourceName string, fs *fsx.FileSys
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).fsxconn
+		conn := testAccProvider.Meta().(*AWSClient).fsxconn()
 
 		filesystem, err := describeFsxFileSystem(conn, rs.Primary.ID)
 
@@ -714,7 +718,7 @@ func testAccCheckFsxWindowsFileSystemExists(resourceName string, fs *fsx.FileSys
 }
 
 func testAccCheckFsxWindowsFileSystemDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).fsxconn
+	conn := testAccProvider.Meta().(*AWSClient).fsxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fsx_windows_file_system" {
@@ -11,54 +11,53 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
-		Name: "aws_gamelift_alias",
+	sweep.AddTestSweepers("aws_gamelift_alias", &sweep.Sweeper{
+		Name:    "aws_gamelift_alias",
+		Service: "gamelift",
 		Dependencies: []string{
 			"aws_gamelift_fleet",
 		},
-		F: testSweepGameliftAliases,
+		List: testSweepGameliftAliases,
 	})
 }
 
-func testSweepGameliftAliases(region string) error {
+func testSweepGameliftAliases(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).gameliftconn
+	conn := client.(*AWSClient).gameliftconn()
+	var sweepables []sweep.Sweepable
 
 	err = listGameliftAliases(&gamelift.ListAliasesInput{}, conn, func(resp *gamelift.ListAliasesOutput) error {
-		if len(resp.Aliases) == 0 {
-			log.Print("[DEBUG] No Gamelift Aliases to sweep")
-			return nil
-		}
-
-		log.Printf("[INFO] Found %d Gamelift Aliases", len(resp.Aliases))
-
 		for _, alias := range resp.Aliases {
-			log.Printf("[INFO] Deleting Gamelift Alias %q", *alias.AliasId)
-			_, err := conn.DeleteAlias(&gamelift.DeleteAliasInput{
+			input := &gamelift.DeleteAliasInput{
 				AliasId: alias.AliasId,
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Gamelift Alias (%s): %s",
-					*alias.AliasId, err)
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(alias.AliasId), func() error {
+				_, err := conn.DeleteAlias(input)
+
+				return err
+			}).WithName(aws.StringValue(alias.Name)))
 		}
+
 		return nil
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Gamelift Alias sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error listing Gamelift Aliases: %s", err)
+		return sweepables, fmt.Errorf("Error listing Gamelift Aliases: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func listGameliftAliases(input *gamelift.ListAliasesInput, conn *gamelift.GameLift, f func(*gamelift.ListAliasesOutput) error) error {
@@ -278,7 +277,7 @@ func TestAccAWSGameliftAlias_disappears(t *testing.T) {
 
 func testAccCheckAWSGameliftAliasDisappears(res *gamelift.Alias) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		input := &gamelift.DeleteAliasInput{AliasId: res.AliasId}
 
@@ -299,7 +298,7 @@ func testAccCheckAWSGameliftAliasExists(n string, res *gamelift.Alias) resource.
 			return fmt.Errorf("No Gamelift Alias ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		out, err := conn.DescribeAlias(&gamelift.DescribeAliasInput{
 			AliasId: aws.String(rs.Primary.ID),
@@ -320,7 +319,7 @@ func testAccCheckAWSGameliftAliasExists(n string, res *gamelift.Alias) resource.
 }
 
 func testAccCheckAWSGameliftAliasDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_alias" {
@@ -11,52 +11,50 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 const testAccGameliftBuildPrefix = "tf_acc_build_"
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
-		Name: "aws_gamelift_build",
-		F:    testSweepGameliftBuilds,
+	sweep.AddTestSweepers("aws_gamelift_build", &sweep.Sweeper{
+		Name:    "aws_gamelift_build",
+		Service: "gamelift",
+		List:    testSweepGameliftBuilds,
 	})
 }
 
-func testSweepGameliftBuilds(region string) error {
+func testSweepGameliftBuilds(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).gameliftconn
+	conn := client.(*AWSClient).gameliftconn()
 
 	resp, err := conn.ListBuilds(&gamelift.ListBuildsInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Gamelife Build sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error listing Gamelift Builds: %s", err)
+		return nil, fmt.Errorf("Error listing Gamelift Builds: %s", err)
 	}
 
-	if len(resp.Builds) == 0 {
-		log.Print("[DEBUG] No Gamelift Builds to sweep")
-		return nil
-	}
-
-	log.Printf("[INFO] Found %d Gamelift Builds", len(resp.Builds))
+	var sweepables []sweep.Sweepable
 
 	for _, build := range resp.Builds {
-		log.Printf("[INFO] Deleting Gamelift Build %q", *build.BuildId)
-		_, err := conn.DeleteBuild(&gamelift.DeleteBuildInput{
+		input := &gamelift.DeleteBuildInput{
 			BuildId: build.BuildId,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting Gamelift Build (%s): %s",
-				*build.BuildId, err)
 		}
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(build.BuildId), func() error {
+			_, err := conn.DeleteBuild(input)
+
+			return err
+		}).WithName(aws.StringValue(build.Name)))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSGameliftBuild_basic(t *testing.T) {
@@ -242,7 +240,7 @@ func testAccCheckAWSGameliftBuildExists(n string, res *gamelift.Build) resource.
 			return fmt.Errorf("No Gamelift Build ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		req := &gamelift.DescribeBuildInput{
 			BuildId: aws.String(rs.Primary.ID),
@@ -266,7 +264,7 @@ func testAccCheckAWSGameliftBuildExists(n string, res *gamelift.Build) resource.
 
 func testAccCheckAWSGameliftBuildDisappears(res *gamelift.Build) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		input := &gamelift.DeleteBuildInput{BuildId: res.BuildId}
 
@@ -276,7 +274,7 @@ func testAccCheckAWSGameliftBuildDisappears(res *gamelift.Build) resource.TestCh
 }
 
 func testAccCheckAWSGameliftBuildDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_build" {
@@ -303,7 +301,7 @@ func testAccCheckAWSGameliftBuildDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckAWSGamelift(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 	input := &gamelift.ListBuildsInput{}
 
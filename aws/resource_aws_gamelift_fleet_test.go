@@ -12,68 +12,75 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
-		Name: "aws_gamelift_fleet",
+	sweep.AddTestSweepers("aws_gamelift_fleet", &sweep.Sweeper{
+		Name:    "aws_gamelift_fleet",
+		Service: "gamelift",
 		Dependencies: []string{
 			"aws_gamelift_build",
 		},
-		F: testSweepGameliftFleets,
+		List: testSweepGameliftFleets,
 	})
 }
 
-func testSweepGameliftFleets(region string) error {
+func testSweepGameliftFleets(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).gameliftconn
+	conn := client.(*AWSClient).gameliftconn()
+	var sweepables []sweep.Sweepable
 
-	return testAccGameliftListFleets(conn, nil, region, func(fleetIds []*string) error {
+	err = testAccGameliftListFleets(conn, nil, region, func(fleetIds []*string) error {
 		if len(fleetIds) == 0 {
-			log.Print("[DEBUG] No Gamelift Fleets to sweep")
 			return nil
 		}
 
 		out, err := conn.DescribeFleetAttributes(&gamelift.DescribeFleetAttributesInput{
 			FleetIds: fleetIds,
 		})
+
 		if err != nil {
 			return fmt.Errorf("Error describing Gamelift Fleet attributes: %s", err)
 		}
 
-		log.Printf("[INFO] Found %d Gamelift Fleets", len(out.FleetAttributes))
-
 		for _, attr := range out.FleetAttributes {
-			log.Printf("[INFO] Deleting Gamelift Fleet %q", *attr.FleetId)
-			err := resource.Retry(60*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteFleet(&gamelift.DeleteFleetInput{
-					FleetId: attr.FleetId,
+			fleetID := aws.StringValue(attr.FleetId)
+
+			sweepables = append(sweepables, sweep.NewResource(fleetID, func() error {
+				err := resource.Retry(60*time.Minute, func() *resource.RetryError {
+					_, err := conn.DeleteFleet(&gamelift.DeleteFleetInput{
+						FleetId: aws.String(fleetID),
+					})
+					if err != nil {
+						msg := fmt.Sprintf("Cannot delete fleet %s that is in status of ", fleetID)
+						if isAWSErr(err, gamelift.ErrCodeInvalidRequestException, msg) {
+							return resource.RetryableError(err)
+						}
+						return resource.NonRetryableError(err)
+					}
+					return nil
 				})
+
 				if err != nil {
-					msg := fmt.Sprintf("Cannot delete fleet %s that is in status of ", *attr.FleetId)
-					if isAWSErr(err, gamelift.ErrCodeInvalidRequestException, msg) {
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
+					return err
 				}
-				return nil
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Gamelift Fleet (%s): %s",
-					*attr.FleetId, err)
-			}
 
-			err = waitForGameliftFleetToBeDeleted(conn, *attr.FleetId, 5*time.Minute)
-			if err != nil {
-				return fmt.Errorf("Error waiting for Gamelift Fleet (%s) to be deleted: %s",
-					*attr.FleetId, err)
-			}
+				if err := waitForGameliftFleetToBeDeleted(conn, fleetID, 5*time.Minute); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}).WithName(aws.StringValue(attr.Name)))
 		}
+
 		return nil
 	})
+
+	return sweepables, err
 }
 
 func testAccGameliftListFleets(conn *gamelift.GameLift, nextToken *string, region string, f func([]*string) error) error {
@@ -559,7 +566,7 @@ func testAccCheckAWSGameliftFleetExists(n string, res *gamelift.FleetAttributes)
 			return fmt.Errorf("No Gamelift Fleet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		out, err := conn.DescribeFleetAttributes(&gamelift.DescribeFleetAttributesInput{
 			FleetIds: aws.StringSlice([]string{rs.Primary.ID}),
@@ -589,7 +596,7 @@ func testAccCheckAWSGameliftFleetExists(n string, res *gamelift.FleetAttributes)
 
 func testAccCheckAWSGameliftFleetDisappears(res *gamelift.FleetAttributes) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		input := &gamelift.DeleteFleetInput{FleetId: res.FleetId}
 		err := resource.Retry(60*time.Minute, func() *resource.RetryError {
@@ -615,7 +622,7 @@ func testAccCheckAWSGameliftFleetDisappears(res *gamelift.FleetAttributes) resou
 }
 
 func testAccCheckAWSGameliftFleetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_fleet" {
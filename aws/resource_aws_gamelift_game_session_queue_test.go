@@ -12,54 +12,52 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 const testAccGameliftGameSessionQueuePrefix = "tfAccQueue-"
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
-		Name: "aws_gamelift_game_session_queue",
-		F:    testSweepGameliftGameSessionQueue,
+	sweep.AddTestSweepers("aws_gamelift_game_session_queue", &sweep.Sweeper{
+		Name:    "aws_gamelift_game_session_queue",
+		Service: "gamelift",
+		List:    testSweepGameliftGameSessionQueue,
 	})
 }
 
-func testSweepGameliftGameSessionQueue(region string) error {
+func testSweepGameliftGameSessionQueue(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).gameliftconn
+	conn := client.(*AWSClient).gameliftconn()
 
 	out, err := conn.DescribeGameSessionQueues(&gamelift.DescribeGameSessionQueuesInput{})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Gamelife Queue sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing Gamelift Session Queue: %s", err)
+		return nil, fmt.Errorf("error listing Gamelift Session Queue: %s", err)
 	}
 
-	if len(out.GameSessionQueues) == 0 {
-		log.Print("[DEBUG] No Gamelift Session Queue to sweep")
-		return nil
-	}
-
-	log.Printf("[INFO] Found %d Gamelift Session Queue", len(out.GameSessionQueues))
+	var sweepables []sweep.Sweepable
 
 	for _, queue := range out.GameSessionQueues {
-		log.Printf("[INFO] Deleting Gamelift Session Queue %q", *queue.Name)
-		_, err := conn.DeleteGameSessionQueue(&gamelift.DeleteGameSessionQueueInput{
-			Name: aws.String(*queue.Name),
-		})
-		if err != nil {
-			return fmt.Errorf("error deleting Gamelift Session Queue (%s): %s",
-				*queue.Name, err)
+		input := &gamelift.DeleteGameSessionQueueInput{
+			Name: queue.Name,
 		}
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(queue.Name), func() error {
+			_, err := conn.DeleteGameSessionQueue(input)
+
+			return err
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSGameliftGameSessionQueue_basic(t *testing.T) {
@@ -248,7 +246,7 @@ func testAccCheckAWSGameliftGameSessionQueueExists(n string, res *gamelift.GameS
 			return fmt.Errorf("no Gamelift Session Queue Name is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		name := rs.Primary.Attributes["name"]
 		limit := int64(1)
@@ -281,7 +279,7 @@ func testAccCheckAWSGameliftGameSessionQueueExists(n string, res *gamelift.GameS
 
 func testAccCheckAWSGameliftGameSessionQueueDisappears(res *gamelift.GameSessionQueue) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+		conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 		input := &gamelift.DeleteGameSessionQueueInput{Name: res.Name}
 
@@ -292,7 +290,7 @@ func testAccCheckAWSGameliftGameSessionQueueDisappears(res *gamelift.GameSession
 }
 
 func testAccCheckAWSGameliftGameSessionQueueDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).gameliftconn
+	conn := testAccProvider.Meta().(*AWSClient).gameliftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_game_session_queue" {
@@ -8,26 +8,27 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glacier"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glacier_vault", &resource.Sweeper{
-		Name: "aws_glacier_vault",
-		F:    testSweepGlacierVaults,
+	sweep.AddTestSweepers("aws_glacier_vault", &sweep.Sweeper{
+		Name:    "aws_glacier_vault",
+		Service: "glacier",
+		List:    testSweepGlacierVaults,
 	})
 }
 
-func testSweepGlacierVaults(region string) error {
+func testSweepGlacierVaults(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).glacierconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).glacierconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListVaultsPages(&glacier.ListVaultsInput{}, func(page *glacier.ListVaultsOutput, isLast bool) bool {
 		if page == nil {
@@ -37,41 +38,37 @@ func testSweepGlacierVaults(region string) error {
 		for _, vault := range page.VaultList {
 			name := aws.StringValue(vault.VaultName)
 
-			// First attempt to delete the vault's notification configuration in case the vault deletion fails.
-			log.Printf("[INFO] Deleting Glacier Vault (%s) Notifications", name)
-			_, err := conn.DeleteVaultNotifications(&glacier.DeleteVaultNotificationsInput{
-				VaultName: aws.String(name),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Glacier Vault (%s) Notifications: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				// First attempt to delete the vault's notification configuration in case the vault deletion fails.
+				_, err := conn.DeleteVaultNotifications(&glacier.DeleteVaultNotificationsInput{
+					VaultName: aws.String(name),
+				})
 
-			log.Printf("[INFO] Deleting Glacier Vault: %s", name)
-			_, err = conn.DeleteVault(&glacier.DeleteVaultInput{
-				VaultName: aws.String(name),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Glacier Vault (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				if err != nil {
+					return fmt.Errorf("error deleting notifications: %w", err)
+				}
+
+				_, err = conn.DeleteVault(&glacier.DeleteVaultInput{
+					VaultName: aws.String(name),
+				})
+
+				return err
+			}))
 		}
 
 		return !isLast
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Glacier Vaults sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Glacier Vaults: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Glacier Vaults: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSGlacierVault_basic(t *testing.T) {
@@ -271,7 +268,7 @@ func testAccCheckGlacierVaultExists(name string, vault *glacier.DescribeVaultOut
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glacierconn
+		conn := testAccProvider.Meta().(*AWSClient).glacierconn()
 		out, err := conn.DescribeVault(&glacier.DescribeVaultInput{
 			VaultName: aws.String(rs.Primary.ID),
 		})
@@ -306,7 +303,7 @@ func testAccCheckVaultNotificationsMissing(name string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glacierconn
+		conn := testAccProvider.Meta().(*AWSClient).glacierconn()
 		out, err := conn.GetVaultNotifications(&glacier.GetVaultNotificationsInput{
 			VaultName: aws.String(rs.Primary.ID),
 		})
@@ -325,7 +322,7 @@ func testAccCheckVaultNotificationsMissing(name string) resource.TestCheckFunc {
 }
 
 func testAccCheckGlacierVaultDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).glacierconn
+	conn := testAccProvider.Meta().(*AWSClient).glacierconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_glacier_vault" {
@@ -13,85 +13,70 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
-		Name: "aws_globalaccelerator_accelerator",
-		F:    testSweepGlobalAcceleratorAccelerators,
+	sweep.AddTestSweepers("aws_globalaccelerator_accelerator", &sweep.Sweeper{
+		Name:    "aws_globalaccelerator_accelerator",
+		Service: "globalaccelerator",
+		List:    testSweepGlobalAcceleratorAccelerators,
 	})
 }
 
-func testSweepGlobalAcceleratorAccelerators(region string) error {
+func testSweepGlobalAcceleratorAccelerators(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %s", err)
+		return nil, fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).globalacceleratorconn
-
+	conn := client.(*AWSClient).globalacceleratorconn()
 	input := &globalaccelerator.ListAcceleratorsInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.ListAccelerators(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Global Accelerator Accelerator sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving Global Accelerator Accelerators: %s", err)
+			return sweepables, fmt.Errorf("Error retrieving Global Accelerator Accelerators: %s", err)
 		}
 
 		for _, accelerator := range output.Accelerators {
 			arn := aws.StringValue(accelerator.AcceleratorArn)
+			enabled := aws.BoolValue(accelerator.Enabled)
 
-			errs := sweepGlobalAcceleratorListeners(conn, accelerator.AcceleratorArn)
-			if errs != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, errs)
-			}
-
-			if aws.BoolValue(accelerator.Enabled) {
-				input := &globalaccelerator.UpdateAcceleratorInput{
-					AcceleratorArn: accelerator.AcceleratorArn,
-					Enabled:        aws.Bool(false),
+			sweepables = append(sweepables, sweep.NewResource(arn, func() error {
+				if errs := sweepGlobalAcceleratorListeners(conn, aws.String(arn)); errs != nil {
+					return errs.ErrorOrNil()
 				}
 
-				log.Printf("[INFO] Disabling Global Accelerator Accelerator: %s", arn)
+				if enabled {
+					_, err := conn.UpdateAccelerator(&globalaccelerator.UpdateAcceleratorInput{
+						AcceleratorArn: aws.String(arn),
+						Enabled:        aws.Bool(false),
+					})
 
-				_, err := conn.UpdateAccelerator(input)
+					if err != nil {
+						return fmt.Errorf("error disabling: %w", err)
+					}
+				}
 
-				if err != nil {
-					sweeperErr := fmt.Errorf("error disabling Global Accelerator Accelerator (%s): %s", arn, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
+				// Global Accelerator accelerators need to be in `DEPLOYED` state before they can be deleted.
+				// Removing listeners or disabling can both set the state to `IN_PROGRESS`.
+				if err := resourceAwsGlobalAcceleratorAcceleratorWaitForDeployedState(conn, arn, 60*time.Minute); err != nil {
+					return fmt.Errorf("error waiting for deployed state: %w", err)
 				}
-			}
-
-			// Global Accelerator accelerators need to be in `DEPLOYED` state before they can be deleted.
-			// Removing listeners or disabling can both set the state to `IN_PROGRESS`.
-			if err := resourceAwsGlobalAcceleratorAcceleratorWaitForDeployedState(conn, arn, 60*time.Minute); err != nil {
-				sweeperErr := fmt.Errorf("error waiting for Global Accelerator Accelerator (%s): %s", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-
-			input := &globalaccelerator.DeleteAcceleratorInput{
-				AcceleratorArn: accelerator.AcceleratorArn,
-			}
-
-			log.Printf("[INFO] Deleting Global Accelerator Accelerator: %s", arn)
-			_, err := conn.DeleteAccelerator(input)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Global Accelerator Accelerator (%s): %s", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+
+				_, err := conn.DeleteAccelerator(&globalaccelerator.DeleteAcceleratorInput{
+					AcceleratorArn: aws.String(arn),
+				})
+
+				return err
+			}).WithName(aws.StringValue(accelerator.Name)))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -101,7 +86,7 @@ func testSweepGlobalAcceleratorAccelerators(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func sweepGlobalAcceleratorListeners(conn *globalaccelerator.GlobalAccelerator, acceleratorArn *string) *multierror.Error {
@@ -319,7 +304,7 @@ func TestAccAwsGlobalAcceleratorAccelerator_tags(t *testing.T) {
 }
 
 func testAccPreCheckGlobalAccelerator(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn()
 
 	input := &globalaccelerator.ListAcceleratorsInput{}
 
@@ -336,7 +321,7 @@ func testAccPreCheckGlobalAccelerator(t *testing.T) {
 
 func testAccCheckGlobalAcceleratorAcceleratorExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -361,7 +346,7 @@ func testAccCheckGlobalAcceleratorAcceleratorExists(name string) resource.TestCh
 }
 
 func testAccCheckGlobalAcceleratorAcceleratorDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_globalaccelerator_accelerator" {
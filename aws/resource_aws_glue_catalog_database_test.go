@@ -10,55 +10,52 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_catalog_database", &resource.Sweeper{
-		Name: "aws_glue_catalog_database",
-		F:    testSweepGlueCatalogDatabases,
+	sweep.AddTestSweepers("aws_glue_catalog_database", &sweep.Sweeper{
+		Name:    "aws_glue_catalog_database",
+		Service: "glue",
+		List:    testSweepGlueCatalogDatabases,
 	})
 }
 
-func testSweepGlueCatalogDatabases(region string) error {
+func testSweepGlueCatalogDatabases(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
+	var sweepables []sweep.Sweepable
 
 	input := &glue.GetDatabasesInput{}
 	err = conn.GetDatabasesPages(input, func(page *glue.GetDatabasesOutput, lastPage bool) bool {
-		if len(page.DatabaseList) == 0 {
-			log.Printf("[INFO] No Glue Catalog Databases to sweep")
-			return false
-		}
 		for _, database := range page.DatabaseList {
 			name := aws.StringValue(database.Name)
 
-			log.Printf("[INFO] Deleting Glue Catalog Database: %s", name)
-
 			r := resourceAwsGlueCatalogDatabase()
 			d := r.Data(nil)
 			d.SetId("???")
 			d.Set("name", name)
 			d.Set("catalog_id", database.CatalogId)
 
-			err := r.Delete(d, client)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Catalog Database %s: %s", name, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s:%s", aws.StringValue(database.CatalogId), name), func() error {
+				return r.Delete(d, client)
+			}).WithName(name))
 		}
 		return !lastPage
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Glue Catalog Database sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving Glue Catalog Databases: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving Glue Catalog Databases: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSGlueCatalogDatabase_full(t *testing.T) {
@@ -135,7 +132,7 @@ func TestAccAWSGlueCatalogDatabase_disappears(t *testing.T) {
 }
 
 func testAccCheckGlueDatabaseDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).glueconn
+	conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_glue_catalog_database" {
@@ -204,7 +201,7 @@ func testAccCheckGlueCatalogDatabaseExists(name string) resource.TestCheckFunc {
 			return err
 		}
 
-		glueconn := testAccProvider.Meta().(*AWSClient).glueconn
+		glueconn := testAccProvider.Meta().(*AWSClient).glueconn()
 		out, err := glueconn.GetDatabase(&glue.GetDatabaseInput{
 			CatalogId: aws.String(catalogId),
 			Name:      aws.String(dbName),
@@ -10,28 +10,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_classifier", &resource.Sweeper{
-		Name: "aws_glue_classifier",
-		F:    testSweepGlueClassifiers,
+	sweep.AddTestSweepers("aws_glue_classifier", &sweep.Sweeper{
+		Name:    "aws_glue_classifier",
+		Service: "glue",
+		List:    testSweepGlueClassifiers,
 	})
 }
 
-func testSweepGlueClassifiers(region string) error {
+func testSweepGlueClassifiers(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
+	var sweepables []sweep.Sweepable
 
 	input := &glue.GetClassifiersInput{}
 	err = conn.GetClassifiersPages(input, func(page *glue.GetClassifiersOutput, lastPage bool) bool {
-		if len(page.Classifiers) == 0 {
-			log.Printf("[INFO] No Glue Classifiers to sweep")
-			return false
-		}
 		for _, classifier := range page.Classifiers {
 			var name string
 			if classifier.CsvClassifier != nil {
@@ -48,23 +47,22 @@ func testSweepGlueClassifiers(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Glue Classifier: %s", name)
-			err := deleteGlueClassifier(conn, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Classifier %s: %s", name, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				return deleteGlueClassifier(conn, name)
+			}))
 		}
 		return !lastPage
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Glue Classifier sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving Glue Classifiers: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving Glue Classifiers: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSGlueClassifier_CsvClassifier(t *testing.T) {
@@ -445,7 +443,7 @@ func testAccCheckAWSGlueClassifierExists(resourceName string, classifier *glue.C
 			return fmt.Errorf("No Glue Classifier ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetClassifier(&glue.GetClassifierInput{
 			Name: aws.String(rs.Primary.ID),
@@ -469,7 +467,7 @@ func testAccCheckAWSGlueClassifierDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetClassifier(&glue.GetClassifierInput{
 			Name: aws.String(rs.Primary.ID),
@@ -10,51 +10,49 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_connection", &resource.Sweeper{
-		Name: "aws_glue_connection",
-		F:    testSweepGlueConnections,
+	sweep.AddTestSweepers("aws_glue_connection", &sweep.Sweeper{
+		Name:    "aws_glue_connection",
+		Service: "glue",
+		List:    testSweepGlueConnections,
 	})
 }
 
-func testSweepGlueConnections(region string) error {
+func testSweepGlueConnections(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
 	catalogID := client.(*AWSClient).accountid
+	var sweepables []sweep.Sweepable
 
 	input := &glue.GetConnectionsInput{
 		CatalogId: aws.String(catalogID),
 	}
 	err = conn.GetConnectionsPages(input, func(page *glue.GetConnectionsOutput, lastPage bool) bool {
-		if len(page.ConnectionList) == 0 {
-			log.Printf("[INFO] No Glue Connections to sweep")
-			return false
-		}
 		for _, connection := range page.ConnectionList {
 			name := aws.StringValue(connection.Name)
 
-			log.Printf("[INFO] Deleting Glue Connection: %s", name)
-			err := deleteGlueConnection(conn, catalogID, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Connection %s: %s", name, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(fmt.Sprintf("%s:%s", catalogID, name), func() error {
+				return deleteGlueConnection(conn, catalogID, name)
+			}).WithName(name))
 		}
 		return !lastPage
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Glue Connection sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving Glue Connections: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving Glue Connections: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSGlueConnection_basic(t *testing.T) {
@@ -307,7 +305,7 @@ func testAccCheckAWSGlueConnectionExists(resourceName string, connection *glue.C
 			return fmt.Errorf("No Glue Connection ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		catalogID, connectionName, err := decodeGlueConnectionID(rs.Primary.ID)
 		if err != nil {
 			return err
@@ -340,7 +338,7 @@ func testAccCheckAWSGlueConnectionDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		catalogID, connectionName, err := decodeGlueConnectionID(rs.Primary.ID)
 		if err != nil {
 			return err
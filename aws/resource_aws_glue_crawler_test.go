@@ -13,50 +13,50 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_crawler", &resource.Sweeper{
-		Name: "aws_glue_crawler",
-		F:    testSweepGlueCrawlers,
+	sweep.AddTestSweepers("aws_glue_crawler", &sweep.Sweeper{
+		Name:    "aws_glue_crawler",
+		Service: "glue",
+		List:    testSweepGlueCrawlers,
 	})
 }
 
-func testSweepGlueCrawlers(region string) error {
+func testSweepGlueCrawlers(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
+	var sweepables []sweep.Sweepable
 
 	input := &glue.GetCrawlersInput{}
 	err = conn.GetCrawlersPages(input, func(page *glue.GetCrawlersOutput, lastPage bool) bool {
-		if len(page.Crawlers) == 0 {
-			log.Printf("[INFO] No Glue Crawlers to sweep")
-			return false
-		}
 		for _, crawler := range page.Crawlers {
-			name := aws.StringValue(crawler.Name)
-
-			log.Printf("[INFO] Deleting Glue Crawler: %s", name)
-			_, err := conn.DeleteCrawler(&glue.DeleteCrawlerInput{
-				Name: aws.String(name),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Crawler %s: %s", name, err)
+			input := &glue.DeleteCrawlerInput{
+				Name: crawler.Name,
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(crawler.Name), func() error {
+				_, err := conn.DeleteCrawler(input)
+
+				return err
+			}))
 		}
 		return !lastPage
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Glue Crawler sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving Glue Crawlers: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving Glue Crawlers: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSGlueCrawler_DynamodbTarget(t *testing.T) {
@@ -1366,7 +1366,7 @@ func testAccCheckAWSGlueCrawlerExists(resourceName string, crawler *glue.Crawler
 			return fmt.Errorf("no ID is set")
 		}
 
-		glueConn := testAccProvider.Meta().(*AWSClient).glueconn
+		glueConn := testAccProvider.Meta().(*AWSClient).glueconn()
 		out, err := glueConn.GetCrawler(&glue.GetCrawlerInput{
 			Name: aws.String(rs.Primary.ID),
 		})
@@ -1391,7 +1391,7 @@ func testAccCheckAWSGlueCrawlerDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := conn.GetCrawler(&glue.GetCrawlerInput{
 			Name: aws.String(rs.Primary.ID),
 		})
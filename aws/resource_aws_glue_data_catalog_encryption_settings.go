@@ -77,7 +77,7 @@ func resourceAwsGlueDataCatalogEncryptionSettings() *schema.Resource {
 }
 
 func resourceAwsGlueDataCatalogEncryptionSettingsPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
 
 	input := &glue.PutDataCatalogEncryptionSettingsInput{
@@ -96,7 +96,7 @@ func resourceAwsGlueDataCatalogEncryptionSettingsPut(d *schema.ResourceData, met
 }
 
 func resourceAwsGlueDataCatalogEncryptionSettingsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	input := &glue.GetDataCatalogEncryptionSettingsInput{
 		CatalogId: aws.String(d.Id()),
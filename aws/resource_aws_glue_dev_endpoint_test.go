@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 const (
@@ -24,51 +25,50 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_dev_endpoint", &resource.Sweeper{
-		Name: "aws_glue_dev_endpoint",
-		F:    testSweepGlueDevEndpoint,
+	sweep.AddTestSweepers("aws_glue_dev_endpoint", &sweep.Sweeper{
+		Name:    "aws_glue_dev_endpoint",
+		Service: "glue",
+		List:    testSweepGlueDevEndpoint,
 	})
 }
 
-func testSweepGlueDevEndpoint(region string) error {
+func testSweepGlueDevEndpoint(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
+	var sweepables []sweep.Sweepable
 
 	input := &glue.GetDevEndpointsInput{}
 	err = conn.GetDevEndpointsPages(input, func(page *glue.GetDevEndpointsOutput, lastPage bool) bool {
-		if len(page.DevEndpoints) == 0 {
-			log.Printf("[INFO] No Glue Dev Endpoints to sweep")
-			return false
-		}
 		for _, endpoint := range page.DevEndpoints {
 			name := aws.StringValue(endpoint.EndpointName)
+
 			if !strings.HasPrefix(name, GlueDevEndpointResourcePrefix) {
-				log.Printf("[INFO] Skipping Glue Dev Endpoint: %s", name)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Glue Dev Endpoint: %s", name)
-			_, err := conn.DeleteDevEndpoint(&glue.DeleteDevEndpointInput{
-				EndpointName: aws.String(name),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Dev Endpoint %s: %s", name, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteDevEndpoint(&glue.DeleteDevEndpointInput{
+					EndpointName: aws.String(name),
+				})
+
+				return err
+			}))
 		}
 		return !lastPage
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Glue Dev Endpoint sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("error retrieving Glue Dev Endpoint: %s", err)
+		return sweepables, fmt.Errorf("error retrieving Glue Dev Endpoint: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccGlueDevEndpoint_Basic(t *testing.T) {
@@ -588,7 +588,7 @@ func testAccCheckAWSGlueDevEndpointExists(resourceName string, endpoint *glue.De
 			return fmt.Errorf("no ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := conn.GetDevEndpoint(&glue.GetDevEndpointInput{
 			EndpointName: aws.String(rs.Primary.ID),
 		})
@@ -613,7 +613,7 @@ func testAccCheckAWSGlueDevEndpointDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := conn.GetDevEndpoint(&glue.GetDevEndpointInput{
 			EndpointName: aws.String(rs.Primary.ID),
 		})
@@ -11,48 +11,46 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_job", &resource.Sweeper{
-		Name: "aws_glue_job",
-		F:    testSweepGlueJobs,
+	sweep.AddTestSweepers("aws_glue_job", &sweep.Sweeper{
+		Name:    "aws_glue_job",
+		Service: "glue",
+		List:    testSweepGlueJobs,
 	})
 }
 
-func testSweepGlueJobs(region string) error {
+func testSweepGlueJobs(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
+	var sweepables []sweep.Sweepable
 
 	input := &glue.GetJobsInput{}
 	err = conn.GetJobsPages(input, func(page *glue.GetJobsOutput, lastPage bool) bool {
-		if len(page.Jobs) == 0 {
-			log.Printf("[INFO] No Glue Jobs to sweep")
-			return false
-		}
 		for _, job := range page.Jobs {
 			name := aws.StringValue(job.Name)
 
-			log.Printf("[INFO] Deleting Glue Job: %s", name)
-			err := deleteGlueJob(conn, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Job %s: %s", name, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				return deleteGlueJob(conn, name)
+			}))
 		}
 		return !lastPage
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Glue Job sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving Glue Jobs: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving Glue Jobs: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSGlueJob_basic(t *testing.T) {
@@ -676,7 +674,7 @@ func testAccCheckAWSGlueJobExists(resourceName string, job *glue.Job) resource.T
 			return fmt.Errorf("No Glue Job ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetJob(&glue.GetJobInput{
 			JobName: aws.String(rs.Primary.ID),
@@ -704,7 +702,7 @@ func testAccCheckAWSGlueJobDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetJob(&glue.GetJobInput{
 			JobName: aws.String(rs.Primary.ID),
@@ -8,60 +8,51 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_ml_transform", &resource.Sweeper{
-		Name: "aws_glue_ml_transform",
-		F:    testSweepGlueMLTransforms,
+	sweep.AddTestSweepers("aws_glue_ml_transform", &sweep.Sweeper{
+		Name:    "aws_glue_ml_transform",
+		Service: "glue",
+		List:    testSweepGlueMLTransforms,
 	})
 }
 
-func testSweepGlueMLTransforms(region string) error {
+func testSweepGlueMLTransforms(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).glueconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).glueconn()
+	var sweepables []sweep.Sweepable
 
 	input := &glue.GetMLTransformsInput{}
 	err = conn.GetMLTransformsPages(input, func(page *glue.GetMLTransformsOutput, lastPage bool) bool {
-		if len(page.Transforms) == 0 {
-			log.Printf("[INFO] No Glue ML Transforms to sweep")
-			return false
-		}
 		for _, transforms := range page.Transforms {
-			id := aws.StringValue(transforms.TransformId)
-
-			log.Printf("[INFO] Deleting Glue ML Transform: %s", id)
 			r := resourceAwsGlueMLTransform()
 			d := r.Data(nil)
-			d.SetId(id)
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(transforms.TransformId))
+			d.Set("name", transforms.Name)
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 		return !lastPage
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Glue ML Transforms sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Glue ML Transforms: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Glue ML Transforms: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSGlueMLTransform_basic(t *testing.T) {
@@ -461,7 +452,7 @@ func testAccCheckAWSGlueMLTransformExists(resourceName string, mlTransform *glue
 			return fmt.Errorf("No Glue Job ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetMLTransform(&glue.GetMLTransformInput{
 			TransformId: aws.String(rs.Primary.ID),
@@ -489,7 +480,7 @@ func testAccCheckAWSGlueMLTransformDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetMLTransform(&glue.GetMLTransformInput{
 			TransformId: aws.String(rs.Primary.ID),
@@ -11,43 +11,47 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_registry", &resource.Sweeper{
-		Name: "aws_glue_registry",
-		F:    testSweepGlueRegistry,
+	sweep.AddTestSweepers("aws_glue_registry", &sweep.Sweeper{
+		Name:    "aws_glue_registry",
+		Service: "glue",
+		List:    testSweepGlueRegistry,
 	})
 }
 
-func testSweepGlueRegistry(region string) error {
+func testSweepGlueRegistry(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
 
 	listOutput, err := conn.ListRegistries(&glue.ListRegistriesInput{})
 	if err != nil {
 		// Some endpoints that do not support Glue Registrys return InternalFailure
 		if testSweepSkipSweepError(err) || isAWSErr(err, "InternalFailure", "") {
 			log.Printf("[WARN] Skipping Glue Registry sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving Glue Registry: %s", err)
+		return nil, fmt.Errorf("Error retrieving Glue Registry: %s", err)
 	}
-	for _, registry := range listOutput.Registries {
-		arn := aws.StringValue(registry.RegistryArn)
+
+	var sweepables []sweep.Sweepable
+
+	for _, item := range listOutput.Registries {
 		r := resourceAwsGlueRegistry()
 		d := r.Data(nil)
-		d.SetId(arn)
+		d.SetId(aws.StringValue(item.RegistryArn))
 
-		err := r.Delete(d, client)
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete Glue Registry %s: %s", arn, err)
-		}
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(item.RegistryArn), func() error {
+			return r.Delete(d, client)
+		}).WithName(aws.StringValue(item.RegistryName)))
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAWSGlueRegistry_basic(t *testing.T) {
@@ -182,7 +186,7 @@ func TestAccAWSGlueRegistry_disappears(t *testing.T) {
 }
 
 func testAccPreCheckAWSGlueRegistry(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).glueconn
+	conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 	_, err := conn.ListRegistries(&glue.ListRegistriesInput{})
 
@@ -207,7 +211,7 @@ func testAccCheckAWSGlueRegistryExists(resourceName string, registry *glue.GetRe
 			return fmt.Errorf("No Glue Registry ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := finder.RegistryByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -232,7 +236,7 @@ func testAccCheckAWSGlueRegistryDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := finder.RegistryByID(conn, rs.Primary.ID)
 		if err != nil {
 			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
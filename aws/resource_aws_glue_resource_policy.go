@@ -33,7 +33,7 @@ func resourceAwsGlueResourcePolicy() *schema.Resource {
 
 func resourceAwsGlueResourcePolicyPut(condition string) func(d *schema.ResourceData, meta interface{}) error {
 	return func(d *schema.ResourceData, meta interface{}) error {
-		conn := meta.(*AWSClient).glueconn
+		conn := meta.(*AWSClient).glueconn()
 
 		_, err := conn.PutResourcePolicy(&glue.PutResourcePolicyInput{
 			PolicyInJson:          aws.String(d.Get("policy").(string)),
@@ -48,7 +48,7 @@ func resourceAwsGlueResourcePolicyPut(condition string) func(d *schema.ResourceD
 }
 
 func resourceAwsGlueResourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	resourcePolicy, err := conn.GetResourcePolicy(&glue.GetResourcePolicyInput{})
 	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
@@ -70,7 +70,7 @@ func resourceAwsGlueResourcePolicyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsGlueResourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	_, err := conn.DeleteResourcePolicy(&glue.DeleteResourcePolicyInput{})
 	if err != nil {
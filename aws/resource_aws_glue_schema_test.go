@@ -11,43 +11,47 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_schema", &resource.Sweeper{
-		Name: "aws_glue_schema",
-		F:    testSweepGlueSchema,
+	sweep.AddTestSweepers("aws_glue_schema", &sweep.Sweeper{
+		Name:    "aws_glue_schema",
+		Service: "glue",
+		List:    testSweepGlueSchema,
 	})
 }
 
-func testSweepGlueSchema(region string) error {
+func testSweepGlueSchema(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
 
 	listOutput, err := conn.ListSchemas(&glue.ListSchemasInput{})
 	if err != nil {
 		// Some endpoints that do not support Glue Schemas return InternalFailure
 		if testSweepSkipSweepError(err) || isAWSErr(err, "InternalFailure", "") {
 			log.Printf("[WARN] Skipping Glue Schema sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving Glue Schema: %s", err)
+		return nil, fmt.Errorf("Error retrieving Glue Schema: %s", err)
 	}
-	for _, schema := range listOutput.Schemas {
-		arn := aws.StringValue(schema.SchemaArn)
+
+	var sweepables []sweep.Sweepable
+
+	for _, item := range listOutput.Schemas {
 		r := resourceAwsGlueSchema()
 		d := r.Data(nil)
-		d.SetId(arn)
+		d.SetId(aws.StringValue(item.SchemaArn))
 
-		err := r.Delete(d, client)
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete Glue Schema %s: %s", arn, err)
-		}
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(item.SchemaArn), func() error {
+			return r.Delete(d, client)
+		}).WithName(aws.StringValue(item.SchemaName)))
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAWSGlueSchema_basic(t *testing.T) {
@@ -286,7 +290,7 @@ func TestAccAWSGlueSchema_disappears_registry(t *testing.T) {
 }
 
 func testAccPreCheckAWSGlueSchema(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).glueconn
+	conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 	_, err := conn.ListRegistries(&glue.ListRegistriesInput{})
 
@@ -311,7 +315,7 @@ func testAccCheckAWSGlueSchemaExists(resourceName string, schema *glue.GetSchema
 			return fmt.Errorf("No Glue Schema ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := finder.SchemaByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -336,7 +340,7 @@ func testAccCheckAWSGlueSchemaDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 		output, err := finder.SchemaByID(conn, rs.Primary.ID)
 		if err != nil {
 			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
@@ -10,44 +10,44 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_security_configuration", &resource.Sweeper{
-		Name: "aws_glue_security_configuration",
-		F:    testSweepGlueSecurityConfigurations,
+	sweep.AddTestSweepers("aws_glue_security_configuration", &sweep.Sweeper{
+		Name:    "aws_glue_security_configuration",
+		Service: "glue",
+		List:    testSweepGlueSecurityConfigurations,
 	})
 }
 
-func testSweepGlueSecurityConfigurations(region string) error {
+func testSweepGlueSecurityConfigurations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
-
+	conn := client.(*AWSClient).glueconn()
 	input := &glue.GetSecurityConfigurationsInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.GetSecurityConfigurations(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Glue Security Configuration sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving Glue Security Configurations: %s", err)
+			return sweepables, fmt.Errorf("Error retrieving Glue Security Configurations: %s", err)
 		}
 
 		for _, securityConfiguration := range output.SecurityConfigurations {
 			name := aws.StringValue(securityConfiguration.Name)
 
-			log.Printf("[INFO] Deleting Glue Security Configuration: %s", name)
-			err := deleteGlueSecurityConfiguration(conn, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Security Configuration %s: %s", name, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				return deleteGlueSecurityConfiguration(conn, name)
+			}))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -57,7 +57,7 @@ func testSweepGlueSecurityConfigurations(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSGlueSecurityConfiguration_basic(t *testing.T) {
@@ -231,7 +231,7 @@ func testAccCheckAWSGlueSecurityConfigurationExists(resourceName string, securit
 			return fmt.Errorf("No Glue Security Configuration ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetSecurityConfiguration(&glue.GetSecurityConfigurationInput{
 			Name: aws.String(rs.Primary.ID),
@@ -259,7 +259,7 @@ func testAccCheckAWSGlueSecurityConfigurationDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetSecurityConfiguration(&glue.GetSecurityConfigurationInput{
 			Name: aws.String(rs.Primary.ID),
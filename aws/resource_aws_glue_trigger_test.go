@@ -10,48 +10,50 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_trigger", &resource.Sweeper{
-		Name: "aws_glue_trigger",
-		F:    testSweepGlueTriggers,
+	sweep.AddTestSweepers("aws_glue_trigger", &sweep.Sweeper{
+		Name:    "aws_glue_trigger",
+		Service: "glue",
+		List:    testSweepGlueTriggers,
 	})
 }
 
-func testSweepGlueTriggers(region string) error {
+func testSweepGlueTriggers(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
+	var sweepables []sweep.Sweepable
 
 	input := &glue.GetTriggersInput{}
 	err = conn.GetTriggersPages(input, func(page *glue.GetTriggersOutput, lastPage bool) bool {
-		if page == nil || len(page.Triggers) == 0 {
-			log.Printf("[INFO] No Glue Triggers to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
+
 		for _, trigger := range page.Triggers {
 			name := aws.StringValue(trigger.Name)
 
-			log.Printf("[INFO] Deleting Glue Trigger: %s", name)
-			err := deleteGlueTrigger(conn, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Trigger %s: %s", name, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				return deleteGlueTrigger(conn, name)
+			}))
 		}
 		return !lastPage
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Glue Trigger sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving Glue Triggers: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving Glue Triggers: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSGlueTrigger_basic(t *testing.T) {
@@ -474,7 +476,7 @@ func testAccCheckAWSGlueTriggerExists(resourceName string, trigger *glue.Trigger
 			return fmt.Errorf("No Glue Trigger ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetTrigger(&glue.GetTriggerInput{
 			Name: aws.String(rs.Primary.ID),
@@ -502,7 +504,7 @@ func testAccCheckAWSGlueTriggerDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetTrigger(&glue.GetTriggerInput{
 			Name: aws.String(rs.Primary.ID),
@@ -10,38 +10,45 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_workflow", &resource.Sweeper{
-		Name: "aws_glue_workflow",
-		F:    testSweepGlueWorkflow,
+	sweep.AddTestSweepers("aws_glue_workflow", &sweep.Sweeper{
+		Name:    "aws_glue_workflow",
+		Service: "glue",
+		List:    testSweepGlueWorkflow,
 	})
 }
 
-func testSweepGlueWorkflow(region string) error {
+func testSweepGlueWorkflow(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).glueconn
+	conn := client.(*AWSClient).glueconn()
 
 	listOutput, err := conn.ListWorkflows(&glue.ListWorkflowsInput{})
 	if err != nil {
 		// Some endpoints that do not support Glue Workflows return InternalFailure
 		if testSweepSkipSweepError(err) || isAWSErr(err, "InternalFailure", "") {
 			log.Printf("[WARN] Skipping Glue Workflow sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving Glue Workflow: %s", err)
+		return nil, fmt.Errorf("Error retrieving Glue Workflow: %s", err)
 	}
+
+	var sweepables []sweep.Sweepable
+
 	for _, workflowName := range listOutput.Workflows {
-		err := deleteWorkflow(conn, *workflowName)
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete Glue Workflow %s: %s", *workflowName, err)
-		}
+		name := aws.StringValue(workflowName)
+
+		sweepables = append(sweepables, sweep.NewResource(name, func() error {
+			return deleteWorkflow(conn, name)
+		}))
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAWSGlueWorkflow_basic(t *testing.T) {
@@ -245,7 +252,7 @@ func TestAccAWSGlueWorkflow_disappears(t *testing.T) {
 }
 
 func testAccPreCheckAWSGlueWorkflow(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).glueconn
+	conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 	_, err := conn.ListWorkflows(&glue.ListWorkflowsInput{})
 
@@ -270,7 +277,7 @@ func testAccCheckAWSGlueWorkflowExists(resourceName string, workflow *glue.Workf
 			return fmt.Errorf("No Glue Workflow ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetWorkflow(&glue.GetWorkflowInput{
 			Name: aws.String(rs.Primary.ID),
@@ -298,7 +305,7 @@ func testAccCheckAWSGlueWorkflowDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		output, err := conn.GetWorkflow(&glue.GetWorkflowInput{
 			Name: aws.String(rs.Primary.ID),
@@ -8,29 +8,28 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/guardduty"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_detector", &sweep.Sweeper{
 		Name:         "aws_guardduty_detector",
-		F:            testSweepGuarddutyDetectors,
+		Service:      "guardduty",
+		List:         testSweepGuarddutyDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 }
 
-func testSweepGuarddutyDetectors(region string) error {
+func testSweepGuarddutyDetectors(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-
-	conn := client.(*AWSClient).guarddutyconn
+	conn := client.(*AWSClient).guarddutyconn()
 	input := &guardduty.ListDetectorsInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListDetectorsPages(input, func(page *guardduty.ListDetectorsOutput, lastPage bool) bool {
 		for _, detectorID := range page.DetectorIds {
@@ -39,32 +38,30 @@ func testSweepGuarddutyDetectors(region string) error {
 				DetectorId: detectorID,
 			}
 
-			log.Printf("[INFO] Deleting GuardDuty Detector: %s", id)
-			_, err := conn.DeleteDetector(input)
-			if testSweepSkipResourceError(err) {
-				log.Printf("[WARN] Skipping GuardDuty Detector (%s): %s", id, err)
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting GuardDuty Detector (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
-		}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteDetector(input)
+
+				if testSweepSkipResourceError(err) {
+					log.Printf("[WARN] Skipping GuardDuty Detector (%s): %s", id, err)
+					return nil
+				}
 
+				return err
+			}))
+		}
 		return !lastPage
 	})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping GuardDuty Detector sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error retrieving GuardDuty Detectors: %w", err)
+		return sweepables, fmt.Errorf("error retrieving GuardDuty Detectors: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func testAccAwsGuardDutyDetector_basic(t *testing.T) {
@@ -159,7 +156,7 @@ func testAccAwsGuardDutyDetector_tags(t *testing.T) {
 }
 
 func testAccCheckAwsGuardDutyDetectorDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_guardduty_detector" {
@@ -67,7 +67,7 @@ func resourceAwsGuardDutyMember() *schema.Resource {
 }
 
 func resourceAwsGuardDutyMemberCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 	accountID := d.Get("account_id").(string)
 	detectorID := d.Get("detector_id").(string)
 
@@ -113,7 +113,7 @@ func resourceAwsGuardDutyMemberCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsGuardDutyMemberRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	accountID, detectorID, err := decodeGuardDutyMemberID(d.Id())
 	if err != nil {
@@ -160,7 +160,7 @@ func resourceAwsGuardDutyMemberRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsGuardDutyMemberUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	accountID, detectorID, err := decodeGuardDutyMemberID(d.Id())
 	if err != nil {
@@ -208,7 +208,7 @@ func resourceAwsGuardDutyMemberUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsGuardDutyMemberDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	accountID, detectorID, err := decodeGuardDutyMemberID(d.Id())
 	if err != nil {
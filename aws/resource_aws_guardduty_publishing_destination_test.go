@@ -11,71 +11,66 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
-		Name: "aws_guardduty_publishing_destination",
-		F:    testSweepGuarddutyPublishingDestinations,
+	sweep.AddTestSweepers("aws_guardduty_publishing_destination", &sweep.Sweeper{
+		Name:    "aws_guardduty_publishing_destination",
+		Service: "guardduty",
+		List:    testSweepGuarddutyPublishingDestinations,
 	})
 }
 
-func testSweepGuarddutyPublishingDestinations(region string) error {
+func testSweepGuarddutyPublishingDestinations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*AWSClient).guarddutyconn
+	conn := client.(*AWSClient).guarddutyconn()
+	var sweepables []sweep.Sweepable
 	var sweeperErrs *multierror.Error
 
 	detect_input := &guardduty.ListDetectorsInput{}
-
 	err = conn.ListDetectorsPages(detect_input, func(page *guardduty.ListDetectorsOutput, lastPage bool) bool {
 		for _, detectorID := range page.DetectorIds {
 			list_input := &guardduty.ListPublishingDestinationsInput{
 				DetectorId: detectorID,
 			}
 
-			err = conn.ListPublishingDestinationsPages(list_input, func(page *guardduty.ListPublishingDestinationsOutput, lastPage bool) bool {
+			err := conn.ListPublishingDestinationsPages(list_input, func(page *guardduty.ListPublishingDestinationsOutput, lastPage bool) bool {
 				for _, destination_element := range page.Destinations {
 					input := &guardduty.DeletePublishingDestinationInput{
 						DestinationId: destination_element.DestinationId,
 						DetectorId:    detectorID,
 					}
 
-					log.Printf("[INFO] Deleting GuardDuty Publishing Destination: %s", *destination_element.DestinationId)
-					_, err := conn.DeletePublishingDestination(input)
+					sweepables = append(sweepables, sweep.NewResource(aws.StringValue(destination_element.DestinationId), func() error {
+						_, err := conn.DeletePublishingDestination(input)
 
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting GuardDuty Publishing Destination (%s): %w", *destination_element.DestinationId, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					}
+						return err
+					}))
 				}
 				return !lastPage
 			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving GuardDuty Publishing Destinations for Detector (%s): %w", aws.StringValue(detectorID), err))
+			}
 		}
 		return !lastPage
 	})
 
-	if err != nil {
-		sweeperErr := fmt.Errorf("Error receiving Guardduty detectors for publishing sweep : %w", err)
-		log.Printf("[ERROR] %s", sweeperErr)
-		sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-	}
-
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping GuardDuty Publishing Destination sweep for %s: %s", region, err)
-		return nil
+		return sweepables, sweeperErrs.ErrorOrNil()
 	}
 
 	if err != nil {
-		return fmt.Errorf("error retrieving GuardDuty Publishing Destinations: %s", err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error receiving Guardduty detectors for publishing sweep : %w", err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func testAccAwsGuardDutyPublishingDestination_basic(t *testing.T) {
@@ -258,7 +253,7 @@ func testAccCheckAwsGuardDutyPublishingDestinationExists(name string) resource.T
 			DestinationId: aws.String(destination_id),
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 		_, err := conn.DescribePublishingDestination(input)
 		return err
 	}
@@ -266,7 +261,7 @@ func testAccCheckAwsGuardDutyPublishingDestinationExists(name string) resource.T
 
 func testAccCheckAwsGuardDutyPublishingDestinationDestroy(s *terraform.State) error {
 
-	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_guardduty_publishing_destination" {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/encryption"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/vault/helper/pgpkeys"
 )
 
@@ -38,7 +39,7 @@ func TestAccAWSAccessKey_basic(t *testing.T) {
 				ResourceName:            "aws_iam_access_key.a_key",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"encrypted_secret", "key_fingerprint", "pgp_key", "secret", "ses_smtp_password_v4"},
+				ImportStateVerifyIgnore: []string{"encrypted_secret", "key_fingerprint", "kms_key_arn", "kms_key_id", "pgp_key", "secret", "ses_smtp_password_v4"},
 			},
 		},
 	})
@@ -71,7 +72,39 @@ func TestAccAWSAccessKey_encrypted(t *testing.T) {
 				ResourceName:            "aws_iam_access_key.a_key",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"encrypted_secret", "key_fingerprint", "pgp_key", "secret", "ses_smtp_password_v4"},
+				ImportStateVerifyIgnore: []string{"encrypted_secret", "key_fingerprint", "kms_key_arn", "kms_key_id", "pgp_key", "secret", "ses_smtp_password_v4"},
+			},
+		},
+	})
+}
+
+func TestAccAWSAccessKey_KmsKeyId(t *testing.T) {
+	var conf iam.AccessKeyMetadata
+	rName := fmt.Sprintf("test-user-%d", acctest.RandInt())
+	resourceName := "aws_iam_access_key.a_key"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAccessKeyConfig_KmsKeyId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAccessKeyExists(resourceName, &conf),
+					testAccCheckAWSAccessKeyAttributes(&conf, "Active"),
+					testDecryptSecretKeyWithKMSAndTest(resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "secret"),
+					resource.TestCheckResourceAttr(resourceName, "key_fingerprint", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_arn", kmsKeyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"encrypted_secret", "key_fingerprint", "kms_key_arn", "kms_key_id", "pgp_key", "secret", "ses_smtp_password_v4"},
 			},
 		},
 	})
@@ -97,7 +130,7 @@ func TestAccAWSAccessKey_Status(t *testing.T) {
 				ResourceName:            "aws_iam_access_key.a_key",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"encrypted_secret", "key_fingerprint", "pgp_key", "secret", "ses_smtp_password_v4"},
+				ImportStateVerifyIgnore: []string{"encrypted_secret", "key_fingerprint", "kms_key_arn", "kms_key_id", "pgp_key", "secret", "ses_smtp_password_v4"},
 			},
 			{
 				Config: testAccAWSAccessKeyConfig_Status(rName, iam.StatusTypeActive),
@@ -118,7 +151,7 @@ func TestAccAWSAccessKey_Status(t *testing.T) {
 }
 
 func testAccCheckAWSAccessKeyDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_access_key" {
@@ -160,7 +193,7 @@ func testAccCheckAWSAccessKeyExists(n string, res *iam.AccessKeyMetadata) resour
 			return fmt.Errorf("No Role name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 		name := rs.Primary.Attributes["user"]
 
 		resp, err := iamconn.ListAccessKeys(&iam.ListAccessKeysInput{
@@ -218,6 +251,33 @@ func testDecryptSecretKeyAndTest(nAccessKey, key string) resource.TestCheckFunc
 	}
 }
 
+func testDecryptSecretKeyWithKMSAndTest(nAccessKey string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		keyResource, ok := s.RootModule().Resources[nAccessKey]
+		if !ok {
+			return fmt.Errorf("Not found: %s", nAccessKey)
+		}
+
+		secret, ok := keyResource.Primary.Attributes["encrypted_secret"]
+		if !ok {
+			return errors.New("No encrypted secret in state")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn()
+
+		decrypted, err := encryption.DecryptValueWithKMS(conn, secret)
+		if err != nil {
+			return fmt.Errorf("Error decrypting secret: %s", err)
+		}
+
+		if decrypted == "" {
+			return errors.New("Decrypted secret is empty")
+		}
+
+		return nil
+	}
+}
+
 func testAccAWSAccessKeyConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_user" "a_user" {
@@ -246,6 +306,24 @@ EOF
 `, rName, key)
 }
 
+func testAccAWSAccessKeyConfig_KmsKeyId(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_iam_user" "a_user" {
+  name = %[1]q
+}
+
+resource "aws_iam_access_key" "a_key" {
+  user       = aws_iam_user.a_user.name
+  kms_key_id = aws_kms_key.test.arn
+}
+`, rName)
+}
+
 func testAccAWSAccessKeyConfig_Status(rName string, status string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_user" "a_user" {
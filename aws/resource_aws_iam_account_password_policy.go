@@ -75,7 +75,7 @@ func resourceAwsIamAccountPasswordPolicy() *schema.Resource {
 }
 
 func resourceAwsIamAccountPasswordPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	input := &iam.UpdateAccountPasswordPolicyInput{}
 
@@ -120,7 +120,7 @@ func resourceAwsIamAccountPasswordPolicyUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsIamAccountPasswordPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	input := &iam.GetAccountPasswordPolicyInput{}
 	resp, err := iamconn.GetAccountPasswordPolicy(input)
@@ -153,7 +153,7 @@ func resourceAwsIamAccountPasswordPolicyRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsIamAccountPasswordPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	log.Println("[DEBUG] Deleting IAM account password policy")
 	input := &iam.DeleteAccountPasswordPolicyInput{}
@@ -10,32 +10,31 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
-		Name: "aws_iam_group",
-		F:    testSweepIamGroups,
+	sweep.AddTestSweepers("aws_iam_group", &sweep.Sweeper{
+		Name:    "aws_iam_group",
+		Service: "iam",
+		List:    testSweepIamGroups,
 		Dependencies: []string{
 			"aws_iam_user",
 		},
 	})
 }
 
-func testSweepIamGroups(region string) error {
+func testSweepIamGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-
-	conn := client.(*AWSClient).iamconn
+	conn := client.(*AWSClient).iamconn()
 	input := &iam.ListGroupsInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListGroupsPages(input, func(page *iam.ListGroupsOutput, isLast bool) bool {
 		if page == nil {
@@ -49,81 +48,58 @@ func testSweepIamGroups(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting IAM Group: %s", name)
-
-			getGroupInput := &iam.GetGroupInput{
-				GroupName: group.GroupName,
-			}
-
-			getGroupOutput, err := conn.GetGroup(getGroupInput)
-
-			if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				getGroupOutput, err := conn.GetGroup(&iam.GetGroupInput{
+					GroupName: aws.String(name),
+				})
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error reading IAM Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+					return nil
+				}
 
-			if getGroupOutput != nil {
-				for _, user := range getGroupOutput.Users {
-					username := aws.StringValue(user.UserName)
+				if err != nil {
+					return fmt.Errorf("error reading IAM Group (%s): %w", name, err)
+				}
 
-					log.Printf("[INFO] Removing IAM User (%s) from Group: %s", username, name)
+				if getGroupOutput != nil {
+					for _, user := range getGroupOutput.Users {
+						username := aws.StringValue(user.UserName)
 
-					input := &iam.RemoveUserFromGroupInput{
-						UserName:  user.UserName,
-						GroupName: group.GroupName,
-					}
+						input := &iam.RemoveUserFromGroupInput{
+							UserName:  user.UserName,
+							GroupName: aws.String(name),
+						}
 
-					_, err := conn.RemoveUserFromGroup(input)
+						_, err := conn.RemoveUserFromGroup(input)
 
-					if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-						continue
-					}
+						if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+							continue
+						}
 
-					if err != nil {
-						sweeperErr := fmt.Errorf("error removing IAM User (%s) from IAM Group (%s): %w", username, name, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
+						if err != nil {
+							return fmt.Errorf("error removing IAM User (%s) from IAM Group (%s): %w", username, name, err)
+						}
 					}
 				}
-			}
-
-			input := &iam.DeleteGroupInput{
-				GroupName: group.GroupName,
-			}
 
-			if err := deleteAwsIamGroupPolicyAttachments(conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policy attachments: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				if err := deleteAwsIamGroupPolicyAttachments(conn, name); err != nil {
+					return fmt.Errorf("error deleting IAM Group (%s) policy attachments: %w", name, err)
+				}
 
-			if err := deleteAwsIamGroupPolicies(conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policies: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				if err := deleteAwsIamGroupPolicies(conn, name); err != nil {
+					return fmt.Errorf("error deleting IAM Group (%s) policies: %w", name, err)
+				}
 
-			_, err = conn.DeleteGroup(input)
+				_, err = conn.DeleteGroup(&iam.DeleteGroupInput{
+					GroupName: aws.String(name),
+				})
 
-			if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-				continue
-			}
+				if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+					return nil
+				}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return err
+			}))
 		}
 
 		return !isLast
@@ -131,14 +107,14 @@ func testSweepIamGroups(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping IAM Group sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+		return sweepables, nil
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IAM Groups: %w", err))
+		return sweepables, fmt.Errorf("error retrieving IAM Groups: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSIAMGroup_basic(t *testing.T) {
@@ -208,7 +184,7 @@ func TestAccAWSIAMGroup_nameChange(t *testing.T) {
 }
 
 func testAccCheckAWSGroupDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_group" {
@@ -247,7 +223,7 @@ func testAccCheckAWSGroupExists(n string, res *iam.GetGroupOutput) resource.Test
 			return errors.New("No Group name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		resp, err := iamconn.GetGroup(&iam.GetGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
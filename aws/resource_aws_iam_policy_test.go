@@ -8,16 +8,17 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
-		Name: "aws_iam_policy",
-		F:    testSweepIamPolicies,
+	sweep.AddTestSweepers("aws_iam_policy", &sweep.Sweeper{
+		Name:    "aws_iam_policy",
+		Service: "iam",
+		List:    testSweepIamPolicies,
 		Dependencies: []string{
 			"aws_iam_group",
 			"aws_iam_role",
@@ -26,18 +27,16 @@ func init() {
 	})
 }
 
-func testSweepIamPolicies(region string) error {
+func testSweepIamPolicies(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-
-	conn := client.(*AWSClient).iamconn
+	conn := client.(*AWSClient).iamconn()
 	input := &iam.ListPoliciesInput{
 		Scope: aws.String(iam.PolicyScopeTypeLocal),
 	}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListPoliciesPages(input, func(page *iam.ListPoliciesOutput, isLast bool) bool {
 		if page == nil {
@@ -50,33 +49,26 @@ func testSweepIamPolicies(region string) error {
 				PolicyArn: policy.Arn,
 			}
 
-			log.Printf("[INFO] Deleting IAM Policy: %s", arn)
-			if err := iamPolicyDeleteNondefaultVersions(arn, conn); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Policy (%s) non-default versions: %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(arn, func() error {
+				if err := iamPolicyDeleteNondefaultVersions(arn, conn); err != nil {
+					return fmt.Errorf("error deleting non-default versions: %w", err)
+				}
 
-			_, err := conn.DeletePolicy(input)
+				_, err := conn.DeletePolicy(input)
 
-			// Treat this sweeper as best effort for now. There are a lot of edge cases
-			// with lingering aws_iam_role resources in the HashiCorp testing accounts.
-			if isAWSErr(err, iam.ErrCodeDeleteConflictException, "") {
-				log.Printf("[WARN] Ignoring IAM Policy (%s) deletion error: %s", arn, err)
-				continue
-			}
+				// Treat this sweeper as best effort for now. There are a lot of edge cases
+				// with lingering aws_iam_role resources in the HashiCorp testing accounts.
+				if isAWSErr(err, iam.ErrCodeDeleteConflictException, "") {
+					log.Printf("[WARN] Ignoring IAM Policy (%s) deletion error: %s", arn, err)
+					return nil
+				}
 
-			if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
-				continue
-			}
+				if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+					return nil
+				}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Policy (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return err
+			}).WithName(aws.StringValue(policy.PolicyName)))
 		}
 
 		return !isLast
@@ -84,14 +76,14 @@ func testSweepIamPolicies(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping IAM Policy sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+		return sweepables, nil
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IAM Policies: %w", err))
+		return sweepables, fmt.Errorf("error retrieving IAM Policies: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSIAMPolicy_basic(t *testing.T) {
@@ -287,7 +279,7 @@ func testAccCheckAWSIAMPolicyExists(resource string, res *iam.GetPolicyOutput) r
 			return fmt.Errorf("No Policy name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		resp, err := iamconn.GetPolicy(&iam.GetPolicyInput{
 			PolicyArn: aws.String(rs.Primary.Attributes["arn"]),
@@ -303,7 +295,7 @@ func testAccCheckAWSIAMPolicyExists(resource string, res *iam.GetPolicyOutput) r
 }
 
 func testAccCheckAWSIAMPolicyDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_policy" {
@@ -330,7 +322,7 @@ func testAccCheckAWSIAMPolicyDestroy(s *terraform.State) error {
 
 func testAccCheckAWSIAMPolicyDisappears(out *iam.GetPolicyOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		params := &iam.DeletePolicyInput{
 			PolicyArn: out.Policy.Arn,
@@ -36,7 +36,7 @@ func resourceAwsIamRolePolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsIamRolePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	role := d.Get("role").(string)
 	arn := d.Get("policy_arn").(string)
@@ -53,7 +53,7 @@ func resourceAwsIamRolePolicyAttachmentCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsIamRolePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	role := d.Get("role").(string)
 	policyARN := d.Get("policy_arn").(string)
 
@@ -82,7 +82,7 @@ func resourceAwsIamRolePolicyAttachmentRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsIamRolePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	role := d.Get("role").(string)
 	arn := d.Get("policy_arn").(string)
 
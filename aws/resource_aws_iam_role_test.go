@@ -11,15 +11,16 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
-		Name: "aws_iam_role",
+	sweep.AddTestSweepers("aws_iam_role", &sweep.Sweeper{
+		Name:    "aws_iam_role",
+		Service: "iam",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
 			"aws_cloudformation_stack_set_instance",
@@ -42,16 +43,16 @@ func init() {
 			"aws_redshift_cluster",
 			"aws_spot_fleet_request",
 		},
-		F: testSweepIamRoles,
+		List: testSweepIamRoles,
 	})
 }
 
-func testSweepIamRoles(region string) error {
+func testSweepIamRoles(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).iamconn
+	conn := client.(*AWSClient).iamconn()
 	prefixes := []string{
 		"another_rds",
 		"batch_tf_acc_test",
@@ -105,41 +106,35 @@ func testSweepIamRoles(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving IAM Roles: %s", err)
+		return nil, fmt.Errorf("Error retrieving IAM Roles: %s", err)
 	}
 
-	if len(roles) == 0 {
-		log.Print("[DEBUG] No IAM Roles to sweep")
-		return nil
-	}
-
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for _, role := range roles {
 		rolename := aws.StringValue(role.RoleName)
-		log.Printf("[DEBUG] Deleting IAM Role (%s)", rolename)
 
-		err := deleteAwsIamRole(conn, rolename, true)
-		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			continue
-		}
-		if testSweepSkipResourceError(err) {
-			log.Printf("[WARN] Skipping IAM Role (%s): %s", rolename, err)
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM Role (%s): %w", rolename, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+		sweepables = append(sweepables, sweep.NewResource(rolename, func() error {
+			err := deleteAwsIamRole(conn, rolename, true)
+
+			if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+				return nil
+			}
+
+			if testSweepSkipResourceError(err) {
+				log.Printf("[WARN] Skipping IAM Role (%s): %s", rolename, err)
+				return nil
+			}
+
+			return err
+		}))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSIAMRole_basic(t *testing.T) {
@@ -492,7 +487,7 @@ func TestAccAWSIAMRole_tags(t *testing.T) {
 }
 
 func testAccCheckAWSRoleDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_role" {
@@ -531,7 +526,7 @@ func testAccCheckAWSRoleExists(n string, res *iam.GetRoleOutput) resource.TestCh
 			return fmt.Errorf("No Role name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		resp, err := iamconn.GetRole(&iam.GetRoleInput{
 			RoleName: aws.String(rs.Primary.ID),
@@ -548,7 +543,7 @@ func testAccCheckAWSRoleExists(n string, res *iam.GetRoleOutput) resource.TestCh
 
 func testAccCheckAWSRoleDisappears(getRoleOutput *iam.GetRoleOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		roleName := aws.StringValue(getRoleOutput.Role.RoleName)
 
@@ -591,7 +586,7 @@ func testAccAddAwsIAMRolePolicy(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Role name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		input := &iam.PutRolePolicyInput{
 			RoleName: aws.String(rs.Primary.ID),
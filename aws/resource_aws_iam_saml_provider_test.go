@@ -7,56 +7,49 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_saml_provider", &resource.Sweeper{
-		Name: "aws_iam_saml_provider",
-		F:    testSweepIamSamlProvider,
+	sweep.AddTestSweepers("aws_iam_saml_provider", &sweep.Sweeper{
+		Name:    "aws_iam_saml_provider",
+		Service: "iam",
+		List:    testSweepIamSamlProvider,
 	})
 }
 
-func testSweepIamSamlProvider(region string) error {
+func testSweepIamSamlProvider(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).iamconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).iamconn()
 
 	out, err := conn.ListSAMLProviders(&iam.ListSAMLProvidersInput{})
 
-	for _, sampProvider := range out.SAMLProviderList {
-		arn := aws.StringValue(sampProvider.Arn)
-
-		r := resourceAwsIamSamlProvider()
-		d := r.Data(nil)
-		d.SetId(arn)
-		err := r.Delete(d, client)
-
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM SAML Provider (%s): %w", arn, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
-	}
-
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping IAM SAML Provider sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return nil, nil
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing IAM SAML Providers: %w", err))
+		return nil, fmt.Errorf("error describing IAM SAML Providers: %w", err)
+	}
+
+	var sweepables []sweep.Sweepable
+
+	for _, sampProvider := range out.SAMLProviderList {
+		r := resourceAwsIamSamlProvider()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(sampProvider.Arn))
+
+		sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSIAMSamlProvider_basic(t *testing.T) {
@@ -117,7 +110,7 @@ func TestAccAWSIAMSamlProvider_disappears(t *testing.T) {
 }
 
 func testAccCheckIAMSamlProviderDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_saml_provider" {
@@ -156,7 +149,7 @@ func testAccCheckIAMSamlProviderExists(id string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		conn := testAccProvider.Meta().(*AWSClient).iamconn()
 		_, err := conn.GetSAMLProvider(&iam.GetSAMLProviderInput{
 			SAMLProviderArn: aws.String(rs.Primary.ID),
 		})
@@ -11,46 +11,49 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
-		Name: "aws_iam_server_certificate",
-		F:    testSweepIamServerCertificates,
+	sweep.AddTestSweepers("aws_iam_server_certificate", &sweep.Sweeper{
+		Name:    "aws_iam_server_certificate",
+		Service: "iam",
+		List:    testSweepIamServerCertificates,
 	})
 }
 
-func testSweepIamServerCertificates(region string) error {
+func testSweepIamServerCertificates(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).iamconn
+	conn := client.(*AWSClient).iamconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListServerCertificatesPages(&iam.ListServerCertificatesInput{}, func(out *iam.ListServerCertificatesOutput, lastPage bool) bool {
 		for _, sc := range out.ServerCertificateMetadataList {
-			log.Printf("[INFO] Deleting IAM Server Certificate: %s", *sc.ServerCertificateName)
-
-			_, err := conn.DeleteServerCertificate(&iam.DeleteServerCertificateInput{
+			input := &iam.DeleteServerCertificateInput{
 				ServerCertificateName: sc.ServerCertificateName,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete IAM Server Certificate %s: %s",
-					*sc.ServerCertificateName, err)
-				continue
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(sc.ServerCertificateName), func() error {
+				_, err := conn.DeleteServerCertificate(input)
+
+				return err
+			}))
 		}
 		return !lastPage
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping IAM Server Certificate sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving IAM Server Certificates: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving IAM Server Certificates: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSIAMServerCertificate_basic(t *testing.T) {
@@ -119,7 +122,7 @@ func TestAccAWSIAMServerCertificate_disappears(t *testing.T) {
 
 	testDestroyCert := func(*terraform.State) error {
 		// reach out and DELETE the Cert
-		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		conn := testAccProvider.Meta().(*AWSClient).iamconn()
 		_, err := conn.DeleteServerCertificate(&iam.DeleteServerCertificateInput{
 			ServerCertificateName: cert.ServerCertificateMetadata.ServerCertificateName,
 		})
@@ -231,7 +234,7 @@ func testAccCheckCertExists(n string, cert *iam.ServerCertificate) resource.Test
 			return fmt.Errorf("No Server Cert ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		conn := testAccProvider.Meta().(*AWSClient).iamconn()
 		describeOpts := &iam.GetServerCertificateInput{
 			ServerCertificateName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -260,7 +263,7 @@ func testAccCheckAWSServerCertAttributes(cert *iam.ServerCertificate, certBody *
 }
 
 func testAccCheckIAMServerCertificateDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_server_certificate" {
@@ -11,21 +11,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
-		Name: "aws_iam_service_linked_role",
-		F:    testSweepIamServiceLinkedRoles,
+	sweep.AddTestSweepers("aws_iam_service_linked_role", &sweep.Sweeper{
+		Name:    "aws_iam_service_linked_role",
+		Service: "iam",
+		List:    testSweepIamServiceLinkedRoles,
 	})
 }
 
-func testSweepIamServiceLinkedRoles(region string) error {
+func testSweepIamServiceLinkedRoles(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).iamconn
+	conn := client.(*AWSClient).iamconn()
 
 	input := &iam.ListRolesInput{
 		PathPrefix: aws.String("/aws-service-role/"),
@@ -35,46 +37,47 @@ func testSweepIamServiceLinkedRoles(region string) error {
 	// TestAccAWSIAMServiceLinkedRole_basic
 	// TestAccAWSIAMServiceLinkedRole_CustomSuffix_DiffSuppressFunc
 	customSuffixRegex := regexp.MustCompile(`_?(tf-acc-test-\d+|ServiceRoleFor(ApplicationAutoScaling_CustomResource|ElasticBeanstalk))$`)
+	var sweepables []sweep.Sweepable
+
 	err = conn.ListRolesPages(input, func(page *iam.ListRolesOutput, lastPage bool) bool {
-		if len(page.Roles) == 0 {
-			log.Printf("[INFO] No IAM Service Roles to sweep")
-			return true
-		}
 		for _, role := range page.Roles {
 			roleName := aws.StringValue(role.RoleName)
 
 			if !customSuffixRegex.MatchString(roleName) {
-				log.Printf("[INFO] Skipping IAM Service Role: %s", roleName)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting IAM Service Role: %s", roleName)
-			deletionTaskID, err := deleteIamServiceLinkedRole(conn, roleName)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete IAM Service Role %s: %s", roleName, err)
-				continue
-			}
-			if deletionTaskID == "" {
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(roleName, func() error {
+				deletionTaskID, err := deleteIamServiceLinkedRole(conn, roleName)
 
-			log.Printf("[INFO] Waiting for deletion of IAM Service Role: %s", roleName)
-			err = deleteIamServiceLinkedRoleWaiter(conn, deletionTaskID)
-			if err != nil {
-				log.Printf("[ERROR] Failed to wait for deletion of IAM Service Role %s: %s", roleName, err)
-			}
+				if err != nil {
+					return err
+				}
+
+				if deletionTaskID == "" {
+					return nil
+				}
+
+				if err := deleteIamServiceLinkedRoleWaiter(conn, deletionTaskID); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping IAM Service Role sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving IAM Service Roles: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving IAM Service Roles: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestDecodeIamServiceLinkedRoleID(t *testing.T) {
@@ -152,7 +155,7 @@ func TestAccAWSIAMServiceLinkedRole_basic(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Remove existing if possible
-					conn := testAccProvider.Meta().(*AWSClient).iamconn
+					conn := testAccProvider.Meta().(*AWSClient).iamconn()
 					deletionID, err := deleteIamServiceLinkedRole(conn, name)
 					if err != nil {
 						t.Fatalf("Error deleting service-linked role %s: %s", name, err)
@@ -285,7 +288,7 @@ func TestAccAWSIAMServiceLinkedRole_Description(t *testing.T) {
 }
 
 func testAccCheckAWSIAMServiceLinkedRoleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_service_linked_role" {
@@ -323,7 +326,7 @@ func testAccCheckAWSIAMServiceLinkedRoleExists(n string) resource.TestCheckFunc
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		conn := testAccProvider.Meta().(*AWSClient).iamconn()
 		_, roleName, _, err := decodeIamServiceLinkedRoleID(rs.Primary.ID)
 		if err != nil {
 			return err
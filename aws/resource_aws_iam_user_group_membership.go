@@ -37,7 +37,7 @@ func resourceAwsIamUserGroupMembership() *schema.Resource {
 }
 
 func resourceAwsIamUserGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	user := d.Get("user").(string)
 	groupList := expandStringSet(d.Get("groups").(*schema.Set))
@@ -53,7 +53,7 @@ func resourceAwsIamUserGroupMembershipCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsIamUserGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	user := d.Get("user").(string)
 	groups := d.Get("groups").(*schema.Set)
@@ -97,7 +97,7 @@ func resourceAwsIamUserGroupMembershipRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsIamUserGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	if d.HasChange("groups") {
 		user := d.Get("user").(string)
@@ -128,7 +128,7 @@ func resourceAwsIamUserGroupMembershipUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsIamUserGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	user := d.Get("user").(string)
 	groups := expandStringSet(d.Get("groups").(*schema.Set))
 
@@ -27,6 +27,7 @@ func resourceAwsIamUserLoginProfile() *schema.Resource {
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				d.Set("encrypted_password", "")
 				d.Set("key_fingerprint", "")
+				d.Set("kms_key_arn", "")
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -38,9 +39,16 @@ func resourceAwsIamUserLoginProfile() *schema.Resource {
 				ForceNew: true,
 			},
 			"pgp_key": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"kms_key_id", "pgp_key"},
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"kms_key_id", "pgp_key"},
 			},
 			"password_reset_required": {
 				Type:     schema.TypeBool,
@@ -60,6 +68,10 @@ func resourceAwsIamUserLoginProfile() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"encrypted_password": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -123,14 +135,9 @@ func checkIAMPwdPolicy(pass []byte) bool {
 }
 
 func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 	username := d.Get("user").(string)
 
-	encryptionKey, err := encryption.RetrieveGPGKey(strings.TrimSpace(d.Get("pgp_key").(string)))
-	if err != nil {
-		return fmt.Errorf("error retrieving GPG Key during IAM User Login Profile (%s) creation: %s", username, err)
-	}
-
 	passwordResetRequired := d.Get("password_reset_required").(bool)
 	passwordLength := d.Get("password_length").(int)
 	initialPassword, err := generateIAMPassword(passwordLength)
@@ -138,7 +145,7 @@ func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, initialPassword, "Password")
+	encryptedPassword, err := encryption.EncryptSecret(meta.(*AWSClient).kmsconn(), strings.TrimSpace(d.Get("pgp_key").(string)), d.Get("kms_key_id").(string), initialPassword, "Password")
 	if err != nil {
 		return fmt.Errorf("error encrypting password during IAM User Login Profile (%s) creation: %s", username, err)
 	}
@@ -156,13 +163,14 @@ func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface
 	}
 
 	d.SetId(aws.StringValue(createResp.LoginProfile.UserName))
-	d.Set("key_fingerprint", fingerprint)
-	d.Set("encrypted_password", encrypted)
+	d.Set("key_fingerprint", encryptedPassword.KeyFingerprint)
+	d.Set("kms_key_arn", encryptedPassword.KmsKeyArn)
+	d.Set("encrypted_password", encryptedPassword.Value)
 	return nil
 }
 
 func resourceAwsIamUserLoginProfileRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	input := &iam.GetLoginProfileInput{
 		UserName: aws.String(d.Id()),
@@ -191,7 +199,7 @@ func resourceAwsIamUserLoginProfileRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsIamUserLoginProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	input := &iam.DeleteLoginProfileInput{
 		UserName: aws.String(d.Id()),
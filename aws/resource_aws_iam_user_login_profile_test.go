@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/encryption"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/vault/helper/pgpkeys"
 )
 
@@ -88,6 +89,48 @@ func TestAccAWSUserLoginProfile_basic(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"encrypted_password",
 					"key_fingerprint",
+					"kms_key_arn",
+					"kms_key_id",
+					"password_length",
+					"password_reset_required",
+					"pgp_key",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSUserLoginProfile_KmsKeyId(t *testing.T) {
+	var conf iam.GetLoginProfileOutput
+
+	username := fmt.Sprintf("test-user-%d", acctest.RandInt())
+	resourceName := "aws_iam_user_login_profile.user"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSUserLoginProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSUserLoginProfileConfig_KmsKeyId(username, "/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserLoginProfileExists(resourceName, &conf),
+					testDecryptPasswordWithKMSAndTest(resourceName, "aws_iam_access_key.user"),
+					resource.TestCheckResourceAttrSet(resourceName, "encrypted_password"),
+					resource.TestCheckResourceAttr(resourceName, "key_fingerprint", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_arn", kmsKeyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"encrypted_password",
+					"key_fingerprint",
+					"kms_key_arn",
+					"kms_key_id",
 					"password_length",
 					"password_reset_required",
 					"pgp_key",
@@ -125,6 +168,8 @@ func TestAccAWSUserLoginProfile_keybase(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"encrypted_password",
 					"key_fingerprint",
+					"kms_key_arn",
+					"kms_key_id",
 					"password_length",
 					"password_reset_required",
 					"pgp_key",
@@ -192,6 +237,8 @@ func TestAccAWSUserLoginProfile_PasswordLength(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"encrypted_password",
 					"key_fingerprint",
+					"kms_key_arn",
+					"kms_key_id",
 					"password_length",
 					"password_reset_required",
 					"pgp_key",
@@ -202,7 +249,7 @@ func TestAccAWSUserLoginProfile_PasswordLength(t *testing.T) {
 }
 
 func testAccCheckAWSUserLoginProfileDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_user_login_profile" {
@@ -235,56 +282,85 @@ func testDecryptPasswordAndTest(nProfile, nAccessKey, key string) resource.TestC
 			return errors.New("No password in state")
 		}
 
-		accessKeyResource, ok := s.RootModule().Resources[nAccessKey]
+		decryptedPassword, err := pgpkeys.DecryptBytes(password, key)
+		if err != nil {
+			return fmt.Errorf("Error decrypting password: %s", err)
+		}
+
+		return testChangeDecryptedPassword(s, nAccessKey, decryptedPassword.String())
+	}
+}
+
+func testDecryptPasswordWithKMSAndTest(nProfile, nAccessKey string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		profileResource, ok := s.RootModule().Resources[nProfile]
 		if !ok {
-			return fmt.Errorf("Not found: %s", nAccessKey)
+			return fmt.Errorf("Not found: %s", nProfile)
 		}
 
-		accessKeyId := accessKeyResource.Primary.ID
-		secretAccessKey, ok := accessKeyResource.Primary.Attributes["secret"]
+		password, ok := profileResource.Primary.Attributes["encrypted_password"]
 		if !ok {
-			return errors.New("No secret access key in state")
+			return errors.New("No password in state")
 		}
 
-		decryptedPassword, err := pgpkeys.DecryptBytes(password, key)
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn()
+
+		decryptedPassword, err := encryption.DecryptValueWithKMS(conn, password)
 		if err != nil {
 			return fmt.Errorf("Error decrypting password: %s", err)
 		}
 
-		iamAsCreatedUserSession := session.New(&aws.Config{
-			Region:      aws.String(testAccGetRegion()),
-			Credentials: credentials.NewStaticCredentials(accessKeyId, secretAccessKey, ""),
-		})
-		_, err = iamAsCreatedUserSession.Config.Credentials.Get()
+		return testChangeDecryptedPassword(s, nAccessKey, decryptedPassword)
+	}
+}
+
+// testChangeDecryptedPassword verifies a decrypted password by changing it as
+// the user, using the given access key.
+func testChangeDecryptedPassword(s *terraform.State, nAccessKey, decryptedPassword string) error {
+	accessKeyResource, ok := s.RootModule().Resources[nAccessKey]
+	if !ok {
+		return fmt.Errorf("Not found: %s", nAccessKey)
+	}
+
+	accessKeyId := accessKeyResource.Primary.ID
+	secretAccessKey, ok := accessKeyResource.Primary.Attributes["secret"]
+	if !ok {
+		return errors.New("No secret access key in state")
+	}
+
+	iamAsCreatedUserSession := session.New(&aws.Config{
+		Region:      aws.String(testAccGetRegion()),
+		Credentials: credentials.NewStaticCredentials(accessKeyId, secretAccessKey, ""),
+	})
+	_, err := iamAsCreatedUserSession.Config.Credentials.Get()
+	if err != nil {
+		return fmt.Errorf("Error getting session credentials: %s", err)
+	}
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		iamAsCreatedUser := iam.New(iamAsCreatedUserSession)
+		newPassword, err := generateIAMPassword(20)
 		if err != nil {
-			return fmt.Errorf("Error getting session credentials: %s", err)
+			return resource.NonRetryableError(err)
 		}
-
-		return resource.Retry(2*time.Minute, func() *resource.RetryError {
-			iamAsCreatedUser := iam.New(iamAsCreatedUserSession)
-			newPassword, err := generateIAMPassword(20)
-			if err != nil {
-				return resource.NonRetryableError(err)
+		_, err = iamAsCreatedUser.ChangePassword(&iam.ChangePasswordInput{
+			OldPassword: aws.String(decryptedPassword),
+			NewPassword: aws.String(newPassword),
+		})
+		if err != nil {
+			// EntityTemporarilyUnmodifiable: Login Profile for User XXX cannot be modified while login profile is being created.
+			if isAWSErr(err, iam.ErrCodeEntityTemporarilyUnmodifiableException, "") {
+				return resource.RetryableError(err)
 			}
-			_, err = iamAsCreatedUser.ChangePassword(&iam.ChangePasswordInput{
-				OldPassword: aws.String(decryptedPassword.String()),
-				NewPassword: aws.String(newPassword),
-			})
-			if err != nil {
-				// EntityTemporarilyUnmodifiable: Login Profile for User XXX cannot be modified while login profile is being created.
-				if isAWSErr(err, iam.ErrCodeEntityTemporarilyUnmodifiableException, "") {
-					return resource.RetryableError(err)
-				}
-				if isAWSErr(err, "InvalidClientTokenId", "") {
-					return resource.RetryableError(err)
-				}
-
-				return resource.NonRetryableError(fmt.Errorf("Error changing decrypted password: %s", err))
+			if isAWSErr(err, "InvalidClientTokenId", "") {
+				return resource.RetryableError(err)
 			}
 
-			return nil
-		})
-	}
+			return resource.NonRetryableError(fmt.Errorf("Error changing decrypted password: %s", err))
+		}
+
+		return nil
+	})
 }
 
 func testAccCheckAWSUserLoginProfileExists(n string, res *iam.GetLoginProfileOutput) resource.TestCheckFunc {
@@ -298,7 +374,7 @@ func testAccCheckAWSUserLoginProfileExists(n string, res *iam.GetLoginProfileOut
 			return errors.New("No UserName is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 		resp, err := iamconn.GetLoginProfile(&iam.GetLoginProfileInput{
 			UserName: aws.String(rs.Primary.ID),
 		})
@@ -379,6 +455,22 @@ EOF
 `, testAccAWSUserLoginProfileConfig_base(rName, path), pgpKey)
 }
 
+func testAccAWSUserLoginProfileConfig_KmsKeyId(rName, path string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "aws_kms_key" "test" {
+  description             = %[2]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_iam_user_login_profile" "user" {
+  user       = aws_iam_user.user.name
+  kms_key_id = aws_kms_key.test.arn
+}
+`, testAccAWSUserLoginProfileConfig_base(rName, path), rName)
+}
+
 const testPubKey1 = `mQENBFXbjPUBCADjNjCUQwfxKL+RR2GA6pv/1K+zJZ8UWIF9S0lk7cVIEfJiprzzwiMwBS5cD0da
 rGin1FHvIWOZxujA7oW0O2TUuatqI3aAYDTfRYurh6iKLC+VS+F7H+/mhfFvKmgr0Y5kDCF1j0T/
 063QZ84IRGucR/X43IY7kAtmxGXH0dYOCzOe5UBX1fTn3mXGe2ImCDWBH7gOViynXmb6XNvXkP0f
@@ -10,26 +10,27 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pquerna/otp/totp"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
-		Name: "aws_iam_user",
-		F:    testSweepIamUsers,
+	sweep.AddTestSweepers("aws_iam_user", &sweep.Sweeper{
+		Name:    "aws_iam_user",
+		Service: "iam",
+		List:    testSweepIamUsers,
 	})
 }
 
-func testSweepIamUsers(region string) error {
+func testSweepIamUsers(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).iamconn
+	conn := client.(*AWSClient).iamconn()
 	prefixes := []string{
 		"test-user",
 		"test_user",
@@ -53,145 +54,110 @@ func testSweepIamUsers(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping IAM User sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving IAM Users: %s", err)
+		return nil, fmt.Errorf("Error retrieving IAM Users: %s", err)
 	}
 
-	if len(users) == 0 {
-		log.Print("[DEBUG] No IAM Users to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
-	var sweeperErrs *multierror.Error
 	for _, user := range users {
 		username := aws.StringValue(user.UserName)
-		log.Printf("[DEBUG] Deleting IAM User: %s", username)
 
-		listUserPoliciesInput := &iam.ListUserPoliciesInput{
-			UserName: user.UserName,
-		}
-		listUserPoliciesOutput, err := conn.ListUserPolicies(listUserPoliciesInput)
+		sweepables = append(sweepables, sweep.NewResource(username, func() error {
+			return testSweepIamUser(conn, username)
+		}))
+	}
 
-		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error listing IAM User (%s) inline policies: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	return sweepables, nil
+}
 
-		for _, inlinePolicyName := range listUserPoliciesOutput.PolicyNames {
-			log.Printf("[DEBUG] Deleting IAM User (%s) inline policy %q", username, *inlinePolicyName)
+func testSweepIamUser(conn *iam.IAM, username string) error {
+	listUserPoliciesInput := &iam.ListUserPoliciesInput{
+		UserName: aws.String(username),
+	}
+	listUserPoliciesOutput, err := conn.ListUserPolicies(listUserPoliciesInput)
 
-			input := &iam.DeleteUserPolicyInput{
-				PolicyName: inlinePolicyName,
-				UserName:   user.UserName,
-			}
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return nil
+	}
 
-			if _, err := conn.DeleteUserPolicy(input); err != nil {
-				if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
-					continue
-				}
-				sweeperErr := fmt.Errorf("error deleting IAM User (%s) inline policy %q: %s", username, *inlinePolicyName, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+	if err != nil {
+		return fmt.Errorf("error listing inline policies: %s", err)
+	}
+
+	for _, inlinePolicyName := range listUserPoliciesOutput.PolicyNames {
+		input := &iam.DeleteUserPolicyInput{
+			PolicyName: inlinePolicyName,
+			UserName:   aws.String(username),
+		}
+
+		if _, err := conn.DeleteUserPolicy(input); err != nil {
+			if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
 				continue
 			}
+			return fmt.Errorf("error deleting inline policy %q: %s", aws.StringValue(inlinePolicyName), err)
 		}
+	}
 
-		listAttachedUserPoliciesInput := &iam.ListAttachedUserPoliciesInput{
-			UserName: user.UserName,
-		}
-		listAttachedUserPoliciesOutput, err := conn.ListAttachedUserPolicies(listAttachedUserPoliciesInput)
+	listAttachedUserPoliciesInput := &iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(username),
+	}
+	listAttachedUserPoliciesOutput, err := conn.ListAttachedUserPolicies(listAttachedUserPoliciesInput)
 
-		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error listing IAM User (%s) attached policies: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return nil
+	}
 
-		for _, attachedPolicy := range listAttachedUserPoliciesOutput.AttachedPolicies {
-			policyARN := aws.StringValue(attachedPolicy.PolicyArn)
+	if err != nil {
+		return fmt.Errorf("error listing attached policies: %s", err)
+	}
 
-			log.Printf("[DEBUG] Detaching IAM User (%s) attached policy: %s", username, policyARN)
+	for _, attachedPolicy := range listAttachedUserPoliciesOutput.AttachedPolicies {
+		policyARN := aws.StringValue(attachedPolicy.PolicyArn)
 
-			if err := detachPolicyFromUser(conn, username, policyARN); err != nil {
-				sweeperErr := fmt.Errorf("error detaching IAM User (%s) attached policy (%s): %s", username, policyARN, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+		if err := detachPolicyFromUser(conn, username, policyARN); err != nil {
+			return fmt.Errorf("error detaching attached policy (%s): %s", policyARN, err)
 		}
+	}
 
-		if err := deleteAwsIamUserGroupMemberships(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) group memberships: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := deleteAwsIamUserGroupMemberships(conn, username); err != nil {
+		return fmt.Errorf("error removing group memberships: %s", err)
+	}
 
-		if err := deleteAwsIamUserAccessKeys(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) access keys: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := deleteAwsIamUserAccessKeys(conn, username); err != nil {
+		return fmt.Errorf("error removing access keys: %s", err)
+	}
 
-		if err := deleteAwsIamUserSSHKeys(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) SSH keys: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := deleteAwsIamUserSSHKeys(conn, username); err != nil {
+		return fmt.Errorf("error removing SSH keys: %s", err)
+	}
 
-		if err := deleteAwsIamUserVirtualMFADevices(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) virtual MFA devices: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := deleteAwsIamUserVirtualMFADevices(conn, username); err != nil {
+		return fmt.Errorf("error removing virtual MFA devices: %s", err)
+	}
 
-		if err := deactivateAwsIamUserMFADevices(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) MFA devices: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := deactivateAwsIamUserMFADevices(conn, username); err != nil {
+		return fmt.Errorf("error removing MFA devices: %s", err)
+	}
 
-		if err := deleteAwsIamUserLoginProfile(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) login profile: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if err := deleteAwsIamUserLoginProfile(conn, username); err != nil {
+		return fmt.Errorf("error removing login profile: %s", err)
+	}
 
-		input := &iam.DeleteUserInput{
-			UserName: aws.String(username),
-		}
+	input := &iam.DeleteUserInput{
+		UserName: aws.String(username),
+	}
 
-		_, err = conn.DeleteUser(input)
+	_, err = conn.DeleteUser(input)
 
-		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM User (%s): %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return err
 }
 
 func TestAccAWSUser_basic(t *testing.T) {
@@ -590,7 +556,7 @@ func TestAccAWSUser_tags(t *testing.T) {
 }
 
 func testAccCheckAWSUserDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_user" {
@@ -625,7 +591,7 @@ func testAccCheckAWSUserExists(n string, res *iam.GetUserOutput) resource.TestCh
 			return fmt.Errorf("No User name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		resp, err := iamconn.GetUser(&iam.GetUserInput{
 			UserName: aws.String(rs.Primary.ID),
@@ -656,7 +622,7 @@ func testAccCheckAWSUserAttributes(user *iam.GetUserOutput, name string, path st
 
 func testAccCheckAWSUserDisappears(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		userName := aws.StringValue(getUserOutput.User.UserName)
 
@@ -689,7 +655,7 @@ func testAccCheckAWSUserPermissionsBoundary(getUserOutput *iam.GetUserOutput, ex
 
 func testAccCheckAWSUserCreatesAccessKey(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		input := &iam.CreateAccessKeyInput{
 			UserName: getUserOutput.User.UserName,
@@ -705,7 +671,7 @@ func testAccCheckAWSUserCreatesAccessKey(getUserOutput *iam.GetUserOutput) resou
 
 func testAccCheckAWSUserCreatesLoginProfile(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 		password, err := generateIAMPassword(32)
 		if err != nil {
 			return err
@@ -725,7 +691,7 @@ func testAccCheckAWSUserCreatesLoginProfile(getUserOutput *iam.GetUserOutput) re
 
 func testAccCheckAWSUserCreatesMFADevice(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		createVirtualMFADeviceInput := &iam.CreateVirtualMFADeviceInput{
 			Path:                 getUserOutput.User.Path,
@@ -770,7 +736,7 @@ func testAccCheckAWSUserUploadsSSHKey(getUserOutput *iam.GetUserOutput) resource
 			return fmt.Errorf("error reading SSH fixture: %s", err)
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		input := &iam.UploadSSHPublicKeyInput{
 			UserName:         getUserOutput.User.UserName,
@@ -788,7 +754,7 @@ func testAccCheckAWSUserUploadsSSHKey(getUserOutput *iam.GetUserOutput) resource
 
 func testAccCheckAWSUserUploadSigningCertificate(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		signingCertificate, err := ioutil.ReadFile("./test-fixtures/iam-ssl-unix-line-endings.pem")
 		if err != nil {
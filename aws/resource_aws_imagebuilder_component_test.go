@@ -13,22 +13,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_imagebuilder_component", &resource.Sweeper{
-		Name: "aws_imagebuilder_component",
-		F:    testSweepImageBuilderComponents,
+	sweep.AddTestSweepers("aws_imagebuilder_component", &sweep.Sweeper{
+		Name:    "aws_imagebuilder_component",
+		Service: "imagebuilder",
+		List:    testSweepImageBuilderComponents,
 	})
 }
 
-func testSweepImageBuilderComponents(region string) error {
+func testSweepImageBuilderComponents(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).imagebuilderconn
+	conn := client.(*AWSClient).imagebuilderconn()
 
+	var sweepables []sweep.Sweepable
 	var sweeperErrs *multierror.Error
 
 	input := &imagebuilder.ListComponentsInput{
@@ -46,6 +49,7 @@ func testSweepImageBuilderComponents(region string) error {
 			}
 
 			arn := aws.StringValue(componentVersion.Arn)
+
 			input := &imagebuilder.ListComponentBuildVersionsInput{
 				ComponentVersionArn: componentVersion.Arn,
 			}
@@ -60,29 +64,20 @@ func testSweepImageBuilderComponents(region string) error {
 						continue
 					}
 
-					arn := aws.StringValue(componentSummary.Arn)
-
 					r := resourceAwsImageBuilderComponent()
 					d := r.Data(nil)
-					d.SetId(arn)
-
-					err := r.Delete(d, client)
+					d.SetId(aws.StringValue(componentSummary.Arn))
 
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting Image Builder Component (%s): %w", arn, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
-					}
+					sweepables = append(sweepables, sweep.NewResource(aws.StringValue(componentSummary.Arn), func() error {
+						return r.Delete(d, client)
+					}).WithName(aws.StringValue(componentSummary.Name)))
 				}
 
 				return !lastPage
 			})
 
 			if err != nil {
-				sweeperErr := fmt.Errorf("error listing Image Builder Component (%s) versions: %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Image Builder Component (%s) versions: %w", arn, err))
 				continue
 			}
 		}
@@ -92,14 +87,14 @@ func testSweepImageBuilderComponents(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Image Builder Component sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 
 	if err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Image Builder Components: %w", err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAwsImageBuilderComponent_basic(t *testing.T) {
@@ -356,7 +351,7 @@ func TestAccAwsImageBuilderComponent_Uri(t *testing.T) {
 }
 
 func testAccCheckAwsImageBuilderComponentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_imagebuilder_component" {
@@ -392,7 +387,7 @@ func testAccCheckAwsImageBuilderComponentExists(resourceName string) resource.Te
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 		input := &imagebuilder.GetComponentInput{
 			ComponentBuildVersionArn: aws.String(rs.Primary.ID),
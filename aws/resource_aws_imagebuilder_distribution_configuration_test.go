@@ -8,27 +8,27 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_imagebuilder_distribution_configuration", &resource.Sweeper{
-		Name: "aws_imagebuilder_distribution_configuration",
-		F:    testSweepImageBuilderDistributionConfigurations,
+	sweep.AddTestSweepers("aws_imagebuilder_distribution_configuration", &sweep.Sweeper{
+		Name:    "aws_imagebuilder_distribution_configuration",
+		Service: "imagebuilder",
+		List:    testSweepImageBuilderDistributionConfigurations,
 	})
 }
 
-func testSweepImageBuilderDistributionConfigurations(region string) error {
+func testSweepImageBuilderDistributionConfigurations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).imagebuilderconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).imagebuilderconn()
+	var sweepables []sweep.Sweepable
 
 	input := &imagebuilder.ListDistributionConfigurationsInput{}
 
@@ -42,20 +42,13 @@ func testSweepImageBuilderDistributionConfigurations(region string) error {
 				continue
 			}
 
-			arn := aws.StringValue(distributionConfigurationSummary.Arn)
-
 			r := resourceAwsImageBuilderDistributionConfiguration()
 			d := r.Data(nil)
-			d.SetId(arn)
-
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(distributionConfigurationSummary.Arn))
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Image Builder Distribution Configuration (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(distributionConfigurationSummary.Arn), func() error {
+				return r.Delete(d, client)
+			}).WithName(aws.StringValue(distributionConfigurationSummary.Name)))
 		}
 
 		return !lastPage
@@ -63,14 +56,14 @@ func testSweepImageBuilderDistributionConfigurations(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Image Builder Distribution Configuration sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Image Builder Distribution Configurations: %w", err))
+		return sweepables, fmt.Errorf("error listing Image Builder Distribution Configurations: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsImageBuilderDistributionConfiguration_basic(t *testing.T) {
@@ -524,7 +517,7 @@ func TestAccAwsImageBuilderDistributionConfiguration_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsImageBuilderDistributionConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_imagebuilder_distribution_configuration" {
@@ -560,7 +553,7 @@ func testAccCheckAwsImageBuilderDistributionConfigurationExists(resourceName str
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 		input := &imagebuilder.GetDistributionConfigurationInput{
 			DistributionConfigurationArn: aws.String(rs.Primary.ID),
@@ -8,27 +8,27 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_imagebuilder_image_pipeline", &resource.Sweeper{
-		Name: "aws_imagebuilder_image_pipeline",
-		F:    testSweepImageBuilderImagePipelines,
+	sweep.AddTestSweepers("aws_imagebuilder_image_pipeline", &sweep.Sweeper{
+		Name:    "aws_imagebuilder_image_pipeline",
+		Service: "imagebuilder",
+		List:    testSweepImageBuilderImagePipelines,
 	})
 }
 
-func testSweepImageBuilderImagePipelines(region string) error {
+func testSweepImageBuilderImagePipelines(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).imagebuilderconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).imagebuilderconn()
+	var sweepables []sweep.Sweepable
 
 	input := &imagebuilder.ListImagePipelinesInput{}
 
@@ -42,20 +42,13 @@ func testSweepImageBuilderImagePipelines(region string) error {
 				continue
 			}
 
-			arn := aws.StringValue(imagePipeline.Arn)
-
 			r := resourceAwsImageBuilderImagePipeline()
 			d := r.Data(nil)
-			d.SetId(arn)
-
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(imagePipeline.Arn))
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Image Builder Image Pipeline (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(imagePipeline.Arn), func() error {
+				return r.Delete(d, client)
+			}).WithName(aws.StringValue(imagePipeline.Name)))
 		}
 
 		return !lastPage
@@ -63,14 +56,14 @@ func testSweepImageBuilderImagePipelines(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Image Builder Image Pipeline sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Image Builder Image Pipelines: %w", err))
+		return sweepables, fmt.Errorf("error listing Image Builder Image Pipelines: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsImageBuilderImagePipeline_basic(t *testing.T) {
@@ -515,7 +508,7 @@ func TestAccAwsImageBuilderImagePipeline_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsImageBuilderImagePipelineDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_imagebuilder_image_pipeline" {
@@ -551,7 +544,7 @@ func testAccCheckAwsImageBuilderImagePipelineExists(resourceName string) resourc
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 		input := &imagebuilder.GetImagePipelineInput{
 			ImagePipelineArn: aws.String(rs.Primary.ID),
@@ -9,28 +9,28 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	tfimagebuilder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_imagebuilder_image_recipe", &resource.Sweeper{
-		Name: "aws_imagebuilder_image_recipe",
-		F:    testSweepImageBuilderImageRecipes,
+	sweep.AddTestSweepers("aws_imagebuilder_image_recipe", &sweep.Sweeper{
+		Name:    "aws_imagebuilder_image_recipe",
+		Service: "imagebuilder",
+		List:    testSweepImageBuilderImageRecipes,
 	})
 }
 
-func testSweepImageBuilderImageRecipes(region string) error {
+func testSweepImageBuilderImageRecipes(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).imagebuilderconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).imagebuilderconn()
+	var sweepables []sweep.Sweepable
 
 	input := &imagebuilder.ListImageRecipesInput{
 		Owner: aws.String(imagebuilder.OwnershipSelf),
@@ -46,20 +46,13 @@ func testSweepImageBuilderImageRecipes(region string) error {
 				continue
 			}
 
-			arn := aws.StringValue(imageRecipeSummary.Arn)
-
 			r := resourceAwsImageBuilderImageRecipe()
 			d := r.Data(nil)
-			d.SetId(arn)
-
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(imageRecipeSummary.Arn))
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Image Builder Image Recipe (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(imageRecipeSummary.Arn), func() error {
+				return r.Delete(d, client)
+			}).WithName(aws.StringValue(imageRecipeSummary.Name)))
 		}
 
 		return !lastPage
@@ -67,14 +60,14 @@ func testSweepImageBuilderImageRecipes(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Image Builder Image Recipe sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Image Builder Image Recipes: %w", err))
+		return sweepables, fmt.Errorf("error listing Image Builder Image Recipes: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsImageBuilderImageRecipe_basic(t *testing.T) {
@@ -561,7 +554,7 @@ func TestAccAwsImageBuilderImageRecipe_WorkingDirectory(t *testing.T) {
 }
 
 func testAccCheckAwsImageBuilderImageRecipeDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_imagebuilder_image_recipe" {
@@ -597,7 +590,7 @@ func testAccCheckAwsImageBuilderImageRecipeExists(resourceName string) resource.
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 		input := &imagebuilder.GetImageRecipeInput{
 			ImageRecipeArn: aws.String(rs.Primary.ID),
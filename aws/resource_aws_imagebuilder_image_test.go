@@ -9,27 +9,27 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_imagebuilder_image", &resource.Sweeper{
-		Name: "aws_imagebuilder_image",
-		F:    testSweepImageBuilderImages,
+	sweep.AddTestSweepers("aws_imagebuilder_image", &sweep.Sweeper{
+		Name:    "aws_imagebuilder_image",
+		Service: "imagebuilder",
+		List:    testSweepImageBuilderImages,
 	})
 }
 
-func testSweepImageBuilderImages(region string) error {
+func testSweepImageBuilderImages(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).imagebuilderconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).imagebuilderconn()
+	var sweepables []sweep.Sweepable
 
 	input := &imagebuilder.ListImagesInput{
 		Owner: aws.String(imagebuilder.OwnershipSelf),
@@ -45,20 +45,13 @@ func testSweepImageBuilderImages(region string) error {
 				continue
 			}
 
-			arn := aws.StringValue(imageVersion.Arn)
-
 			r := resourceAwsImageBuilderImage()
 			d := r.Data(nil)
-			d.SetId(arn)
-
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(imageVersion.Arn))
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Image Builder Image (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(imageVersion.Arn), func() error {
+				return r.Delete(d, client)
+			}).WithName(aws.StringValue(imageVersion.Name)))
 		}
 
 		return !lastPage
@@ -66,14 +59,14 @@ func testSweepImageBuilderImages(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Image Builder Image sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Image Builder Images: %w", err))
+		return sweepables, fmt.Errorf("error listing Image Builder Images: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsImageBuilderImage_basic(t *testing.T) {
@@ -285,7 +278,7 @@ func TestAccAwsImageBuilderImage_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsImageBuilderImageDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_imagebuilder_image_pipeline" {
@@ -321,7 +314,7 @@ func testAccCheckAwsImageBuilderImageExists(resourceName string) resource.TestCh
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 		input := &imagebuilder.GetImageInput{
 			ImageBuildVersionArn: aws.String(rs.Primary.ID),
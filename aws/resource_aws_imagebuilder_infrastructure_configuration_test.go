@@ -8,27 +8,27 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &resource.Sweeper{
-		Name: "aws_imagebuilder_infrastructure_configuration",
-		F:    testSweepImageBuilderInfrastructureConfigurations,
+	sweep.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &sweep.Sweeper{
+		Name:    "aws_imagebuilder_infrastructure_configuration",
+		Service: "imagebuilder",
+		List:    testSweepImageBuilderInfrastructureConfigurations,
 	})
 }
 
-func testSweepImageBuilderInfrastructureConfigurations(region string) error {
+func testSweepImageBuilderInfrastructureConfigurations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).imagebuilderconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).imagebuilderconn()
+	var sweepables []sweep.Sweepable
 
 	input := &imagebuilder.ListInfrastructureConfigurationsInput{}
 
@@ -42,20 +42,13 @@ func testSweepImageBuilderInfrastructureConfigurations(region string) error {
 				continue
 			}
 
-			arn := aws.StringValue(infrastructureConfigurationSummary.Arn)
-
 			r := resourceAwsImageBuilderInfrastructureConfiguration()
 			d := r.Data(nil)
-			d.SetId(arn)
-
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(infrastructureConfigurationSummary.Arn))
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Image Builder Infrastructure Configuration (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(infrastructureConfigurationSummary.Arn), func() error {
+				return r.Delete(d, client)
+			}).WithName(aws.StringValue(infrastructureConfigurationSummary.Name)))
 		}
 
 		return !lastPage
@@ -63,14 +56,14 @@ func testSweepImageBuilderInfrastructureConfigurations(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Image Builder Infrastructure Configuration sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Image Builder Infrastructure Configurations: %w", err))
+		return sweepables, fmt.Errorf("error listing Image Builder Infrastructure Configurations: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsImageBuilderInfrastructureConfiguration_basic(t *testing.T) {
@@ -565,7 +558,7 @@ func TestAccAwsImageBuilderInfrastructureConfiguration_TerminateInstanceOnFailur
 }
 
 func testAccCheckAwsImageBuilderInfrastructureConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+	conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_imagebuilder_infrastructure_configuration" {
@@ -601,7 +594,7 @@ func testAccCheckAwsImageBuilderInfrastructureConfigurationExists(resourceName s
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn
+		conn := testAccProvider.Meta().(*AWSClient).imagebuilderconn()
 
 		input := &imagebuilder.GetInfrastructureConfigurationInput{
 			InfrastructureConfigurationArn: aws.String(rs.Primary.ID),
@@ -118,7 +118,7 @@ func TestAccAWSInspectorTemplate_tags(t *testing.T) {
 }
 
 func testAccCheckAWSInspectorTemplateDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).inspectorconn
+	conn := testAccProvider.Meta().(*AWSClient).inspectorconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_inspector_assessment_template" {
@@ -149,7 +149,7 @@ func testAccCheckAWSInspectorTemplateDestroy(s *terraform.State) error {
 
 func testAccCheckAWSInspectorTemplateDisappears(v *inspector.AssessmentTemplate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).inspectorconn
+		conn := testAccProvider.Meta().(*AWSClient).inspectorconn()
 
 		_, err := conn.DeleteAssessmentTemplate(&inspector.DeleteAssessmentTemplateInput{
 			AssessmentTemplateArn: v.Arn,
@@ -173,7 +173,7 @@ func testAccCheckAWSInspectorTemplateExists(name string, v *inspector.Assessment
 			return fmt.Errorf("No Inspector assessment template ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).inspectorconn
+		conn := testAccProvider.Meta().(*AWSClient).inspectorconn()
 
 		resp, err := conn.DescribeAssessmentTemplates(&inspector.DescribeAssessmentTemplatesInput{
 			AssessmentTemplateArns: aws.StringSlice([]string{rs.Primary.ID}),
@@ -43,7 +43,7 @@ func TestAccAWSInspectorResourceGroup_basic(t *testing.T) {
 
 func testAccCheckAWSInspectorResourceGroupExists(name string, rg *inspector.ResourceGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).inspectorconn
+		conn := testAccProvider.Meta().(*AWSClient).inspectorconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -19,12 +19,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
-		Name: "aws_instance",
-		F:    testSweepInstances,
+	sweep.AddTestSweepers("aws_instance", &sweep.Sweeper{
+		Name:    "aws_instance",
+		Service: "ec2",
+		List:    testSweepInstances,
 		Dependencies: []string{
 			"aws_autoscaling_group",
 			"aws_spot_fleet_request",
@@ -32,64 +35,57 @@ func init() {
 	})
 }
 
-func testSweepInstances(region string) error {
+func testSweepInstances(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeInstancesPages(&ec2.DescribeInstancesInput{}, func(page *ec2.DescribeInstancesOutput, isLast bool) bool {
-		if len(page.Reservations) == 0 {
-			log.Print("[DEBUG] No EC2 Instances to sweep")
-			return false
-		}
-
 		for _, reservation := range page.Reservations {
 			for _, instance := range reservation.Instances {
 				id := aws.StringValue(instance.InstanceId)
 
 				if instance.State != nil && aws.StringValue(instance.State.Name) == ec2.InstanceStateNameTerminated {
-					log.Printf("[INFO] Skipping terminated EC2 Instance: %s", id)
 					continue
 				}
 
-				log.Printf("[INFO] Terminating EC2 Instance: %s", id)
-				err := awsTerminateInstance(conn, id, 5*time.Minute)
+				sweepables = append(sweepables, sweep.NewResource(id, func() error {
+					err := awsTerminateInstance(conn, id, 5*time.Minute)
 
-				if isAWSErr(err, "OperationNotPermitted", "Modify its 'disableApiTermination' instance attribute and try again.") {
-					log.Printf("[INFO] Enabling API Termination on EC2 Instance: %s", id)
-
-					input := &ec2.ModifyInstanceAttributeInput{
-						InstanceId: instance.InstanceId,
-						DisableApiTermination: &ec2.AttributeBooleanValue{
-							Value: aws.Bool(false),
-						},
-					}
+					if isAWSErr(err, "OperationNotPermitted", "Modify its 'disableApiTermination' instance attribute and try again.") {
+						input := &ec2.ModifyInstanceAttributeInput{
+							InstanceId: aws.String(id),
+							DisableApiTermination: &ec2.AttributeBooleanValue{
+								Value: aws.Bool(false),
+							},
+						}
 
-					_, err = conn.ModifyInstanceAttribute(input)
+						_, err = conn.ModifyInstanceAttribute(input)
 
-					if err == nil {
-						err = awsTerminateInstance(conn, id, 5*time.Minute)
+						if err == nil {
+							err = awsTerminateInstance(conn, id, 5*time.Minute)
+						}
 					}
-				}
 
-				if err != nil {
-					log.Printf("[ERROR] Error terminating EC2 Instance (%s): %s", id, err)
-				}
+					return err
+				}).WithTags(keyvaluetags.Ec2KeyValueTags(instance.Tags).IgnoreAws().Map()))
 			}
 		}
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping EC2 Instance sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving EC2 Instances: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving EC2 Instances: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestFetchRootDevice(t *testing.T) {
@@ -752,7 +748,7 @@ func TestAccAWSInstance_disableApiTermination(t *testing.T) {
 
 	checkDisableApiTermination := func(expected bool) resource.TestCheckFunc {
 		return func(*terraform.State) error {
-			conn := testAccProvider.Meta().(*AWSClient).ec2conn
+			conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 			r, err := conn.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
 				InstanceId: v.InstanceId,
 				Attribute:  aws.String("disableApiTermination"),
@@ -1505,7 +1501,8 @@ func TestAccAWSInstance_rootBlockDeviceMismatch(t *testing.T) {
 }
 
 // This test reproduces the bug here:
-//   https://github.com/hashicorp/terraform/issues/1752
+//
+//	https://github.com/hashicorp/terraform/issues/1752
 //
 // I wish there were a way to exercise resources built with helper.Schema in a
 // unit context, in which case this test could be moved there, but for now this
@@ -3327,7 +3324,7 @@ func testAccCheckInstanceDestroy(s *terraform.State) error {
 }
 
 func testAccCheckInstanceDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).ec2conn
+	conn := provider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_instance" {
@@ -3374,7 +3371,7 @@ func testAccCheckInstanceExistsWithProvider(n string, i *ec2.Instance, providerF
 
 		provider := providerF()
 
-		conn := provider.Meta().(*AWSClient).ec2conn
+		conn := provider.Meta().(*AWSClient).ec2conn()
 		instance, err := resourceAwsInstanceFindByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -3391,7 +3388,7 @@ func testAccCheckInstanceExistsWithProvider(n string, i *ec2.Instance, providerF
 
 func testAccCheckStopInstance(instance *ec2.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		params := &ec2.StopInstancesInput{
 			InstanceIds: []*string{instance.InstanceId},
@@ -3454,7 +3451,7 @@ func TestInstanceCpuThreadsPerCoreSchema(t *testing.T) {
 
 func driftTags(instance *ec2.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		_, err := conn.CreateTags(&ec2.CreateTagsInput{
 			Resources: []*string{instance.InstanceId},
 			Tags: []*ec2.Tag{
@@ -5567,8 +5564,9 @@ data "aws_ami" "win2016core-ami" {
 }
 
 // testAccAwsInstanceVpcConfig returns the configuration for tests that create
-//   1) a VPC without IPv6 support
-//   2) a subnet in the VPC that optionally assigns public IP addresses to ENIs
+//  1. a VPC without IPv6 support
+//  2. a subnet in the VPC that optionally assigns public IP addresses to ENIs
+//
 // The resources are named 'test'.
 func testAccAwsInstanceVpcConfig(rName string, mapPublicIpOnLaunch bool) string {
 	return composeConfig(testAccAvailableAZsNoOptInDefaultExcludeConfig(), fmt.Sprintf(`
@@ -5616,8 +5614,9 @@ resource "aws_subnet" "test" {
 }
 
 // testAccAwsInstanceVpcSecurityGroupConfig returns the configuration for tests that create
-//   1) a VPC security group
-//   2) an internet gateway in the VPC
+//  1. a VPC security group
+//  2. an internet gateway in the VPC
+//
 // The resources are named 'test'.
 func testAccAwsInstanceVpcSecurityGroupConfig(rName string) string {
 	return fmt.Sprintf(`
@@ -5649,8 +5648,9 @@ resource "aws_security_group" "test" {
 }
 
 // testAccAwsInstanceVpcIpv6Config returns the configuration for tests that create
-//   1) a VPC with IPv6 support
-//   2) a subnet in the VPC with an assigned IPv6 CIDR block
+//  1. a VPC with IPv6 support
+//  2. a subnet in the VPC with an assigned IPv6 CIDR block
+//
 // The resources are named 'test'.
 func testAccAwsInstanceVpcIpv6Config(rName string) string {
 	return composeConfig(testAccAvailableAZsNoOptInDefaultExcludeConfig(), fmt.Sprintf(`
@@ -5824,9 +5824,10 @@ data "aws_ec2_instance_type_offering" "available" {
 // testAccAvailableEc2InstanceTypeForAvailabilityZone returns the configuration for a data source that describes
 // the first available EC2 instance type offering in the specified availability zone from a list of preferred instance types.
 // The first argument is either an Availability Zone name or Terraform configuration reference to one, e.g.
-//   * data.aws_availability_zones.available.names[0]
-//   * aws_subnet.test.availability_zone
-//   * us-west-2a
+//   - data.aws_availability_zones.available.names[0]
+//   - aws_subnet.test.availability_zone
+//   - us-west-2a
+//
 // The data source is named 'available'.
 func testAccAvailableEc2InstanceTypeForAvailabilityZone(availabilityZoneName string, preferredInstanceTypes ...string) string {
 	if !strings.Contains(availabilityZoneName, ".") {
@@ -12,38 +12,40 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
-		Name: "aws_internet_gateway",
+	sweep.AddTestSweepers("aws_internet_gateway", &sweep.Sweeper{
+		Name:    "aws_internet_gateway",
+		Service: "ec2",
 		Dependencies: []string{
 			"aws_subnet",
 		},
-		F: testSweepInternetGateways,
+		List: testSweepInternetGateways,
 	})
 }
 
-func testSweepInternetGateways(region string) error {
+func testSweepInternetGateways(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeInternetGatewaysInput{}
 	resp, err := conn.DescribeInternetGateways(req)
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Internet Gateway sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error describing Internet Gateways: %s", err)
+		return nil, fmt.Errorf("Error describing Internet Gateways: %s", err)
 	}
 
 	if len(resp.InternetGateways) == 0 {
-		log.Print("[DEBUG] No AWS Internet Gateways to sweep")
-		return nil
+		return nil, nil
 	}
 
 	defaultVPCID := ""
@@ -59,14 +61,18 @@ func testSweepInternetGateways(region string) error {
 	describeVpcsOutput, err := conn.DescribeVpcs(describeVpcsInput)
 
 	if err != nil {
-		return fmt.Errorf("Error describing VPCs: %s", err)
+		return nil, fmt.Errorf("Error describing VPCs: %s", err)
 	}
 
 	if describeVpcsOutput != nil && len(describeVpcsOutput.Vpcs) == 1 {
 		defaultVPCID = aws.StringValue(describeVpcsOutput.Vpcs[0].VpcId)
 	}
 
+	var sweepables []sweep.Sweepable
+
 	for _, internetGateway := range resp.InternetGateways {
+		internetGateway := internetGateway
+		id := aws.StringValue(internetGateway.InternetGatewayId)
 		isDefaultVPCInternetGateway := false
 
 		for _, attachment := range internetGateway.Attachments {
@@ -74,49 +80,50 @@ func testSweepInternetGateways(region string) error {
 				isDefaultVPCInternetGateway = true
 				break
 			}
-
-			input := &ec2.DetachInternetGatewayInput{
-				InternetGatewayId: internetGateway.InternetGatewayId,
-				VpcId:             attachment.VpcId,
-			}
-
-			log.Printf("[DEBUG] Detaching Internet Gateway: %s", input)
-			_, err := conn.DetachInternetGateway(input)
-			if err != nil {
-				return fmt.Errorf("error detaching Internet Gateway (%s) from VPC (%s): %s", aws.StringValue(internetGateway.InternetGatewayId), aws.StringValue(attachment.VpcId), err)
-			}
-
-			stateConf := &resource.StateChangeConf{
-				Pending: []string{ec2.AttachmentStatusDetaching},
-				Target:  []string{ec2.AttachmentStatusDetached},
-				Refresh: detachIGStateRefreshFunc(conn, aws.StringValue(internetGateway.InternetGatewayId), aws.StringValue(attachment.VpcId)),
-				Timeout: 10 * time.Minute,
-				Delay:   10 * time.Second,
-			}
-
-			log.Printf("[DEBUG] Waiting for Internet Gateway (%s) to detach from VPC (%s)", aws.StringValue(internetGateway.InternetGatewayId), aws.StringValue(attachment.VpcId))
-			if _, err = stateConf.WaitForState(); err != nil {
-				return fmt.Errorf("error waiting for VPN Gateway (%s) to detach from VPC (%s): %s", aws.StringValue(internetGateway.InternetGatewayId), aws.StringValue(attachment.VpcId), err)
-			}
 		}
 
 		if isDefaultVPCInternetGateway {
-			log.Printf("[DEBUG] Skipping Default VPC Internet Gateway: %s", aws.StringValue(internetGateway.InternetGatewayId))
 			continue
 		}
 
-		input := &ec2.DeleteInternetGatewayInput{
-			InternetGatewayId: internetGateway.InternetGatewayId,
-		}
+		sweepables = append(sweepables, sweep.NewResource(id, func() error {
+			for _, attachment := range internetGateway.Attachments {
+				vpcID := aws.StringValue(attachment.VpcId)
+				input := &ec2.DetachInternetGatewayInput{
+					InternetGatewayId: internetGateway.InternetGatewayId,
+					VpcId:             attachment.VpcId,
+				}
+
+				_, err := conn.DetachInternetGateway(input)
+
+				if err != nil {
+					return fmt.Errorf("error detaching from VPC (%s): %s", vpcID, err)
+				}
+
+				stateConf := &resource.StateChangeConf{
+					Pending: []string{ec2.AttachmentStatusDetaching},
+					Target:  []string{ec2.AttachmentStatusDetached},
+					Refresh: detachIGStateRefreshFunc(conn, id, vpcID),
+					Timeout: 10 * time.Minute,
+					Delay:   10 * time.Second,
+				}
+
+				if _, err = stateConf.WaitForState(); err != nil {
+					return fmt.Errorf("error waiting to detach from VPC (%s): %s", vpcID, err)
+				}
+			}
 
-		log.Printf("[DEBUG] Deleting Internet Gateway: %s", input)
-		_, err := conn.DeleteInternetGateway(input)
-		if err != nil {
-			return fmt.Errorf("error deleting Internet Gateway (%s): %s", aws.StringValue(internetGateway.InternetGatewayId), err)
-		}
+			input := &ec2.DeleteInternetGatewayInput{
+				InternetGatewayId: internetGateway.InternetGatewayId,
+			}
+
+			_, err := conn.DeleteInternetGateway(input)
+
+			return err
+		}).WithTags(keyvaluetags.Ec2KeyValueTags(internetGateway.Tags).IgnoreAws().Map()))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSInternetGateway_basic(t *testing.T) {
@@ -272,7 +279,7 @@ func TestAccAWSInternetGateway_disappears(t *testing.T) {
 }
 
 func testAccCheckInternetGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_internet_gateway" {
@@ -311,7 +318,7 @@ func testAccCheckInternetGatewayExists(n string, ig *ec2.InternetGateway) resour
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		resp, err := conn.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
 			InternetGatewayIds: []*string{aws.String(rs.Primary.ID)},
 		})
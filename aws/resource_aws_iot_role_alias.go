@@ -44,7 +44,7 @@ func resourceAwsIotRoleAlias() *schema.Resource {
 }
 
 func resourceAwsIotRoleAliasCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	roleAlias := d.Get("alias").(string)
 	roleArn := d.Get("role_arn").(string)
@@ -82,7 +82,7 @@ func getIotRoleAliasDescription(conn *iot.IoT, alias string) (*iot.RoleAliasDesc
 }
 
 func resourceAwsIotRoleAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	var roleAliasDescription *iot.RoleAliasDescription
 
@@ -107,7 +107,7 @@ func resourceAwsIotRoleAliasRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsIotRoleAliasDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	alias := d.Get("alias").(string)
 
@@ -123,7 +123,7 @@ func resourceAwsIotRoleAliasDelete(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIotRoleAliasUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	if d.HasChange("credential_duration") {
 		roleAliasInput := &iot.UpdateRoleAliasInput{
@@ -54,7 +54,7 @@ func resourceAwsIotThing() *schema.Resource {
 }
 
 func resourceAwsIotThingCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.CreateThingInput{
 		ThingName: aws.String(d.Get("name").(string)),
@@ -81,7 +81,7 @@ func resourceAwsIotThingCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIotThingRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.DescribeThingInput{
 		ThingName: aws.String(d.Id()),
@@ -110,7 +110,7 @@ func resourceAwsIotThingRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIotThingUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.UpdateThingInput{
 		ThingName: aws.String(d.Get("name").(string)),
@@ -144,7 +144,7 @@ func resourceAwsIotThingUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIotThingDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	params := &iot.DeleteThingInput{
 		ThingName: aws.String(d.Id()),
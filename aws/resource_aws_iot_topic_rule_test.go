@@ -7,64 +7,65 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
-		Name: "aws_iot_topic_rule",
-		F:    testSweepIotTopicRules,
+	sweep.AddTestSweepers("aws_iot_topic_rule", &sweep.Sweeper{
+		Name:    "aws_iot_topic_rule",
+		Service: "iot",
+		List:    testSweepIotTopicRules,
 	})
 }
 
-func testSweepIotTopicRules(region string) error {
+func testSweepIotTopicRules(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).iotconn
+	conn := client.(*AWSClient).iotconn()
 	input := &iot.ListTopicRulesInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.ListTopicRules(input)
+
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping IoT Topic Rules sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return sweepables, nil // In case we have completed some pages
 		}
+
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IoT Topic Rules: %w", err))
-			return sweeperErrs
+			return sweepables, fmt.Errorf("error retrieving IoT Topic Rules: %w", err)
 		}
 
 		for _, rule := range output.Rules {
 			name := aws.StringValue(rule.RuleName)
 
-			log.Printf("[INFO] Deleting IoT Topic Rule: %s", name)
-			_, err := conn.DeleteTopicRule(&iot.DeleteTopicRuleInput{
-				RuleName: aws.String(name),
-			})
-			if isAWSErr(err, iot.ErrCodeUnauthorizedException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IoT Topic Rule (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteTopicRule(&iot.DeleteTopicRuleInput{
+					RuleName: aws.String(name),
+				})
+
+				if isAWSErr(err, iot.ErrCodeUnauthorizedException, "") {
+					return nil
+				}
+
+				return err
+			}))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
 			break
 		}
+
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSIoTTopicRule_basic(t *testing.T) {
@@ -567,7 +568,7 @@ func TestAccAWSIoTTopicRule_errorAction(t *testing.T) {
 }
 
 func testAccCheckAWSIoTTopicRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iotconn
+	conn := testAccProvider.Meta().(*AWSClient).iotconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_topic_rule" {
@@ -600,7 +601,7 @@ func testAccCheckAWSIoTTopicRuleExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		conn := testAccProvider.Meta().(*AWSClient).iotconn()
 		input := &iot.ListTopicRulesInput{}
 
 		output, err := conn.ListTopicRules(input)
@@ -12,49 +12,54 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
-		Name: "aws_key_pair",
+	sweep.AddTestSweepers("aws_key_pair", &sweep.Sweeper{
+		Name:    "aws_key_pair",
+		Service: "ec2",
 		Dependencies: []string{
 			"aws_elastic_beanstalk_environment",
 			"aws_instance",
 			"aws_spot_fleet_request",
 		},
-		F: testSweepKeyPairs,
+		List: testSweepKeyPairs,
 	})
 }
 
-func testSweepKeyPairs(region string) error {
+func testSweepKeyPairs(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	ec2conn := client.(*AWSClient).ec2conn
-
-	log.Printf("Destroying the tmp keys in (%s)", client.(*AWSClient).region)
+	ec2conn := client.(*AWSClient).ec2conn()
 
 	resp, err := ec2conn.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping EC2 Key Pair sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error describing key pairs in Sweeper: %s", err)
+		return nil, fmt.Errorf("Error describing key pairs in Sweeper: %s", err)
 	}
 
-	keyPairs := resp.KeyPairs
-	for _, d := range keyPairs {
-		_, err := ec2conn.DeleteKeyPair(&ec2.DeleteKeyPairInput{
-			KeyName: d.KeyName,
-		})
+	var sweepables []sweep.Sweepable
 
-		if err != nil {
-			return fmt.Errorf("Error deleting key pairs in Sweeper: %s", err)
+	for _, d := range resp.KeyPairs {
+		input := &ec2.DeleteKeyPairInput{
+			KeyName: d.KeyName,
 		}
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(d.KeyName), func() error {
+			_, err := ec2conn.DeleteKeyPair(input)
+
+			return err
+		}).WithTags(keyvaluetags.Ec2KeyValueTags(d.Tags).IgnoreAws().Map()))
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAWSKeyPair_basic(t *testing.T) {
@@ -212,7 +217,7 @@ func TestAccAWSKeyPair_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSKeyPairDestroy(s *terraform.State) error {
-	ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_key_pair" {
@@ -267,7 +272,7 @@ func testAccCheckAWSKeyPairExists(n string, res *ec2.KeyPairInfo) resource.TestC
 			return fmt.Errorf("No KeyPair name is set")
 		}
 
-		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		resp, err := ec2conn.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{
 			KeyNames: []*string{aws.String(rs.Primary.ID)},
@@ -15,22 +15,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalytics/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalytics/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kinesis_analytics_application", &resource.Sweeper{
-		Name: "aws_kinesis_analytics_application",
-		F:    testSweepKinesisAnalyticsApplications,
+	sweep.AddTestSweepers("aws_kinesis_analytics_application", &sweep.Sweeper{
+		Name:    "aws_kinesis_analytics_application",
+		Service: "kinesisanalytics",
+		List:    testSweepKinesisAnalyticsApplications,
 	})
 }
 
-func testSweepKinesisAnalyticsApplications(region string) error {
+func testSweepKinesisAnalyticsApplications(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).kinesisanalyticsconn
+	conn := client.(*AWSClient).kinesisanalyticsconn()
 	input := &kinesisanalytics.ListApplicationsInput{}
+	var sweepables []sweep.Sweepable
 	var sweeperErrs *multierror.Error
 
 	err = lister.ListApplicationsPages(conn, input, func(page *kinesisanalytics.ListApplicationsOutput, isLast bool) bool {
@@ -49,9 +52,7 @@ func testSweepKinesisAnalyticsApplications(region string) error {
 			}
 
 			if err != nil {
-				sweeperErr := fmt.Errorf("error reading Kinesis Analytics Application (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error reading Kinesis Analytics Application (%s): %w", arn, err))
 				continue
 			}
 
@@ -60,13 +61,8 @@ func testSweepKinesisAnalyticsApplications(region string) error {
 			d.SetId(arn)
 			d.Set("create_timestamp", aws.TimeValue(application.CreateTimestamp).Format(time.RFC3339))
 			d.Set("name", name)
-			err = r.Delete(d, client)
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !isLast
@@ -74,14 +70,14 @@ func testSweepKinesisAnalyticsApplications(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Kinesis Analytics Application sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 
 	if err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Kinesis Analytics Applications: %w", err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSKinesisAnalyticsApplication_basic(t *testing.T) {
@@ -1482,7 +1478,7 @@ func TestAccAWSKinesisAnalyticsApplication_ReferenceDataSource_Update(t *testing
 }
 
 func testAccCheckKinesisAnalyticsApplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn
+	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kinesis_analytics_application" {
@@ -1513,7 +1509,7 @@ func testAccCheckKinesisAnalyticsApplicationExists(n string, v *kinesisanalytics
 			return fmt.Errorf("No Kinesis Analytics Application ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn()
 
 		application, err := finder.ApplicationByName(conn, rs.Primary.Attributes["name"])
 		if err != nil {
@@ -1527,7 +1523,7 @@ func testAccCheckKinesisAnalyticsApplicationExists(n string, v *kinesisanalytics
 }
 
 func testAccPreCheckAWSKinesisAnalytics(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn
+	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn()
 
 	input := &kinesisanalytics.ListApplicationsInput{}
 
@@ -13,69 +13,71 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kinesis_firehose_delivery_stream", &resource.Sweeper{
-		Name: "aws_kinesis_firehose_delivery_stream",
-		F:    testSweepKinesisFirehoseDeliveryStreams,
+	sweep.AddTestSweepers("aws_kinesis_firehose_delivery_stream", &sweep.Sweeper{
+		Name:    "aws_kinesis_firehose_delivery_stream",
+		Service: "firehose",
+		List:    testSweepKinesisFirehoseDeliveryStreams,
 	})
 }
 
-func testSweepKinesisFirehoseDeliveryStreams(region string) error {
+func testSweepKinesisFirehoseDeliveryStreams(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*AWSClient).firehoseconn
+	conn := client.(*AWSClient).firehoseconn()
 	input := &firehose.ListDeliveryStreamsInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.ListDeliveryStreams(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Kinesis Firehose Delivery Streams sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("error listing Kinesis Firehose Delivery Streams: %s", err)
-		}
-
-		if len(output.DeliveryStreamNames) == 0 {
-			log.Print("[DEBUG] No Kinesis Firehose Delivery Streams to sweep")
-			return nil
+			return sweepables, fmt.Errorf("error listing Kinesis Firehose Delivery Streams: %s", err)
 		}
 
 		for _, deliveryStreamNamePtr := range output.DeliveryStreamNames {
+			name := aws.StringValue(deliveryStreamNamePtr)
 			input := &firehose.DeleteDeliveryStreamInput{
 				DeliveryStreamName: deliveryStreamNamePtr,
 			}
-			name := aws.StringValue(deliveryStreamNamePtr)
 
-			log.Printf("[INFO] Deleting Kinesis Firehose Delivery Stream: %s", name)
-			_, err := conn.DeleteDeliveryStream(input)
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteDeliveryStream(input)
 
-			if isAWSErr(err, firehose.ErrCodeResourceNotFoundException, "") {
-				continue
-			}
+				if isAWSErr(err, firehose.ErrCodeResourceNotFoundException, "") {
+					return nil
+				}
 
-			if err != nil {
-				return fmt.Errorf("error deleting Kinesis Firehose Delivery Stream (%s): %s", name, err)
-			}
+				if err != nil {
+					return err
+				}
 
-			if err := waitForKinesisFirehoseDeliveryStreamDeletion(conn, name); err != nil {
-				return fmt.Errorf("error waiting for Kinesis Firehose Delivery Stream (%s) deletion: %s", name, err)
-			}
+				if err := waitForKinesisFirehoseDeliveryStreamDeletion(conn, name); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}))
 		}
 
-		if !aws.BoolValue(output.HasMoreDeliveryStreams) {
+		if !aws.BoolValue(output.HasMoreDeliveryStreams) || len(output.DeliveryStreamNames) == 0 {
 			break
 		}
+
+		input.ExclusiveStartDeliveryStreamName = output.DeliveryStreamNames[len(output.DeliveryStreamNames)-1]
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSKinesisFirehoseDeliveryStream_basic(t *testing.T) {
@@ -555,7 +557,7 @@ func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_ExternalUpdate(t *testin
 			},
 			{
 				PreConfig: func() {
-					conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+					conn := testAccProvider.Meta().(*AWSClient).firehoseconn()
 					udi := firehose.UpdateDestinationInput{
 						DeliveryStreamName:             aws.String(rName),
 						DestinationId:                  aws.String("destinationId-000000000001"),
@@ -1462,7 +1464,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamExists(n string, stream *firehose.
 			return fmt.Errorf("No Kinesis Firehose ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+		conn := testAccProvider.Meta().(*AWSClient).firehoseconn()
 		describeOpts := &firehose.DescribeDeliveryStreamInput{
 			DeliveryStreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -1665,7 +1667,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamDestroy(s *terraform.State) error
 		if rs.Type != "aws_kinesis_firehose_delivery_stream" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+		conn := testAccProvider.Meta().(*AWSClient).firehoseconn()
 		describeOpts := &firehose.DescribeDeliveryStreamInput{
 			DeliveryStreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -1684,7 +1686,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamDestroy(s *terraform.State) error
 }
 
 func testAccCheckFirehoseLambdaFunctionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_function" {
@@ -10,27 +10,28 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesis"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kinesis_stream", &resource.Sweeper{
-		Name: "aws_kinesis_stream",
-		F:    testSweepKinesisStreams,
+	sweep.AddTestSweepers("aws_kinesis_stream", &sweep.Sweeper{
+		Name:    "aws_kinesis_stream",
+		Service: "kinesis",
+		List:    testSweepKinesisStreams,
 	})
 }
 
-func testSweepKinesisStreams(region string) error {
+func testSweepKinesisStreams(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).kinesisconn
+	conn := client.(*AWSClient).kinesisconn()
 	input := &kinesis.ListStreamsInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListStreamsPages(input, func(page *kinesis.ListStreamsOutput, lastPage bool) bool {
 		for _, streamNamePtr := range page.StreamNames {
@@ -38,21 +39,16 @@ func testSweepKinesisStreams(region string) error {
 				continue
 			}
 
-			streamName := aws.StringValue(streamNamePtr)
 			input := &kinesis.DeleteStreamInput{
 				EnforceConsumerDeletion: aws.Bool(false),
 				StreamName:              streamNamePtr,
 			}
 
-			log.Printf("[INFO] Deleting Kinesis Stream: %s", streamName)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(streamNamePtr), func() error {
+				_, err := conn.DeleteStream(input)
 
-			_, err := conn.DeleteStream(input)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Kinesis Stream (%s): %w", streamName, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+				return err
+			}))
 		}
 
 		return !lastPage
@@ -60,14 +56,14 @@ func testSweepKinesisStreams(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Kinesis Stream sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error listing Kinesis Streams: %s", err)
+		return sweepables, fmt.Errorf("Error listing Kinesis Streams: %s", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSKinesisStream_basic(t *testing.T) {
@@ -450,7 +446,7 @@ func testAccCheckKinesisStreamExists(n string, stream *kinesis.StreamDescription
 			return fmt.Errorf("No Kinesis ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn()
 		describeOpts := &kinesis.DescribeStreamInput{
 			StreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -491,7 +487,7 @@ func testAccCheckKinesisStreamDestroy(s *terraform.State) error {
 		if rs.Type != "aws_kinesis_stream" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn()
 		describeOpts := &kinesis.DescribeStreamInput{
 			StreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -511,7 +507,7 @@ func testAccCheckKinesisStreamDestroy(s *terraform.State) error {
 
 func testAccAWSKinesisStreamRegisterStreamConsumer(stream *kinesis.StreamDescription, rStr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn()
 
 		if _, err := conn.RegisterStreamConsumer(&kinesis.RegisterStreamConsumerInput{
 			ConsumerName: aws.String(rStr),
@@ -166,7 +166,7 @@ func TestAccAWSKinesisVideoStream_disappears(t *testing.T) {
 
 func testAccCheckKinesisVideoStreamDisappears(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn()
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -214,7 +214,7 @@ func testAccCheckKinesisVideoStreamExists(n string, stream *kinesisvideo.StreamI
 			return fmt.Errorf("No Kinesis ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn()
 		describeOpts := &kinesisvideo.DescribeStreamInput{
 			StreamARN: aws.String(rs.Primary.ID),
 		}
@@ -234,7 +234,7 @@ func testAccCheckKinesisVideoStreamDestroy(s *terraform.State) error {
 		if rs.Type != "aws_kinesis_video_stream" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn()
 		describeOpts := &kinesisvideo.DescribeStreamInput{
 			StreamARN: aws.String(rs.Primary.ID),
 		}
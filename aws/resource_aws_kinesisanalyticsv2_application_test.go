@@ -14,22 +14,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kinesisanalyticsv2_application", &resource.Sweeper{
-		Name: "aws_kinesisanalyticsv2_application",
-		F:    testSweepKinesisAnalyticsV2Application,
+	sweep.AddTestSweepers("aws_kinesisanalyticsv2_application", &sweep.Sweeper{
+		Name:    "aws_kinesisanalyticsv2_application",
+		Service: "kinesisanalyticsv2",
+		List:    testSweepKinesisAnalyticsV2Application,
 	})
 }
 
-func testSweepKinesisAnalyticsV2Application(region string) error {
+func testSweepKinesisAnalyticsV2Application(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).kinesisanalyticsv2conn
+	conn := client.(*AWSClient).kinesisanalyticsv2conn()
 	input := &kinesisanalyticsv2.ListApplicationsInput{}
+	var sweepables []sweep.Sweepable
 	var sweeperErrs *multierror.Error
 
 	err = lister.ListApplicationsPages(conn, input, func(page *kinesisanalyticsv2.ListApplicationsOutput, isLast bool) bool {
@@ -44,9 +47,7 @@ func testSweepKinesisAnalyticsV2Application(region string) error {
 			application, err := finder.ApplicationByName(conn, name)
 
 			if err != nil {
-				sweeperErr := fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): %w", arn, err))
 				continue
 			}
 
@@ -55,13 +56,8 @@ func testSweepKinesisAnalyticsV2Application(region string) error {
 			d.SetId(arn)
 			d.Set("create_timestamp", aws.TimeValue(application.CreateTimestamp).Format(time.RFC3339))
 			d.Set("name", name)
-			err = r.Delete(d, client)
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !isLast
@@ -69,14 +65,14 @@ func testSweepKinesisAnalyticsV2Application(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Kinesis Analytics v2 Application sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 
 	if err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Kinesis Analytics v2 Applications: %w", err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSKinesisAnalyticsV2Application_basicFlinkApplication(t *testing.T) {
@@ -2687,7 +2683,7 @@ func TestAccAWSKinesisAnalyticsV2Application_SQLApplicationConfiguration_VPCConf
 }
 
 func testAccCheckKinesisAnalyticsV2ApplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
+	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kinesisanalyticsv2_application" {
@@ -2718,7 +2714,7 @@ func testAccCheckKinesisAnalyticsV2ApplicationExists(n string, v *kinesisanalyti
 			return fmt.Errorf("No Kinesis Analytics v2 Application ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn()
 
 		application, err := finder.ApplicationByName(conn, rs.Primary.Attributes["name"])
 		if err != nil {
@@ -2732,7 +2728,7 @@ func testAccCheckKinesisAnalyticsV2ApplicationExists(n string, v *kinesisanalyti
 }
 
 func testAccPreCheckAWSKinesisAnalyticsV2(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
+	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn()
 
 	input := &kinesisanalyticsv2.ListApplicationsInput{}
 
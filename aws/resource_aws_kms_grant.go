@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsKmsGrant() *schema.Resource {
@@ -130,7 +131,7 @@ func resourceAwsKmsGrant() *schema.Resource {
 }
 
 func resourceAwsKmsGrantCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 	keyId := d.Get("key_id").(string)
 
 	input := kms.CreateGrantInput{
@@ -157,37 +158,19 @@ func resourceAwsKmsGrantCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG]: Adding new KMS Grant: %s", input)
 
-	var out *kms.CreateGrantOutput
-
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-		var err error
-		out, err = conn.CreateGrant(&input)
-
-		if err != nil {
-			// Error Codes: https://docs.aws.amazon.com/sdk-for-go/api/service/kms/#KMS.CreateGrant
-			// Under some circumstances a newly created IAM Role doesn't show up and causes
-			// an InvalidArnException to be thrown.
-			if isAWSErr(err, kms.ErrCodeDependencyTimeoutException, "") ||
-				isAWSErr(err, kms.ErrCodeInternalException, "") ||
-				isAWSErr(err, kms.ErrCodeInvalidArnException, "") {
-				return resource.RetryableError(
-					fmt.Errorf("Error adding new KMS Grant for key: %s, retrying %s",
-						*input.KeyId, err))
-			}
-			log.Printf("[ERROR] An error occurred creating new AWS KMS Grant: %s", err)
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-
-	if isResourceTimeoutError(err) {
-		out, err = conn.CreateGrant(&input)
-	}
+	// Error Codes: https://docs.aws.amazon.com/sdk-for-go/api/service/kms/#KMS.CreateGrant
+	// Under some circumstances a newly created IAM Role doesn't show up and causes
+	// an InvalidArnException to be thrown.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(tfresource.PropagationTimeout(kms.ServiceName), func() (interface{}, error) {
+		return conn.CreateGrant(&input)
+	}, kms.ErrCodeDependencyTimeoutException, kms.ErrCodeInternalException, kms.ErrCodeInvalidArnException)
 
 	if err != nil {
 		return fmt.Errorf("Error creating KMS grant: %s", err)
 	}
 
+	out := outputRaw.(*kms.CreateGrantOutput)
+
 	log.Printf("[DEBUG] Created new KMS Grant: %s", *out.GrantId)
 	d.SetId(fmt.Sprintf("%s:%s", keyId, *out.GrantId))
 	d.Set("grant_id", out.GrantId)
@@ -197,7 +180,7 @@ func resourceAwsKmsGrantCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	keyId, grantId, err := decodeKmsGrantId(d.Id())
 	if err != nil {
@@ -208,7 +191,7 @@ func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
 	grant, err := findKmsGrantByIdWithRetry(conn, keyId, grantId)
 
 	if err != nil {
-		if isResourceNotFoundError(err) {
+		if tfresource.NotFound(err) {
 			log.Printf("[WARN] %s KMS grant id not found for key id %s, removing from state file", grantId, keyId)
 			d.SetId("")
 			return nil
@@ -258,7 +241,7 @@ func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsKmsGrantDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 
 	keyId, grantId, decodeErr := decodeKmsGrantId(d.Id())
 	if decodeErr != nil {
@@ -317,26 +300,15 @@ by the list grants call when expected.
 // NB: This function only retries the grant not being returned and some edge cases, while AWS Errors
 // are handled by the findKmsGrantById function
 func findKmsGrantByIdWithRetry(conn *kms.KMS, keyId string, grantId string) (*kms.GrantListEntry, error) {
-	var grant *kms.GrantListEntry
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-		var err error
-		grant, err = findKmsGrantById(conn, keyId, grantId, nil)
-
-		if isResourceNotFoundError(err) {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	outputRaw, err := tfresource.RetryWhenNotFound(tfresource.PropagationTimeout(kms.ServiceName), func() (interface{}, error) {
+		return findKmsGrantById(conn, keyId, grantId, nil)
 	})
-	if isResourceTimeoutError(err) {
-		grant, err = findKmsGrantById(conn, keyId, grantId, nil)
+
+	if err != nil {
+		return nil, err
 	}
 
-	return grant, err
+	return outputRaw.(*kms.GrantListEntry), nil
 }
 
 // Used by the tests as well
@@ -379,33 +351,16 @@ func findKmsGrantById(conn *kms.KMS, keyId string, grantId string, marker *strin
 		Marker: marker,
 	}
 
-	var out *kms.ListGrantsResponse
-	var err error
-	var grant *kms.GrantListEntry
-
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
-		out, err = conn.ListGrants(&input)
-
-		if err != nil {
-			if isAWSErr(err, kms.ErrCodeDependencyTimeoutException, "") ||
-				isAWSErr(err, kms.ErrCodeInternalException, "") ||
-				isAWSErr(err, kms.ErrCodeInvalidArnException, "") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-	if isResourceTimeoutError(err) {
-		out, err = conn.ListGrants(&input)
-	}
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(tfresource.PropagationTimeout(kms.ServiceName), func() (interface{}, error) {
+		return conn.ListGrants(&input)
+	}, kms.ErrCodeDependencyTimeoutException, kms.ErrCodeInternalException, kms.ErrCodeInvalidArnException)
 
 	if err != nil {
 		return nil, fmt.Errorf("error listing KMS Grants: %s", err)
 	}
 
-	grant = getKmsGrantById(out.Grants, grantId)
+	out := outputRaw.(*kms.ListGrantsResponse)
+	grant := getKmsGrantById(out.Grants, grantId)
 	if grant != nil {
 		return grant, nil
 	}
@@ -7,64 +7,76 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	awspolicy "github.com/jen20/awspolicyequivalence"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kms_key", &resource.Sweeper{
-		Name: "aws_kms_key",
-		F:    testSweepKmsKeys,
+	sweep.AddTestSweepers("aws_kms_key", &sweep.Sweeper{
+		Name:    "aws_kms_key",
+		Service: "kms",
+		List:    testSweepKmsKeys,
 	})
 }
 
-func testSweepKmsKeys(region string) error {
+func testSweepKmsKeys(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).kmsconn
+	conn := client.(*AWSClient).kmsconn()
+	var sweepables []sweep.Sweepable
+	var sweeperErrs *multierror.Error
 
 	err = conn.ListKeysPages(&kms.ListKeysInput{Limit: aws.Int64(int64(1000))}, func(out *kms.ListKeysOutput, lastPage bool) bool {
 		for _, k := range out.Keys {
 			kOut, err := conn.DescribeKey(&kms.DescribeKeyInput{
 				KeyId: k.KeyId,
 			})
+
 			if err != nil {
-				log.Printf("Error: Failed to describe key %q: %s", *k.KeyId, err)
-				return false
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing KMS Key (%s): %w", aws.StringValue(k.KeyId), err))
+				continue
 			}
-			if *kOut.KeyMetadata.KeyManager == kms.KeyManagerTypeAws {
+
+			if aws.StringValue(kOut.KeyMetadata.KeyManager) == kms.KeyManagerTypeAws {
 				// Skip (default) keys which are managed by AWS
 				continue
 			}
-			if *kOut.KeyMetadata.KeyState == kms.KeyStatePendingDeletion {
+
+			if aws.StringValue(kOut.KeyMetadata.KeyState) == kms.KeyStatePendingDeletion {
 				// Skip keys which are already scheduled for deletion
 				continue
 			}
 
-			_, err = conn.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{
+			input := &kms.ScheduleKeyDeletionInput{
 				KeyId:               k.KeyId,
 				PendingWindowInDays: aws.Int64(int64(7)),
-			})
-			if err != nil {
-				log.Printf("Error: Failed to schedule key %q for deletion: %s", *k.KeyId, err)
-				return false
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(k.KeyId), func() error {
+				_, err := conn.ScheduleKeyDeletion(input)
+
+				return err
+			}).WithName(aws.StringValue(kOut.KeyMetadata.Description)))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping KMS Key sweep for %s: %s", region, err)
-			return nil
+			return sweepables, sweeperErrs.ErrorOrNil()
 		}
-		return fmt.Errorf("Error describing KMS keys: %s", err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error describing KMS keys: %s", err))
 	}
 
-	return nil
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSKmsKey_basic(t *testing.T) {
@@ -318,7 +330,7 @@ func testAccCheckAWSKmsKeyHasPolicy(name string, expectedPolicyText string) reso
 			return fmt.Errorf("No KMS Key ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn()
 
 		out, err := conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
 			KeyId:      aws.String(rs.Primary.ID),
@@ -344,7 +356,7 @@ func testAccCheckAWSKmsKeyHasPolicy(name string, expectedPolicyText string) reso
 }
 
 func testAccCheckAWSKmsKeyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kms_key" {
@@ -380,7 +392,7 @@ func testAccCheckAWSKmsKeyExists(name string, key *kms.KeyMetadata) resource.Tes
 			return fmt.Errorf("No KMS Key ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn()
 
 		o, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
 			return conn.DescribeKey(&kms.DescribeKeyInput{
@@ -411,7 +423,7 @@ func testAccCheckAWSKmsKeyIsEnabled(key *kms.KeyMetadata, isEnabled bool) resour
 
 func testAccCheckAWSKmsKeyDisappears(key *kms.KeyMetadata) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn()
 
 		_, err := conn.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{
 			KeyId:               key.KeyId,
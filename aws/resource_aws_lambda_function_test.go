@@ -17,48 +17,49 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
-		Name: "aws_lambda_function",
-		F:    testSweepLambdaFunctions,
+	sweep.AddTestSweepers("aws_lambda_function", &sweep.Sweeper{
+		Name:    "aws_lambda_function",
+		Service: "lambda",
+		List:    testSweepLambdaFunctions,
 	})
 }
 
-func testSweepLambdaFunctions(region string) error {
+func testSweepLambdaFunctions(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
 
-	lambdaconn := client.(*AWSClient).lambdaconn
+	lambdaconn := client.(*AWSClient).lambdaconn()
 
 	resp, err := lambdaconn.ListFunctions(&lambda.ListFunctionsInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Lambda Function sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving Lambda functions: %s", err)
+		return nil, fmt.Errorf("Error retrieving Lambda functions: %s", err)
 	}
 
-	if len(resp.Functions) == 0 {
-		log.Print("[DEBUG] No aws lambda functions to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, f := range resp.Functions {
-		_, err := lambdaconn.DeleteFunction(
-			&lambda.DeleteFunctionInput{
-				FunctionName: f.FunctionName,
-			})
-		if err != nil {
-			return err
+		input := &lambda.DeleteFunctionInput{
+			FunctionName: f.FunctionName,
 		}
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(f.FunctionName), func() error {
+			_, err := lambdaconn.DeleteFunction(input)
+
+			return err
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSLambdaFunction_basic(t *testing.T) {
@@ -1737,7 +1738,7 @@ func TestAccAWSLambdaFunction_runtimes(t *testing.T) {
 }
 
 func testAccCheckLambdaFunctionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_function" {
@@ -1760,7 +1761,7 @@ func testAccCheckLambdaFunctionDestroy(s *terraform.State) error {
 
 func testAccCheckAwsLambdaFunctionDisappears(function *lambda.GetFunctionOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 		input := &lambda.DeleteFunctionInput{
 			FunctionName: function.Configuration.FunctionName,
@@ -1784,7 +1785,7 @@ func testAccCheckAwsLambdaFunctionExists(res, funcName string, function *lambda.
 			return fmt.Errorf("Lambda function ID not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 		params := &lambda.GetFunctionInput{
 			FunctionName: aws.String(funcName),
@@ -1811,7 +1812,7 @@ func testAccCheckAwsLambdaFunctionInvokeArn(name string, function *lambda.GetFun
 func testAccAwsInvokeLambdaFunction(function *lambda.GetFunctionOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		f := function.Configuration
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 		// If the function is VPC-enabled this will create ENI automatically
 		_, err := conn.Invoke(&lambda.InvokeInput{
@@ -11,56 +11,58 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
-		Name: "aws_lambda_layer",
-		F:    testSweepLambdaLayerVersions,
+	sweep.AddTestSweepers("aws_lambda_layer", &sweep.Sweeper{
+		Name:    "aws_lambda_layer",
+		Service: "lambda",
+		List:    testSweepLambdaLayerVersions,
 	})
 }
 
-func testSweepLambdaLayerVersions(region string) error {
+func testSweepLambdaLayerVersions(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
 
-	lambdaconn := client.(*AWSClient).lambdaconn
+	lambdaconn := client.(*AWSClient).lambdaconn()
 	resp, err := lambdaconn.ListLayers(&lambda.ListLayersInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Lambda Layer sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving Lambda layers: %s", err)
+		return nil, fmt.Errorf("Error retrieving Lambda layers: %s", err)
 	}
 
-	if len(resp.Layers) == 0 {
-		log.Print("[DEBUG] No aws lambda layers to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, l := range resp.Layers {
 		versionResp, err := lambdaconn.ListLayerVersions(&lambda.ListLayerVersionsInput{
 			LayerName: l.LayerName,
 		})
 		if err != nil {
-			return fmt.Errorf("Error retrieving versions for lambda layer: %s", err)
+			return sweepables, fmt.Errorf("Error retrieving versions for lambda layer: %s", err)
 		}
 
 		for _, v := range versionResp.LayerVersions {
-			_, err := lambdaconn.DeleteLayerVersion(&lambda.DeleteLayerVersionInput{
+			input := &lambda.DeleteLayerVersionInput{
 				LayerName:     l.LayerName,
 				VersionNumber: v.Version,
-			})
-			if err != nil {
-				return err
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(v.LayerVersionArn), func() error {
+				_, err := lambdaconn.DeleteLayerVersion(input)
+
+				return err
+			}).WithName(aws.StringValue(l.LayerName)))
 		}
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSLambdaLayerVersion_basic(t *testing.T) {
@@ -240,7 +242,7 @@ func TestAccAWSLambdaLayerVersion_licenseInfo(t *testing.T) {
 }
 
 func testAccCheckLambdaLayerVersionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_layer_version" {
@@ -289,7 +291,7 @@ func testAccCheckAwsLambdaLayerVersionExists(res, layerName string) resource.Tes
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 		_, err = conn.GetLayerVersion(&lambda.GetLayerVersionInput{
 			LayerName:     aws.String(layerName),
 			VersionNumber: aws.Int64(int64(version)),
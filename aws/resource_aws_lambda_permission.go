@@ -89,7 +89,7 @@ func resourceAwsLambdaPermission() *schema.Resource {
 }
 
 func resourceAwsLambdaPermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	functionName := d.Get("function_name").(string)
 
@@ -129,28 +129,16 @@ func resourceAwsLambdaPermissionCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	log.Printf("[DEBUG] Adding new Lambda permission: %s", input)
-	var out *lambda.AddPermissionOutput
 	// Retry for IAM and Lambda eventual consistency
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		out, err = conn.AddPermission(&input)
-
-		if isAWSErr(err, lambda.ErrCodeResourceConflictException, "") || isAWSErr(err, lambda.ErrCodeResourceNotFoundException, "") {
-			return resource.RetryableError(err)
-		}
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-	if isResourceTimeoutError(err) {
-		out, err = conn.AddPermission(&input)
-	}
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(tfresource.PropagationTimeout(lambda.ServiceName), func() (interface{}, error) {
+		return conn.AddPermission(&input)
+	}, lambda.ErrCodeResourceConflictException, lambda.ErrCodeResourceNotFoundException)
 	if err != nil {
 		return fmt.Errorf("Error adding new Lambda Permission for %s: %s", functionName, err)
 	}
 
+	out := outputRaw.(*lambda.AddPermissionOutput)
+
 	if out != nil && out.Statement != nil {
 		log.Printf("[DEBUG] Created new Lambda permission: %s", *out.Statement)
 	} else {
@@ -189,7 +177,7 @@ func resourceAwsLambdaPermissionCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsLambdaPermissionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	input := lambda.GetPolicyInput{
 		FunctionName: aws.String(d.Get("function_name").(string)),
@@ -308,7 +296,7 @@ func resourceAwsLambdaPermissionRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLambdaPermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	functionName := d.Get("function_name").(string)
 
@@ -441,7 +429,7 @@ func resourceAwsLambdaPermissionImport(d *schema.ResourceData, meta interface{})
 	statementId := idParts[1]
 	log.Printf("[DEBUG] Importing Lambda Permission %s for function name %s", statementId, functionName)
 
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 	getFunctionOutput, err := conn.GetFunction(input)
 	if err != nil {
 		return nil, err
@@ -13,54 +13,54 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_launch_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_launch_configuration", &sweep.Sweeper{
 		Name:         "aws_launch_configuration",
+		Service:      "autoscaling",
 		Dependencies: []string{"aws_autoscaling_group"},
-		F:            testSweepLaunchConfigurations,
+		List:         testSweepLaunchConfigurations,
 	})
 }
 
-func testSweepLaunchConfigurations(region string) error {
+func testSweepLaunchConfigurations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	autoscalingconn := client.(*AWSClient).autoscalingconn
+	autoscalingconn := client.(*AWSClient).autoscalingconn()
 
 	resp, err := autoscalingconn.DescribeLaunchConfigurations(&autoscaling.DescribeLaunchConfigurationsInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping AutoScaling Launch Configuration sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving launch configuration: %s", err)
+		return nil, fmt.Errorf("Error retrieving launch configuration: %s", err)
 	}
 
-	if len(resp.LaunchConfigurations) == 0 {
-		log.Print("[DEBUG] No aws launch configurations to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, lc := range resp.LaunchConfigurations {
-		name := *lc.LaunchConfigurationName
+		name := aws.StringValue(lc.LaunchConfigurationName)
+
+		sweepables = append(sweepables, sweep.NewResource(name, func() error {
+			_, err := autoscalingconn.DeleteLaunchConfiguration(
+				&autoscaling.DeleteLaunchConfigurationInput{
+					LaunchConfigurationName: aws.String(name),
+				})
 
-		log.Printf("[INFO] Deleting Launch Configuration: %s", name)
-		_, err := autoscalingconn.DeleteLaunchConfiguration(
-			&autoscaling.DeleteLaunchConfigurationInput{
-				LaunchConfigurationName: aws.String(name),
-			})
-		if err != nil {
 			if isAWSErr(err, "InvalidConfiguration.NotFound", "") || isAWSErr(err, "ValidationError", "") {
 				return nil
 			}
+
 			return err
-		}
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSLaunchConfiguration_basic(t *testing.T) {
@@ -526,7 +526,7 @@ func testAccCheckAWSLaunchConfigurationGeneratedNamePrefix(
 }
 
 func testAccCheckAWSLaunchConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_launch_configuration" {
@@ -605,7 +605,7 @@ func testAccCheckAWSLaunchConfigurationExists(n string, res *autoscaling.LaunchC
 			return fmt.Errorf("No Launch Configuration ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 
 		describeOpts := autoscaling.DescribeLaunchConfigurationsInput{
 			LaunchConfigurationNames: []*string{aws.String(rs.Primary.ID)},
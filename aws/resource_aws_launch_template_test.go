@@ -9,54 +9,49 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
-		Name: "aws_launch_template",
+	sweep.AddTestSweepers("aws_launch_template", &sweep.Sweeper{
+		Name:    "aws_launch_template",
+		Service: "ec2",
 		Dependencies: []string{
 			"aws_autoscaling_group",
 			"aws_batch_compute_environment",
 		},
-		F: testSweepLaunchTemplates,
+		List: testSweepLaunchTemplates,
 	})
 }
 
-func testSweepLaunchTemplates(region string) error {
+func testSweepLaunchTemplates(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 	input := &ec2.DescribeLaunchTemplatesInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeLaunchTemplatesPages(input, func(page *ec2.DescribeLaunchTemplatesOutput, lastPage bool) bool {
 		for _, launchTemplate := range page.LaunchTemplates {
-			id := aws.StringValue(launchTemplate.LaunchTemplateId)
 			input := &ec2.DeleteLaunchTemplateInput{
 				LaunchTemplateId: launchTemplate.LaunchTemplateId,
 			}
 
-			log.Printf("[INFO] Deleting EC2 Launch Template: %s", id)
-			_, err := conn.DeleteLaunchTemplate(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(launchTemplate.LaunchTemplateId), func() error {
+				_, err := conn.DeleteLaunchTemplate(input)
 
-			if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") {
-				continue
-			}
+				if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") {
+					return nil
+				}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting EC2 Launch Template (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return err
+			}).WithName(aws.StringValue(launchTemplate.LaunchTemplateName)).WithTags(keyvaluetags.Ec2KeyValueTags(launchTemplate.Tags).IgnoreAws().Map()))
 		}
 
 		return !lastPage
@@ -64,14 +59,14 @@ func testSweepLaunchTemplates(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 Launch Template sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error describing EC2 Launch Templates: %w", err)
+		return sweepables, fmt.Errorf("Error describing EC2 Launch Templates: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSLaunchTemplate_basic(t *testing.T) {
@@ -1232,7 +1227,7 @@ func testAccCheckAWSLaunchTemplateExists(n string, t *ec2.LaunchTemplate) resour
 			return fmt.Errorf("No Launch Template ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
 			LaunchTemplateIds: []*string{aws.String(rs.Primary.ID)},
@@ -1252,7 +1247,7 @@ func testAccCheckAWSLaunchTemplateExists(n string, t *ec2.LaunchTemplate) resour
 }
 
 func testAccCheckAWSLaunchTemplateDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_launch_template" {
@@ -1281,7 +1276,7 @@ func testAccCheckAWSLaunchTemplateDestroy(s *terraform.State) error {
 
 func testAccCheckAWSLaunchTemplateDisappears(launchTemplate *ec2.LaunchTemplate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		input := &ec2.DeleteLaunchTemplateInput{
 			LaunchTemplateId: launchTemplate.LaunchTemplateId,
@@ -12,52 +12,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
-		Name: "aws_lb_target_group",
-		F:    testSweepLBTargetGroups,
+	sweep.AddTestSweepers("aws_lb_target_group", &sweep.Sweeper{
+		Name:    "aws_lb_target_group",
+		Service: "elbv2",
+		List:    testSweepLBTargetGroups,
 		Dependencies: []string{
 			"aws_lb",
 		},
 	})
 }
 
-func testSweepLBTargetGroups(region string) error {
+func testSweepLBTargetGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).elbv2conn
+	conn := client.(*AWSClient).elbv2conn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeTargetGroupsPages(&elbv2.DescribeTargetGroupsInput{}, func(page *elbv2.DescribeTargetGroupsOutput, isLast bool) bool {
-		if page == nil || len(page.TargetGroups) == 0 {
-			log.Print("[DEBUG] No LB Target Groups to sweep")
+		if page == nil {
 			return false
 		}
 
 		for _, targetGroup := range page.TargetGroups {
-			name := aws.StringValue(targetGroup.TargetGroupName)
-
-			log.Printf("[INFO] Deleting LB Target Group: %s", name)
-			_, err := conn.DeleteTargetGroup(&elbv2.DeleteTargetGroupInput{
+			input := &elbv2.DeleteTargetGroupInput{
 				TargetGroupArn: targetGroup.TargetGroupArn,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete LB Target Group (%s): %s", name, err)
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(targetGroup.TargetGroupArn), func() error {
+				_, err := conn.DeleteTargetGroup(input)
+
+				return err
+			}).WithName(aws.StringValue(targetGroup.TargetGroupName)))
 		}
+
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping LB Target Group sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving LB Target Groups: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving LB Target Groups: %s", err)
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestLBTargetGroupCloudwatchSuffixFromARN(t *testing.T) {
@@ -1314,7 +1319,7 @@ func testAccCheckAWSLBTargetGroupExists(n string, res *elbv2.TargetGroup) resour
 			return errors.New("No Target Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 		describe, err := conn.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
 			TargetGroupArns: []*string{aws.String(rs.Primary.ID)},
@@ -1425,7 +1430,7 @@ func testAccCheckAWSLBTargetGroupUnhealthyThreshold(res *elbv2.TargetGroup, expe
 }
 
 func testAccCheckAWSLBTargetGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+	conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lb_target_group" && rs.Type != "aws_alb_target_group" {
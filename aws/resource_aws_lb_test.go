@@ -12,12 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lb", &resource.Sweeper{
-		Name: "aws_lb",
-		F:    testSweepLBs,
+	sweep.AddTestSweepers("aws_lb", &sweep.Sweeper{
+		Name:    "aws_lb",
+		Service: "elbv2",
+		List:    testSweepLBs,
 		Dependencies: []string{
 			"aws_api_gateway_vpc_link",
 			"aws_vpc_endpoint_service",
@@ -25,40 +27,43 @@ func init() {
 	})
 }
 
-func testSweepLBs(region string) error {
+func testSweepLBs(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).elbv2conn
+	conn := client.(*AWSClient).elbv2conn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, func(page *elbv2.DescribeLoadBalancersOutput, isLast bool) bool {
-		if page == nil || len(page.LoadBalancers) == 0 {
-			log.Print("[DEBUG] No LBs to sweep")
+		if page == nil {
 			return false
 		}
 
 		for _, loadBalancer := range page.LoadBalancers {
-			name := aws.StringValue(loadBalancer.LoadBalancerName)
-
-			log.Printf("[INFO] Deleting LB: %s", name)
-			_, err := conn.DeleteLoadBalancer(&elbv2.DeleteLoadBalancerInput{
+			input := &elbv2.DeleteLoadBalancerInput{
 				LoadBalancerArn: loadBalancer.LoadBalancerArn,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete LB (%s): %s", name, err)
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(loadBalancer.LoadBalancerArn), func() error {
+				_, err := conn.DeleteLoadBalancer(input)
+
+				return err
+			}).WithName(aws.StringValue(loadBalancer.LoadBalancerName)))
 		}
+
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping LB sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving LBs: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving LBs: %s", err)
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestLBCloudwatchSuffixFromARN(t *testing.T) {
@@ -1156,7 +1161,7 @@ func testAccCheckAWSLBExists(n string, res *elbv2.LoadBalancer) resource.TestChe
 			return errors.New("No LB ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 		describe, err := conn.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
 			LoadBalancerArns: []*string{aws.String(rs.Primary.ID)},
@@ -1187,7 +1192,7 @@ func testAccCheckAWSLBAttribute(n, key, value string) resource.TestCheckFunc {
 			return errors.New("No LB ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 		attributesResp, err := conn.DescribeLoadBalancerAttributes(&elbv2.DescribeLoadBalancerAttributesInput{
 			LoadBalancerArn: aws.String(rs.Primary.ID),
 		})
@@ -1208,7 +1213,7 @@ func testAccCheckAWSLBAttribute(n, key, value string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSLBDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+	conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lb" && rs.Type != "aws_alb" {
@@ -1238,7 +1243,7 @@ func testAccCheckAWSLBDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckElbv2GatewayLoadBalancer(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+	conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 	input := &elbv2.DescribeAccountLimitsInput{}
 
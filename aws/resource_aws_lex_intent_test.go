@@ -513,7 +513,7 @@ func TestAccAwsLexIntent_updateWithExternalChange(t *testing.T) {
 
 	testAccCheckAwsLexIntentUpdateDescription := func(provider *schema.Provider, _ *schema.Resource, resourceName string) resource.TestCheckFunc {
 		return func(s *terraform.State) error {
-			conn := provider.Meta().(*AWSClient).lexmodelconn
+			conn := provider.Meta().(*AWSClient).lexmodelconn()
 
 			resourceState, ok := s.RootModule().Resources[resourceName]
 			if !ok {
@@ -583,7 +583,7 @@ func testAccCheckAwsLexIntentExistsWithVersion(rName, intentVersion string, outp
 		}
 
 		var err error
-		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
 
 		output, err = conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
 			Name:    aws.String(rs.Primary.ID),
@@ -606,7 +606,7 @@ func testAccCheckAwsLexIntentExists(rName string, output *lexmodelbuildingservic
 
 func testAccCheckAwsLexIntentNotExists(intentName, intentVersion string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
 
 		_, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
 			Name:    aws.String(intentName),
@@ -624,7 +624,7 @@ func testAccCheckAwsLexIntentNotExists(intentName, intentVersion string) resourc
 }
 
 func testAccCheckAwsLexIntentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lex_intent" {
@@ -10,54 +10,49 @@ import (
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_licensemanager_license_configuration", &resource.Sweeper{
-		Name: "aws_licensemanager_license_configuration",
-		F:    testSweepLicenseManagerLicenseConfigurations,
+	sweep.AddTestSweepers("aws_licensemanager_license_configuration", &sweep.Sweeper{
+		Name:    "aws_licensemanager_license_configuration",
+		Service: "licensemanager",
+		List:    testSweepLicenseManagerLicenseConfigurations,
 	})
 }
 
-func testSweepLicenseManagerLicenseConfigurations(region string) error {
+func testSweepLicenseManagerLicenseConfigurations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).licensemanagerconn
+	conn := client.(*AWSClient).licensemanagerconn()
 
 	resp, err := conn.ListLicenseConfigurations(&licensemanager.ListLicenseConfigurationsInput{})
 
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping License Manager License Configuration sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving License Manager license configurations: %s", err)
+		return nil, fmt.Errorf("Error retrieving License Manager license configurations: %s", err)
 	}
 
-	if len(resp.LicenseConfigurations) == 0 {
-		log.Print("[DEBUG] No License Manager license configurations to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, lc := range resp.LicenseConfigurations {
-		id := aws.StringValue(lc.LicenseConfigurationArn)
-
-		log.Printf("[INFO] Deleting License Manager license configuration: %s", id)
-
 		opts := &licensemanager.DeleteLicenseConfigurationInput{
-			LicenseConfigurationArn: aws.String(id),
+			LicenseConfigurationArn: lc.LicenseConfigurationArn,
 		}
 
-		_, err := conn.DeleteLicenseConfiguration(opts)
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(lc.LicenseConfigurationArn), func() error {
+			_, err := conn.DeleteLicenseConfiguration(opts)
 
-		if err != nil {
-			log.Printf("[ERROR] Error deleting License Manager license configuration (%s): %s", id, err)
-		}
+			return err
+		}).WithName(aws.StringValue(lc.Name)))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSLicenseManagerLicenseConfiguration_basic(t *testing.T) {
@@ -143,7 +138,7 @@ func testAccCheckLicenseManagerLicenseConfigurationExists(resourceName string, l
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).licensemanagerconn
+		conn := testAccProvider.Meta().(*AWSClient).licensemanagerconn()
 		resp, err := conn.ListLicenseConfigurations(&licensemanager.ListLicenseConfigurationsInput{
 			LicenseConfigurationArns: [](*string){aws.String(rs.Primary.ID)},
 		})
@@ -162,7 +157,7 @@ func testAccCheckLicenseManagerLicenseConfigurationExists(resourceName string, l
 }
 
 func testAccCheckLicenseManagerLicenseConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).licensemanagerconn
+	conn := testAccProvider.Meta().(*AWSClient).licensemanagerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_licensemanager_license_configuration" {
@@ -36,9 +36,16 @@ func resourceAwsLightsailKeyPair() *schema.Resource {
 
 			// optional fields
 			"pgp_key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"kms_key_id"},
+			},
+			"kms_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"pgp_key"},
 			},
 
 			// additional info returned from the API
@@ -63,7 +70,7 @@ func resourceAwsLightsailKeyPair() *schema.Resource {
 				Computed: true,
 			},
 
-			// encrypted fields if pgp_key is given
+			// encrypted fields if pgp_key or kms_key_id is given
 			"encrypted_fingerprint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -72,12 +79,16 @@ func resourceAwsLightsailKeyPair() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func resourceAwsLightsailKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 
 	var kName string
 	if v, ok := d.GetOk("name"); ok {
@@ -112,22 +123,17 @@ func resourceAwsLightsailKeyPairCreate(d *schema.ResourceData, meta interface{})
 
 		// private_key and public_key are only available in the response from
 		// CreateKey pair. Here we set the public_key, and encrypt the private_key
-		// if a pgp_key is given, else we store the private_key in state
+		// if a pgp_key or kms_key_id is given, else we store the private_key in state
 		d.Set("public_key", resp.PublicKeyBase64)
 
-		// encrypt private key if pgp_key is given
-		pgpKey, err := encryption.RetrieveGPGKey(d.Get("pgp_key").(string))
+		encryptedPrivateKey, err := encryption.EncryptSecret(meta.(*AWSClient).kmsconn(), d.Get("pgp_key").(string), d.Get("kms_key_id").(string), *resp.PrivateKeyBase64, "Lightsail Private Key")
 		if err != nil {
 			return err
 		}
-		if pgpKey != "" {
-			fingerprint, encrypted, err := encryption.EncryptValue(pgpKey, *resp.PrivateKeyBase64, "Lightsail Private Key")
-			if err != nil {
-				return err
-			}
-
-			d.Set("encrypted_fingerprint", fingerprint)
-			d.Set("encrypted_private_key", encrypted)
+		if encryptedPrivateKey != nil {
+			d.Set("encrypted_fingerprint", encryptedPrivateKey.KeyFingerprint)
+			d.Set("kms_key_arn", encryptedPrivateKey.KmsKeyArn)
+			d.Set("encrypted_private_key", encryptedPrivateKey.Value)
 		} else {
 			d.Set("private_key", resp.PrivateKeyBase64)
 		}
@@ -168,7 +174,7 @@ func resourceAwsLightsailKeyPairCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsLightsailKeyPairRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 
 	resp, err := conn.GetKeyPair(&lightsail.GetKeyPairInput{
 		KeyPairName: aws.String(d.Id()),
@@ -195,7 +201,7 @@ func resourceAwsLightsailKeyPairRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLightsailKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 	resp, err := conn.DeleteKeyPair(&lightsail.DeleteKeyPairInput{
 		KeyPairName: aws.String(d.Id()),
 	})
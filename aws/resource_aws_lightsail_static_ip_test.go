@@ -12,58 +12,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
-		Name: "aws_lightsail_static_ip",
-		F:    testSweepLightsailStaticIps,
+	sweep.AddTestSweepers("aws_lightsail_static_ip", &sweep.Sweeper{
+		Name:    "aws_lightsail_static_ip",
+		Service: "lightsail",
+		List:    testSweepLightsailStaticIps,
 	})
 }
 
-func testSweepLightsailStaticIps(region string) error {
+func testSweepLightsailStaticIps(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %s", err)
+		return nil, fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).lightsailconn
+	conn := client.(*AWSClient).lightsailconn()
 
 	input := &lightsail.GetStaticIpsInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.GetStaticIps(input)
 		if err != nil {
 			if testSweepSkipSweepError(err) {
 				log.Printf("[WARN] Skipping Lightsail Static IP sweep for %s: %s", region, err)
-				return nil
+				return sweepables, nil
 			}
-			return fmt.Errorf("Error retrieving Lightsail Static IPs: %s", err)
-		}
-
-		if len(output.StaticIps) == 0 {
-			log.Print("[DEBUG] No Lightsail Static IPs to sweep")
-			return nil
+			return sweepables, fmt.Errorf("Error retrieving Lightsail Static IPs: %s", err)
 		}
 
 		for _, staticIp := range output.StaticIps {
 			name := aws.StringValue(staticIp.Name)
 
-			log.Printf("[INFO] Deleting Lightsail Static IP %s", name)
-			_, err := conn.ReleaseStaticIp(&lightsail.ReleaseStaticIpInput{
-				StaticIpName: aws.String(name),
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Lightsail Static IP %s: %s", name, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.ReleaseStaticIp(&lightsail.ReleaseStaticIpInput{
+					StaticIpName: aws.String(name),
+				})
+
+				return err
+			}))
 		}
 
 		if output.NextPageToken == nil {
 			break
 		}
+
 		input.PageToken = output.NextPageToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSLightsailStaticIp_basic(t *testing.T) {
@@ -90,7 +89,7 @@ func TestAccAWSLightsailStaticIp_disappears(t *testing.T) {
 	staticIpName := fmt.Sprintf("tf-test-lightsail-%s", acctest.RandString(5))
 
 	staticIpDestroy := func(*terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 		_, err := conn.ReleaseStaticIp(&lightsail.ReleaseStaticIpInput{
 			StaticIpName: aws.String(staticIpName),
 		})
@@ -130,7 +129,7 @@ func testAccCheckAWSLightsailStaticIpExists(n string, staticIp *lightsail.Static
 			return errors.New("No Lightsail Static IP ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 
 		resp, err := conn.GetStaticIp(&lightsail.GetStaticIpInput{
 			StaticIpName: aws.String(rs.Primary.ID),
@@ -154,7 +153,7 @@ func testAccCheckAWSLightsailStaticIpDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 
 		resp, err := conn.GetStaticIp(&lightsail.GetStaticIpInput{
 			StaticIpName: aws.String(rs.Primary.ID),
@@ -62,7 +62,7 @@ func resourceAwsMacieS3BucketAssociation() *schema.Resource {
 }
 
 func resourceAwsMacieS3BucketAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).macieconn
+	conn := meta.(*AWSClient).macieconn()
 
 	req := &macie.AssociateS3ResourcesInput{
 		S3Resources: []*macie.S3ResourceClassification{
@@ -93,7 +93,7 @@ func resourceAwsMacieS3BucketAssociationCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsMacieS3BucketAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).macieconn
+	conn := meta.(*AWSClient).macieconn()
 
 	req := &macie.ListS3ResourcesInput{}
 	if v, ok := d.GetOk("member_account_id"); ok {
@@ -132,7 +132,7 @@ func resourceAwsMacieS3BucketAssociationRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsMacieS3BucketAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).macieconn
+	conn := meta.(*AWSClient).macieconn()
 
 	if d.HasChange("classification_type") {
 		req := &macie.UpdateS3ResourcesInput{
@@ -164,7 +164,7 @@ func resourceAwsMacieS3BucketAssociationUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsMacieS3BucketAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).macieconn
+	conn := meta.(*AWSClient).macieconn()
 
 	log.Printf("[DEBUG] Deleting Macie S3 bucket association: %s", d.Id())
 
@@ -118,7 +118,7 @@ func TestAccAWSMediaPackageChannel_tags(t *testing.T) {
 }
 
 func testAccCheckAwsMediaPackageChannelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mediapackageconn
+	conn := testAccProvider.Meta().(*AWSClient).mediapackageconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_media_package_channel" {
@@ -149,7 +149,7 @@ func testAccCheckAwsMediaPackageChannelExists(name string) resource.TestCheckFun
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).mediapackageconn
+		conn := testAccProvider.Meta().(*AWSClient).mediapackageconn()
 
 		input := &mediapackage.DescribeChannelInput{
 			Id: aws.String(rs.Primary.ID),
@@ -162,7 +162,7 @@ func testAccCheckAwsMediaPackageChannelExists(name string) resource.TestCheckFun
 }
 
 func testAccPreCheckAWSMediaPackage(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).mediapackageconn
+	conn := testAccProvider.Meta().(*AWSClient).mediapackageconn()
 
 	input := &mediapackage.ListChannelsInput{}
 
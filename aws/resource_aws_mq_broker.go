@@ -27,6 +27,8 @@ func resourceAwsMqBroker() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"apply_immediately": {
 				Type:     schema.TypeBool,
@@ -252,13 +254,16 @@ func resourceAwsMqBroker() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 		},
 	}
 }
 
 func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).mqconn()
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	name := d.Get("broker_name").(string)
 	requestId := resource.PrefixedUniqueId(fmt.Sprintf("tf-%s", name))
@@ -288,8 +293,8 @@ func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("subnet_ids"); ok {
 		input.SubnetIds = expandStringSet(v.(*schema.Set))
 	}
-	if v, ok := d.GetOk("tags"); ok {
-		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().MqTags()
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().MqTags()
 	}
 
 	log.Printf("[INFO] Creating MQ Broker: %s", input)
@@ -328,7 +333,34 @@ func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+
+	tags, err := mqBrokerRead(d, meta)
+
+	if err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+// mqBrokerRead reads the MQ Broker into the given ResourceData, returning its
+// tags so that the resource and data source can each set them accordingly.
+func mqBrokerRead(d *schema.ResourceData, meta interface{}) (keyvaluetags.KeyValueTags, error) {
+	conn := meta.(*AWSClient).mqconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	log.Printf("[INFO] Reading MQ Broker: %s", d.Id())
@@ -339,15 +371,15 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 		if isAWSErr(err, mq.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] MQ Broker %q not found, removing from state", d.Id())
 			d.SetId("")
-			return nil
+			return nil, nil
 		}
 		// API docs say a 404 can also return a 403
 		if isAWSErr(err, mq.ErrCodeForbiddenException, "Forbidden") {
 			log.Printf("[WARN] MQ Broker %q not found, removing from state", d.Id())
 			d.SetId("")
-			return nil
+			return nil, nil
 		}
-		return err
+		return nil, err
 	}
 
 	d.Set("auto_minor_version_upgrade", out.AutoMinorVersionUpgrade)
@@ -357,7 +389,7 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("deployment_mode", out.DeploymentMode)
 
 	if err := d.Set("encryption_options", flattenMqEncryptionOptions(out.EncryptionOptions)); err != nil {
-		return fmt.Errorf("error setting encryption_options: %s", err)
+		return nil, fmt.Errorf("error setting encryption_options: %s", err)
 	}
 
 	d.Set("engine_type", out.EngineType)
@@ -366,18 +398,18 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("publicly_accessible", out.PubliclyAccessible)
 	err = d.Set("maintenance_window_start_time", flattenMqWeeklyStartTime(out.MaintenanceWindowStartTime))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	d.Set("security_groups", aws.StringValueSlice(out.SecurityGroups))
 	d.Set("subnet_ids", aws.StringValueSlice(out.SubnetIds))
 
 	if err := d.Set("logs", flattenMqLogs(out.Logs)); err != nil {
-		return fmt.Errorf("error setting logs: %s", err)
+		return nil, fmt.Errorf("error setting logs: %s", err)
 	}
 
 	err = d.Set("configuration", flattenMqConfigurationId(out.Configurations.Current))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	rawUsers := make([]*mq.User, len(out.Users))
@@ -387,7 +419,7 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 			Username: u.Username,
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		rawUsers[i] = &mq.User{
@@ -399,18 +431,14 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 
 	users := flattenMqUsers(rawUsers, d.Get("user").(*schema.Set).List())
 	if err = d.Set("user", users); err != nil {
-		return err
-	}
-
-	if err := d.Set("tags", keyvaluetags.MqKeyValueTags(out.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %s", err)
+		return nil, err
 	}
 
-	return nil
+	return keyvaluetags.MqKeyValueTags(out.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig), nil
 }
 
 func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).mqconn()
 
 	requiresReboot := false
 
@@ -485,8 +513,8 @@ func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.MqUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating MQ Broker (%s) tags: %s", d.Get("arn").(string), err)
@@ -497,7 +525,7 @@ func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsMqBrokerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mqconn
+	conn := meta.(*AWSClient).mqconn()
 
 	log.Printf("[INFO] Deleting MQ Broker: %s", d.Id())
 	_, err := conn.DeleteBroker(&mq.DeleteBrokerInput{
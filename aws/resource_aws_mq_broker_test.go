@@ -13,12 +13,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
-		Name: "aws_mq_broker",
-		F:    testSweepMqBrokers,
+	sweep.AddTestSweepers("aws_mq_broker", &sweep.Sweeper{
+		Name:    "aws_mq_broker",
+		Service: "mq",
+		List:    testSweepMqBrokers,
 	})
 }
 
@@ -70,12 +72,12 @@ func TestResourceAWSMqBrokerPasswordValidation(t *testing.T) {
 	}
 }
 
-func testSweepMqBrokers(region string) error {
+func testSweepMqBrokers(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).mqconn
+	conn := client.(*AWSClient).mqconn()
 
 	resp, err := conn.ListBrokers(&mq.ListBrokersInput{
 		MaxResults: aws.Int64(100),
@@ -83,32 +85,34 @@ func testSweepMqBrokers(region string) error {
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping MQ Broker sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error listing MQ brokers: %s", err)
+		return nil, fmt.Errorf("Error listing MQ brokers: %s", err)
 	}
 
-	if len(resp.BrokerSummaries) == 0 {
-		log.Print("[DEBUG] No MQ brokers found to sweep")
-		return nil
-	}
-	log.Printf("[DEBUG] %d MQ brokers found", len(resp.BrokerSummaries))
+	var sweepables []sweep.Sweepable
 
 	for _, bs := range resp.BrokerSummaries {
-		log.Printf("[INFO] Deleting MQ broker %s", *bs.BrokerId)
-		_, err := conn.DeleteBroker(&mq.DeleteBrokerInput{
-			BrokerId: bs.BrokerId,
-		})
-		if err != nil {
-			return err
-		}
-		err = waitForMqBrokerDeletion(conn, *bs.BrokerId)
-		if err != nil {
-			return err
-		}
+		id := aws.StringValue(bs.BrokerId)
+
+		sweepables = append(sweepables, sweep.NewResource(id, func() error {
+			_, err := conn.DeleteBroker(&mq.DeleteBrokerInput{
+				BrokerId: aws.String(id),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			if err := waitForMqBrokerDeletion(conn, id); err != nil {
+				return fmt.Errorf("error waiting for deletion: %w", err)
+			}
+
+			return nil
+		}).WithName(aws.StringValue(bs.BrokerName)))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestDiffAwsMqBrokerUsers(t *testing.T) {
@@ -816,7 +820,7 @@ func TestAccAWSMqBroker_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsMqBrokerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mqconn
+	conn := testAccProvider.Meta().(*AWSClient).mqconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_mq_broker" {
@@ -852,7 +856,7 @@ func testAccCheckAwsMqBrokerExists(name string, broker *mq.DescribeBrokerRespons
 			return fmt.Errorf("No MQ Broker is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).mqconn
+		conn := testAccProvider.Meta().(*AWSClient).mqconn()
 		resp, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
 			BrokerId: aws.String(rs.Primary.ID),
 		})
@@ -869,7 +873,7 @@ func testAccCheckAwsMqBrokerExists(name string, broker *mq.DescribeBrokerRespons
 
 func testAccCheckAwsMqBrokerDisappears(broker *mq.DescribeBrokerResponse) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).mqconn
+		conn := testAccProvider.Meta().(*AWSClient).mqconn()
 		_, err := conn.DeleteBroker(&mq.DeleteBrokerInput{
 			BrokerId: broker.BrokerId,
 		})
@@ -879,7 +883,7 @@ func testAccCheckAwsMqBrokerDisappears(broker *mq.DescribeBrokerResponse) resour
 }
 
 func testAccPreCheckAWSMq(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).mqconn
+	conn := testAccProvider.Meta().(*AWSClient).mqconn()
 
 	input := &mq.ListBrokersInput{}
 
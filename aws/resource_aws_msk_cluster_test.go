@@ -12,47 +12,56 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_msk_cluster", &resource.Sweeper{
-		Name: "aws_msk_cluster",
-		F:    testSweepMskClusters,
+	sweep.AddTestSweepers("aws_msk_cluster", &sweep.Sweeper{
+		Name:    "aws_msk_cluster",
+		Service: "kafka",
+		List:    testSweepMskClusters,
 	})
 }
 
-func testSweepMskClusters(region string) error {
+func testSweepMskClusters(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*AWSClient).kafkaconn
+	conn := client.(*AWSClient).kafkaconn()
 
 	out, err := conn.ListClusters(&kafka.ListClustersInput{})
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] skipping msk cluster domain sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error retrieving MSK clusters: %s", err)
+		return nil, fmt.Errorf("Error retrieving MSK clusters: %s", err)
 	}
 
+	var sweepables []sweep.Sweepable
+
 	for _, cluster := range out.ClusterInfoList {
-		log.Printf("[INFO] Deleting Msk cluster: %s", *cluster.ClusterName)
-		_, err := conn.DeleteCluster(&kafka.DeleteClusterInput{
-			ClusterArn: cluster.ClusterArn,
-		})
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete MSK cluster %s: %s", *cluster.ClusterName, err)
-			continue
-		}
-		err = resourceAwsMskClusterDeleteWaiter(conn, *cluster.ClusterArn)
-		if err != nil {
-			log.Printf("[ERROR] failed to wait for deletion of MSK cluster %s: %s", *cluster.ClusterName, err)
-		}
+		arn := aws.StringValue(cluster.ClusterArn)
+
+		sweepables = append(sweepables, sweep.NewResource(arn, func() error {
+			_, err := conn.DeleteCluster(&kafka.DeleteClusterInput{
+				ClusterArn: aws.String(arn),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			if err := resourceAwsMskClusterDeleteWaiter(conn, arn); err != nil {
+				return fmt.Errorf("error waiting for deletion: %w", err)
+			}
+
+			return nil
+		}).WithName(aws.StringValue(cluster.ClusterName)).WithTags(aws.StringValueMap(cluster.Tags)))
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAWSMskCluster_basic(t *testing.T) {
@@ -737,7 +746,7 @@ func testAccCheckMskClusterDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 		opts := &kafka.DescribeClusterInput{
 			ClusterArn: aws.String(rs.Primary.ID),
 		}
@@ -764,7 +773,7 @@ func testAccCheckMskClusterExists(n string, cluster *kafka.ClusterInfo) resource
 			return fmt.Errorf("No Cluster arn is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 		resp, err := conn.DescribeCluster(&kafka.DescribeClusterInput{
 			ClusterArn: aws.String(rs.Primary.ID),
 		})
@@ -799,7 +808,7 @@ func testAccCheckMskClusterRecreated(i, j *kafka.ClusterInfo) resource.TestCheck
 
 func testAccLoadMskTags(cluster *kafka.ClusterInfo, td *kafka.ListTagsForResourceOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 
 		tagOut, err := conn.ListTagsForResource(&kafka.ListTagsForResourceInput{
 			ResourceArn: cluster.ClusterArn,
@@ -835,7 +844,7 @@ func testAccCheckMskClusterTags(td *kafka.ListTagsForResourceOutput, key string,
 }
 
 func testAccPreCheckAWSMsk(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+	conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 
 	input := &kafka.ListClustersInput{}
 
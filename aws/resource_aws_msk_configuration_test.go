@@ -9,29 +9,30 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_msk_configuration", &resource.Sweeper{
-		Name: "aws_msk_configuration",
-		F:    testSweepMskConfigurations,
+	sweep.AddTestSweepers("aws_msk_configuration", &sweep.Sweeper{
+		Name:    "aws_msk_configuration",
+		Service: "kafka",
+		List:    testSweepMskConfigurations,
 		Dependencies: []string{
 			"aws_msk_cluster",
 		},
 	})
 }
 
-func testSweepMskConfigurations(region string) error {
+func testSweepMskConfigurations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).kafkaconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).kafkaconn()
+	var sweepables []sweep.Sweepable
 
 	input := &kafka.ListConfigurationsInput{}
 
@@ -45,19 +46,12 @@ func testSweepMskConfigurations(region string) error {
 				continue
 			}
 
-			arn := aws.StringValue(configuration.Arn)
-			log.Printf("[INFO] Deleting MSK Configuration: %s", arn)
-
 			r := resourceAwsMskConfiguration()
 			d := r.Data(nil)
-			d.SetId(arn)
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(configuration.Arn))
+			d.Set("name", configuration.Name)
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !isLast
@@ -65,14 +59,14 @@ func testSweepMskConfigurations(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping MSK Configurations sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving MSK Configurations: %w", err))
+		return sweepables, fmt.Errorf("error retrieving MSK Configurations: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSMskConfiguration_basic(t *testing.T) {
@@ -225,7 +219,7 @@ func TestAccAWSMskConfiguration_ServerProperties(t *testing.T) {
 }
 
 func testAccCheckMskConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+	conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_msk_configuration" {
@@ -265,7 +259,7 @@ func testAccCheckMskConfigurationExists(resourceName string, configuration *kafk
 			return fmt.Errorf("Resource ID not set: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+		conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 
 		input := &kafka.DescribeConfigurationInput{
 			Arn: aws.String(rs.Primary.ID),
@@ -11,49 +11,50 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
-		Name: "aws_nat_gateway",
-		F:    testSweepNatGateways,
+	sweep.AddTestSweepers("aws_nat_gateway", &sweep.Sweeper{
+		Name:    "aws_nat_gateway",
+		Service: "ec2",
+		List:    testSweepNatGateways,
 	})
 }
 
-func testSweepNatGateways(region string) error {
+func testSweepNatGateways(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeNatGatewaysInput{}
 	resp, err := conn.DescribeNatGateways(req)
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping EC2 NAT Gateway sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error describing NAT Gateways: %s", err)
+		return nil, fmt.Errorf("Error describing NAT Gateways: %s", err)
 	}
 
-	if len(resp.NatGateways) == 0 {
-		log.Print("[DEBUG] No AWS NAT Gateways to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, natGateway := range resp.NatGateways {
-		_, err := conn.DeleteNatGateway(&ec2.DeleteNatGatewayInput{
+		input := &ec2.DeleteNatGatewayInput{
 			NatGatewayId: natGateway.NatGatewayId,
-		})
-		if err != nil {
-			return fmt.Errorf(
-				"Error deleting NAT Gateway (%s): %s",
-				*natGateway.NatGatewayId, err)
 		}
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(natGateway.NatGatewayId), func() error {
+			_, err := conn.DeleteNatGateway(input)
+
+			return err
+		}).WithTags(keyvaluetags.Ec2KeyValueTags(natGateway.Tags).IgnoreAws().Map()))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSNatGateway_basic(t *testing.T) {
@@ -126,7 +127,7 @@ func TestAccAWSNatGateway_tags(t *testing.T) {
 }
 
 func testAccCheckNatGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_nat_gateway" {
@@ -174,7 +175,7 @@ func testAccCheckNatGatewayExists(n string, ng *ec2.NatGateway) resource.TestChe
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		resp, err := conn.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{
 			NatGatewayIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -8,27 +8,28 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/neptune/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_neptune_event_subscription", &resource.Sweeper{
-		Name: "aws_neptune_event_subscription",
-		F:    testSweepNeptuneEventSubscriptions,
+	sweep.AddTestSweepers("aws_neptune_event_subscription", &sweep.Sweeper{
+		Name:    "aws_neptune_event_subscription",
+		Service: "neptune",
+		List:    testSweepNeptuneEventSubscriptions,
 	})
 }
 
-func testSweepNeptuneEventSubscriptions(region string) error {
+func testSweepNeptuneEventSubscriptions(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).neptuneconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).neptuneconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeEventSubscriptionsPages(&neptune.DescribeEventSubscriptionsInput{}, func(page *neptune.DescribeEventSubscriptionsOutput, isLast bool) bool {
 		if page == nil {
@@ -38,43 +39,46 @@ func testSweepNeptuneEventSubscriptions(region string) error {
 		for _, eventSubscription := range page.EventSubscriptionsList {
 			name := aws.StringValue(eventSubscription.CustSubscriptionId)
 
-			log.Printf("[INFO] Deleting Neptune Event Subscription: %s", name)
-			_, err = conn.DeleteEventSubscription(&neptune.DeleteEventSubscriptionInput{
-				SubscriptionName: aws.String(name),
-			})
-			if isAWSErr(err, neptune.ErrCodeSubscriptionNotFoundFault, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Neptune Event Subscription (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-
-			_, err = waiter.EventSubscriptionDeleted(conn, name)
-			if isAWSErr(err, neptune.ErrCodeSubscriptionNotFoundFault, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error waiting for Neptune Event Subscription (%s) deletion: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteEventSubscription(&neptune.DeleteEventSubscriptionInput{
+					SubscriptionName: aws.String(name),
+				})
+
+				if isAWSErr(err, neptune.ErrCodeSubscriptionNotFoundFault, "") {
+					return nil
+				}
+
+				if err != nil {
+					return err
+				}
+
+				_, err = waiter.EventSubscriptionDeleted(conn, name)
+
+				if isAWSErr(err, neptune.ErrCodeSubscriptionNotFoundFault, "") {
+					return nil
+				}
+
+				if err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}))
 		}
 
 		return !isLast
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Neptune Event Subscriptions sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Neptune Event Subscriptions: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Neptune Event Subscriptions: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSNeptuneEventSubscription_basic(t *testing.T) {
@@ -233,7 +237,7 @@ func testAccCheckAWSNeptuneEventSubscriptionExists(n string, v *neptune.EventSub
 			return fmt.Errorf("No Neptune Event Subscription is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+		conn := testAccProvider.Meta().(*AWSClient).neptuneconn()
 
 		opts := neptune.DescribeEventSubscriptionsInput{
 			SubscriptionName: aws.String(rs.Primary.ID),
@@ -256,7 +260,7 @@ func testAccCheckAWSNeptuneEventSubscriptionExists(n string, v *neptune.EventSub
 }
 
 func testAccCheckAWSNeptuneEventSubscriptionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).neptuneconn
+	conn := testAccProvider.Meta().(*AWSClient).neptuneconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_neptune_event_subscription" {
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"testing"
@@ -10,61 +11,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
-		Name: "aws_network_interface",
-		F:    testSweepEc2NetworkInterfaces,
+	sweep.AddTestSweepers("aws_network_interface", &sweep.Sweeper{
+		Name:    "aws_network_interface",
+		Service: "ec2",
+		List:    testSweepEc2NetworkInterfaces,
 		Dependencies: []string{
 			"aws_instance",
 		},
 	})
 }
 
-func testSweepEc2NetworkInterfaces(region string) error {
+func testSweepEc2NetworkInterfaces(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
+	var sweepables []sweep.Sweepable
 
-	err = conn.DescribeNetworkInterfacesPages(&ec2.DescribeNetworkInterfacesInput{}, func(page *ec2.DescribeNetworkInterfacesOutput, isLast bool) bool {
-		if page == nil {
-			return !isLast
+	err = lister.DescribeNetworkInterfacesItems(context.Background(), conn, &ec2.DescribeNetworkInterfacesInput{}, func(networkInterface *ec2.NetworkInterface) bool {
+		if aws.StringValue(networkInterface.Status) != ec2.NetworkInterfaceStatusAvailable {
+			return true
 		}
 
-		for _, networkInterface := range page.NetworkInterfaces {
-			id := aws.StringValue(networkInterface.NetworkInterfaceId)
-
-			if aws.StringValue(networkInterface.Status) != ec2.NetworkInterfaceStatusAvailable {
-				log.Printf("[INFO] Skipping EC2 Network Interface in unavailable (%s) status: %s", aws.StringValue(networkInterface.Status), id)
-				continue
-			}
-
-			input := &ec2.DeleteNetworkInterfaceInput{
-				NetworkInterfaceId: aws.String(id),
-			}
+		input := &ec2.DeleteNetworkInterfaceInput{
+			NetworkInterfaceId: networkInterface.NetworkInterfaceId,
+		}
 
-			log.Printf("[INFO] Deleting EC2 Network Interface: %s", id)
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(networkInterface.NetworkInterfaceId), func() error {
 			_, err := conn.DeleteNetworkInterface(input)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting EC2 Network Interface (%s): %s", id, err)
-			}
-		}
+			return err
+		}).WithTags(keyvaluetags.Ec2KeyValueTags(networkInterface.TagSet).IgnoreAws().Map()))
 
-		return !isLast
+		return true
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping EC2 Network Interface sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("error retrieving EC2 Network Interfaces: %s", err)
+		return sweepables, fmt.Errorf("error retrieving EC2 Network Interfaces: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSENI_basic(t *testing.T) {
@@ -488,7 +485,7 @@ func testAccCheckAWSENIExists(n string, res *ec2.NetworkInterface) resource.Test
 			return fmt.Errorf("No ENI ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		input := &ec2.DescribeNetworkInterfacesInput{
 			NetworkInterfaceIds: []*string{aws.String(rs.Primary.ID)},
 		}
@@ -588,7 +585,7 @@ func testAccCheckAWSENIDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		input := &ec2.DescribeNetworkInterfacesInput{
 			NetworkInterfaceIds: []*string{aws.String(rs.Primary.ID)},
 		}
@@ -617,7 +614,7 @@ func testAccCheckAWSENIMakeExternalAttachment(n string, conf *ec2.NetworkInterfa
 			InstanceId:         aws.String(rs.Primary.ID),
 			NetworkInterfaceId: conf.NetworkInterfaceId,
 		}
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		_, err := conn.AttachNetworkInterface(input)
 		if err != nil {
 			return fmt.Errorf("Error attaching ENI: %s", err)
@@ -9,72 +9,65 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkfirewall/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkfirewall_firewall_policy", &resource.Sweeper{
-		Name: "aws_networkfirewall_firewall_policy",
-		F:    testSweepNetworkFirewallFirewallPolicies,
+	sweep.AddTestSweepers("aws_networkfirewall_firewall_policy", &sweep.Sweeper{
+		Name:    "aws_networkfirewall_firewall_policy",
+		Service: "networkfirewall",
+		List:    testSweepNetworkFirewallFirewallPolicies,
 		Dependencies: []string{
 			"aws_networkfirewall_firewall",
 		},
 	})
 }
 
-func testSweepNetworkFirewallFirewallPolicies(region string) error {
+func testSweepNetworkFirewallFirewallPolicies(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).networkfirewallconn
-	ctx := context.Background()
+	conn := client.(*AWSClient).networkfirewallconn()
 	input := &networkfirewall.ListFirewallPoliciesInput{MaxResults: aws.Int64(100)}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
-		resp, err := conn.ListFirewallPoliciesWithContext(ctx, input)
+		resp, err := conn.ListFirewallPolicies(input)
+
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping NetworkFirewall Firewall Policy sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
+
 		if err != nil {
-			return fmt.Errorf("error retrieving NetworkFirewall Firewall Policies: %w", err)
+			return sweepables, fmt.Errorf("error retrieving NetworkFirewall Firewall Policies: %w", err)
 		}
 
-		for _, fp := range resp.FirewallPolicies {
-			if fp == nil {
+		for _, item := range resp.FirewallPolicies {
+			if item == nil {
 				continue
 			}
 
-			arn := aws.StringValue(fp.Arn)
-			log.Printf("[INFO] Deleting NetworkFirewall Firewall Policy: %s", arn)
-
 			r := resourceAwsNetworkFirewallFirewallPolicy()
 			d := r.Data(nil)
-			d.SetId(arn)
-			diags := r.DeleteContext(ctx, d, client)
-			for i := range diags {
-				if diags[i].Severity == diag.Error {
-					log.Printf("[ERROR] %s", diags[i].Summary)
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf(diags[i].Summary))
-					continue
-				}
-			}
+			d.SetId(aws.StringValue(item.Arn))
+
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		if aws.StringValue(resp.NextToken) == "" {
 			break
 		}
+
 		input.NextToken = resp.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsNetworkFirewallFirewallPolicy_basic(t *testing.T) {
@@ -606,7 +599,7 @@ func testAccCheckAwsNetworkFirewallFirewallPolicyDestroy(s *terraform.State) err
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.FirewallPolicy(context.Background(), conn, rs.Primary.ID)
 		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 			continue
@@ -633,7 +626,7 @@ func testAccCheckAwsNetworkFirewallFirewallPolicyExists(n string) resource.TestC
 			return fmt.Errorf("No NetworkFirewall Firewall Policy ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.FirewallPolicy(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -10,71 +10,63 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkfirewall/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkfirewall_firewall", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_firewall", &sweep.Sweeper{
 		Name:         "aws_networkfirewall_firewall",
-		F:            testSweepNetworkFirewallFirewalls,
+		Service:      "networkfirewall",
+		List:         testSweepNetworkFirewallFirewalls,
 		Dependencies: []string{"aws_networkfirewall_logging_configuration"},
 	})
 }
 
-func testSweepNetworkFirewallFirewalls(region string) error {
+func testSweepNetworkFirewallFirewalls(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).networkfirewallconn
-	ctx := context.TODO()
+	conn := client.(*AWSClient).networkfirewallconn()
 	input := &networkfirewall.ListFirewallsInput{MaxResults: aws.Int64(100)}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
-		resp, err := conn.ListFirewallsWithContext(ctx, input)
+		resp, err := conn.ListFirewalls(input)
+
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping NetworkFirewall Firewall sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
+
 		if err != nil {
-			return fmt.Errorf("error retrieving NetworkFirewall firewalls: %s", err)
+			return sweepables, fmt.Errorf("error retrieving NetworkFirewall firewalls: %s", err)
 		}
 
-		for _, f := range resp.Firewalls {
-			if f == nil {
+		for _, item := range resp.Firewalls {
+			if item == nil {
 				continue
 			}
 
-			arn := aws.StringValue(f.FirewallArn)
-
-			log.Printf("[INFO] Deleting NetworkFirewall Firewall: %s", arn)
-
 			r := resourceAwsNetworkFirewallFirewall()
 			d := r.Data(nil)
-			d.SetId(arn)
-			diags := r.DeleteContext(ctx, d, client)
-			for i := range diags {
-				if diags[i].Severity == diag.Error {
-					log.Printf("[ERROR] %s", diags[i].Summary)
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf(diags[i].Summary))
-					continue
-				}
-			}
+			d.SetId(aws.StringValue(item.FirewallArn))
+
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		if aws.StringValue(resp.NextToken) == "" {
 			break
 		}
+
 		input.NextToken = resp.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsNetworkFirewallFirewall_basic(t *testing.T) {
@@ -370,7 +362,7 @@ func testAccCheckAwsNetworkFirewallFirewallDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.Firewall(context.Background(), conn, rs.Primary.ID)
 		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 			continue
@@ -397,7 +389,7 @@ func testAccCheckAwsNetworkFirewallFirewallExists(n string) resource.TestCheckFu
 			return fmt.Errorf("No NetworkFirewall Firewall ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.Firewall(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -412,7 +404,7 @@ func testAccCheckAwsNetworkFirewallFirewallExists(n string) resource.TestCheckFu
 }
 
 func testAccPreCheckAwsNetworkFirewall(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+	conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 
 	input := &networkfirewall.ListFirewallsInput{}
 
@@ -9,70 +9,62 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkfirewall/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkfirewall_logging_configuration", &resource.Sweeper{
-		Name: "aws_networkfirewall_logging_configuration",
-		F:    testSweepNetworkFirewallLoggingConfigurations,
+	sweep.AddTestSweepers("aws_networkfirewall_logging_configuration", &sweep.Sweeper{
+		Name:    "aws_networkfirewall_logging_configuration",
+		Service: "networkfirewall",
+		List:    testSweepNetworkFirewallLoggingConfigurations,
 	})
 }
 
-func testSweepNetworkFirewallLoggingConfigurations(region string) error {
+func testSweepNetworkFirewallLoggingConfigurations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).networkfirewallconn
-	ctx := context.TODO()
+	conn := client.(*AWSClient).networkfirewallconn()
 	input := &networkfirewall.ListFirewallsInput{MaxResults: aws.Int64(100)}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
-		resp, err := conn.ListFirewallsWithContext(ctx, input)
+		resp, err := conn.ListFirewalls(input)
+
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping NetworkFirewall Logging Configuration sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
+
 		if err != nil {
-			return fmt.Errorf("error retrieving NetworkFirewall firewalls: %s", err)
+			return sweepables, fmt.Errorf("error retrieving NetworkFirewall firewalls: %s", err)
 		}
 
-		for _, f := range resp.Firewalls {
-			if f == nil {
+		for _, item := range resp.Firewalls {
+			if item == nil {
 				continue
 			}
 
-			arn := aws.StringValue(f.FirewallArn)
-
-			log.Printf("[INFO] Deleting NetworkFirewall Logging Configuration for firewall: %s", arn)
-
 			r := resourceAwsNetworkFirewallLoggingConfiguration()
 			d := r.Data(nil)
-			d.SetId(arn)
-			diags := r.DeleteContext(ctx, d, client)
-			for i := range diags {
-				if diags[i].Severity == diag.Error {
-					log.Printf("[ERROR] %s", diags[i].Summary)
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf(diags[i].Summary))
-					continue
-				}
-			}
+			d.SetId(aws.StringValue(item.FirewallArn))
+
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		if aws.StringValue(resp.NextToken) == "" {
 			break
 		}
+
 		input.NextToken = resp.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsNetworkFirewallLoggingConfiguration_cloudwatchLogDestination_logGroup(t *testing.T) {
@@ -650,7 +642,7 @@ func testAccCheckAwsNetworkFirewallLoggingConfigurationDestroy(s *terraform.Stat
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.LoggingConfiguration(context.Background(), conn, rs.Primary.ID)
 		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 			continue
@@ -677,7 +669,7 @@ func testAccCheckAwsNetworkFirewallLoggingConfigurationExists(n string) resource
 			return fmt.Errorf("No NetworkFirewall Logging Configuration ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.LoggingConfiguration(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			return err
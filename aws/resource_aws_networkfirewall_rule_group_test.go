@@ -9,72 +9,65 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkfirewall/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkfirewall_rule_group", &resource.Sweeper{
-		Name: "aws_networkfirewall_rule_group",
-		F:    testSweepNetworkFirewallRuleGroups,
+	sweep.AddTestSweepers("aws_networkfirewall_rule_group", &sweep.Sweeper{
+		Name:    "aws_networkfirewall_rule_group",
+		Service: "networkfirewall",
+		List:    testSweepNetworkFirewallRuleGroups,
 		Dependencies: []string{
 			"aws_networkfirewall_firewall_policy",
 		},
 	})
 }
 
-func testSweepNetworkFirewallRuleGroups(region string) error {
+func testSweepNetworkFirewallRuleGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).networkfirewallconn
-	ctx := context.Background()
+	conn := client.(*AWSClient).networkfirewallconn()
 	input := &networkfirewall.ListRuleGroupsInput{MaxResults: aws.Int64(100)}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
-		resp, err := conn.ListRuleGroupsWithContext(ctx, input)
+		resp, err := conn.ListRuleGroups(input)
+
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping NetworkFirewall Rule Group sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
+
 		if err != nil {
-			return fmt.Errorf("error retrieving NetworkFirewall Rule Groups: %w", err)
+			return sweepables, fmt.Errorf("error retrieving NetworkFirewall Rule Groups: %w", err)
 		}
 
-		for _, r := range resp.RuleGroups {
-			if r == nil {
+		for _, item := range resp.RuleGroups {
+			if item == nil {
 				continue
 			}
 
-			arn := aws.StringValue(r.Arn)
-			log.Printf("[INFO] Deleting NetworkFirewall Rule Group: %s", arn)
-
 			r := resourceAwsNetworkFirewallRuleGroup()
 			d := r.Data(nil)
-			d.SetId(arn)
-			diags := r.DeleteContext(ctx, d, client)
-			for i := range diags {
-				if diags[i].Severity == diag.Error {
-					log.Printf("[ERROR] %s", diags[i].Summary)
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf(diags[i].Summary))
-					continue
-				}
-			}
+			d.SetId(aws.StringValue(item.Arn))
+
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		if aws.StringValue(resp.NextToken) == "" {
 			break
 		}
+
 		input.NextToken = resp.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsNetworkFirewallRuleGroup_basic_rulesSourceList(t *testing.T) {
@@ -766,7 +759,7 @@ func testAccCheckAwsNetworkFirewallRuleGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.RuleGroup(context.Background(), conn, rs.Primary.ID)
 		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 			continue
@@ -793,7 +786,7 @@ func testAccCheckAwsNetworkFirewallRuleGroupExists(n string) resource.TestCheckF
 			return fmt.Errorf("No NetworkFirewall Rule Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.RuleGroup(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -155,7 +155,7 @@ func testAccAwsOrganizationsAccount_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsOrganizationsAccountDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_organizations_account" {
@@ -192,7 +192,7 @@ func testAccCheckAwsOrganizationsAccountExists(n string, a *organizations.Accoun
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 		params := &organizations.DescribeAccountInput{
 			AccountId: &rs.Primary.ID,
 		}
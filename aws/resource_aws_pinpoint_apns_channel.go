@@ -82,7 +82,7 @@ func resourceAwsPinpointAPNSChannelUpsert(d *schema.ResourceData, meta interface
 		return errors.New("At least one set of credentials is required; either [certificate, private_key] or [bundle_id, team_id, token_key, token_key_id]")
 	}
 
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	applicationId := d.Get("application_id").(string)
 
@@ -115,7 +115,7 @@ func resourceAwsPinpointAPNSChannelUpsert(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsPinpointAPNSChannelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[INFO] Reading Pinpoint APNs Channel for Application %s", d.Id())
 
@@ -141,7 +141,7 @@ func resourceAwsPinpointAPNSChannelRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsPinpointAPNSChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[DEBUG] Deleting Pinpoint APNs Channel: %s", d.Id())
 	_, err := conn.DeleteApnsChannel(&pinpoint.DeleteApnsChannelInput{
@@ -10,58 +10,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_pinpoint_app", &resource.Sweeper{
-		Name: "aws_pinpoint_app",
-		F:    testSweepPinpointApps,
+	sweep.AddTestSweepers("aws_pinpoint_app", &sweep.Sweeper{
+		Name:    "aws_pinpoint_app",
+		Service: "pinpoint",
+		List:    testSweepPinpointApps,
 	})
 }
 
-func testSweepPinpointApps(region string) error {
+func testSweepPinpointApps(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).pinpointconn
+	conn := client.(*AWSClient).pinpointconn()
 
 	input := &pinpoint.GetAppsInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.GetApps(input)
 		if err != nil {
 			if testSweepSkipSweepError(err) {
 				log.Printf("[WARN] Skipping Pinpoint app sweep for %s: %s", region, err)
-				return nil
+				return sweepables, nil
 			}
-			return fmt.Errorf("Error retrieving Pinpoint apps: %s", err)
-		}
-
-		if len(output.ApplicationsResponse.Item) == 0 {
-			log.Print("[DEBUG] No Pinpoint apps to sweep")
-			return nil
+			return sweepables, fmt.Errorf("Error retrieving Pinpoint apps: %s", err)
 		}
 
 		for _, item := range output.ApplicationsResponse.Item {
-			name := aws.StringValue(item.Name)
-
-			log.Printf("[INFO] Deleting Pinpoint app %s", name)
-			_, err := conn.DeleteApp(&pinpoint.DeleteAppInput{
+			input := &pinpoint.DeleteAppInput{
 				ApplicationId: item.Id,
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Pinpoint app %s: %s", name, err)
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(item.Id), func() error {
+				_, err := conn.DeleteApp(input)
+
+				return err
+			}).WithName(aws.StringValue(item.Name)).WithTags(aws.StringValueMap(item.Tags)))
 		}
 
 		if output.ApplicationsResponse.NextToken == nil {
 			break
 		}
+
 		input.Token = output.ApplicationsResponse.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSPinpointApp_basic(t *testing.T) {
@@ -218,7 +217,7 @@ func TestAccAWSPinpointApp_Tags(t *testing.T) {
 }
 
 func testAccPreCheckAWSPinpointApp(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 	input := &pinpoint.GetAppsInput{}
 
@@ -244,7 +243,7 @@ func testAccCheckAWSPinpointAppExists(n string, application *pinpoint.Applicatio
 			return fmt.Errorf("No Pinpoint app with that ID exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 		// Check if the app exists
 		params := &pinpoint.GetAppInput{
@@ -378,7 +377,7 @@ resource "aws_pinpoint_app" "test" {
 }
 
 func testAccCheckAWSPinpointAppDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_pinpoint_app" {
@@ -40,7 +40,7 @@ func resourceAwsPinpointGCMChannel() *schema.Resource {
 }
 
 func resourceAwsPinpointGCMChannelUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	applicationId := d.Get("application_id").(string)
 
@@ -65,7 +65,7 @@ func resourceAwsPinpointGCMChannelUpsert(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsPinpointGCMChannelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[INFO] Reading Pinpoint GCM Channel for application %s", d.Id())
 
@@ -90,7 +90,7 @@ func resourceAwsPinpointGCMChannelRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsPinpointGCMChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[DEBUG] Deleting Pinpoint GCM Channel for application %s", d.Id())
 	_, err := conn.DeleteGcmChannel(&pinpoint.DeleteGcmChannelInput{
@@ -15,37 +15,40 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_rds_cluster_parameter_group", &resource.Sweeper{
-		Name: "aws_rds_cluster_parameter_group",
-		F:    testSweepRdsClusterParameterGroups,
+	sweep.AddTestSweepers("aws_rds_cluster_parameter_group", &sweep.Sweeper{
+		Name:    "aws_rds_cluster_parameter_group",
+		Service: "rds",
+		List:    testSweepRdsClusterParameterGroups,
 		Dependencies: []string{
 			"aws_rds_cluster",
 		},
 	})
 }
 
-func testSweepRdsClusterParameterGroups(region string) error {
+func testSweepRdsClusterParameterGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).rdsconn
+	conn := client.(*AWSClient).rdsconn()
 
 	input := &rds.DescribeDBClusterParameterGroupsInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.DescribeDBClusterParameterGroups(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping RDS DB Cluster Parameter Group sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
 
 		if err != nil {
-			return fmt.Errorf("error retrieving DB Cluster Parameter Groups: %s", err)
+			return sweepables, fmt.Errorf("error retrieving DB Cluster Parameter Groups: %s", err)
 		}
 
 		for _, dbcpg := range output.DBClusterParameterGroups {
@@ -53,24 +56,21 @@ func testSweepRdsClusterParameterGroups(region string) error {
 				continue
 			}
 
-			input := &rds.DeleteDBClusterParameterGroupInput{
-				DBClusterParameterGroupName: dbcpg.DBClusterParameterGroupName,
-			}
 			name := aws.StringValue(dbcpg.DBClusterParameterGroupName)
 
 			if strings.HasPrefix(name, "default.") {
-				log.Printf("[INFO] Skipping DB Cluster Parameter Group: %s", name)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting DB Cluster Parameter Group: %s", name)
+			input := &rds.DeleteDBClusterParameterGroupInput{
+				DBClusterParameterGroupName: dbcpg.DBClusterParameterGroupName,
+			}
 
-			_, err := conn.DeleteDBClusterParameterGroup(input)
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteDBClusterParameterGroup(input)
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DB Cluster Parameter Group %s: %s", name, err)
-				continue
-			}
+				return err
+			}))
 		}
 
 		if aws.StringValue(output.Marker) == "" {
@@ -80,7 +80,7 @@ func testSweepRdsClusterParameterGroups(region string) error {
 		input.Marker = output.Marker
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDBClusterParameterGroup_basic(t *testing.T) {
@@ -435,7 +435,7 @@ func TestAccAWSDBClusterParameterGroup_updateParameters(t *testing.T) {
 }
 
 func testAccCheckAWSDBClusterParameterGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_rds_cluster_parameter_group" {
@@ -479,7 +479,7 @@ func testAccCheckAWSRDSClusterParameterNotUserDefined(n, paramName string) resou
 			return fmt.Errorf("No DB Parameter Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		opts := rds.DescribeDBClusterParametersInput{
 			DBClusterParameterGroupName: aws.String(rs.Primary.ID),
@@ -517,7 +517,7 @@ func testAccCheckAWSDBClusterParameterGroupAttributes(v *rds.DBClusterParameterG
 
 func testAccAWSDBClusterParameterGroupDisappears(v *rds.DBClusterParameterGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 		opts := &rds.DeleteDBClusterParameterGroupInput{
 			DBClusterParameterGroupName: v.DBClusterParameterGroupName,
 		}
@@ -554,7 +554,7 @@ func testAccCheckAWSDBClusterParameterGroupExists(n string, v *rds.DBClusterPara
 			return errors.New("No DB Cluster Parameter Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		opts := rds.DescribeDBClusterParameterGroupsInput{
 			DBClusterParameterGroupName: aws.String(rs.Primary.ID),
@@ -15,88 +15,88 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
-		Name: "aws_rds_cluster",
-		F:    testSweepRdsClusters,
+	sweep.AddTestSweepers("aws_rds_cluster", &sweep.Sweeper{
+		Name:    "aws_rds_cluster",
+		Service: "rds",
+		List:    testSweepRdsClusters,
 		Dependencies: []string{
 			"aws_db_instance",
 		},
 	})
 }
 
-func testSweepRdsClusters(region string) error {
+func testSweepRdsClusters(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*AWSClient).rdsconn
+	conn := client.(*AWSClient).rdsconn()
 	input := &rds.DescribeDBClustersInput{}
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeDBClustersPages(input, func(out *rds.DescribeDBClustersOutput, lastPage bool) bool {
 		for _, cluster := range out.DBClusters {
 			id := aws.StringValue(cluster.DBClusterIdentifier)
+			arn := aws.StringValue(cluster.DBClusterArn)
+			engineMode := aws.StringValue(cluster.EngineMode)
 
-			// Automatically remove from global cluster to bypass this error on deletion:
-			// InvalidDBClusterStateFault: This cluster is a part of a global cluster, please remove it from globalcluster first
-			if aws.StringValue(cluster.EngineMode) == "global" {
-				globalCluster, err := rdsDescribeGlobalClusterFromDbClusterARN(conn, aws.StringValue(cluster.DBClusterArn))
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				// Automatically remove from global cluster to bypass this error on deletion:
+				// InvalidDBClusterStateFault: This cluster is a part of a global cluster, please remove it from globalcluster first
+				if engineMode == "global" {
+					globalCluster, err := rdsDescribeGlobalClusterFromDbClusterARN(conn, arn)
 
-				if err != nil {
-					log.Printf("[ERROR] Failure reading RDS Global Cluster information for DB Cluster (%s): %s", id, err)
-				}
-
-				if globalCluster != nil {
-					globalClusterID := aws.StringValue(globalCluster.GlobalClusterIdentifier)
-					input := &rds.RemoveFromGlobalClusterInput{
-						DbClusterIdentifier:     cluster.DBClusterArn,
-						GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
+					if err != nil {
+						return fmt.Errorf("error reading RDS Global Cluster information: %w", err)
 					}
 
-					log.Printf("[INFO] Removing RDS Cluster (%s) from RDS Global Cluster: %s", id, globalClusterID)
-					_, err = conn.RemoveFromGlobalCluster(input)
+					if globalCluster != nil {
+						input := &rds.RemoveFromGlobalClusterInput{
+							DbClusterIdentifier:     aws.String(arn),
+							GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
+						}
 
-					if err != nil {
-						log.Printf("[ERROR] Failure removing RDS Cluster (%s) from RDS Global Cluster (%s): %s", id, globalClusterID, err)
+						if _, err := conn.RemoveFromGlobalCluster(input); err != nil {
+							return fmt.Errorf("error removing from RDS Global Cluster (%s): %w", aws.StringValue(globalCluster.GlobalClusterIdentifier), err)
+						}
 					}
 				}
-			}
 
-			input := &rds.DeleteDBClusterInput{
-				DBClusterIdentifier: cluster.DBClusterIdentifier,
-				SkipFinalSnapshot:   aws.Bool(true),
-			}
-
-			log.Printf("[INFO] Deleting RDS DB Cluster: %s", id)
+				input := &rds.DeleteDBClusterInput{
+					DBClusterIdentifier: aws.String(id),
+					SkipFinalSnapshot:   aws.Bool(true),
+				}
 
-			_, err := conn.DeleteDBCluster(input)
+				if _, err := conn.DeleteDBCluster(input); err != nil {
+					return err
+				}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete RDS DB Cluster (%s): %s", id, err)
-				continue
-			}
+				if err := waitForRDSClusterDeletion(conn, id, 40*time.Minute); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
 
-			if err := waitForRDSClusterDeletion(conn, id, 40*time.Minute); err != nil {
-				log.Printf("[ERROR] Failure while waiting for RDS DB Cluster (%s) to be deleted: %s", id, err)
-			}
+				return nil
+			}).WithTags(keyvaluetags.RdsKeyValueTags(cluster.TagList).IgnoreAws().Map()))
 		}
+
 		return !lastPage
 	})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping RDS DB Cluster sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error retrieving RDS DB Clusters: %s", err)
+		return sweepables, fmt.Errorf("error retrieving RDS DB Clusters: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSRDSCluster_basic(t *testing.T) {
@@ -2243,7 +2243,7 @@ func testAccCheckAWSClusterDestroy(s *terraform.State) error {
 }
 
 func testAccCheckAWSClusterDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).rdsconn
+	conn := provider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_rds_cluster" {
@@ -2288,7 +2288,7 @@ func testAccCheckAWSClusterSnapshot(rInt int) resource.TestCheckFunc {
 			snapshot_identifier := fmt.Sprintf("tf-acctest-rdscluster-snapshot-%d", rInt)
 
 			awsClient := testAccProvider.Meta().(*AWSClient)
-			conn := awsClient.rdsconn
+			conn := awsClient.rdsconn()
 
 			log.Printf("[INFO] Deleting the Snapshot %s", snapshot_identifier)
 			_, snapDeleteErr := conn.DeleteDBClusterSnapshot(
@@ -2343,7 +2343,7 @@ func testAccCheckAWSClusterExistsWithProvider(n string, v *rds.DBCluster, provid
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*AWSClient).rdsconn
+		conn := provider.Meta().(*AWSClient).rdsconn()
 		resp, err := conn.DescribeDBClusters(&rds.DescribeDBClustersInput{
 			DBClusterIdentifier: aws.String(rs.Primary.ID),
 		})
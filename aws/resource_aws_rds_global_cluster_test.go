@@ -12,27 +12,28 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
-		Name: "aws_rds_global_cluster",
-		F:    testSweepRdsGlobalClusters,
+	sweep.AddTestSweepers("aws_rds_global_cluster", &sweep.Sweeper{
+		Name:    "aws_rds_global_cluster",
+		Service: "rds",
+		List:    testSweepRdsGlobalClusters,
 		Dependencies: []string{
 			"aws_rds_cluster",
 		},
 	})
 }
 
-func testSweepRdsGlobalClusters(region string) error {
+func testSweepRdsGlobalClusters(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*AWSClient).rdsconn
+	conn := client.(*AWSClient).rdsconn()
 	input := &rds.DescribeGlobalClustersInput{}
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeGlobalClustersPages(input, func(out *rds.DescribeGlobalClustersOutput, lastPage bool) bool {
 		for _, globalCluster := range out.GlobalClusters {
@@ -41,32 +42,32 @@ func testSweepRdsGlobalClusters(region string) error {
 				GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
 			}
 
-			log.Printf("[INFO] Deleting RDS Global Cluster: %s", id)
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				if _, err := conn.DeleteGlobalCluster(input); err != nil {
+					return err
+				}
 
-			_, err := conn.DeleteGlobalCluster(input)
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete RDS Global Cluster (%s): %s", id, err)
-				continue
-			}
+				if err := waitForRdsGlobalClusterDeletion(conn, id); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
 
-			if err := waitForRdsGlobalClusterDeletion(conn, id); err != nil {
-				log.Printf("[ERROR] Failure while waiting for RDS Global Cluster (%s) to be deleted: %s", id, err)
-			}
+				return nil
+			}))
 		}
+
 		return !lastPage
 	})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping RDS Global Cluster sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error retrieving RDS Global Clusters: %s", err)
+		return sweepables, fmt.Errorf("error retrieving RDS Global Clusters: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSRdsGlobalCluster_basic(t *testing.T) {
@@ -397,7 +398,7 @@ func testAccCheckAWSRdsGlobalClusterExists(resourceName string, globalCluster *r
 			return fmt.Errorf("No RDS Global Cluster ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		cluster, err := rdsDescribeGlobalCluster(conn, rs.Primary.ID)
 
@@ -420,7 +421,7 @@ func testAccCheckAWSRdsGlobalClusterExists(resourceName string, globalCluster *r
 }
 
 func testAccCheckAWSRdsGlobalClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_rds_global_cluster" {
@@ -449,7 +450,7 @@ func testAccCheckAWSRdsGlobalClusterDestroy(s *terraform.State) error {
 
 func testAccCheckAWSRdsGlobalClusterDisappears(globalCluster *rds.GlobalCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 		input := &rds.DeleteGlobalClusterInput{
 			GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
@@ -486,7 +487,7 @@ func testAccCheckAWSRdsGlobalClusterRecreated(i, j *rds.GlobalCluster) resource.
 }
 
 func testAccPreCheckAWSRdsGlobalCluster(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn()
 
 	input := &rds.DescribeGlobalClustersInput{}
 
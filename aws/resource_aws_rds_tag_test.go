@@ -0,0 +1,20 @@
+package aws
+
+import (
+	"fmt"
+)
+
+func testAccRdsTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_db_parameter_group" "test" {
+  name   = %[1]q
+  family = "mysql5.6"
+}
+
+resource "aws_rds_tag" "test" {
+  resource_id = aws_db_parameter_group.test.arn
+  key         = %[2]q
+  value       = %[3]q
+}
+`, rName, key, value)
+}
@@ -7,56 +7,56 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_cluster_snapshot", &resource.Sweeper{
-		Name: "aws_redshift_cluster_snapshot",
-		F:    testSweepRedshiftClusterSnapshots,
+	sweep.AddTestSweepers("aws_redshift_cluster_snapshot", &sweep.Sweeper{
+		Name:    "aws_redshift_cluster_snapshot",
+		Service: "redshift",
+		List:    testSweepRedshiftClusterSnapshots,
 		Dependencies: []string{
 			"aws_redshift_cluster",
 		},
 	})
 }
 
-func testSweepRedshiftClusterSnapshots(region string) error {
+func testSweepRedshiftClusterSnapshots(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).redshiftconn
+	conn := client.(*AWSClient).redshiftconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeClusterSnapshotsPages(&redshift.DescribeClusterSnapshotsInput{}, func(resp *redshift.DescribeClusterSnapshotsOutput, isLast bool) bool {
-		if len(resp.Snapshots) == 0 {
-			log.Print("[DEBUG] No Redshift cluster snapshots to sweep")
-			return false
-		}
-
 		for _, s := range resp.Snapshots {
-			id := aws.StringValue(s.SnapshotIdentifier)
-
 			if !strings.EqualFold(aws.StringValue(s.SnapshotType), "manual") || !strings.EqualFold(aws.StringValue(s.Status), "available") {
-				log.Printf("[INFO] Skipping Redshift cluster snapshot: %s", id)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Redshift cluster snapshot: %s", id)
-			_, err := conn.DeleteClusterSnapshot(&redshift.DeleteClusterSnapshotInput{
+			input := &redshift.DeleteClusterSnapshotInput{
 				SnapshotIdentifier: s.SnapshotIdentifier,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed deleting Redshift cluster snapshot (%s): %s", id, err)
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(s.SnapshotIdentifier), func() error {
+				_, err := conn.DeleteClusterSnapshot(input)
+
+				return err
+			}).WithTags(keyvaluetags.RedshiftKeyValueTags(s.Tags).IgnoreAws().Map()))
 		}
+
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Redshift Cluster Snapshot sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving Redshift cluster snapshots: %w", err)
+		return sweepables, fmt.Errorf("Error retrieving Redshift cluster snapshots: %w", err)
 	}
-	return nil
+
+	return sweepables, nil
 }
@@ -14,50 +14,52 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_cluster", &resource.Sweeper{
-		Name: "aws_redshift_cluster",
-		F:    testSweepRedshiftClusters,
+	sweep.AddTestSweepers("aws_redshift_cluster", &sweep.Sweeper{
+		Name:    "aws_redshift_cluster",
+		Service: "redshift",
+		List:    testSweepRedshiftClusters,
 	})
 }
 
-func testSweepRedshiftClusters(region string) error {
+func testSweepRedshiftClusters(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).redshiftconn
+	conn := client.(*AWSClient).redshiftconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeClustersPages(&redshift.DescribeClustersInput{}, func(resp *redshift.DescribeClustersOutput, isLast bool) bool {
-		if len(resp.Clusters) == 0 {
-			log.Print("[DEBUG] No Redshift clusters to sweep")
-			return false
-		}
-
 		for _, c := range resp.Clusters {
-			id := aws.StringValue(c.ClusterIdentifier)
-
 			input := &redshift.DeleteClusterInput{
 				ClusterIdentifier:        c.ClusterIdentifier,
 				SkipFinalClusterSnapshot: aws.Bool(true),
 			}
-			_, err := conn.DeleteCluster(input)
-			if err != nil {
-				log.Printf("[ERROR] Failed deleting Redshift cluster (%s): %s", id, err)
-			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(c.ClusterIdentifier), func() error {
+				_, err := conn.DeleteCluster(input)
+
+				return err
+			}).WithTags(keyvaluetags.RedshiftKeyValueTags(c.Tags).IgnoreAws().Map()))
 		}
+
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Redshift Cluster sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving Redshift Clusters: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving Redshift Clusters: %s", err)
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAWSRedshiftCluster_basic(t *testing.T) {
@@ -586,7 +588,7 @@ func testAccCheckAWSRedshiftClusterDestroy(s *terraform.State) error {
 		}
 
 		// Try to find the Group
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 		var err error
 		resp, err := conn.DescribeClusters(
 			&redshift.DescribeClustersInput{
@@ -623,7 +625,7 @@ func testAccCheckAWSRedshiftClusterSnapshot(rInt int) resource.TestCheckFunc {
 			var err error
 
 			// Try and delete the snapshot before we check for the cluster not found
-			conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+			conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 			snapshot_identifier := fmt.Sprintf("tf-acctest-snapshot-%d", rInt)
 
@@ -675,7 +677,7 @@ func testAccCheckAWSRedshiftClusterExists(n string, v *redshift.Cluster) resourc
 			return fmt.Errorf("No Redshift Cluster Instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 		resp, err := conn.DescribeClusters(&redshift.DescribeClustersInput{
 			ClusterIdentifier: aws.String(rs.Primary.ID),
 		})
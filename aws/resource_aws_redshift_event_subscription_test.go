@@ -7,26 +7,28 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_event_subscription", &resource.Sweeper{
-		Name: "aws_redshift_event_subscription",
-		F:    testSweepRedshiftEventSubscriptions,
+	sweep.AddTestSweepers("aws_redshift_event_subscription", &sweep.Sweeper{
+		Name:    "aws_redshift_event_subscription",
+		Service: "redshift",
+		List:    testSweepRedshiftEventSubscriptions,
 	})
 }
 
-func testSweepRedshiftEventSubscriptions(region string) error {
+func testSweepRedshiftEventSubscriptions(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).redshiftconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).redshiftconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeEventSubscriptionsPages(&redshift.DescribeEventSubscriptionsInput{}, func(page *redshift.DescribeEventSubscriptionsOutput, isLast bool) bool {
 		if page == nil {
@@ -36,32 +38,32 @@ func testSweepRedshiftEventSubscriptions(region string) error {
 		for _, eventSubscription := range page.EventSubscriptionsList {
 			name := aws.StringValue(eventSubscription.CustSubscriptionId)
 
-			log.Printf("[INFO] Deleting Redshift Event Subscription: %s", name)
-			_, err = conn.DeleteEventSubscription(&redshift.DeleteEventSubscriptionInput{
-				SubscriptionName: aws.String(name),
-			})
-			if isAWSErr(err, redshift.ErrCodeSubscriptionNotFoundFault, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Redshift Event Subscription (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteEventSubscription(&redshift.DeleteEventSubscriptionInput{
+					SubscriptionName: aws.String(name),
+				})
+
+				if isAWSErr(err, redshift.ErrCodeSubscriptionNotFoundFault, "") {
+					return nil
+				}
+
+				return err
+			}).WithTags(keyvaluetags.RedshiftKeyValueTags(eventSubscription.Tags).IgnoreAws().Map()))
 		}
 
 		return !isLast
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Redshift Event Subscriptions sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Redshift Event Subscriptions: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Redshift Event Subscriptions: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSRedshiftEventSubscription_basicUpdate(t *testing.T) {
@@ -284,7 +286,7 @@ func testAccCheckAWSRedshiftEventSubscriptionExists(n string, v *redshift.EventS
 			return fmt.Errorf("No Redshift Event Subscription is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 		opts := redshift.DescribeEventSubscriptionsInput{
 			SubscriptionName: aws.String(rs.Primary.ID),
@@ -307,7 +309,7 @@ func testAccCheckAWSRedshiftEventSubscriptionExists(n string, v *redshift.EventS
 }
 
 func testAccCheckAWSRedshiftEventSubscriptionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_redshift_event_subscription" {
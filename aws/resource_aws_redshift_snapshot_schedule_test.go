@@ -12,21 +12,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_snapshot_schedule", &resource.Sweeper{
-		Name: "aws_redshift_snapshot_schedule",
-		F:    testSweepRedshiftSnapshotSchedules,
+	sweep.AddTestSweepers("aws_redshift_snapshot_schedule", &sweep.Sweeper{
+		Name:    "aws_redshift_snapshot_schedule",
+		Service: "redshift",
+		List:    testSweepRedshiftSnapshotSchedules,
 	})
 }
 
-func testSweepRedshiftSnapshotSchedules(region string) error {
+func testSweepRedshiftSnapshotSchedules(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).redshiftconn
+	conn := client.(*AWSClient).redshiftconn()
 
 	req := &redshift.DescribeSnapshotSchedulesInput{}
 
@@ -34,15 +37,12 @@ func testSweepRedshiftSnapshotSchedules(region string) error {
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Redshift Regional Snapshot Schedules sweep for %s: %s", region, err)
-			return nil
+			return nil, nil
 		}
-		return fmt.Errorf("Error describing Redshift Regional Snapshot Schedules: %s", err)
+		return nil, fmt.Errorf("Error describing Redshift Regional Snapshot Schedules: %s", err)
 	}
 
-	if len(resp.SnapshotSchedules) == 0 {
-		log.Print("[DEBUG] No AWS Redshift Regional Snapshot Schedules to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, snapshotSchedules := range resp.SnapshotSchedules {
 		identifier := aws.StringValue(snapshotSchedules.ScheduleIdentifier)
@@ -58,22 +58,23 @@ func testSweepRedshiftSnapshotSchedules(region string) error {
 		}
 
 		if !hasPrefix {
-			log.Printf("[INFO] Skipping Delete Redshift Snapshot Schedule: %s", identifier)
 			continue
 		}
 
-		_, err := conn.DeleteSnapshotSchedule(&redshift.DeleteSnapshotScheduleInput{
-			ScheduleIdentifier: snapshotSchedules.ScheduleIdentifier,
-		})
-		if isAWSErr(err, redshift.ErrCodeSnapshotScheduleNotFoundFault, "") {
-			return nil
-		}
-		if err != nil {
-			return fmt.Errorf("Error deleting Redshift Snapshot Schedule %s: %s", identifier, err)
-		}
+		sweepables = append(sweepables, sweep.NewResource(identifier, func() error {
+			_, err := conn.DeleteSnapshotSchedule(&redshift.DeleteSnapshotScheduleInput{
+				ScheduleIdentifier: aws.String(identifier),
+			})
+
+			if isAWSErr(err, redshift.ErrCodeSnapshotScheduleNotFoundFault, "") {
+				return nil
+			}
+
+			return err
+		}).WithTags(keyvaluetags.RedshiftKeyValueTags(snapshotSchedules.Tags).IgnoreAws().Map()))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSRedshiftSnapshotSchedule_basic(t *testing.T) {
@@ -291,7 +292,7 @@ func testAccCheckAWSRedshiftSnapshotScheduleDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 		resp, err := conn.DescribeSnapshotSchedules(&redshift.DescribeSnapshotSchedulesInput{
 			ScheduleIdentifier: aws.String(rs.Primary.ID),
 		})
@@ -323,7 +324,7 @@ func testAccCheckAWSRedshiftSnapshotScheduleExists(n string, v *redshift.Snapsho
 			return fmt.Errorf("No Redshift Cluster Snapshot Schedule ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 		resp, err := conn.DescribeSnapshotSchedules(&redshift.DescribeSnapshotSchedulesInput{
 			ScheduleIdentifier: aws.String(rs.Primary.ID),
 		})
@@ -345,7 +346,7 @@ func testAccCheckAWSRedshiftSnapshotScheduleExists(n string, v *redshift.Snapsho
 
 func testAccCheckAWSRedshiftSnapshotScheduleCreateSnapshotScheduleAssociation(cluster *redshift.Cluster, snapshotSchedule *redshift.SnapshotSchedule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 		if _, err := conn.ModifyClusterSnapshotSchedule(&redshift.ModifyClusterSnapshotScheduleInput{
 			ClusterIdentifier:    cluster.ClusterIdentifier,
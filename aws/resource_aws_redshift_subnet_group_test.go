@@ -8,29 +8,30 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/redshift"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_subnet_group", &resource.Sweeper{
-		Name: "aws_redshift_subnet_group",
-		F:    testSweepRedshiftSubnetGroups,
+	sweep.AddTestSweepers("aws_redshift_subnet_group", &sweep.Sweeper{
+		Name:    "aws_redshift_subnet_group",
+		Service: "redshift",
+		List:    testSweepRedshiftSubnetGroups,
 		Dependencies: []string{
 			"aws_redshift_cluster",
 		},
 	})
 }
 
-func testSweepRedshiftSubnetGroups(region string) error {
+func testSweepRedshiftSubnetGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).redshiftconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).redshiftconn()
+	var sweepables []sweep.Sweepable
 
 	input := &redshift.DescribeClusterSubnetGroupsInput{}
 
@@ -50,17 +51,11 @@ func testSweepRedshiftSubnetGroups(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Redshift Cluster Subnet Group: %s", name)
 			r := resourceAwsRedshiftSubnetGroup()
 			d := r.Data(nil)
 			d.SetId(name)
-			err := r.Delete(d, client)
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !isLast
@@ -68,14 +63,14 @@ func testSweepRedshiftSubnetGroups(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Redshift Cluster Subnet Group sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Redshift Cluster Subnet Group: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Redshift Cluster Subnet Group: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSRedshiftSubnetGroup_basic(t *testing.T) {
@@ -247,7 +242,7 @@ func TestAccAWSRedshiftSubnetGroup_tags(t *testing.T) {
 }
 
 func testAccCheckRedshiftSubnetGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_redshift_subnet_group" {
@@ -288,7 +283,7 @@ func testAccCheckRedshiftSubnetGroupExists(n string, v *redshift.ClusterSubnetGr
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 		resp, err := conn.DescribeClusterSubnetGroups(
 			&redshift.DescribeClusterSubnetGroupsInput{ClusterSubnetGroupName: aws.String(rs.Primary.ID)})
 		if err != nil {
@@ -120,7 +120,7 @@ func testAccCheckAWSResourceGroupExists(n string, v *resourcegroups.Group) resou
 			return fmt.Errorf("No resource group name is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn
+		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn()
 
 		resp, err := conn.GetGroup(&resourcegroups.GetGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
@@ -145,7 +145,7 @@ func testAccCheckAWSResourceGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn
+		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn()
 		resp, err := conn.GetGroup(&resourcegroups.GetGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
 		})
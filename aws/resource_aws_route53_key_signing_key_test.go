@@ -118,7 +118,7 @@ func TestAccAwsRoute53KeySigningKey_Status(t *testing.T) {
 }
 
 func testAccCheckAwsRoute53KeySigningKeyDestroy(s *terraform.State) error {
-	conn := testAccProviderRoute53KeySigningKey.Meta().(*AWSClient).r53conn
+	conn := testAccProviderRoute53KeySigningKey.Meta().(*AWSClient).r53conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_key_signing_key" {
@@ -159,7 +159,7 @@ func testAccAwsRoute53KeySigningKeyExists(resourceName string) resource.TestChec
 			return fmt.Errorf("resource %s has not set its id", resourceName)
 		}
 
-		conn := testAccProviderRoute53KeySigningKey.Meta().(*AWSClient).r53conn
+		conn := testAccProviderRoute53KeySigningKey.Meta().(*AWSClient).r53conn()
 
 		keySigningKey, err := finder.KeySigningKeyByResourceID(conn, rs.Primary.ID)
 
@@ -8,26 +8,27 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_query_log", &resource.Sweeper{
-		Name: "aws_route53_query_log",
-		F:    testSweepRoute53QueryLogs,
+	sweep.AddTestSweepers("aws_route53_query_log", &sweep.Sweeper{
+		Name:    "aws_route53_query_log",
+		Service: "r53",
+		List:    testSweepRoute53QueryLogs,
 	})
 }
 
-func testSweepRoute53QueryLogs(region string) error {
+func testSweepRoute53QueryLogs(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).r53conn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).r53conn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListQueryLoggingConfigsPages(&route53.ListQueryLoggingConfigsInput{}, func(page *route53.ListQueryLoggingConfigsOutput, isLast bool) bool {
 		if page == nil {
@@ -37,34 +38,34 @@ func testSweepRoute53QueryLogs(region string) error {
 		for _, queryLoggingConfig := range page.QueryLoggingConfigs {
 			id := aws.StringValue(queryLoggingConfig.Id)
 
-			log.Printf("[INFO] Deleting Route53 query logging configuration: %s", id)
-			_, err := conn.DeleteQueryLoggingConfig(&route53.DeleteQueryLoggingConfigInput{
-				Id: aws.String(id),
-			})
-			if isAWSErr(err, route53.ErrCodeNoSuchQueryLoggingConfig, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Route53 query logging configuration (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteQueryLoggingConfig(&route53.DeleteQueryLoggingConfigInput{
+					Id: aws.String(id),
+				})
+
+				if isAWSErr(err, route53.ErrCodeNoSuchQueryLoggingConfig, "") {
+					return nil
+				}
+
+				return err
+			}))
 		}
 
 		return !isLast
 	})
+
 	// In unsupported AWS partitions, the API may return an error even the SDK cannot handle.
 	// Reference: https://github.com/aws/aws-sdk-go/issues/3313
 	if testSweepSkipSweepError(err) || isAWSErr(err, "SerializationError", "failed to unmarshal error message") || isAWSErr(err, "AccessDeniedException", "Unable to determine service/operation name to be authorized") {
 		log.Printf("[WARN] Skipping Route53 query logging configurations sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Route53 query logging configurations: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Route53 query logging configurations: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSRoute53QueryLog_basic(t *testing.T) {
@@ -99,7 +100,7 @@ func TestAccAWSRoute53QueryLog_basic(t *testing.T) {
 
 func testAccCheckRoute53QueryLogExists(pr string, queryLoggingConfig *route53.QueryLoggingConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProviderRoute53QueryLog.Meta().(*AWSClient).r53conn
+		conn := testAccProviderRoute53QueryLog.Meta().(*AWSClient).r53conn()
 		rs, ok := s.RootModule().Resources[pr]
 		if !ok {
 			return fmt.Errorf("Not found: %s", pr)
@@ -126,7 +127,7 @@ func testAccCheckRoute53QueryLogExists(pr string, queryLoggingConfig *route53.Qu
 }
 
 func testAccCheckRoute53QueryLogDestroy(s *terraform.State) error {
-	conn := testAccProviderRoute53QueryLog.Meta().(*AWSClient).r53conn
+	conn := testAccProviderRoute53QueryLog.Meta().(*AWSClient).r53conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_query_log" {
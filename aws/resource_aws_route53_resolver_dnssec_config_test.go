@@ -9,28 +9,29 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53resolver/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_dnssec_config", &resource.Sweeper{
-		Name: "aws_route53_resolver_dnssec_config",
-		F:    testSweepRoute53ResolverDnssecConfig,
+	sweep.AddTestSweepers("aws_route53_resolver_dnssec_config", &sweep.Sweeper{
+		Name:    "aws_route53_resolver_dnssec_config",
+		Service: "route53resolver",
+		List:    testSweepRoute53ResolverDnssecConfig,
 	})
 }
 
-func testSweepRoute53ResolverDnssecConfig(region string) error {
+func testSweepRoute53ResolverDnssecConfig(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).route53resolverconn
+	conn := client.(*AWSClient).route53resolverconn()
+	var sweepables []sweep.Sweepable
 
-	var sweeperErrs *multierror.Error
 	err = conn.ListResolverDnssecConfigsPages(&route53resolver.ListResolverDnssecConfigsInput{}, func(page *route53resolver.ListResolverDnssecConfigsOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -41,24 +42,12 @@ func testSweepRoute53ResolverDnssecConfig(region string) error {
 				continue
 			}
 
-			id := aws.StringValue(resolverDnssecConfig.Id)
-			resourceId := aws.StringValue(resolverDnssecConfig.ResourceId)
-
-			log.Printf("[INFO] Deleting Route 53 Resolver Dnssec config: %s", id)
-
 			r := resourceAwsRoute53ResolverDnssecConfig()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(resolverDnssecConfig.Id))
-			d.Set("resource_id", resourceId)
-
-			err := r.Delete(d, client)
+			d.Set("resource_id", resolverDnssecConfig.ResourceId)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Route 53 Resolver Resolver Dnssec config (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !isLast
@@ -66,14 +55,14 @@ func testSweepRoute53ResolverDnssecConfig(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Route 53 Resolver Resolver Dnssec config sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+		return sweepables, nil
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Route 53 Resolver Resolver Dnssec config: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Route 53 Resolver Resolver Dnssec config: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSRoute53ResolverDnssecConfig_basic(t *testing.T) {
@@ -129,7 +118,7 @@ func TestAccAWSRoute53ResolverDnssecConfig_disappear(t *testing.T) {
 }
 
 func testAccCheckRoute53ResolverDnssecConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_dnssec_config" {
@@ -168,7 +157,7 @@ func testAccCheckRoute53ResolverDnssecConfigExists(n string) resource.TestCheckF
 		}
 
 		id := rs.Primary.ID
-		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 
 		config, err := finder.ResolverDnssecConfigByID(conn, id)
 
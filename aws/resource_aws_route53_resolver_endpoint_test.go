@@ -8,30 +8,31 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
-		Name: "aws_route53_resolver_endpoint",
-		F:    testSweepRoute53ResolverEndpoints,
+	sweep.AddTestSweepers("aws_route53_resolver_endpoint", &sweep.Sweeper{
+		Name:    "aws_route53_resolver_endpoint",
+		Service: "route53resolver",
+		List:    testSweepRoute53ResolverEndpoints,
 		Dependencies: []string{
 			"aws_route53_resolver_rule",
 		},
 	})
 }
 
-func testSweepRoute53ResolverEndpoints(region string) error {
+func testSweepRoute53ResolverEndpoints(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).route53resolverconn
+	conn := client.(*AWSClient).route53resolverconn()
+	var sweepables []sweep.Sweepable
 
-	var errors error
 	err = conn.ListResolverEndpointsPages(&route53resolver.ListResolverEndpointsInput{}, func(page *route53resolver.ListResolverEndpointsOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -40,38 +41,43 @@ func testSweepRoute53ResolverEndpoints(region string) error {
 		for _, resolverEndpoint := range page.ResolverEndpoints {
 			id := aws.StringValue(resolverEndpoint.Id)
 
-			log.Printf("[INFO] Deleting Route53 Resolver endpoint: %s", id)
-			_, err := conn.DeleteResolverEndpoint(&route53resolver.DeleteResolverEndpointInput{
-				ResolverEndpointId: aws.String(id),
-			})
-			if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				errors = multierror.Append(errors, fmt.Errorf("error deleting Route53 Resolver endpoint (%s): %w", id, err))
-				continue
-			}
-
-			err = route53ResolverEndpointWaitUntilTargetState(conn, id, 10*time.Minute,
-				[]string{route53resolver.ResolverEndpointStatusDeleting},
-				[]string{route53ResolverEndpointStatusDeleted})
-			if err != nil {
-				errors = multierror.Append(errors, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteResolverEndpoint(&route53resolver.DeleteResolverEndpointInput{
+					ResolverEndpointId: aws.String(id),
+				})
+
+				if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+					return nil
+				}
+
+				if err != nil {
+					return err
+				}
+
+				err = route53ResolverEndpointWaitUntilTargetState(conn, id, 10*time.Minute,
+					[]string{route53resolver.ResolverEndpointStatusDeleting},
+					[]string{route53ResolverEndpointStatusDeleted})
+
+				if err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}).WithName(aws.StringValue(resolverEndpoint.Name)))
 		}
 
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Route53 Resolver endpoint sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		errors = multierror.Append(errors, fmt.Errorf("error retrievingRoute53 Resolver endpoints: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Route53 Resolver endpoints: %w", err)
 	}
 
-	return errors
+	return sweepables, nil
 }
 
 func TestAccAWSRoute53ResolverEndpoint_basicInbound(t *testing.T) {
@@ -146,7 +152,7 @@ func TestAccAWSRoute53ResolverEndpoint_updateOutbound(t *testing.T) {
 }
 
 func testAccCheckRoute53ResolverEndpointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_endpoint" {
@@ -181,7 +187,7 @@ func testAccCheckRoute53ResolverEndpointExists(n string, ep *route53resolver.Res
 			return fmt.Errorf("No Route 53 Resolver endpoint ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 		resp, err := conn.GetResolverEndpoint(&route53resolver.GetResolverEndpointInput{
 			ResolverEndpointId: aws.String(rs.Primary.ID),
 		})
@@ -196,7 +202,7 @@ func testAccCheckRoute53ResolverEndpointExists(n string, ep *route53resolver.Res
 }
 
 func testAccPreCheckAWSRoute53Resolver(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 
 	input := &route53resolver.ListResolverEndpointsInput{}
 
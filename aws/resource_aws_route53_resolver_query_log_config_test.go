@@ -7,30 +7,31 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53resolver/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_query_log_config", &resource.Sweeper{
-		Name: "aws_route53_resolver_query_log_config",
-		F:    testSweepRoute53ResolverQueryLogConfigs,
+	sweep.AddTestSweepers("aws_route53_resolver_query_log_config", &sweep.Sweeper{
+		Name:    "aws_route53_resolver_query_log_config",
+		Service: "route53resolver",
+		List:    testSweepRoute53ResolverQueryLogConfigs,
 		Dependencies: []string{
 			"aws_route53_resolver_query_log_config_association",
 		},
 	})
 }
 
-func testSweepRoute53ResolverQueryLogConfigs(region string) error {
+func testSweepRoute53ResolverQueryLogConfigs(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).route53resolverconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).route53resolverconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListResolverQueryLogConfigsPages(&route53resolver.ListResolverQueryLogConfigsInput{}, func(page *route53resolver.ListResolverQueryLogConfigsOutput, isLast bool) bool {
 		if page == nil {
@@ -38,32 +39,26 @@ func testSweepRoute53ResolverQueryLogConfigs(region string) error {
 		}
 
 		for _, queryLogConfig := range page.ResolverQueryLogConfigs {
-			id := aws.StringValue(queryLogConfig.Id)
-
-			log.Printf("[INFO] Deleting Route53 Resolver Query Log Config: %s", id)
 			r := resourceAwsRoute53ResolverQueryLogConfig()
 			d := r.Data(nil)
-			d.SetId(id)
-			err := r.Delete(d, client)
-
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			d.SetId(aws.StringValue(queryLogConfig.Id))
+
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !isLast
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Route53 Resolver Query Log Configs sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Route53 Resolver Query Log Configs: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Route53 Resolver Query Log Configs: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSRoute53ResolverQueryLogConfig_basic(t *testing.T) {
@@ -180,7 +175,7 @@ func TestAccAWSRoute53ResolverQueryLogConfig_tags(t *testing.T) {
 }
 
 func testAccCheckRoute53ResolverQueryLogConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_query_log_config" {
@@ -213,7 +208,7 @@ func testAccCheckRoute53ResolverQueryLogConfigExists(n string, v *route53resolve
 			return fmt.Errorf("No Route 53 Resolver Query Log Config ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 		out, err := finder.ResolverQueryLogConfigByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
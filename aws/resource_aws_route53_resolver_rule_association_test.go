@@ -8,27 +8,28 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_rule_association", &resource.Sweeper{
-		Name: "aws_route53_resolver_rule_association",
-		F:    testSweepRoute53ResolverRuleAssociations,
+	sweep.AddTestSweepers("aws_route53_resolver_rule_association", &sweep.Sweeper{
+		Name:    "aws_route53_resolver_rule_association",
+		Service: "route53resolver",
+		List:    testSweepRoute53ResolverRuleAssociations,
 	})
 }
 
-func testSweepRoute53ResolverRuleAssociations(region string) error {
+func testSweepRoute53ResolverRuleAssociations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).route53resolverconn
+	conn := client.(*AWSClient).route53resolverconn()
+	var sweepables []sweep.Sweepable
 
-	var errors error
 	err = conn.ListResolverRuleAssociationsPages(&route53resolver.ListResolverRuleAssociationsInput{}, func(page *route53resolver.ListResolverRuleAssociationsOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -36,44 +37,51 @@ func testSweepRoute53ResolverRuleAssociations(region string) error {
 
 		for _, resolverRuleAssociation := range page.ResolverRuleAssociations {
 			id := aws.StringValue(resolverRuleAssociation.Id)
-
-			log.Printf("[INFO] Deleting Route53 Resolver rule association %q", id)
-			_, err := conn.DisassociateResolverRule(&route53resolver.DisassociateResolverRuleInput{
+			input := &route53resolver.DisassociateResolverRuleInput{
 				ResolverRuleId: resolverRuleAssociation.ResolverRuleId,
 				VPCId:          resolverRuleAssociation.VPCId,
-			})
-			if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
-				continue
-			}
-			if testSweepSkipSweepError(err) {
-				log.Printf("[INFO] Skipping Route53 Resolver rule association %q: %s", id, err)
-				continue
-			}
-			if err != nil {
-				errors = multierror.Append(errors, fmt.Errorf("error deleting Route53 Resolver rule association (%s): %w", id, err))
-				continue
 			}
 
-			err = route53ResolverRuleAssociationWaitUntilTargetState(conn, id, 10*time.Minute,
-				[]string{route53resolver.ResolverRuleAssociationStatusDeleting},
-				[]string{route53ResolverRuleAssociationStatusDeleted})
-			if err != nil {
-				errors = multierror.Append(errors, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DisassociateResolverRule(input)
+
+				if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+					return nil
+				}
+
+				if testSweepSkipSweepError(err) {
+					log.Printf("[INFO] Skipping Route53 Resolver rule association %q: %s", id, err)
+					return nil
+				}
+
+				if err != nil {
+					return err
+				}
+
+				err = route53ResolverRuleAssociationWaitUntilTargetState(conn, id, 10*time.Minute,
+					[]string{route53resolver.ResolverRuleAssociationStatusDeleting},
+					[]string{route53ResolverRuleAssociationStatusDeleted})
+
+				if err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}).WithName(aws.StringValue(resolverRuleAssociation.Name)))
 		}
 
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Route53 Resolver rule association sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		errors = multierror.Append(errors, fmt.Errorf("error retrievingRoute53 Resolver rule associations: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Route53 Resolver rule associations: %w", err)
 	}
 
-	return errors
+	return sweepables, nil
 }
 
 func TestAccAWSRoute53ResolverRuleAssociation_basic(t *testing.T) {
@@ -108,7 +116,7 @@ func TestAccAWSRoute53ResolverRuleAssociation_basic(t *testing.T) {
 }
 
 func testAccCheckRoute53ResolverRuleAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_rule_association" {
 			continue
@@ -141,7 +149,7 @@ func testAccCheckRoute53ResolverRuleAssociationExists(n string, assn *route53res
 			return fmt.Errorf("No Route 53 Resolver rule association ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 		resp, err := conn.GetResolverRuleAssociation(&route53resolver.GetResolverRuleAssociationInput{
 			ResolverRuleAssociationId: aws.String(rs.Primary.ID),
 		})
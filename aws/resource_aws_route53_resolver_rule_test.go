@@ -8,30 +8,31 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_rule", &resource.Sweeper{
-		Name: "aws_route53_resolver_rule",
-		F:    testSweepRoute53ResolverRules,
+	sweep.AddTestSweepers("aws_route53_resolver_rule", &sweep.Sweeper{
+		Name:    "aws_route53_resolver_rule",
+		Service: "route53resolver",
+		List:    testSweepRoute53ResolverRules,
 		Dependencies: []string{
 			"aws_route53_resolver_rule_association",
 		},
 	})
 }
 
-func testSweepRoute53ResolverRules(region string) error {
+func testSweepRoute53ResolverRules(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).route53resolverconn
+	conn := client.(*AWSClient).route53resolverconn()
+	var sweepables []sweep.Sweepable
 
-	var errors error
 	err = conn.ListResolverRulesPages(&route53resolver.ListResolverRulesInput{}, func(page *route53resolver.ListResolverRulesOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -40,44 +41,47 @@ func testSweepRoute53ResolverRules(region string) error {
 		for _, resolverRule := range page.ResolverRules {
 			id := aws.StringValue(resolverRule.Id)
 
-			ownerID := aws.StringValue(resolverRule.OwnerId)
-			if ownerID != client.(*AWSClient).accountid {
-				log.Printf("[INFO] Skipping Route53 Resolver rule %q, owned by %q", id, ownerID)
+			if aws.StringValue(resolverRule.OwnerId) != client.(*AWSClient).accountid {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Route53 Resolver rule %q", id)
-			_, err := conn.DeleteResolverRule(&route53resolver.DeleteResolverRuleInput{
-				ResolverRuleId: aws.String(id),
-			})
-			if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				errors = multierror.Append(errors, fmt.Errorf("error deleting Route53 Resolver rule (%s): %w", id, err))
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteResolverRule(&route53resolver.DeleteResolverRuleInput{
+					ResolverRuleId: aws.String(id),
+				})
 
-			err = route53ResolverRuleWaitUntilTargetState(conn, id, 10*time.Minute,
-				[]string{route53resolver.ResolverRuleStatusDeleting},
-				[]string{route53ResolverRuleStatusDeleted})
-			if err != nil {
-				errors = multierror.Append(errors, err)
-				continue
-			}
+				if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+					return nil
+				}
+
+				if err != nil {
+					return err
+				}
+
+				err = route53ResolverRuleWaitUntilTargetState(conn, id, 10*time.Minute,
+					[]string{route53resolver.ResolverRuleStatusDeleting},
+					[]string{route53ResolverRuleStatusDeleted})
+
+				if err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}).WithName(aws.StringValue(resolverRule.Name)))
 		}
 
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Route53 Resolver rule sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		errors = multierror.Append(errors, fmt.Errorf("error retrievingRoute53 Resolver rules: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Route53 Resolver rules: %w", err)
 	}
 
-	return errors
+	return sweepables, nil
 }
 
 func TestAccAWSRoute53ResolverRule_basic(t *testing.T) {
@@ -404,7 +408,7 @@ func testAccCheckRoute53ResolverRulesDifferent(before, after *route53resolver.Re
 }
 
 func testAccCheckRoute53ResolverRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_rule" {
@@ -438,7 +442,7 @@ func testAccCheckRoute53ResolverRuleExists(n string, rule *route53resolver.Resol
 			return fmt.Errorf("No Route 53 Resolver rule ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 		res, err := conn.GetResolverRule(&route53resolver.GetResolverRuleInput{
 			ResolverRuleId: aws.String(rs.Primary.ID),
 		})
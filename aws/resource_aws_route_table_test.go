@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -14,132 +15,110 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
-		Name: "aws_route_table",
-		F:    testSweepRouteTables,
+	sweep.AddTestSweepers("aws_route_table", &sweep.Sweeper{
+		Name:    "aws_route_table",
+		Service: "ec2",
+		List:    testSweepRouteTables,
 	})
 }
 
-func testSweepRouteTables(region string) error {
+func testSweepRouteTables(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-
-	conn := client.(*AWSClient).ec2conn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).ec2conn()
+	var sweepables []sweep.Sweepable
 
 	input := &ec2.DescribeRouteTablesInput{}
 
-	err = conn.DescribeRouteTablesPages(input, func(page *ec2.DescribeRouteTablesOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
+	err = lister.DescribeRouteTablesItems(context.Background(), conn, input, func(routeTable *ec2.RouteTable) bool {
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(routeTable.RouteTableId), func() error {
+			return testSweepRouteTable(conn, routeTable)
+		}).WithTags(keyvaluetags.Ec2KeyValueTags(routeTable.Tags).IgnoreAws().Map()))
 
-		for _, routeTable := range page.RouteTables {
-			if routeTable == nil {
-				continue
-			}
+		return true
+	})
 
-			id := aws.StringValue(routeTable.RouteTableId)
-			isMainRouteTableAssociation := false
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Route Table sweep for %s: %s", region, err)
+		return sweepables, nil
+	}
 
-			for _, routeTableAssociation := range routeTable.Associations {
-				if routeTableAssociation == nil {
-					continue
-				}
+	if err != nil {
+		return sweepables, fmt.Errorf("error listing EC2 Route Tables: %w", err)
+	}
 
-				if aws.BoolValue(routeTableAssociation.Main) {
-					isMainRouteTableAssociation = true
-					break
-				}
+	return sweepables, nil
+}
 
-				associationID := aws.StringValue(routeTableAssociation.RouteTableAssociationId)
+// testSweepRouteTable disassociates and deletes a route table, or deletes the
+// routes of a main route table.
+func testSweepRouteTable(conn *ec2.EC2, routeTable *ec2.RouteTable) error {
+	isMainRouteTableAssociation := false
 
-				input := &ec2.DisassociateRouteTableInput{
-					AssociationId: routeTableAssociation.RouteTableAssociationId,
-				}
+	for _, routeTableAssociation := range routeTable.Associations {
+		if routeTableAssociation == nil {
+			continue
+		}
 
-				log.Printf("[DEBUG] Deleting EC2 Route Table Association: %s", associationID)
-				_, err := conn.DisassociateRouteTable(input)
+		if aws.BoolValue(routeTableAssociation.Main) {
+			isMainRouteTableAssociation = true
+			break
+		}
 
-				if err != nil {
-					sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s) Association (%s): %w", id, associationID, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
-			}
+		input := &ec2.DisassociateRouteTableInput{
+			AssociationId: routeTableAssociation.RouteTableAssociationId,
+		}
 
-			if isMainRouteTableAssociation {
-				for _, route := range routeTable.Routes {
-					if route == nil {
-						continue
-					}
-
-					if aws.StringValue(route.GatewayId) == "local" {
-						continue
-					}
-
-					// Prevent deleting default VPC route for Internet Gateway
-					// which some testing is still reliant on operating correctly
-					if strings.HasPrefix(aws.StringValue(route.GatewayId), "igw-") && aws.StringValue(route.DestinationCidrBlock) == "0.0.0.0/0" {
-						continue
-					}
-
-					input := &ec2.DeleteRouteInput{
-						DestinationCidrBlock:     route.DestinationCidrBlock,
-						DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
-						RouteTableId:             routeTable.RouteTableId,
-					}
-
-					log.Printf("[DEBUG] Deleting EC2 Route Table (%s) Route", id)
-					_, err := conn.DeleteRoute(input)
-
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s) Route: %w", id, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
-					}
-				}
+		if _, err := conn.DisassociateRouteTable(input); err != nil {
+			return fmt.Errorf("error deleting Association (%s): %w", aws.StringValue(routeTableAssociation.RouteTableAssociationId), err)
+		}
+	}
 
-				continue
-			}
+	if !isMainRouteTableAssociation {
+		_, err := conn.DeleteRouteTable(&ec2.DeleteRouteTableInput{
+			RouteTableId: routeTable.RouteTableId,
+		})
 
-			input := &ec2.DeleteRouteTableInput{
-				RouteTableId: routeTable.RouteTableId,
-			}
+		return err
+	}
 
-			log.Printf("[DEBUG] Deleting EC2 Route Table: %s", id)
-			_, err := conn.DeleteRouteTable(input)
+	var errs *multierror.Error
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting EC2 Route Table (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+	for _, route := range routeTable.Routes {
+		if route == nil {
+			continue
 		}
 
-		return !lastPage
-	})
+		if aws.StringValue(route.GatewayId) == "local" {
+			continue
+		}
 
-	if testSweepSkipSweepError(err) {
-		log.Printf("[WARN] Skipping EC2 Route Table sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
-	}
+		// Prevent deleting default VPC route for Internet Gateway
+		// which some testing is still reliant on operating correctly
+		if strings.HasPrefix(aws.StringValue(route.GatewayId), "igw-") && aws.StringValue(route.DestinationCidrBlock) == "0.0.0.0/0" {
+			continue
+		}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EC2 Route Tables: %w", err))
+		input := &ec2.DeleteRouteInput{
+			DestinationCidrBlock:     route.DestinationCidrBlock,
+			DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
+			RouteTableId:             routeTable.RouteTableId,
+		}
+
+		if _, err := conn.DeleteRoute(input); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error deleting Route: %w", err))
+		}
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func TestAccAWSRouteTable_basic(t *testing.T) {
@@ -977,7 +956,7 @@ func testAccCheckRouteTableExists(n string, v *ec2.RouteTable) resource.TestChec
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		resp, err := conn.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
 			RouteTableIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -995,7 +974,7 @@ func testAccCheckRouteTableExists(n string, v *ec2.RouteTable) resource.TestChec
 }
 
 func testAccCheckRouteTableDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route_table" {
@@ -1056,7 +1035,7 @@ func testAccCheckAWSRouteTableRoute(resourceName, destinationAttr, destination,
 // a route to the specified VPC endpoint's prefix list to appear in the specified route table.
 func testAccCheckAWSRouteTableWaitForVpcEndpointRoute(routeTable *ec2.RouteTable, vpce *ec2.VpcEndpoint) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		resp, err := conn.DescribePrefixLists(&ec2.DescribePrefixListsInput{
 			Filters: buildEC2AttributeFilterList(map[string]string{
@@ -8,33 +8,34 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3control"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	awspolicy "github.com/jen20/awspolicyequivalence"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
-		Name: "aws_s3_access_point",
-		F:    testSweepS3AccessPoints,
+	sweep.AddTestSweepers("aws_s3_access_point", &sweep.Sweeper{
+		Name:    "aws_s3_access_point",
+		Service: "s3control",
+		List:    testSweepS3AccessPoints,
 	})
 }
 
-func testSweepS3AccessPoints(region string) error {
+func testSweepS3AccessPoints(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
 
 	accountId := client.(*AWSClient).accountid
-	conn := client.(*AWSClient).s3controlconn
+	conn := client.(*AWSClient).s3controlconn()
 
 	input := &s3control.ListAccessPointsInput{
 		AccountId: aws.String(accountId),
 	}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListAccessPointsPages(input, func(page *s3control.ListAccessPointsOutput, lastPage bool) bool {
 		if page == nil {
@@ -46,21 +47,16 @@ func testSweepS3AccessPoints(region string) error {
 				AccountId: aws.String(accountId),
 				Name:      accessPoint.Name,
 			}
-			name := aws.StringValue(accessPoint.Name)
 
-			log.Printf("[INFO] Deleting S3 Access Point: %s", name)
-			_, err := conn.DeleteAccessPoint(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(accessPoint.Name), func() error {
+				_, err := conn.DeleteAccessPoint(input)
 
-			if isAWSErr(err, "NoSuchAccessPoint", "") {
-				continue
-			}
+				if isAWSErr(err, "NoSuchAccessPoint", "") {
+					return nil
+				}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting S3 Access Point (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return err
+			}))
 		}
 
 		return !lastPage
@@ -68,14 +64,14 @@ func testSweepS3AccessPoints(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping S3 Access Point sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing S3 Access Points: %w", err)
+		return sweepables, fmt.Errorf("error listing S3 Access Points: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSS3AccessPoint_basic(t *testing.T) {
@@ -392,7 +388,7 @@ func testAccCheckAWSS3AccessPointDisappears(n string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 		_, err = conn.DeleteAccessPoint(&s3control.DeleteAccessPointInput{
 			AccountId: aws.String(accountId),
@@ -407,7 +403,7 @@ func testAccCheckAWSS3AccessPointDisappears(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSS3AccessPointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_access_point" {
@@ -446,7 +442,7 @@ func testAccCheckAWSS3AccessPointExists(n string, output *s3control.GetAccessPoi
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 		resp, err := conn.GetAccessPoint(&s3control.GetAccessPointInput{
 			AccountId: aws.String(accountId),
@@ -478,7 +474,7 @@ func testAccCheckAWSS3AccessPointHasPolicy(n string, fn func() string) resource.
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 		resp, err := conn.GetAccessPointPolicy(&s3control.GetAccessPointPolicyInput{
 			AccountId: aws.String(accountId),
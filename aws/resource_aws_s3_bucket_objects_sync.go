@@ -0,0 +1,649 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/go-homedir"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/objectsync"
+)
+
+const (
+	// s3ObjectsSyncDeleteBatchSize is the maximum number of keys of a
+	// DeleteObjects request.
+	s3ObjectsSyncDeleteBatchSize = 1000
+)
+
+func resourceAwsS3BucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAwsS3BucketObjectsSyncCreate,
+		ReadContext:   resourceAwsS3BucketObjectsSyncRead,
+		UpdateContext: resourceAwsS3BucketObjectsSyncUpdate,
+		DeleteContext: resourceAwsS3BucketObjectsSyncDelete,
+
+		CustomizeDiff: resourceAwsS3BucketObjectsSyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.ObjectCannedACLPrivate,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"delete_extraneous": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateS3ObjectsSyncGlob,
+				},
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateS3ObjectsSyncGlob,
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"manifest": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"multipart_chunk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      8 * 1024 * 1024,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_disposition": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_language": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: validateMetadataIsLowerCase,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateS3ObjectsSyncGlob,
+						},
+					},
+				},
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, keyPrefix))
+
+	if err := resourceAwsS3BucketObjectsSyncApply(ctx, d, meta, map[string]interface{}{}, true); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAwsS3BucketObjectsSyncRead(ctx, d, meta)
+}
+
+func resourceAwsS3BucketObjectsSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	filter, err := resourceAwsS3BucketObjectsSyncFilter(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remote := make(map[string]string)
+
+	err = conn.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(keyPrefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, object := range page.Contents {
+			remote[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+
+		return !lastPage
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing S3 Bucket Objects Sync (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error listing S3 Bucket (%s) Objects: %s", bucket, err)
+	}
+
+	// The ETags of objects encrypted with SSE-KMS are not MD5 digests, so
+	// only missing objects can be detected.
+	compareETags := d.Get("server_side_encryption").(string) != s3.ServerSideEncryptionAwsKms && d.Get("kms_key_id").(string) == ""
+
+	manifest := make(map[string]interface{})
+
+	for key, etag := range d.Get("manifest").(map[string]interface{}) {
+		remoteETag, ok := remote[key]
+
+		if !ok {
+			log.Printf("[DEBUG] S3 Bucket (%s) Object (%s) not found", bucket, key)
+			continue
+		}
+
+		if compareETags {
+			manifest[key] = remoteETag
+		} else {
+			manifest[key] = etag
+		}
+	}
+
+	if d.Get("delete_extraneous").(bool) {
+		for key, etag := range remote {
+			if _, ok := manifest[key]; ok {
+				continue
+			}
+
+			if path, ok := objectsync.Path(keyPrefix, key); ok && filter.Match(path) {
+				manifest[key] = etag
+			}
+		}
+	}
+
+	if err := d.Set("manifest", manifest); err != nil {
+		return diag.Errorf("error setting manifest: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	o, _ := d.GetChange("manifest")
+
+	// Changes to the object settings apply to all objects.
+	uploadAll := d.HasChanges("acl", "kms_key_id", "rule", "server_side_encryption", "storage_class")
+
+	if err := resourceAwsS3BucketObjectsSyncApply(ctx, d, meta, o.(map[string]interface{}), uploadAll); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAwsS3BucketObjectsSyncRead(ctx, d, meta)
+}
+
+func resourceAwsS3BucketObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+
+	var keys []string
+	for key := range d.Get("manifest").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	_, err := s3ObjectsSyncDeleteObjects(ctx, conn, bucket, keys)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting S3 Bucket (%s) Objects: %s", bucket, err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsSyncCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"exclude", "include", "key_prefix", "multipart_chunk_size", "source_dir"} {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed("manifest")
+		}
+	}
+
+	files, err := resourceAwsS3BucketObjectsSyncFiles(diff)
+
+	if err != nil {
+		return err
+	}
+
+	keyPrefix := diff.Get("key_prefix").(string)
+	manifest := make(map[string]interface{}, len(files))
+
+	for _, file := range files {
+		manifest[keyPrefix+file.Path] = file.ETag
+	}
+
+	if reflect.DeepEqual(manifest, diff.Get("manifest").(map[string]interface{})) {
+		return nil
+	}
+
+	return diff.SetNew("manifest", manifest)
+}
+
+// resourceAwsS3BucketObjectsSyncApply uploads the local files that are not in
+// the old manifest with the same ETag, or all of them, and deletes the objects
+// in the old manifest that are no longer local files. The manifest is set to
+// the objects that were successfully uploaded or could not be deleted.
+func resourceAwsS3BucketObjectsSyncApply(ctx context.Context, d *schema.ResourceData, meta interface{}, oldManifest map[string]interface{}, uploadAll bool) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	files, err := resourceAwsS3BucketObjectsSyncFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	rules, err := expandS3ObjectsSyncRules(d.Get("rule").([]interface{}))
+
+	if err != nil {
+		return err
+	}
+
+	manifest := make(map[string]interface{}, len(oldManifest))
+	for key, etag := range oldManifest {
+		manifest[key] = etag
+	}
+
+	var uploads []objectsync.File
+	local := make(map[string]bool, len(files))
+
+	for _, file := range files {
+		key := keyPrefix + file.Path
+		local[key] = true
+
+		if uploadAll || oldManifest[key] != file.ETag {
+			uploads = append(uploads, file)
+		}
+	}
+
+	var deletes []string
+
+	for key := range oldManifest {
+		if !local[key] {
+			deletes = append(deletes, key)
+		}
+	}
+
+	sort.Strings(deletes)
+
+	var errs *multierror.Error
+
+	log.Printf("[DEBUG] Uploading %d and deleting %d S3 Bucket (%s) Objects", len(uploads), len(deletes), bucket)
+
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		u.PartSize = int64(d.Get("multipart_chunk_size").(int))
+	})
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	ch := make(chan objectsync.File)
+
+	for i := 0; i < d.Get("parallelism").(int); i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for file := range ch {
+				key := keyPrefix + file.Path
+				err := resourceAwsS3BucketObjectsSyncUpload(ctx, d, uploader, rules, file, key)
+
+				mu.Lock()
+				if err != nil {
+					// Removing the object from the manifest makes it be uploaded again.
+					delete(manifest, key)
+					errs = multierror.Append(errs, err)
+				} else {
+					manifest[key] = file.ETag
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, file := range uploads {
+		ch <- file
+	}
+
+	close(ch)
+	wg.Wait()
+
+	deleted, err := s3ObjectsSyncDeleteObjects(ctx, conn, bucket, deletes)
+
+	for _, key := range deleted {
+		delete(manifest, key)
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error deleting S3 Bucket (%s) Objects: %w", bucket, err))
+	}
+
+	if err := d.Set("manifest", manifest); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error setting manifest: %w", err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func resourceAwsS3BucketObjectsSyncUpload(ctx context.Context, d *schema.ResourceData, uploader *s3manager.Uploader, rules []*s3ObjectsSyncRule, file objectsync.File, key string) error {
+	body, err := os.Open(file.FullPath)
+
+	if err != nil {
+		return fmt.Errorf("error opening S3 bucket objects sync source (%s): %w", file.FullPath, err)
+	}
+
+	defer func() {
+		if err := body.Close(); err != nil {
+			log.Printf("[WARN] Error closing S3 bucket objects sync source (%s): %s", file.FullPath, err)
+		}
+	}()
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3manager.UploadInput{
+		ACL:    aws.String(d.Get("acl").(string)),
+		Body:   body,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	metadata := make(map[string]*string)
+
+	for _, rule := range rules {
+		if !rule.filter.Match(file.Path) {
+			continue
+		}
+
+		if rule.cacheControl != "" {
+			input.CacheControl = aws.String(rule.cacheControl)
+		}
+
+		if rule.contentDisposition != "" {
+			input.ContentDisposition = aws.String(rule.contentDisposition)
+		}
+
+		if rule.contentEncoding != "" {
+			input.ContentEncoding = aws.String(rule.contentEncoding)
+		}
+
+		if rule.contentLanguage != "" {
+			input.ContentLanguage = aws.String(rule.contentLanguage)
+		}
+
+		if rule.contentType != "" {
+			input.ContentType = aws.String(rule.contentType)
+		}
+
+		for k, v := range rule.metadata {
+			metadata[k] = v
+		}
+	}
+
+	if len(metadata) > 0 {
+		input.Metadata = metadata
+	}
+
+	if input.ContentType == nil {
+		contentType, err := objectsync.ContentType(file.FullPath)
+
+		if err != nil {
+			return err
+		}
+
+		input.ContentType = aws.String(contentType)
+	}
+
+	log.Printf("[DEBUG] Uploading S3 Bucket (%s) Object (%s) from %s", bucket, key, file.FullPath)
+
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
+		return fmt.Errorf("error uploading S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+	}
+
+	return nil
+}
+
+// s3ObjectsSyncDeleteObjects deletes the objects in batches, returning the
+// keys of the deleted objects.
+func s3ObjectsSyncDeleteObjects(ctx context.Context, conn *s3.S3, bucket string, keys []string) ([]string, error) {
+	var deleted []string
+	var errs *multierror.Error
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > s3ObjectsSyncDeleteBatchSize {
+			n = s3ObjectsSyncDeleteBatchSize
+		}
+
+		batch := keys[:n]
+		keys = keys[n:]
+
+		objects := make([]*s3.ObjectIdentifier, 0, len(batch))
+		for _, key := range batch {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		log.Printf("[DEBUG] Deleting %d S3 Bucket (%s) Objects", len(objects), bucket)
+		output, err := conn.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+
+		if err != nil {
+			return deleted, err
+		}
+
+		failed := make(map[string]bool)
+
+		for _, v := range output.Errors {
+			key := aws.StringValue(v.Key)
+			failed[key] = true
+			errs = multierror.Append(errs, fmt.Errorf("error deleting S3 Bucket (%s) Object (%s): %s: %s", bucket, key, aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+
+		for _, key := range batch {
+			if !failed[key] {
+				deleted = append(deleted, key)
+			}
+		}
+	}
+
+	return deleted, errs.ErrorOrNil()
+}
+
+// s3ObjectsSyncGetter is implemented by both schema.ResourceData and
+// schema.ResourceDiff.
+type s3ObjectsSyncGetter interface {
+	Get(string) interface{}
+}
+
+func resourceAwsS3BucketObjectsSyncFilter(d s3ObjectsSyncGetter) (*objectsync.Filter, error) {
+	include := aws.StringValueSlice(expandStringList(d.Get("include").([]interface{})))
+	exclude := aws.StringValueSlice(expandStringList(d.Get("exclude").([]interface{})))
+
+	return objectsync.NewFilter(include, exclude)
+}
+
+// resourceAwsS3BucketObjectsSyncFiles returns the local files to upload.
+func resourceAwsS3BucketObjectsSyncFiles(d s3ObjectsSyncGetter) ([]objectsync.File, error) {
+	sourceDir := d.Get("source_dir").(string)
+	dir, err := homedir.Expand(sourceDir)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source_dir (%s): %w", sourceDir, err)
+	}
+
+	filter, err := resourceAwsS3BucketObjectsSyncFilter(d)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return objectsync.Walk(dir, filter, int64(d.Get("multipart_chunk_size").(int)))
+}
+
+type s3ObjectsSyncRule struct {
+	filter             *objectsync.Filter
+	cacheControl       string
+	contentDisposition string
+	contentEncoding    string
+	contentLanguage    string
+	contentType        string
+	metadata           map[string]*string
+}
+
+func expandS3ObjectsSyncRules(tfList []interface{}) ([]*s3ObjectsSyncRule, error) {
+	var rules []*s3ObjectsSyncRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		filter, err := objectsync.NewFilter([]string{tfMap["pattern"].(string)}, nil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, &s3ObjectsSyncRule{
+			filter:             filter,
+			cacheControl:       tfMap["cache_control"].(string),
+			contentDisposition: tfMap["content_disposition"].(string),
+			contentEncoding:    tfMap["content_encoding"].(string),
+			contentLanguage:    tfMap["content_language"].(string),
+			contentType:        tfMap["content_type"].(string),
+			metadata:           stringMapToPointers(tfMap["metadata"].(map[string]interface{})),
+		})
+	}
+
+	return rules, nil
+}
+
+func validateS3ObjectsSyncGlob(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := objectsync.CompileGlob(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
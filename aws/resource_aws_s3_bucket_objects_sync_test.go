@@ -0,0 +1,456 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSS3BucketObjectsSync_basic(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	dir := testAccAWSS3BucketObjectsSyncCreateTempDir(t, map[string]string{
+		"index.html":       "<p>hello</p>",
+		"css/site.css":     "p {}",
+		"img/logo":         "\x89PNG\x0D\x0A\x1A\x0A",
+		"drafts/next.html": "<p>next</p>",
+	})
+	defer os.RemoveAll(dir)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/css/site.css"),
+					resource.TestCheckNoResourceAttr(resourceName, "manifest.site/drafts/next.html"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "site/index.html", &obj),
+					testAccCheckAWSS3BucketObjectsSyncManifestETag(resourceName, "site/index.html", &obj),
+					testAccCheckAWSS3BucketObjectsSyncObjectContentType(&obj, "text/html; charset=utf-8"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "site/img/logo", &obj),
+					testAccCheckAWSS3BucketObjectsSyncObjectContentType(&obj, "image/png"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObjectsSync_updates(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	dir := testAccAWSS3BucketObjectsSyncCreateTempDir(t, map[string]string{
+		"index.html":   "<p>hello</p>",
+		"css/site.css": "p {}",
+	})
+	defer os.RemoveAll(dir)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccAWSS3BucketObjectsSyncWriteFiles(t, dir, map[string]string{
+						"index.html": "<p>updated</p>",
+						"about.html": "<p>about</p>",
+					})
+
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/about.html"),
+					resource.TestCheckNoResourceAttr(resourceName, "manifest.site/css/site.css"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "site/index.html", &obj),
+					testAccCheckAWSS3BucketObjectsSyncManifestETag(resourceName, "site/index.html", &obj),
+					testAccCheckAWSS3BucketObjectsSyncObjectNotExists(resourceName, "site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObjectsSync_rules(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	dir := testAccAWSS3BucketObjectsSyncCreateTempDir(t, map[string]string{
+		"index.html":   "<p>hello</p>",
+		"css/site.css": "p {}",
+	})
+	defer os.RemoveAll(dir)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfigRules(rName, dir, "max-age=60"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "index.html", &obj),
+					testAccCheckAWSS3BucketObjectsSyncObjectCacheControl(&obj, "no-cache"),
+					testAccCheckAWSS3BucketObjectsSyncObjectMetadata(&obj, "team", "web"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "css/site.css", &obj),
+					testAccCheckAWSS3BucketObjectsSyncObjectCacheControl(&obj, "max-age=60"),
+					testAccCheckAWSS3BucketObjectsSyncObjectMetadata(&obj, "team", "web"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfigRules(rName, dir, "max-age=3600"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "css/site.css", &obj),
+					testAccCheckAWSS3BucketObjectsSyncObjectCacheControl(&obj, "max-age=3600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObjectsSync_deleteExtraneous(t *testing.T) {
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	dir := testAccAWSS3BucketObjectsSyncCreateTempDir(t, map[string]string{
+		"index.html": "<p>hello</p>",
+	})
+	defer os.RemoveAll(dir)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfigDeleteExtraneous(rName, dir, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccAWSS3BucketObjectsSyncPutObject(t, rName, "site/stale.html")
+					testAccAWSS3BucketObjectsSyncPutObject(t, rName, "site/stale.txt")
+					testAccAWSS3BucketObjectsSyncPutObject(t, rName, "other/stale.html")
+				},
+				Config: testAccAWSS3BucketObjectsSyncConfigDeleteExtraneous(rName, dir, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "1"),
+					testAccCheckAWSS3BucketObjectsSyncObjectNotExists(resourceName, "site/stale.html"),
+					// Excluded and outside the key prefix.
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "site/stale.txt", &s3.HeadObjectOutput{}),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "other/stale.html", &s3.HeadObjectOutput{}),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccAWSS3BucketObjectsSyncPutObject(t, rName, "site/kept.html")
+				},
+				Config: testAccAWSS3BucketObjectsSyncConfigDeleteExtraneous(rName, dir, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "1"),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "site/kept.html", &s3.HeadObjectOutput{}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObjectsSync_multipart(t *testing.T) {
+	var obj s3.HeadObjectOutput
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	dir := testAccAWSS3BucketObjectsSyncCreateTempDir(t, map[string]string{
+		"large.bin": string(bytes.Repeat([]byte("0123456789"), 600*1024)),
+		"small.txt": "hello",
+	})
+	defer os.RemoveAll(dir)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfigMultipart(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "manifest.large.bin", regexp.MustCompile(`^[0-9a-f]{32}-2$`)),
+					resource.TestMatchResourceAttr(resourceName, "manifest.small.txt", regexp.MustCompile(`^[0-9a-f]{32}$`)),
+					testAccCheckAWSS3BucketObjectsSyncObjectExists(resourceName, "large.bin", &obj),
+					testAccCheckAWSS3BucketObjectsSyncManifestETag(resourceName, "large.bin", &obj),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketObjectsSyncDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_objects_sync" {
+			continue
+		}
+
+		bucket := rs.Primary.Attributes["bucket"]
+
+		for k := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "manifest.") || k == "manifest.%" {
+				continue
+			}
+
+			key := strings.TrimPrefix(k, "manifest.")
+
+			_, err := conn.HeadObject(&s3.HeadObjectInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+			})
+
+			if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket (%s) Object (%s) still exists", bucket, key)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3BucketObjectsSyncObjectExists(n, key string, obj *s3.HeadObjectOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn()
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Bucket (%s) Object (%s): %w", rs.Primary.Attributes["bucket"], key, err)
+		}
+
+		*obj = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncObjectNotExists(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn()
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket (%s) Object (%s) still exists", rs.Primary.Attributes["bucket"], key)
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncManifestETag(n, key string, obj *s3.HeadObjectOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return resource.TestCheckResourceAttr(n, "manifest."+key, strings.Trim(aws.StringValue(obj.ETag), `"`))(s)
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncObjectContentType(obj *s3.HeadObjectOutput, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValue(obj.ContentType); got != expected {
+			return fmt.Errorf("expected Content-Type %q, got %q", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncObjectCacheControl(obj *s3.HeadObjectOutput, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValue(obj.CacheControl); got != expected {
+			return fmt.Errorf("expected Cache-Control %q, got %q", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncObjectMetadata(obj *s3.HeadObjectOutput, key, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// AWS Go SDK capitalizes metadata, see https://github.com/aws/aws-sdk-go/issues/445
+		for k, v := range obj.Metadata {
+			if strings.EqualFold(k, key) {
+				if got := aws.StringValue(v); got != expected {
+					return fmt.Errorf("expected metadata %s %q, got %q", key, expected, got)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected metadata %s, got none", key)
+	}
+}
+
+func testAccAWSS3BucketObjectsSyncPutObject(t *testing.T, bucket, key string) {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn()
+
+	_, err := conn.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   strings.NewReader("stale"),
+	})
+
+	if err != nil {
+		t.Fatalf("error putting S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+	}
+}
+
+func testAccAWSS3BucketObjectsSyncCreateTempDir(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "tf-acc-s3-objects-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccAWSS3BucketObjectsSyncWriteFiles(t, dir, files)
+
+	return dir
+}
+
+func testAccAWSS3BucketObjectsSyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	for path, content := range files {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccAWSS3BucketObjectsSyncConfigBucket(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccAWSS3BucketObjectsSyncConfig(rName, dir string) string {
+	return composeConfig(testAccAWSS3BucketObjectsSyncConfigBucket(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[1]q
+  exclude    = ["drafts/**"]
+}
+`, dir))
+}
+
+func testAccAWSS3BucketObjectsSyncConfigRules(rName, dir, cssCacheControl string) string {
+	return composeConfig(testAccAWSS3BucketObjectsSyncConfigBucket(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[1]q
+
+  rule {
+    pattern = "**"
+
+    metadata = {
+      team = "web"
+    }
+  }
+
+  rule {
+    pattern       = "**/*.html"
+    cache_control = "no-cache"
+  }
+
+  rule {
+    pattern       = "css/**"
+    cache_control = %[2]q
+  }
+}
+`, dir, cssCacheControl))
+}
+
+func testAccAWSS3BucketObjectsSyncConfigDeleteExtraneous(rName, dir string, deleteExtraneous bool) string {
+	return composeConfig(testAccAWSS3BucketObjectsSyncConfigBucket(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket            = aws_s3_bucket.test.bucket
+  key_prefix        = "site/"
+  source_dir        = %[1]q
+  exclude           = ["**/*.txt"]
+  delete_extraneous = %[2]t
+}
+`, dir, deleteExtraneous))
+}
+
+func testAccAWSS3BucketObjectsSyncConfigMultipart(rName, dir string) string {
+	return composeConfig(testAccAWSS3BucketObjectsSyncConfigBucket(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket               = aws_s3_bucket.test.bucket
+  source_dir           = %[1]q
+  multipart_chunk_size = 5242880
+}
+`, dir))
+}
@@ -94,7 +94,7 @@ func TestAccAWSS3ControlBucketPolicy_Policy(t *testing.T) {
 }
 
 func testAccCheckAWSS3ControlBucketPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3control_bucket_policy" {
@@ -147,7 +147,7 @@ func testAccCheckAWSS3ControlBucketPolicyExists(resourceName string) resource.Te
 			return fmt.Errorf("no resource ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 		parsedArn, err := arn.Parse(rs.Primary.ID)
 
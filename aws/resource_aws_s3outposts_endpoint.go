@@ -73,7 +73,7 @@ func resourceAwsS3OutpostsEndpoint() *schema.Resource {
 }
 
 func resourceAwsS3OutpostsEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3outpostsconn
+	conn := meta.(*AWSClient).s3outpostsconn()
 
 	input := &s3outposts.CreateEndpointInput{
 		OutpostId:       aws.String(d.Get("outpost_id").(string)),
@@ -101,7 +101,7 @@ func resourceAwsS3OutpostsEndpointCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsS3OutpostsEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3outpostsconn
+	conn := meta.(*AWSClient).s3outpostsconn()
 
 	endpoint, err := finder.Endpoint(conn, d.Id())
 
@@ -136,7 +136,7 @@ func resourceAwsS3OutpostsEndpointRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsS3OutpostsEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3outpostsconn
+	conn := meta.(*AWSClient).s3outpostsconn()
 
 	parsedArn, err := arn.Parse(d.Id())
 
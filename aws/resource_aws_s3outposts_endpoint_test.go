@@ -65,7 +65,7 @@ func TestAccAWSS3OutpostsEndpoint_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSS3OutpostsEndpointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).s3outpostsconn
+	conn := testAccProvider.Meta().(*AWSClient).s3outpostsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3outposts_endpoint" {
@@ -97,7 +97,7 @@ func testAccCheckAWSS3OutpostsEndpointExists(resourceName string) resource.TestC
 			return fmt.Errorf("no resource ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3outpostsconn
+		conn := testAccProvider.Meta().(*AWSClient).s3outpostsconn()
 
 		endpoint, err := finder.Endpoint(conn, rs.Primary.ID)
 
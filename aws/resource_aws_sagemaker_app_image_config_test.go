@@ -7,59 +7,52 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_app_image_config", &resource.Sweeper{
-		Name: "aws_sagemaker_app_image_config",
-		F:    testSweepSagemakerAppImageConfigs,
+	sweep.AddTestSweepers("aws_sagemaker_app_image_config", &sweep.Sweeper{
+		Name:    "aws_sagemaker_app_image_config",
+		Service: "sagemaker",
+		List:    testSweepSagemakerAppImageConfigs,
 	})
 }
 
-func testSweepSagemakerAppImageConfigs(region string) error {
+func testSweepSagemakerAppImageConfigs(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
 	input := &sagemaker.ListAppImageConfigsInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.ListAppImageConfigs(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping SageMaker App Image Config for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil()
+			return sweepables, nil
 		}
 
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Example Thing: %w", err))
-			return sweeperErrs
+			return sweepables, fmt.Errorf("error retrieving SageMaker App Image Configs: %w", err)
 		}
 
 		for _, config := range output.AppImageConfigs {
-			name := aws.StringValue(config.AppImageConfigName)
 			input := &sagemaker.DeleteAppImageConfigInput{
-				AppImageConfigName: aws.String(name),
+				AppImageConfigName: config.AppImageConfigName,
 			}
 
-			log.Printf("[INFO] Deleting SageMaker App Image Config: %s", name)
-			_, err := conn.DeleteAppImageConfig(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(config.AppImageConfigName), func() error {
+				_, err := conn.DeleteAppImageConfig(input)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting SageMaker App Image Config (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return err
+			}))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -69,7 +62,7 @@ func testSweepSagemakerAppImageConfigs(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSSagemakerAppImageConfig_basic(t *testing.T) {
@@ -211,7 +204,7 @@ func TestAccAWSSagemakerAppImageConfig_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerAppImageConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_app_image_config" {
@@ -242,7 +235,7 @@ func testAccCheckAWSSagemakerAppImageConfigExists(n string, config *sagemaker.De
 			return fmt.Errorf("No sagmaker App Image Config ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		resp, err := finder.AppImageConfigByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
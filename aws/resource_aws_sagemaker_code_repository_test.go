@@ -11,35 +11,36 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_code_repository", &resource.Sweeper{
-		Name: "aws_sagemaker_code_repository",
-		F:    testSweepSagemakerCodeRepositories,
+	sweep.AddTestSweepers("aws_sagemaker_code_repository", &sweep.Sweeper{
+		Name:    "aws_sagemaker_code_repository",
+		Service: "sagemaker",
+		List:    testSweepSagemakerCodeRepositories,
 	})
 }
 
-func testSweepSagemakerCodeRepositories(region string) error {
+func testSweepSagemakerCodeRepositories(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListCodeRepositoriesPages(&sagemaker.ListCodeRepositoriesInput{}, func(page *sagemaker.ListCodeRepositoriesOutput, lastPage bool) bool {
 		for _, instance := range page.CodeRepositorySummaryList {
-			name := aws.StringValue(instance.CodeRepositoryName)
-
 			input := &sagemaker.DeleteCodeRepositoryInput{
 				CodeRepositoryName: instance.CodeRepositoryName,
 			}
 
-			log.Printf("[INFO] Deleting SageMaker Code Repository: %s", name)
-			if _, err := conn.DeleteCodeRepository(input); err != nil {
-				log.Printf("[ERROR] Error deleting SageMaker Code Repository (%s): %s", name, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(instance.CodeRepositoryName), func() error {
+				_, err := conn.DeleteCodeRepository(input)
+
+				return err
+			}))
 		}
 
 		return !lastPage
@@ -47,14 +48,14 @@ func testSweepSagemakerCodeRepositories(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SageMaker Code Repository sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving SageMaker Code Repositorys: %w", err)
+		return sweepables, fmt.Errorf("Error retrieving SageMaker Code Repositorys: %w", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSSagemakerCodeRepository_basic(t *testing.T) {
@@ -180,7 +181,7 @@ func TestAccAWSSagemakerCodeRepository_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerCodeRepositoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_code_repository" {
@@ -211,7 +212,7 @@ func testAccCheckAWSSagemakerCodeRepositoryExists(n string, codeRepo *sagemaker.
 			return fmt.Errorf("No sagmaker Code Repository ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		resp, err := finder.CodeRepositoryByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
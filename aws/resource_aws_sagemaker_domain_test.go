@@ -8,16 +8,19 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_domain", &resource.Sweeper{
-		Name: "aws_sagemaker_domain",
-		F:    testSweepSagemakerDomains,
+	sweep.AddTestSweepers("aws_sagemaker_domain", &sweep.Sweeper{
+		Name:    "aws_sagemaker_domain",
+		Service: "sagemaker",
+		List:    testSweepSagemakerDomains,
 		Dependencies: []string{
 			"aws_efs_mount_target",
 			"aws_efs_file_system",
@@ -25,29 +28,34 @@ func init() {
 	})
 }
 
-func testSweepSagemakerDomains(region string) error {
+func testSweepSagemakerDomains(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
+	var sweepables []sweep.Sweepable
+	var sweeperErrs *multierror.Error
 
 	err = conn.ListDomainsPages(&sagemaker.ListDomainsInput{}, func(page *sagemaker.ListDomainsOutput, lastPage bool) bool {
 		for _, instance := range page.Domains {
 			domainArn := aws.StringValue(instance.DomainArn)
 			domainID, err := decodeSagemakerDomainID(domainArn)
+
 			if err != nil {
-				log.Printf("[ERROR] Error parsing sagemaker domain arn (%s): %s", domainArn, err)
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error parsing SageMaker Domain ARN (%s): %w", domainArn, err))
+				continue
 			}
+
 			input := &sagemaker.DeleteDomainInput{
 				DomainId: aws.String(domainID),
 			}
 
-			log.Printf("[INFO] Deleting SageMaker domain: %s", domainArn)
-			if _, err := conn.DeleteDomain(input); err != nil {
-				log.Printf("[ERROR] Error deleting SageMaker domain (%s): %s", domainArn, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(domainID, func() error {
+				_, err := conn.DeleteDomain(input)
+
+				return err
+			}).WithName(aws.StringValue(instance.DomainName)))
 		}
 
 		return !lastPage
@@ -55,14 +63,14 @@ func testSweepSagemakerDomains(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SageMaker domain sweep for %s: %s", region, err)
-		return nil
+		return sweepables, sweeperErrs.ErrorOrNil()
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving SageMaker domains: %w", err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error retrieving SageMaker domains: %w", err))
 	}
 
-	return nil
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSSagemakerDomain_basic(t *testing.T) {
@@ -376,7 +384,7 @@ func TestAccAWSSagemakerDomain_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerDomainDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_domain" {
@@ -413,7 +421,7 @@ func testAccCheckAWSSagemakerDomainExists(n string, codeRepo *sagemaker.Describe
 			return fmt.Errorf("No sagmaker domain ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		resp, err := finder.DomainByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
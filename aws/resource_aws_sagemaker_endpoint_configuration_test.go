@@ -2,7 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"log"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -10,50 +9,50 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_endpoint_configuration", &resource.Sweeper{
-		Name: "aws_sagemaker_endpoint_configuration",
+	sweep.AddTestSweepers("aws_sagemaker_endpoint_configuration", &sweep.Sweeper{
+		Name:    "aws_sagemaker_endpoint_configuration",
+		Service: "sagemaker",
 		Dependencies: []string{
 			"aws_sagemaker_model",
 		},
-		F: testSweepSagemakerEndpointConfigurations,
+		List: testSweepSagemakerEndpointConfigurations,
 	})
 }
 
-func testSweepSagemakerEndpointConfigurations(region string) error {
+func testSweepSagemakerEndpointConfigurations(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
 
 	req := &sagemaker.ListEndpointConfigsInput{
 		NameContains: aws.String("tf-acc-test"),
 	}
 	resp, err := conn.ListEndpointConfigs(req)
 	if err != nil {
-		return fmt.Errorf("error listing endpoint configs: %s", err)
+		return nil, fmt.Errorf("error listing endpoint configs: %s", err)
 	}
 
-	if len(resp.EndpointConfigs) == 0 {
-		log.Print("[DEBUG] No SageMaker endpoint config to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, endpointConfig := range resp.EndpointConfigs {
-		_, err := conn.DeleteEndpointConfig(&sagemaker.DeleteEndpointConfigInput{
+		input := &sagemaker.DeleteEndpointConfigInput{
 			EndpointConfigName: endpointConfig.EndpointConfigName,
-		})
-		if err != nil {
-			return fmt.Errorf(
-				"failed to delete SageMaker endpoint config (%s): %s",
-				*endpointConfig.EndpointConfigName, err)
 		}
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(endpointConfig.EndpointConfigName), func() error {
+			_, err := conn.DeleteEndpointConfig(input)
+
+			return err
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSSagemakerEndpointConfiguration_basic(t *testing.T) {
@@ -261,7 +260,7 @@ func TestAccAWSSagemakerEndpointConfiguration_disappears(t *testing.T) {
 }
 
 func testAccCheckSagemakerEndpointConfigurationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_endpoint_configuration" {
@@ -299,7 +298,7 @@ func testAccCheckSagemakerEndpointConfigurationExists(n string) resource.TestChe
 			return fmt.Errorf("no SageMaker endpoint config ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		opts := &sagemaker.DescribeEndpointConfigInput{
 			EndpointConfigName: aws.String(rs.Primary.ID),
 		}
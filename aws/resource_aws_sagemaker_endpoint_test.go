@@ -2,7 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"log"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -10,51 +9,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_endpoint", &resource.Sweeper{
-		Name: "aws_sagemaker_endpoint",
+	sweep.AddTestSweepers("aws_sagemaker_endpoint", &sweep.Sweeper{
+		Name:    "aws_sagemaker_endpoint",
+		Service: "sagemaker",
 		Dependencies: []string{
 			"aws_sagemaker_model",
 			"aws_sagemaker_endpoint_configuration",
 		},
-		F: testSweepSagemakerEndpoints,
+		List: testSweepSagemakerEndpoints,
 	})
 }
 
-func testSweepSagemakerEndpoints(region string) error {
+func testSweepSagemakerEndpoints(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
 
 	req := &sagemaker.ListEndpointsInput{
 		NameContains: aws.String("tf-acc-test"),
 	}
 	resp, err := conn.ListEndpoints(req)
 	if err != nil {
-		return fmt.Errorf("error listing endpoints: %s", err)
+		return nil, fmt.Errorf("error listing endpoints: %s", err)
 	}
 
-	if len(resp.Endpoints) == 0 {
-		log.Print("[DEBUG] No SageMaker Endpoint to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, endpoint := range resp.Endpoints {
-		_, err := conn.DeleteEndpoint(&sagemaker.DeleteEndpointInput{
+		input := &sagemaker.DeleteEndpointInput{
 			EndpointName: endpoint.EndpointName,
-		})
-		if err != nil {
-			return fmt.Errorf(
-				"error deleting SageMaker Endpoint (%s): %s",
-				*endpoint.EndpointName, err)
 		}
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(endpoint.EndpointName), func() error {
+			_, err := conn.DeleteEndpoint(input)
+
+			return err
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSSagemakerEndpoint_basic(t *testing.T) {
@@ -153,7 +152,7 @@ func TestAccAWSSagemakerEndpoint_Tags(t *testing.T) {
 }
 
 func testAccCheckSagemakerEndpointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_endpoint" {
@@ -190,7 +189,7 @@ func testAccCheckSagemakerEndpointExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("no SageMaker Endpoint ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		opts := &sagemaker.DescribeEndpointInput{
 			EndpointName: aws.String(rs.Primary.ID),
 		}
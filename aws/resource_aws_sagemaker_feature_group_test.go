@@ -12,35 +12,36 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_feature_group", &resource.Sweeper{
-		Name: "aws_sagemaker_feature_group",
-		F:    testSweepSagemakerFeatureGroups,
+	sweep.AddTestSweepers("aws_sagemaker_feature_group", &sweep.Sweeper{
+		Name:    "aws_sagemaker_feature_group",
+		Service: "sagemaker",
+		List:    testSweepSagemakerFeatureGroups,
 	})
 }
 
-func testSweepSagemakerFeatureGroups(region string) error {
+func testSweepSagemakerFeatureGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListFeatureGroupsPages(&sagemaker.ListFeatureGroupsInput{}, func(page *sagemaker.ListFeatureGroupsOutput, lastPage bool) bool {
 		for _, group := range page.FeatureGroupSummaries {
-			name := aws.StringValue(group.FeatureGroupName)
-
 			input := &sagemaker.DeleteFeatureGroupInput{
 				FeatureGroupName: group.FeatureGroupName,
 			}
 
-			log.Printf("[INFO] Deleting SageMaker Feature Group: %s", name)
-			if _, err := conn.DeleteFeatureGroup(input); err != nil {
-				log.Printf("[ERROR] Error deleting SageMaker Feature Group (%s): %s", name, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(group.FeatureGroupName), func() error {
+				_, err := conn.DeleteFeatureGroup(input)
+
+				return err
+			}))
 		}
 
 		return !lastPage
@@ -48,14 +49,14 @@ func testSweepSagemakerFeatureGroups(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SageMaker Feature Group sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving SageMaker Feature Groups: %w", err)
+		return sweepables, fmt.Errorf("Error retrieving SageMaker Feature Groups: %w", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSSagemakerFeatureGroup_basic(t *testing.T) {
@@ -351,7 +352,7 @@ func TestAccAWSSagemakerFeatureGroup_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerFeatureGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_feature_group" {
@@ -382,7 +383,7 @@ func testAccCheckAWSSagemakerFeatureGroupExists(n string, codeRepo *sagemaker.De
 			return fmt.Errorf("No sagmaker Feature Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		resp, err := finder.FeatureGroupByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -11,35 +11,36 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_image", &resource.Sweeper{
-		Name: "aws_sagemaker_image",
-		F:    testSweepSagemakerImages,
+	sweep.AddTestSweepers("aws_sagemaker_image", &sweep.Sweeper{
+		Name:    "aws_sagemaker_image",
+		Service: "sagemaker",
+		List:    testSweepSagemakerImages,
 	})
 }
 
-func testSweepSagemakerImages(region string) error {
+func testSweepSagemakerImages(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListImagesPages(&sagemaker.ListImagesInput{}, func(page *sagemaker.ListImagesOutput, lastPage bool) bool {
-		for _, Image := range page.Images {
-			name := aws.StringValue(Image.ImageName)
-
+		for _, image := range page.Images {
 			input := &sagemaker.DeleteImageInput{
-				ImageName: Image.ImageName,
+				ImageName: image.ImageName,
 			}
 
-			log.Printf("[INFO] Deleting SageMaker Image: %s", name)
-			if _, err := conn.DeleteImage(input); err != nil {
-				log.Printf("[ERROR] Error deleting SageMaker Image (%s): %s", name, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(image.ImageName), func() error {
+				_, err := conn.DeleteImage(input)
+
+				return err
+			}))
 		}
 
 		return !lastPage
@@ -47,14 +48,14 @@ func testSweepSagemakerImages(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SageMaker Image sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving SageMaker Images: %w", err)
+		return sweepables, fmt.Errorf("Error retrieving SageMaker Images: %w", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSSagemakerImage_basic(t *testing.T) {
@@ -233,7 +234,7 @@ func TestAccAWSSagemakerImage_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerImageDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_image" {
@@ -264,7 +265,7 @@ func testAccCheckAWSSagemakerImageExists(n string, image *sagemaker.DescribeImag
 			return fmt.Errorf("No sagmaker Image ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		resp, err := finder.ImageByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -52,7 +52,7 @@ func resourceAwsSagemakerImageVersion() *schema.Resource {
 }
 
 func resourceAwsSagemakerImageVersionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	name := d.Get("image_name").(string)
 	input := &sagemaker.CreateImageVersionInput{
@@ -75,7 +75,7 @@ func resourceAwsSagemakerImageVersionCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsSagemakerImageVersionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	image, err := finder.ImageVersionByName(conn, d.Id())
 	if err != nil {
@@ -99,7 +99,7 @@ func resourceAwsSagemakerImageVersionRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsSagemakerImageVersionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	input := &sagemaker.DeleteImageVersionInput{
 		ImageName: aws.String(d.Id()),
@@ -11,35 +11,36 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_model_package_group", &resource.Sweeper{
-		Name: "aws_sagemaker_model_package_group",
-		F:    testSweepSagemakerModelPackageGroups,
+	sweep.AddTestSweepers("aws_sagemaker_model_package_group", &sweep.Sweeper{
+		Name:    "aws_sagemaker_model_package_group",
+		Service: "sagemaker",
+		List:    testSweepSagemakerModelPackageGroups,
 	})
 }
 
-func testSweepSagemakerModelPackageGroups(region string) error {
+func testSweepSagemakerModelPackageGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListModelPackageGroupsPages(&sagemaker.ListModelPackageGroupsInput{}, func(page *sagemaker.ListModelPackageGroupsOutput, lastPage bool) bool {
-		for _, ModelPackageGroup := range page.ModelPackageGroupSummaryList {
-			name := aws.StringValue(ModelPackageGroup.ModelPackageGroupName)
-
+		for _, modelPackageGroup := range page.ModelPackageGroupSummaryList {
 			input := &sagemaker.DeleteModelPackageGroupInput{
-				ModelPackageGroupName: ModelPackageGroup.ModelPackageGroupName,
+				ModelPackageGroupName: modelPackageGroup.ModelPackageGroupName,
 			}
 
-			log.Printf("[INFO] Deleting SageMaker Model Package Group: %s", name)
-			if _, err := conn.DeleteModelPackageGroup(input); err != nil {
-				log.Printf("[ERROR] Error deleting SageMaker Model Package Group (%s): %s", name, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(modelPackageGroup.ModelPackageGroupName), func() error {
+				_, err := conn.DeleteModelPackageGroup(input)
+
+				return err
+			}))
 		}
 
 		return !lastPage
@@ -47,14 +48,14 @@ func testSweepSagemakerModelPackageGroups(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SageMaker Model Package Group sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving SageMaker Model Package Groups: %w", err)
+		return sweepables, fmt.Errorf("Error retrieving SageMaker Model Package Groups: %w", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSSagemakerModelPackageGroup_basic(t *testing.T) {
@@ -178,7 +179,7 @@ func TestAccAWSSagemakerModelPackageGroup_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerModelPackageGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_model_package_group" {
@@ -209,7 +210,7 @@ func testAccCheckAWSSagemakerModelPackageGroupExists(n string, mpg *sagemaker.De
 			return fmt.Errorf("No sagmaker Model Package Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		resp, err := finder.ModelPackageGroupByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
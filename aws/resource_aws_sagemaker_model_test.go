@@ -2,7 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"log"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,44 +10,45 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_model", &resource.Sweeper{
-		Name: "aws_sagemaker_model",
-		F:    testSweepSagemakerModels,
+	sweep.AddTestSweepers("aws_sagemaker_model", &sweep.Sweeper{
+		Name:    "aws_sagemaker_model",
+		Service: "sagemaker",
+		List:    testSweepSagemakerModels,
 	})
 }
 
-func testSweepSagemakerModels(region string) error {
+func testSweepSagemakerModels(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
 
 	req := &sagemaker.ListModelsInput{}
 	resp, err := conn.ListModels(req)
 	if err != nil {
-		return fmt.Errorf("error listing models: %w", err)
+		return nil, fmt.Errorf("error listing models: %w", err)
 	}
 
-	if len(resp.Models) == 0 {
-		log.Print("[DEBUG] No sagemaker models to sweep")
-		return nil
-	}
+	var sweepables []sweep.Sweepable
 
 	for _, model := range resp.Models {
-		_, err := conn.DeleteModel(&sagemaker.DeleteModelInput{
+		input := &sagemaker.DeleteModelInput{
 			ModelName: model.ModelName,
-		})
-		if err != nil {
-			return fmt.Errorf(
-				"error deleting sagemaker model (%s): %w", aws.StringValue(model.ModelName), err)
 		}
+
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(model.ModelName), func() error {
+			_, err := conn.DeleteModel(input)
+
+			return err
+		}))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSSagemakerModel_basic(t *testing.T) {
@@ -355,7 +355,7 @@ func TestAccAWSSagemakerModel_disappears(t *testing.T) {
 }
 
 func testAccCheckSagemakerModelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_model" {
@@ -396,7 +396,7 @@ func testAccCheckSagemakerModelExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No sagmaker model ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		DescribeModelOpts := &sagemaker.DescribeModelInput{
 			ModelName: aws.String(rs.Primary.ID),
 		}
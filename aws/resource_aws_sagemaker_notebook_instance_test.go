@@ -13,49 +13,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_notebook_instance", &resource.Sweeper{
-		Name: "aws_sagemaker_notebook_instance",
-		F:    testSweepSagemakerNotebookInstances,
+	sweep.AddTestSweepers("aws_sagemaker_notebook_instance", &sweep.Sweeper{
+		Name:    "aws_sagemaker_notebook_instance",
+		Service: "sagemaker",
+		List:    testSweepSagemakerNotebookInstances,
 	})
 }
 
-func testSweepSagemakerNotebookInstances(region string) error {
+func testSweepSagemakerNotebookInstances(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListNotebookInstancesPages(&sagemaker.ListNotebookInstancesInput{}, func(page *sagemaker.ListNotebookInstancesOutput, lastPage bool) bool {
 		for _, instance := range page.NotebookInstances {
 			name := aws.StringValue(instance.NotebookInstanceName)
 			status := aws.StringValue(instance.NotebookInstanceStatus)
 
-			input := &sagemaker.DeleteNotebookInstanceInput{
-				NotebookInstanceName: instance.NotebookInstanceName,
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				if status != sagemaker.NotebookInstanceStatusFailed && status != sagemaker.NotebookInstanceStatusStopped {
+					if err := stopSagemakerNotebookInstance(conn, name); err != nil {
+						return fmt.Errorf("error stopping: %w", err)
+					}
+				}
+
+				input := &sagemaker.DeleteNotebookInstanceInput{
+					NotebookInstanceName: aws.String(name),
+				}
+
+				if _, err := conn.DeleteNotebookInstance(input); err != nil {
+					return err
+				}
 
-			log.Printf("[INFO] Stopping SageMaker Notebook Instance: %s", name)
-			if status != sagemaker.NotebookInstanceStatusFailed && status != sagemaker.NotebookInstanceStatusStopped {
-				if err := stopSagemakerNotebookInstance(conn, name); err != nil {
-					log.Printf("[ERROR] Error stopping SageMaker Notebook Instance (%s): %s", name, err)
-					continue
+				if _, err := waiter.NotebookInstanceDeleted(conn, name); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
 				}
-			}
-
-			log.Printf("[INFO] Deleting SageMaker Notebook Instance: %s", name)
-			if _, err := conn.DeleteNotebookInstance(input); err != nil {
-				log.Printf("[ERROR] Error deleting SageMaker Notebook Instance (%s): %s", name, err)
-				continue
-			}
-
-			if _, err := waiter.NotebookInstanceDeleted(conn, name); err != nil {
-				log.Printf("error waiting for sagemaker notebook instance (%s) to delete: %s", name, err)
-				continue
-			}
+
+				return nil
+			}))
 		}
 
 		return !lastPage
@@ -63,14 +65,14 @@ func testSweepSagemakerNotebookInstances(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SageMaker Notebook Instance sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving SageMaker Notebook Instances: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving SageMaker Notebook Instances: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSSagemakerNotebookInstance_basic(t *testing.T) {
@@ -318,7 +320,7 @@ func TestAccAWSSagemakerNotebookInstance_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerNotebookInstanceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_notebook_instance" {
@@ -352,7 +354,7 @@ func testAccCheckAWSSagemakerNotebookInstanceExists(n string, notebook *sagemake
 			return fmt.Errorf("No sagmaker Notebook Instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		opts := &sagemaker.DescribeNotebookInstanceInput{
 			NotebookInstanceName: aws.String(rs.Primary.ID),
 		}
@@ -12,12 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_user_profile", &resource.Sweeper{
-		Name: "aws_sagemaker_user_profile",
-		F:    testSweepSagemakerUserProfiles,
+	sweep.AddTestSweepers("aws_sagemaker_user_profile", &sweep.Sweeper{
+		Name:    "aws_sagemaker_user_profile",
+		Service: "sagemaker",
+		List:    testSweepSagemakerUserProfiles,
 		Dependencies: []string{
 			"aws_efs_mount_target",
 			"aws_efs_file_system",
@@ -25,12 +27,13 @@ func init() {
 	})
 }
 
-func testSweepSagemakerUserProfiles(region string) error {
+func testSweepSagemakerUserProfiles(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListUserProfilesPages(&sagemaker.ListUserProfilesInput{}, func(page *sagemaker.ListUserProfilesOutput, lastPage bool) bool {
 		for _, instance := range page.UserProfiles {
@@ -38,28 +41,28 @@ func testSweepSagemakerUserProfiles(region string) error {
 				UserProfileName: instance.UserProfileName,
 				DomainId:        instance.DomainId,
 			}
+			id := fmt.Sprintf("%s/%s", aws.StringValue(instance.DomainId), aws.StringValue(instance.UserProfileName))
 
-			userProfile := aws.StringValue(instance.UserProfileName)
-			log.Printf("[INFO] Deleting SageMaker User Profile: %s", userProfile)
-			if _, err := conn.DeleteUserProfile(input); err != nil {
-				log.Printf("[ERROR] Error deleting SageMaker User Profile (%s): %s", userProfile, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteUserProfile(input)
+
+				return err
+			}).WithName(aws.StringValue(instance.UserProfileName)))
 		}
 
 		return !lastPage
 	})
 
 	if testSweepSkipSweepError(err) {
-		log.Printf("[WARN] Skipping SageMaker domain sweep for %s: %s", region, err)
-		return nil
+		log.Printf("[WARN] Skipping SageMaker User Profile sweep for %s: %s", region, err)
+		return sweepables, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving SageMaker domains: %w", err)
+		return sweepables, fmt.Errorf("Error retrieving SageMaker User Profiles: %w", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSSagemakerUserProfile_basic(t *testing.T) {
@@ -277,7 +280,7 @@ func TestAccAWSSagemakerUserProfile_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerUserProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_user_settings" {
@@ -312,7 +315,7 @@ func testAccCheckAWSSagemakerUserProfileExists(n string, userProfile *sagemaker.
 			return fmt.Errorf("No sagmaker domain ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 		domainID := rs.Primary.Attributes["domain_id"]
 		userProfileName := rs.Primary.Attributes["user_profile_name"]
@@ -11,56 +11,56 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/secretsmanager/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_secretsmanager_secret_policy", &resource.Sweeper{
-		Name: "aws_secretsmanager_secret_policy",
-		F:    testSweepSecretsManagerSecretPolicies,
+	sweep.AddTestSweepers("aws_secretsmanager_secret_policy", &sweep.Sweeper{
+		Name:    "aws_secretsmanager_secret_policy",
+		Service: "secretsmanager",
+		List:    testSweepSecretsManagerSecretPolicies,
 	})
 }
 
-func testSweepSecretsManagerSecretPolicies(region string) error {
+func testSweepSecretsManagerSecretPolicies(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).secretsmanagerconn
+	conn := client.(*AWSClient).secretsmanagerconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListSecretsPages(&secretsmanager.ListSecretsInput{}, func(page *secretsmanager.ListSecretsOutput, isLast bool) bool {
-		if len(page.SecretList) == 0 {
-			log.Print("[DEBUG] No Secrets Manager Secrets to sweep")
-			return true
-		}
-
 		for _, secret := range page.SecretList {
-			name := aws.StringValue(secret.Name)
-
-			log.Printf("[INFO] Deleting Secrets Manager Secret Policy: %s", name)
 			input := &secretsmanager.DeleteResourcePolicyInput{
-				SecretId: aws.String(name),
+				SecretId: secret.Name,
 			}
 
-			_, err := conn.DeleteResourcePolicy(input)
-			if err != nil {
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(secret.Name), func() error {
+				_, err := conn.DeleteResourcePolicy(input)
+
 				if isAWSErr(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
-					continue
+					return nil
 				}
-				log.Printf("[ERROR] Failed to delete Secrets Manager Secret Policy (%s): %s", name, err)
-			}
+
+				return err
+			}).WithTags(keyvaluetags.SecretsmanagerKeyValueTags(secret.Tags).IgnoreAws().Map()))
 		}
 
 		return !isLast
 	})
+
 	if err != nil {
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Secrets Manager Secret sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil
 		}
-		return fmt.Errorf("Error retrieving Secrets Manager Secrets: %w", err)
+		return sweepables, fmt.Errorf("Error retrieving Secrets Manager Secrets: %w", err)
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAwsSecretsManagerSecretPolicy_basic(t *testing.T) {
@@ -163,7 +163,7 @@ func TestAccAwsSecretsManagerSecretPolicy_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsSecretsManagerSecretPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+	conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_secretsmanager_secret_policy" {
@@ -235,7 +235,7 @@ func testAccCheckAwsSecretsManagerSecretPolicyExists(resourceName string, policy
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn()
 		input := &secretsmanager.GetResourcePolicyInput{
 			SecretId: aws.String(rs.Primary.ID),
 		}
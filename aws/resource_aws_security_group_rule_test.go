@@ -15,8 +15,83 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
+func init() {
+	sweep.AddTestSweepers("aws_security_group_rule", &sweep.Sweeper{
+		Name:    "aws_security_group_rule",
+		Service: "ec2",
+		List:    testSweepSecurityGroupRules,
+	})
+}
+
+// testSweepSecurityGroupRules revokes the rules of all non-default security
+// groups, which may reference each other, before the groups are deleted.
+func testSweepSecurityGroupRules(region string) ([]sweep.Sweepable, error) {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return nil, fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).ec2conn()
+	var sweepables []sweep.Sweepable
+
+	input := &ec2.DescribeSecurityGroupsInput{}
+	err = conn.DescribeSecurityGroupsPages(input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		for _, sg := range page.SecurityGroups {
+			if aws.StringValue(sg.GroupName) == "default" {
+				continue
+			}
+
+			if len(sg.IpPermissions) == 0 && len(sg.IpPermissionsEgress) == 0 {
+				continue
+			}
+
+			sg := sg
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(sg.GroupId), func() error {
+				if len(sg.IpPermissions) > 0 {
+					req := &ec2.RevokeSecurityGroupIngressInput{
+						GroupId:       sg.GroupId,
+						IpPermissions: sg.IpPermissions,
+					}
+
+					if _, err := conn.RevokeSecurityGroupIngress(req); err != nil {
+						return fmt.Errorf("error revoking ingress rules: %w", err)
+					}
+				}
+
+				if len(sg.IpPermissionsEgress) > 0 {
+					req := &ec2.RevokeSecurityGroupEgressInput{
+						GroupId:       sg.GroupId,
+						IpPermissions: sg.IpPermissionsEgress,
+					}
+
+					if _, err := conn.RevokeSecurityGroupEgress(req); err != nil {
+						return fmt.Errorf("error revoking egress rules: %w", err)
+					}
+				}
+
+				return nil
+			}).WithName(aws.StringValue(sg.GroupName)).WithTags(keyvaluetags.Ec2KeyValueTags(sg.Tags).IgnoreAws().Map()))
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Security Group Rule sweep for %s: %s", region, err)
+		return sweepables, nil
+	}
+
+	if err != nil {
+		return sweepables, fmt.Errorf("Error retrieving EC2 Security Groups: %s", err)
+	}
+
+	return sweepables, nil
+}
+
 func TestIpPermissionIDHash(t *testing.T) {
 	simple := &ec2.IpPermission{
 		IpProtocol: aws.String("tcp"),
@@ -637,7 +712,7 @@ func TestAccAWSSecurityGroupRule_PrefixListEgress(t *testing.T) {
 	// This function creates the expected IPPermission with the prefix list ID from
 	// the VPC Endpoint created in the test
 	setupSG := func(*terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		prefixListInput := &ec2.DescribePrefixListsInput{
 			Filters: []*ec2.Filter{
 				{Name: aws.String("prefix-list-name"), Values: []*string{endpoint.ServiceName}},
@@ -1032,7 +1107,7 @@ func TestAccAWSSecurityGroupRule_MultiDescription(t *testing.T) {
 	// This function creates the expected IPPermission with the prefix list ID from
 	// the VPC Endpoint created in the test
 	setupPL := func(*terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		prefixListInput := &ec2.DescribePrefixListsInput{
 			Filters: []*ec2.Filter{
 				{Name: aws.String("prefix-list-name"), Values: []*string{endpoint.ServiceName}},
@@ -1157,7 +1232,7 @@ func TestAccAWSSecurityGroupRule_MultiDescription(t *testing.T) {
 }
 
 func testAccCheckAWSSecurityGroupRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_security_group" {
@@ -1201,7 +1276,7 @@ func testAccCheckAWSSecurityGroupRuleExists(n string, group *ec2.SecurityGroup)
 			return fmt.Errorf("No Security Group is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		req := &ec2.DescribeSecurityGroupsInput{
 			GroupIds: []*string{aws.String(rs.Primary.ID)},
 		}
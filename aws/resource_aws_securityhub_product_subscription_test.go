@@ -29,7 +29,7 @@ func testAccAWSSecurityHubProductSubscription_basic(t *testing.T) {
 				// AWS product subscriptions happen automatically when enabling Security Hub.
 				// Here we attempt to remove one so we can attempt to (re-)enable it.
 				PreConfig: func() {
-					conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+					conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 					productSubscriptionARN := arn.ARN{
 						AccountID: testAccGetAccountID(),
 						Partition: testAccGetPartition(),
@@ -74,7 +74,7 @@ func testAccCheckAWSSecurityHubProductSubscriptionExists(n string) resource.Test
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 		_, productSubscriptionArn, err := resourceAwsSecurityHubProductSubscriptionParseId(rs.Primary.ID)
 
@@ -97,7 +97,7 @@ func testAccCheckAWSSecurityHubProductSubscriptionExists(n string) resource.Test
 }
 
 func testAccCheckAWSSecurityHubProductSubscriptionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_securityhub_product_subscription" {
@@ -45,6 +45,8 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStack() *schema.Res
 			Delete: schema.DefaultTimeout(waiter.CloudFormationStackDeletedDefaultTimeout),
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -82,13 +84,14 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStack() *schema.Res
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 		},
 	}
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate(d *schema.ResourceData, meta interface{}) error {
-	cfConn := meta.(*AWSClient).cfconn
+	cfConn := meta.(*AWSClient).cfconn()
 
 	changeSet, err := createServerlessApplicationRepositoryCloudFormationChangeSet(d, meta.(*AWSClient))
 	if err != nil {
@@ -121,8 +124,9 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate(d *sche
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
-	serverlessConn := meta.(*AWSClient).serverlessapplicationrepositoryconn
-	cfConn := meta.(*AWSClient).cfconn
+	serverlessConn := meta.(*AWSClient).serverlessapplicationrepositoryconn()
+	cfConn := meta.(*AWSClient).cfconn()
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	stack, err := cffinder.Stack(cfConn, d.Id())
@@ -154,10 +158,17 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d *schema
 		return fmt.Errorf("error describing Serverless Application Repository CloudFormation Stack (%s): missing required tag \"%s\"", d.Id(), serverlessApplicationRepositoryCloudFormationStackTagSemanticVersion)
 	}
 
-	if err = d.Set("tags", tags.IgnoreServerlessApplicationRepository().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags = tags.IgnoreServerlessApplicationRepository().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("failed to set tags: %w", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("failed to set tags_all: %w", err)
+	}
+
 	if err = d.Set("outputs", flattenCloudFormationOutputs(stack.Outputs)); err != nil {
 		return fmt.Errorf("failed to set outputs: %w", err)
 	}
@@ -206,7 +217,7 @@ func flattenServerlessRepositoryParameterDefinitions(parameterDefinitions []*ser
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate(d *schema.ResourceData, meta interface{}) error {
-	cfConn := meta.(*AWSClient).cfconn
+	cfConn := meta.(*AWSClient).cfconn()
 
 	changeSet, err := createServerlessApplicationRepositoryCloudFormationChangeSet(d, meta.(*AWSClient))
 	if err != nil {
@@ -237,7 +248,7 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate(d *sche
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	requestToken := resource.UniqueId()
 	input := &cloudformation.DeleteStackInput{
@@ -273,7 +284,7 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackImport(d *sche
 		}
 	}
 
-	cfConn := meta.(*AWSClient).cfconn
+	cfConn := meta.(*AWSClient).cfconn()
 	stack, err := cffinder.Stack(cfConn, stackID)
 	if err != nil {
 		return nil, fmt.Errorf("error describing Serverless Application Repository CloudFormation Stack (%s): %w", stackID, err)
@@ -285,8 +296,8 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackImport(d *sche
 }
 
 func createServerlessApplicationRepositoryCloudFormationChangeSet(d *schema.ResourceData, client *AWSClient) (*cloudformation.DescribeChangeSetOutput, error) {
-	serverlessConn := client.serverlessapplicationrepositoryconn
-	cfConn := client.cfconn
+	serverlessConn := client.serverlessapplicationrepositoryconn()
+	cfConn := client.cfconn()
 
 	stackName := d.Get("name").(string)
 	changeSetRequest := serverlessrepository.CreateCloudFormationChangeSetRequest{
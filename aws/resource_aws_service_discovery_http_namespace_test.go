@@ -8,30 +8,31 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicediscovery/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_service_discovery_http_namespace", &resource.Sweeper{
-		Name: "aws_service_discovery_http_namespace",
-		F:    testSweepServiceDiscoveryHttpNamespaces,
+	sweep.AddTestSweepers("aws_service_discovery_http_namespace", &sweep.Sweeper{
+		Name:    "aws_service_discovery_http_namespace",
+		Service: "sd",
+		List:    testSweepServiceDiscoveryHttpNamespaces,
 		Dependencies: []string{
 			"aws_service_discovery_service",
 		},
 	})
 }
 
-func testSweepServiceDiscoveryHttpNamespaces(region string) error {
+func testSweepServiceDiscoveryHttpNamespaces(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sdconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).sdconn()
+	var sweepables []sweep.Sweepable
 
 	input := &servicediscovery.ListNamespacesInput{
 		Filters: []*servicediscovery.NamespaceFilter{
@@ -53,29 +54,25 @@ func testSweepServiceDiscoveryHttpNamespaces(region string) error {
 				continue
 			}
 
-			id := aws.StringValue(namespace.Id)
 			input := &servicediscovery.DeleteNamespaceInput{
 				Id: namespace.Id,
 			}
 
-			log.Printf("[INFO] Deleting Service Discovery HTTP Namespace: %s", id)
-			output, err := conn.DeleteNamespace(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(namespace.Id), func() error {
+				output, err := conn.DeleteNamespace(input)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Service Discovery HTTP Namespace (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				if err != nil {
+					return err
+				}
 
-			if output != nil && output.OperationId != nil {
-				if _, err := waiter.OperationSuccess(conn, aws.StringValue(output.OperationId)); err != nil {
-					sweeperErr := fmt.Errorf("error waiting for Service Discovery HTTP Namespace (%s) deletion: %w", id, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
+				if output != nil && output.OperationId != nil {
+					if _, err := waiter.OperationSuccess(conn, aws.StringValue(output.OperationId)); err != nil {
+						return fmt.Errorf("error waiting for deletion: %w", err)
+					}
 				}
-			}
+
+				return nil
+			}).WithName(aws.StringValue(namespace.Name)))
 		}
 
 		return !isLast
@@ -83,14 +80,14 @@ func testSweepServiceDiscoveryHttpNamespaces(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Service Discovery HTTP Namespaces sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Service Discovery HTTP Namespaces: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Service Discovery HTTP Namespaces: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSServiceDiscoveryHttpNamespace_basic(t *testing.T) {
@@ -212,7 +209,7 @@ func TestAccAWSServiceDiscoveryHttpNamespace_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsServiceDiscoveryHttpNamespaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sdconn
+	conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_service_discovery_http_namespace" {
@@ -241,7 +238,7 @@ func testAccCheckAwsServiceDiscoveryHttpNamespaceExists(name string) resource.Te
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sdconn
+		conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 		input := &servicediscovery.GetNamespaceInput{
 			Id: aws.String(rs.Primary.ID),
@@ -8,30 +8,31 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicediscovery/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_service_discovery_private_dns_namespace", &resource.Sweeper{
-		Name: "aws_service_discovery_private_dns_namespace",
-		F:    testSweepServiceDiscoveryPrivateDnsNamespaces,
+	sweep.AddTestSweepers("aws_service_discovery_private_dns_namespace", &sweep.Sweeper{
+		Name:    "aws_service_discovery_private_dns_namespace",
+		Service: "sd",
+		List:    testSweepServiceDiscoveryPrivateDnsNamespaces,
 		Dependencies: []string{
 			"aws_service_discovery_service",
 		},
 	})
 }
 
-func testSweepServiceDiscoveryPrivateDnsNamespaces(region string) error {
+func testSweepServiceDiscoveryPrivateDnsNamespaces(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sdconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).sdconn()
+	var sweepables []sweep.Sweepable
 
 	input := &servicediscovery.ListNamespacesInput{
 		Filters: []*servicediscovery.NamespaceFilter{
@@ -53,29 +54,25 @@ func testSweepServiceDiscoveryPrivateDnsNamespaces(region string) error {
 				continue
 			}
 
-			id := aws.StringValue(namespace.Id)
 			input := &servicediscovery.DeleteNamespaceInput{
 				Id: namespace.Id,
 			}
 
-			log.Printf("[INFO] Deleting Service Discovery Private DNS Namespace: %s", id)
-			output, err := conn.DeleteNamespace(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(namespace.Id), func() error {
+				output, err := conn.DeleteNamespace(input)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Service Discovery Private DNS Namespace (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				if err != nil {
+					return err
+				}
 
-			if output != nil && output.OperationId != nil {
-				if _, err := waiter.OperationSuccess(conn, aws.StringValue(output.OperationId)); err != nil {
-					sweeperErr := fmt.Errorf("error waiting for Service Discovery Private DNS Namespace (%s) deletion: %w", id, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
+				if output != nil && output.OperationId != nil {
+					if _, err := waiter.OperationSuccess(conn, aws.StringValue(output.OperationId)); err != nil {
+						return fmt.Errorf("error waiting for deletion: %w", err)
+					}
 				}
-			}
+
+				return nil
+			}).WithName(aws.StringValue(namespace.Name)))
 		}
 
 		return !isLast
@@ -83,14 +80,14 @@ func testSweepServiceDiscoveryPrivateDnsNamespaces(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Service Discovery Private DNS Namespaces sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Service Discovery Private DNS Namespaces: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Service Discovery Private DNS Namespaces: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSServiceDiscoveryPrivateDnsNamespace_basic(t *testing.T) {
@@ -164,8 +161,8 @@ func TestAccAWSServiceDiscoveryPrivateDnsNamespace_Description(t *testing.T) {
 }
 
 // This acceptance test ensures we properly send back error messaging. References:
-//  * https://github.com/hashicorp/terraform-provider-aws/issues/2830
-//  * https://github.com/hashicorp/terraform-provider-aws/issues/5532
+//   - https://github.com/hashicorp/terraform-provider-aws/issues/2830
+//   - https://github.com/hashicorp/terraform-provider-aws/issues/5532
 func TestAccAWSServiceDiscoveryPrivateDnsNamespace_error_Overlap(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 
@@ -227,7 +224,7 @@ func TestAccAWSServiceDiscoveryPrivateDnsNamespace_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsServiceDiscoveryPrivateDnsNamespaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sdconn
+	conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_service_discovery_private_dns_namespace" {
@@ -261,7 +258,7 @@ func testAccCheckAwsServiceDiscoveryPrivateDnsNamespaceExists(name string) resou
 }
 
 func testAccPreCheckAWSServiceDiscovery(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).sdconn
+	conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 	input := &servicediscovery.ListNamespacesInput{}
 
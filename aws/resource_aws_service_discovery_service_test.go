@@ -12,22 +12,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_service_discovery_service", &resource.Sweeper{
-		Name: "aws_service_discovery_service",
-		F:    testSweepServiceDiscoveryServices,
+	sweep.AddTestSweepers("aws_service_discovery_service", &sweep.Sweeper{
+		Name:    "aws_service_discovery_service",
+		Service: "sd",
+		List:    testSweepServiceDiscoveryServices,
 	})
 }
 
-func testSweepServiceDiscoveryServices(region string) error {
+func testSweepServiceDiscoveryServices(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sdconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).sdconn()
+	var sweepables []sweep.Sweepable
 
 	input := &servicediscovery.ListServicesInput{}
 
@@ -42,60 +44,54 @@ func testSweepServiceDiscoveryServices(region string) error {
 			}
 
 			serviceID := aws.StringValue(service.Id)
-			input := &servicediscovery.DeleteServiceInput{
-				Id: service.Id,
-			}
+			instanceCount := aws.Int64Value(service.InstanceCount)
 
-			if aws.Int64Value(service.InstanceCount) > 0 {
-				input := &servicediscovery.ListInstancesInput{}
+			sweepables = append(sweepables, sweep.NewResource(serviceID, func() error {
+				if instanceCount > 0 {
+					var errs *multierror.Error
 
-				err := conn.ListInstancesPages(input, func(page *servicediscovery.ListInstancesOutput, isLast bool) bool {
-					if page == nil {
-						return !isLast
+					input := &servicediscovery.ListInstancesInput{
+						ServiceId: aws.String(serviceID),
 					}
 
-					for _, instance := range page.Instances {
-						if instance == nil {
-							continue
+					err := conn.ListInstancesPages(input, func(page *servicediscovery.ListInstancesOutput, isLast bool) bool {
+						if page == nil {
+							return !isLast
 						}
 
-						instanceID := aws.StringValue(instance.Id)
-						input := &servicediscovery.DeregisterInstanceInput{
-							InstanceId: instance.Id,
-							ServiceId:  service.Id,
-						}
+						for _, instance := range page.Instances {
+							if instance == nil {
+								continue
+							}
 
-						log.Printf("[INFO] Deregistering Service Discovery Service (%s) Instance: %s", serviceID, instanceID)
-						_, err := conn.DeregisterInstance(input)
+							input := &servicediscovery.DeregisterInstanceInput{
+								InstanceId: instance.Id,
+								ServiceId:  aws.String(serviceID),
+							}
 
-						if err != nil {
-							sweeperErr := fmt.Errorf("error deregistering Service Discovery Service (%s) Instance (%s): %w", serviceID, instanceID, err)
-							log.Printf("[ERROR] %s", sweeperErr)
-							sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-							continue
+							if _, err := conn.DeregisterInstance(input); err != nil {
+								errs = multierror.Append(errs, fmt.Errorf("error deregistering Instance (%s): %w", aws.StringValue(instance.Id), err))
+							}
 						}
-					}
 
-					return !isLast
-				})
+						return !isLast
+					})
 
-				if err != nil {
-					sweeperErr := fmt.Errorf("error listing Service Discovery Service (%s) Instances: %w", serviceID, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
+					if err != nil {
+						errs = multierror.Append(errs, fmt.Errorf("error listing Instances: %w", err))
+					}
+
+					if err := errs.ErrorOrNil(); err != nil {
+						return err
+					}
 				}
-			}
 
-			log.Printf("[INFO] Deleting Service Discovery Service: %s", serviceID)
-			_, err := conn.DeleteService(input)
+				_, err := conn.DeleteService(&servicediscovery.DeleteServiceInput{
+					Id: aws.String(serviceID),
+				})
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Service Discovery Service (%s): %w", serviceID, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return err
+			}).WithName(aws.StringValue(service.Name)))
 		}
 
 		return !isLast
@@ -103,14 +99,14 @@ func testSweepServiceDiscoveryServices(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Service Discovery Services sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Service Discovery Services: %w", err))
+		return sweepables, fmt.Errorf("error retrieving Service Discovery Services: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSServiceDiscoveryService_private(t *testing.T) {
@@ -312,7 +308,7 @@ func TestAccAWSServiceDiscoveryService_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsServiceDiscoveryServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sdconn
+	conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_service_discovery_service" {
@@ -341,7 +337,7 @@ func testAccCheckAwsServiceDiscoveryServiceExists(name string) resource.TestChec
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sdconn
+		conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 		input := &servicediscovery.GetServiceInput{
 			Id: aws.String(rs.Primary.ID),
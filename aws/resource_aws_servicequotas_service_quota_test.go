@@ -136,7 +136,7 @@ func TestAccAwsServiceQuotasServiceQuota_Value_IncreaseOnUpdate(t *testing.T) {
 }
 
 func testAccPreCheckAWSServiceQuotas(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).servicequotasconn
+	conn := testAccProvider.Meta().(*AWSClient).servicequotasconn()
 
 	input := &servicequotas.ListServicesInput{}
 
@@ -7,64 +7,65 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
-		Name: "aws_ses_configuration_set",
-		F:    testSweepSesConfigurationSets,
+	sweep.AddTestSweepers("aws_ses_configuration_set", &sweep.Sweeper{
+		Name:    "aws_ses_configuration_set",
+		Service: "ses",
+		List:    testSweepSesConfigurationSets,
 	})
 }
 
-func testSweepSesConfigurationSets(region string) error {
+func testSweepSesConfigurationSets(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).sesconn
+	conn := client.(*AWSClient).sesconn()
 	input := &ses.ListConfigurationSetsInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.ListConfigurationSets(input)
+
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping SES Configuration Sets sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return sweepables, nil // In case we have completed some pages
 		}
+
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SES Configuration Sets: %w", err))
-			return sweeperErrs
+			return sweepables, fmt.Errorf("error retrieving SES Configuration Sets: %w", err)
 		}
 
 		for _, configurationSet := range output.ConfigurationSets {
 			name := aws.StringValue(configurationSet.Name)
 
-			log.Printf("[INFO] Deleting SES Configuration Set: %s", name)
-			_, err := conn.DeleteConfigurationSet(&ses.DeleteConfigurationSetInput{
-				ConfigurationSetName: aws.String(name),
-			})
-			if isAWSErr(err, ses.ErrCodeConfigurationSetDoesNotExistException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting SES Configuration Set (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				_, err := conn.DeleteConfigurationSet(&ses.DeleteConfigurationSetInput{
+					ConfigurationSetName: aws.String(name),
+				})
+
+				if isAWSErr(err, ses.ErrCodeConfigurationSetDoesNotExistException, "") {
+					return nil
+				}
+
+				return err
+			}))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
 			break
 		}
+
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSSESConfigurationSet_basic(t *testing.T) {
@@ -293,7 +294,7 @@ func testAccCheckAwsSESConfigurationSetExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("SES configuration set ID not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sesconn
+		conn := testAccProvider.Meta().(*AWSClient).sesconn()
 
 		response, err := conn.DescribeConfigurationSet(&ses.DescribeConfigurationSetInput{
 			ConfigurationSetName: aws.String(rs.Primary.ID),
@@ -312,7 +313,7 @@ func testAccCheckAwsSESConfigurationSetExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckSESConfigurationSetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sesconn
+	conn := testAccProvider.Meta().(*AWSClient).sesconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ses_configuration_set" {
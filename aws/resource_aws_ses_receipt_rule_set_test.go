@@ -7,77 +7,91 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
-		Name: "aws_ses_receipt_rule_set",
-		F:    testSweepSesReceiptRuleSets,
+	sweep.AddTestSweepers("aws_ses_receipt_rule_set", &sweep.Sweeper{
+		Name:    "aws_ses_receipt_rule_set",
+		Service: "ses",
+		List:    testSweepSesReceiptRuleSets,
 	})
 }
 
-func testSweepSesReceiptRuleSets(region string) error {
+func testSweepSesReceiptRuleSets(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).sesconn
+	conn := client.(*AWSClient).sesconn()
+
+	activeOutput, err := conn.DescribeActiveReceiptRuleSet(&ses.DescribeActiveReceiptRuleSetInput{})
 
-	// You cannot delete the receipt rule set that is currently active.
-	// Setting the name of the receipt rule set to make active to null disables all email receiving.
-	log.Printf("[INFO] Disabling any currently active SES Receipt Rule Set")
-	_, err = conn.SetActiveReceiptRuleSet(&ses.SetActiveReceiptRuleSetInput{})
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
+
 	if err != nil {
-		return fmt.Errorf("error disabling any currently active SES Receipt Rule Set: %w", err)
+		return nil, fmt.Errorf("error describing active SES Receipt Rule Set: %w", err)
+	}
+
+	var activeName string
+
+	if activeOutput != nil && activeOutput.Metadata != nil {
+		activeName = aws.StringValue(activeOutput.Metadata.Name)
 	}
 
 	input := &ses.ListReceiptRuleSetsInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.ListReceiptRuleSets(input)
+
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			return sweepables, nil // In case we have completed some pages
 		}
+
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SES Receipt Rule Sets: %w", err))
-			return sweeperErrs
+			return sweepables, fmt.Errorf("error retrieving SES Receipt Rule Sets: %w", err)
 		}
 
 		for _, ruleSet := range output.RuleSets {
 			name := aws.StringValue(ruleSet.Name)
 
-			log.Printf("[INFO] Deleting SES Receipt Rule Set: %s", name)
-			_, err := conn.DeleteReceiptRuleSet(&ses.DeleteReceiptRuleSetInput{
-				RuleSetName: aws.String(name),
-			})
-			if isAWSErr(err, ses.ErrCodeRuleSetDoesNotExistException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting SES Receipt Rule Set (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(name, func() error {
+				// You cannot delete the receipt rule set that is currently active.
+				// Setting the name of the receipt rule set to make active to null disables all email receiving.
+				if name == activeName {
+					if _, err := conn.SetActiveReceiptRuleSet(&ses.SetActiveReceiptRuleSetInput{}); err != nil {
+						return fmt.Errorf("error disabling: %w", err)
+					}
+				}
+
+				_, err := conn.DeleteReceiptRuleSet(&ses.DeleteReceiptRuleSetInput{
+					RuleSetName: aws.String(name),
+				})
+
+				if isAWSErr(err, ses.ErrCodeRuleSetDoesNotExistException, "") {
+					return nil
+				}
+
+				return err
+			}))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
 			break
 		}
+
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSSESReceiptRuleSet_basic(t *testing.T) {
@@ -128,7 +142,7 @@ func TestAccAWSSESReceiptRuleSet_disappears(t *testing.T) {
 }
 
 func testAccCheckSESReceiptRuleSetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sesconn
+	conn := testAccProvider.Meta().(*AWSClient).sesconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ses_receipt_rule_set" {
@@ -167,7 +181,7 @@ func testAccCheckAwsSESReceiptRuleSetExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("SES Receipt Rule Set name not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sesconn
+		conn := testAccProvider.Meta().(*AWSClient).sesconn()
 
 		params := &ses.DescribeReceiptRuleSetInput{
 			RuleSetName: aws.String(rs.Primary.ID),
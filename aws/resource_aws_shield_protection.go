@@ -34,7 +34,7 @@ func resourceAwsShieldProtection() *schema.Resource {
 }
 
 func resourceAwsShieldProtectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).shieldconn
+	conn := meta.(*AWSClient).shieldconn()
 
 	input := &shield.CreateProtectionInput{
 		Name:        aws.String(d.Get("name").(string)),
@@ -50,7 +50,7 @@ func resourceAwsShieldProtectionCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsShieldProtectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).shieldconn
+	conn := meta.(*AWSClient).shieldconn()
 
 	input := &shield.DescribeProtectionInput{
 		ProtectionId: aws.String(d.Id()),
@@ -66,7 +66,7 @@ func resourceAwsShieldProtectionRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsShieldProtectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).shieldconn
+	conn := meta.(*AWSClient).shieldconn()
 
 	input := &shield.DeleteProtectionInput{
 		ProtectionId: aws.String(d.Id()),
@@ -92,7 +92,7 @@ func resourceAwsSnsPlatformApplication() *schema.Resource {
 }
 
 func resourceAwsSnsPlatformApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	attributes := make(map[string]*string)
 	name := d.Get("name").(string)
@@ -122,7 +122,7 @@ func resourceAwsSnsPlatformApplicationCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsSnsPlatformApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	attributes := make(map[string]*string)
 
@@ -202,7 +202,7 @@ func resourceAwsSnsPlatformApplicationUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsSnsPlatformApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	// There is no SNS Describe/GetPlatformApplication to fetch attributes like name and platform
 	// We will use the ID, which should be a platform application ARN, to:
@@ -273,7 +273,7 @@ func resourceAwsSnsPlatformApplicationRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSnsPlatformApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	log.Printf("[DEBUG] SNS Delete Application: %s", d.Id())
 	_, err := snsconn.DeletePlatformApplication(&sns.DeletePlatformApplicationInput{
@@ -11,26 +11,27 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sns"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sns_platform_application", &resource.Sweeper{
-		Name: "aws_sns_platform_application",
-		F:    testSweepSnsPlatformApplications,
+	sweep.AddTestSweepers("aws_sns_platform_application", &sweep.Sweeper{
+		Name:    "aws_sns_platform_application",
+		Service: "sns",
+		List:    testSweepSnsPlatformApplications,
 	})
 }
 
-func testSweepSnsPlatformApplications(region string) error {
+func testSweepSnsPlatformApplications(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).snsconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).snsconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListPlatformApplicationsPages(&sns.ListPlatformApplicationsInput{}, func(page *sns.ListPlatformApplicationsOutput, isLast bool) bool {
 		if page == nil {
@@ -38,21 +39,19 @@ func testSweepSnsPlatformApplications(region string) error {
 		}
 
 		for _, platformApplication := range page.PlatformApplications {
-			arn := aws.StringValue(platformApplication.PlatformApplicationArn)
-
-			log.Printf("[INFO] Deleting SNS Platform Application: %s", arn)
-			_, err := conn.DeletePlatformApplication(&sns.DeletePlatformApplicationInput{
-				PlatformApplicationArn: aws.String(arn),
-			})
-			if isAWSErr(err, sns.ErrCodeNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting SNS Platform Application (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
+			input := &sns.DeletePlatformApplicationInput{
+				PlatformApplicationArn: platformApplication.PlatformApplicationArn,
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(platformApplication.PlatformApplicationArn), func() error {
+				_, err := conn.DeletePlatformApplication(input)
+
+				if isAWSErr(err, sns.ErrCodeNotFoundException, "") {
+					return nil
+				}
+
+				return err
+			}))
 		}
 
 		return !isLast
@@ -60,13 +59,14 @@ func testSweepSnsPlatformApplications(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SNS Platform Applications sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SNS Platform Applications: %w", err))
+		return sweepables, fmt.Errorf("error retrieving SNS Platform Applications: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 /**
@@ -421,7 +421,7 @@ func testAccCheckAwsSnsPlatformApplicationExists(name string) resource.TestCheck
 			return fmt.Errorf("missing ID: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 		input := &sns.GetPlatformApplicationAttributesInput{
 			PlatformApplicationArn: aws.String(rs.Primary.ID),
@@ -435,7 +435,7 @@ func testAccCheckAwsSnsPlatformApplicationExists(name string) resource.TestCheck
 }
 
 func testAccCheckAWSSNSPlatformApplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).snsconn
+	conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sns_platform_application" {
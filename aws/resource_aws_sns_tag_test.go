@@ -0,0 +1,19 @@
+package aws
+
+import (
+	"fmt"
+)
+
+func testAccSnsTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sns_tag" "test" {
+  resource_id = aws_sns_topic.test.arn
+  key         = %[2]q
+  value       = %[3]q
+}
+`, rName, key, value)
+}
@@ -8,17 +8,18 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sns"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	awspolicy "github.com/jen20/awspolicyequivalence"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sns_topic", &resource.Sweeper{
-		Name: "aws_sns_topic",
-		F:    testSweepSnsTopics,
+	sweep.AddTestSweepers("aws_sns_topic", &sweep.Sweeper{
+		Name:    "aws_sns_topic",
+		Service: "sns",
+		List:    testSweepSnsTopics,
 		Dependencies: []string{
 			"aws_autoscaling_group",
 			"aws_backup_vault_notifications",
@@ -42,13 +43,13 @@ func init() {
 	})
 }
 
-func testSweepSnsTopics(region string) error {
+func testSweepSnsTopics(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).snsconn
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).snsconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListTopicsPages(&sns.ListTopicsInput{}, func(page *sns.ListTopicsOutput, isLast bool) bool {
 		if page == nil {
@@ -56,34 +57,34 @@ func testSweepSnsTopics(region string) error {
 		}
 
 		for _, topic := range page.Topics {
-			arn := aws.StringValue(topic.TopicArn)
-
-			log.Printf("[INFO] Deleting SNS Topic: %s", arn)
-			_, err := conn.DeleteTopic(&sns.DeleteTopicInput{
-				TopicArn: aws.String(arn),
-			})
-			if isAWSErr(err, sns.ErrCodeNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting SNS Topic (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
+			input := &sns.DeleteTopicInput{
+				TopicArn: topic.TopicArn,
 			}
+
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(topic.TopicArn), func() error {
+				_, err := conn.DeleteTopic(input)
+
+				if isAWSErr(err, sns.ErrCodeNotFoundException, "") {
+					return nil
+				}
+
+				return err
+			}))
 		}
 
 		return !isLast
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SNS Topics sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SNS Topics: %w", err))
+		return sweepables, fmt.Errorf("error retrieving SNS Topics: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSSNSTopic_basic(t *testing.T) {
@@ -391,7 +392,7 @@ func testAccCheckAWSNSTopicHasPolicy(n string, expectedPolicyText string) resour
 			return fmt.Errorf("No SNS topic with that ARN exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
@@ -433,7 +434,7 @@ func testAccCheckAWSNSTopicHasDeliveryPolicy(n string, expectedPolicyText string
 			return fmt.Errorf("no Queue URL specified")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
@@ -463,7 +464,7 @@ func testAccCheckAWSNSTopicHasDeliveryPolicy(n string, expectedPolicyText string
 }
 
 func testAccCheckAWSSNSTopicDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).snsconn
+	conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sns_topic" {
@@ -498,7 +499,7 @@ func testAccCheckAWSSNSTopicExists(n string, attributes map[string]string) resou
 			return fmt.Errorf("No SNS topic with that ARN exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).snsconn
+		conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
@@ -13,47 +13,52 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
-		Name: "aws_spot_fleet_request",
-		F:    testSweepSpotFleetRequests,
+	sweep.AddTestSweepers("aws_spot_fleet_request", &sweep.Sweeper{
+		Name:    "aws_spot_fleet_request",
+		Service: "ec2",
+		List:    testSweepSpotFleetRequests,
 	})
 }
 
-func testSweepSpotFleetRequests(region string) error {
+func testSweepSpotFleetRequests(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeSpotFleetRequestsPages(&ec2.DescribeSpotFleetRequestsInput{}, func(page *ec2.DescribeSpotFleetRequestsOutput, isLast bool) bool {
-		if len(page.SpotFleetRequestConfigs) == 0 {
-			log.Print("[DEBUG] No Spot Fleet Requests to sweep")
-			return false
+		if page == nil {
+			return !isLast
 		}
 
 		for _, config := range page.SpotFleetRequestConfigs {
 			id := aws.StringValue(config.SpotFleetRequestId)
 
-			log.Printf("[INFO] Deleting Spot Fleet Request: %s", id)
-			err := deleteSpotFleetRequest(id, true, 15*time.Minute, conn)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Spot Fleet Request (%s): %s", id, err)
-			}
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				return deleteSpotFleetRequest(id, true, 15*time.Minute, conn)
+			}).WithTags(keyvaluetags.Ec2KeyValueTags(config.Tags).IgnoreAws().Map()))
 		}
+
 		return !isLast
 	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Spot Fleet Requests sweep for %s: %s", region, err)
+		return sweepables, nil // In case we have completed some pages
+	}
+
 	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping EC2 Spot Fleet Requests sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving EC2 Spot Fleet Requests: %s", err)
+		return sweepables, fmt.Errorf("Error retrieving EC2 Spot Fleet Requests: %s", err)
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAWSSpotFleetRequest_basic(t *testing.T) {
@@ -1263,7 +1268,7 @@ func testAccCheckAWSSpotFleetRequestExists(
 			return errors.New("No Spot fleet request with that id exists")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		params := &ec2.DescribeSpotFleetRequestsInput{
 			SpotFleetRequestIds: []*string{&rs.Primary.ID},
@@ -1285,7 +1290,7 @@ func testAccCheckAWSSpotFleetRequestExists(
 }
 
 func testAccCheckAWSSpotFleetRequestDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_spot_fleet_request" {
@@ -1357,7 +1362,7 @@ func testAccCheckAWSSpotFleetRequest_PlacementAttributes(
 }
 
 func testAccPreCheckAWSEc2SpotFleetRequest(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeSpotFleetRequestsInput{}
 
@@ -9,17 +9,19 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	awspolicy "github.com/jen20/awspolicyequivalence"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+	"strings"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sqs_queue", &resource.Sweeper{
-		Name: "aws_sqs_queue",
-		F:    testSweepSqsQueues,
+	sweep.AddTestSweepers("aws_sqs_queue", &sweep.Sweeper{
+		Name:    "aws_sqs_queue",
+		Service: "sqs",
+		List:    testSweepSqsQueues,
 		Dependencies: []string{
 			"aws_autoscaling_group",
 			"aws_cloudwatch_event_rule",
@@ -32,44 +34,44 @@ func init() {
 	})
 }
 
-func testSweepSqsQueues(region string) error {
+func testSweepSqsQueues(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).sqsconn
+	conn := client.(*AWSClient).sqsconn()
 	input := &sqs.ListQueuesInput{}
-	var sweeperErrs *multierror.Error
 
 	output, err := conn.ListQueues(input)
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SQS Queues sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+		return nil, nil
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SQS Queues: %w", err))
-		return sweeperErrs
+		return nil, fmt.Errorf("error retrieving SQS Queues: %w", err)
 	}
 
+	var sweepables []sweep.Sweepable
+
 	for _, queueUrl := range output.QueueUrls {
 		url := aws.StringValue(queueUrl)
 
-		log.Printf("[INFO] Deleting SQS Queue: %s", url)
-		_, err := conn.DeleteQueue(&sqs.DeleteQueueInput{
-			QueueUrl: aws.String(url),
-		})
-		if isAWSErr(err, sqs.ErrCodeQueueDoesNotExist, "") {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting SQS Queue (%s): %w", url, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+		sweepables = append(sweepables, sweep.NewResource(url, func() error {
+			_, err := conn.DeleteQueue(&sqs.DeleteQueueInput{
+				QueueUrl: aws.String(url),
+			})
+
+			if isAWSErr(err, sqs.ErrCodeQueueDoesNotExist, "") {
+				return nil
+			}
+
+			return err
+		}).WithName(url[strings.LastIndex(url, "/")+1:]))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSSQSQueue_basic(t *testing.T) {
@@ -453,7 +455,7 @@ func TestAccAWSSQSQueue_Encryption(t *testing.T) {
 }
 
 func testAccCheckAWSSQSQueueDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sqsconn
+	conn := testAccProvider.Meta().(*AWSClient).sqsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sqs_queue" {
@@ -520,7 +522,7 @@ func testAccCheckAWSSQSQueueExists(resourceName string, queueAttributes *map[str
 			return fmt.Errorf("No Queue URL specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sqsconn
+		conn := testAccProvider.Meta().(*AWSClient).sqsconn()
 
 		input := &sqs.GetQueueAttributesInput{
 			QueueUrl:       aws.String(rs.Primary.ID),
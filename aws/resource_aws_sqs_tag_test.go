@@ -0,0 +1,19 @@
+package aws
+
+import (
+	"fmt"
+)
+
+func testAccSqsTagConfig(rName string, key string, value string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_tag" "test" {
+  resource_id = aws_sqs_queue.test.id
+  key         = %[2]q
+  value       = %[3]q
+}
+`, rName, key, value)
+}
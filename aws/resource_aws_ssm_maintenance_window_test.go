@@ -8,62 +8,55 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
-		Name: "aws_ssm_maintenance_window",
-		F:    testSweepSsmMaintenanceWindows,
+	sweep.AddTestSweepers("aws_ssm_maintenance_window", &sweep.Sweeper{
+		Name:    "aws_ssm_maintenance_window",
+		Service: "ssm",
+		List:    testSweepSsmMaintenanceWindows,
 	})
 }
 
-func testSweepSsmMaintenanceWindows(region string) error {
+func testSweepSsmMaintenanceWindows(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*AWSClient).ssmconn
+	conn := client.(*AWSClient).ssmconn()
 	input := &ssm.DescribeMaintenanceWindowsInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.DescribeMaintenanceWindows(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping SSM Maintenance Window sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil // In case we have completed some pages
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving SSM Maintenance Windows: %s", err)
+			return sweepables, fmt.Errorf("Error retrieving SSM Maintenance Windows: %s", err)
 		}
 
 		for _, window := range output.WindowIdentities {
-			id := aws.StringValue(window.WindowId)
 			input := &ssm.DeleteMaintenanceWindowInput{
 				WindowId: window.WindowId,
 			}
 
-			log.Printf("[INFO] Deleting SSM Maintenance Window: %s", id)
-
-			_, err := conn.DeleteMaintenanceWindow(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(window.WindowId), func() error {
+				_, err := conn.DeleteMaintenanceWindow(input)
 
-			if isAWSErr(err, ssm.ErrCodeDoesNotExistException, "") {
-				continue
-			}
+				if isAWSErr(err, ssm.ErrCodeDoesNotExistException, "") {
+					return nil
+				}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting SSM Maintenance Window (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return err
+			}).WithName(aws.StringValue(window.Name)))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -73,7 +66,7 @@ func testSweepSsmMaintenanceWindows(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSSSMMaintenanceWindow_basic(t *testing.T) {
@@ -549,7 +542,7 @@ func testAccCheckAWSSSMMaintenanceWindowExists(n string, res *ssm.MaintenanceWin
 			return fmt.Errorf("No SSM Maintenance Window ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 		resp, err := conn.DescribeMaintenanceWindows(&ssm.DescribeMaintenanceWindowsInput{
 			Filters: []*ssm.MaintenanceWindowFilter{
@@ -576,7 +569,7 @@ func testAccCheckAWSSSMMaintenanceWindowExists(n string, res *ssm.MaintenanceWin
 
 func testAccCheckAWSSSMMaintenanceWindowDisappears(maintenanceWindowIdentity *ssm.MaintenanceWindowIdentity) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 		id := aws.StringValue(maintenanceWindowIdentity.WindowId)
 		_, err := conn.DeleteMaintenanceWindow(&ssm.DeleteMaintenanceWindowInput{
@@ -590,7 +583,7 @@ func testAccCheckAWSSSMMaintenanceWindowDisappears(maintenanceWindowIdentity *ss
 }
 
 func testAccCheckAWSSSMMaintenanceWindowDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+	conn := testAccProvider.Meta().(*AWSClient).ssmconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_maintenance_window" {
@@ -75,7 +75,7 @@ func resourceAwsSsoAdminAccountAssignment() *schema.Resource {
 }
 
 func resourceAwsSsoAdminAccountAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 
 	instanceArn := d.Get("instance_arn").(string)
 	permissionSetArn := d.Get("permission_set_arn").(string)
@@ -127,7 +127,7 @@ func resourceAwsSsoAdminAccountAssignmentCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsSsoAdminAccountAssignmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 
 	idParts, err := parseSsoAdminAccountAssignmentID(d.Id())
 	if err != nil {
@@ -174,7 +174,7 @@ func resourceAwsSsoAdminAccountAssignmentRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsSsoAdminAccountAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 
 	idParts, err := parseSsoAdminAccountAssignmentID(d.Id())
 	if err != nil {
@@ -16,22 +16,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ssoadmin_account_assignment", &resource.Sweeper{
-		Name: "aws_ssoadmin_account_assignment",
-		F:    testSweepSsoAdminAccountAssignments,
+	sweep.AddTestSweepers("aws_ssoadmin_account_assignment", &sweep.Sweeper{
+		Name:    "aws_ssoadmin_account_assignment",
+		Service: "ssoadmin",
+		List:    testSweepSsoAdminAccountAssignments,
 	})
 }
 
-func testSweepSsoAdminAccountAssignments(region string) error {
+func testSweepSsoAdminAccountAssignments(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
+	conn := client.(*AWSClient).ssoadminconn()
 
-	conn := client.(*AWSClient).ssoadminconn
+	var sweepables []sweep.Sweepable
 	var sweeperErrs *multierror.Error
 
 	// Need to Read the SSO Instance first; assumes the first instance returned
@@ -43,11 +46,11 @@ func testSweepSsoAdminAccountAssignments(region string) error {
 
 	if testSweepSkipResourceError(err) {
 		log.Printf("[WARN] Skipping SSO Account Assignment sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	instanceArn := dsData.Get("arns").(*schema.Set).List()[0].(string)
@@ -95,13 +98,7 @@ func testSweepSsoAdminAccountAssignments(region string) error {
 					d := r.Data(nil)
 					d.SetId(fmt.Sprintf("%s,%s,%s,%s,%s,%s", principalID, principalType, targetID, targetType, permissionSetArn, instanceArn))
 
-					err = r.Delete(d, client)
-
-					if err != nil {
-						log.Printf("[ERROR] %s", err)
-						sweeperErrs = multierror.Append(sweeperErrs, err)
-						continue
-					}
+					sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 				}
 
 				return !lastPage
@@ -122,14 +119,14 @@ func testSweepSsoAdminAccountAssignments(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SSO Account Assignment sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
 
 	if err != nil {
 		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SSO Permission Sets for Account Assignment sweep: %w", err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, sweeperErrs.ErrorOrNil()
 }
 
 func TestAccAWSSSOAdminAccountAssignment_Basic_Group(t *testing.T) {
@@ -224,7 +221,7 @@ func TestAccAWSSSOAdminAccountAssignment_Disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSSOAdminAccountAssignmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssoadmin_account_assignment" {
@@ -272,7 +269,7 @@ func testAccCheckAWSSSOAdminAccountAssignmentExists(resourceName string) resourc
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn()
 
 		idParts, err := parseSsoAdminAccountAssignmentID(rs.Primary.ID)
 
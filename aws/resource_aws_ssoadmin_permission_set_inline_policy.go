@@ -45,7 +45,7 @@ func resourceAwsSsoAdminPermissionSetInlinePolicy() *schema.Resource {
 }
 
 func resourceAwsSsoAdminPermissionSetInlinePolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 
 	instanceArn := d.Get("instance_arn").(string)
 	permissionSetArn := d.Get("permission_set_arn").(string)
@@ -72,7 +72,7 @@ func resourceAwsSsoAdminPermissionSetInlinePolicyPut(d *schema.ResourceData, met
 }
 
 func resourceAwsSsoAdminPermissionSetInlinePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 
 	permissionSetArn, instanceArn, err := parseSsoAdminResourceID(d.Id())
 	if err != nil {
@@ -108,7 +108,7 @@ func resourceAwsSsoAdminPermissionSetInlinePolicyRead(d *schema.ResourceData, me
 }
 
 func resourceAwsSsoAdminPermissionSetInlinePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 
 	permissionSetArn, instanceArn, err := parseSsoAdminResourceID(d.Id())
 	if err != nil {
@@ -8,31 +8,32 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ssoadmin_permission_set", &resource.Sweeper{
-		Name: "aws_ssoadmin_permission_set",
-		F:    testSweepSsoAdminPermissionSets,
+	sweep.AddTestSweepers("aws_ssoadmin_permission_set", &sweep.Sweeper{
+		Name:    "aws_ssoadmin_permission_set",
+		Service: "ssoadmin",
+		List:    testSweepSsoAdminPermissionSets,
 		Dependencies: []string{
 			"aws_ssoadmin_account_assignment",
 		},
 	})
 }
 
-func testSweepSsoAdminPermissionSets(region string) error {
+func testSweepSsoAdminPermissionSets(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
+	conn := client.(*AWSClient).ssoadminconn()
 
-	conn := client.(*AWSClient).ssoadminconn
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	// Need to Read the SSO Instance first; assumes the first instance returned
 	// is where the permission sets exist as AWS SSO currently supports only 1 instance
@@ -43,11 +44,11 @@ func testSweepSsoAdminPermissionSets(region string) error {
 
 	if testSweepSkipResourceError(err) {
 		log.Printf("[WARN] Skipping SSO Permission Set sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	instanceArn := dsData.Get("arns").(*schema.Set).List()[0].(string)
@@ -68,19 +69,13 @@ func testSweepSsoAdminPermissionSets(region string) error {
 
 			arn := aws.StringValue(permissionSet)
 
-			log.Printf("[INFO] Deleting SSO Permission Set: %s", arn)
-
 			r := resourceAwsSsoAdminPermissionSet()
 			d := r.Data(nil)
 			d.SetId(fmt.Sprintf("%s,%s", arn, instanceArn))
 
-			err = r.Delete(d, client)
-
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(arn, func() error {
+				return r.Delete(d, client)
+			}))
 		}
 
 		return !isLast
@@ -88,14 +83,14 @@ func testSweepSsoAdminPermissionSets(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SSO Permission Set sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SSO Permission Set: %w", err))
+		return sweepables, fmt.Errorf("error retrieving SSO Permission Set: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSSSOAdminPermissionSet_basic(t *testing.T) {
@@ -344,7 +339,7 @@ func TestAccAWSSSOAdminPermissionSet_mixedPolicyAttachments(t *testing.T) {
 }
 
 func testAccCheckAWSSSOAdminPermissionSetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssoadmin_permission_set" {
@@ -389,7 +384,7 @@ func testAccCheckAWSSOAdminPermissionSetExists(resourceName string) resource.Tes
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn()
 
 		arn, instanceArn, err := parseSsoAdminResourceID(rs.Primary.ID)
 
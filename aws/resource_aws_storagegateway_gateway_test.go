@@ -11,55 +11,59 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_storagegateway_gateway", &resource.Sweeper{
-		Name: "aws_storagegateway_gateway",
-		F:    testSweepStorageGatewayGateways,
+	sweep.AddTestSweepers("aws_storagegateway_gateway", &sweep.Sweeper{
+		Name:    "aws_storagegateway_gateway",
+		Service: "storagegateway",
+		List:    testSweepStorageGatewayGateways,
 	})
 }
 
-func testSweepStorageGatewayGateways(region string) error {
+func testSweepStorageGatewayGateways(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).storagegatewayconn
+	conn := client.(*AWSClient).storagegatewayconn()
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListGatewaysPages(&storagegateway.ListGatewaysInput{}, func(page *storagegateway.ListGatewaysOutput, isLast bool) bool {
-		if len(page.Gateways) == 0 {
-			log.Print("[DEBUG] No Storage Gateway Gateways to sweep")
-			return true
+		if page == nil {
+			return !isLast
 		}
 
 		for _, gateway := range page.Gateways {
-			name := aws.StringValue(gateway.GatewayName)
-
-			log.Printf("[INFO] Deleting Storage Gateway Gateway: %s", name)
 			input := &storagegateway.DeleteGatewayInput{
 				GatewayARN: gateway.GatewayARN,
 			}
 
-			_, err := conn.DeleteGateway(input)
-			if err != nil {
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(gateway.GatewayARN), func() error {
+				_, err := conn.DeleteGateway(input)
+
 				if isAWSErr(err, storagegateway.ErrorCodeGatewayNotFound, "") {
-					continue
+					return nil
 				}
-				log.Printf("[ERROR] Failed to delete Storage Gateway Gateway (%s): %s", name, err)
-			}
+
+				return err
+			}).WithName(aws.StringValue(gateway.GatewayName)))
 		}
 
 		return !isLast
 	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Storage Gateway Gateway sweep for %s: %s", region, err)
+		return sweepables, nil // In case we have completed some pages
+	}
+
 	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping Storage Gateway Gateway sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Storage Gateway Gateways: %w", err)
+		return sweepables, fmt.Errorf("Error retrieving Storage Gateway Gateways: %w", err)
 	}
-	return nil
+
+	return sweepables, nil
 }
 
 func TestAccAWSStorageGatewayGateway_GatewayType_Cached(t *testing.T) {
@@ -668,7 +672,7 @@ func TestAccAWSStorageGatewayGateway_bandwidthAll(t *testing.T) {
 }
 
 func testAccCheckAWSStorageGatewayGatewayDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_storagegateway_gateway" {
@@ -700,7 +704,7 @@ func testAccCheckAWSStorageGatewayGatewayExists(resourceName string, gateway *st
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 		input := &storagegateway.DescribeGatewayInformationInput{
 			GatewayARN: aws.String(rs.Primary.ID),
 		}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,19 +11,21 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // add sweeper to delete known test subnets
 func init() {
-	resource.AddTestSweepers("aws_subnet", &resource.Sweeper{
-		Name: "aws_subnet",
-		F:    testSweepSubnets,
+	sweep.AddTestSweepers("aws_subnet", &sweep.Sweeper{
+		Name:    "aws_subnet",
+		Service: "ec2",
+		List:    testSweepSubnets,
 		Dependencies: []string{
 			"aws_autoscaling_group",
 			"aws_batch_compute_environment",
@@ -55,33 +58,25 @@ func init() {
 	})
 }
 
-func testSweepSubnets(region string) error {
+func testSweepSubnets(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 	input := &ec2.DescribeSubnetsInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
-	err = conn.DescribeSubnetsPages(input, func(page *ec2.DescribeSubnetsOutput, lastPage bool) bool {
-		for _, subnet := range page.Subnets {
-			if subnet == nil {
-				continue
-			}
-
-			id := aws.StringValue(subnet.SubnetId)
-			input := &ec2.DeleteSubnetInput{
-				SubnetId: subnet.SubnetId,
-			}
-
-			if aws.BoolValue(subnet.DefaultForAz) {
-				log.Printf("[DEBUG] Skipping default EC2 Subnet: %s", id)
-				continue
-			}
+	err = lister.DescribeSubnetsItems(context.Background(), conn, input, func(subnet *ec2.Subnet) bool {
+		if aws.BoolValue(subnet.DefaultForAz) {
+			return true
+		}
 
-			log.Printf("[INFO] Deleting EC2 Subnet: %s", id)
+		input := &ec2.DeleteSubnetInput{
+			SubnetId: subnet.SubnetId,
+		}
 
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(subnet.SubnetId), func() error {
 			// Handle eventual consistency, especially with lingering ENIs from Load Balancers and Lambda
 			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 				_, err := conn.DeleteSubnet(input)
@@ -101,26 +96,22 @@ func testSweepSubnets(region string) error {
 				_, err = conn.DeleteSubnet(input)
 			}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting EC2 Subnet (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
-		}
+			return err
+		}).WithTags(keyvaluetags.Ec2KeyValueTags(subnet.Tags).IgnoreAws().Map()))
 
-		return !lastPage
+		return true
 	})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 Subnet sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error describing subnets: %w", err)
+		return sweepables, fmt.Errorf("Error describing subnets: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSSubnet_basic(t *testing.T) {
@@ -516,7 +507,7 @@ func testAccCheckAwsSubnetNotRecreated(t *testing.T, before, after *ec2.Subnet)
 }
 
 func testAccCheckSubnetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_subnet" {
@@ -559,7 +550,7 @@ func testAccCheckSubnetExists(n string, v *ec2.Subnet) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		resp, err := conn.DescribeSubnets(&ec2.DescribeSubnetsInput{
 			SubnetIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -578,7 +569,7 @@ func testAccCheckSubnetExists(n string, v *ec2.Subnet) resource.TestCheckFunc {
 
 func testAccCheckSubnetUpdateTags(subnet *ec2.Subnet, oldTags, newTags map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		return keyvaluetags.Ec2UpdateTags(conn, aws.StringValue(subnet.SubnetId), oldTags, newTags)
 	}
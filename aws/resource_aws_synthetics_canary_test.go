@@ -11,17 +11,18 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/synthetics"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_synthetics_canary", &resource.Sweeper{
-		Name: "aws_synthetics_canary",
-		F:    testSweepSyntheticsCanaries,
+	sweep.AddTestSweepers("aws_synthetics_canary", &sweep.Sweeper{
+		Name:    "aws_synthetics_canary",
+		Service: "synthetics",
+		List:    testSweepSyntheticsCanaries,
 		Dependencies: []string{
 			"aws_lambda_function",
 			"aws_lambda_layer",
@@ -30,48 +31,45 @@ func init() {
 	})
 }
 
-func testSweepSyntheticsCanaries(region string) error {
+func testSweepSyntheticsCanaries(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).syntheticsconn
+	conn := client.(*AWSClient).syntheticsconn()
 	input := &synthetics.DescribeCanariesInput{}
-	var sweeperErrs *multierror.Error
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.DescribeCanaries(input)
+
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping Synthetics Canary sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil // In case we have completed some pages
 		}
+
 		if err != nil {
-			return fmt.Errorf("error retrieving Synthetics Canaries: %w", err)
+			return sweepables, fmt.Errorf("error retrieving Synthetics Canaries: %w", err)
 		}
 
 		for _, canary := range output.Canaries {
-			name := aws.StringValue(canary.Name)
-			log.Printf("[INFO] Deleting Synthetics Canary: %s", name)
-
 			r := resourceAwsSyntheticsCanary()
 			d := r.Data(nil)
-			d.SetId(name)
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(canary.Name))
+			d.Set("name", canary.Name)
+			d.Set("tags", aws.StringValueMap(canary.Tags))
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
 			break
 		}
+
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSSyntheticsCanary_basic(t *testing.T) {
@@ -530,7 +528,7 @@ func TestAccAWSSyntheticsCanary_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsSyntheticsCanaryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).syntheticsconn
+	conn := testAccProvider.Meta().(*AWSClient).syntheticsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_synthetics_canary" {
@@ -562,7 +560,7 @@ func testAccCheckAwsSyntheticsCanaryExists(n string, canary *synthetics.Canary)
 		}
 
 		name := rs.Primary.ID
-		conn := testAccProvider.Meta().(*AWSClient).syntheticsconn
+		conn := testAccProvider.Meta().(*AWSClient).syntheticsconn()
 
 		out, err := finder.CanaryByName(conn, name)
 		if err != nil {
@@ -586,7 +584,7 @@ func testAccCheckAwsSyntheticsCanaryDeleteImplicitResources(n string) resource.T
 			return fmt.Errorf("synthetics Canary name not set")
 		}
 
-		lambdaConn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		lambdaConn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 		layerArn := rs.Primary.Attributes["source_location_arn"]
 		layerArnObj, err := arn.Parse(layerArn)
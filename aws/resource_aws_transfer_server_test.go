@@ -12,41 +12,45 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_transfer_server", &resource.Sweeper{
-		Name: "aws_transfer_server",
-		F:    testSweepTransferServers,
+	sweep.AddTestSweepers("aws_transfer_server", &sweep.Sweeper{
+		Name:    "aws_transfer_server",
+		Service: "transfer",
+		List:    testSweepTransferServers,
 	})
 }
 
-func testSweepTransferServers(region string) error {
+func testSweepTransferServers(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).transferconn
+	conn := client.(*AWSClient).transferconn()
 	input := &transfer.ListServersInput{}
+	var sweepables []sweep.Sweepable
 
 	err = conn.ListServersPages(input, func(page *transfer.ListServersOutput, lastPage bool) bool {
 		for _, server := range page.Servers {
 			id := aws.StringValue(server.ServerId)
-			input := &transfer.DeleteServerInput{
-				ServerId: server.ServerId,
-			}
 
-			log.Printf("[INFO] Deleting Transfer Server: %s", id)
-			_, err := conn.DeleteServer(input)
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				_, err := conn.DeleteServer(&transfer.DeleteServerInput{
+					ServerId: aws.String(id),
+				})
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting Transfer Server (%s): %s", id, err)
-				continue
-			}
+				if err != nil {
+					return err
+				}
 
-			if err := waitForTransferServerDeletion(conn, id); err != nil {
-				log.Printf("[ERROR] Error waiting for Transfer Server (%s) deletion: %s", id, err)
-			}
+				if err := waitForTransferServerDeletion(conn, id); err != nil {
+					return fmt.Errorf("error waiting for deletion: %w", err)
+				}
+
+				return nil
+			}))
 		}
 
 		return !lastPage
@@ -54,14 +58,14 @@ func testSweepTransferServers(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Transfer Server sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		return fmt.Errorf("error listing Transfer Servers: %s", err)
+		return sweepables, fmt.Errorf("error listing Transfer Servers: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSTransferServer_basic(t *testing.T) {
@@ -306,7 +310,7 @@ func testAccCheckAWSTransferServerExists(n string, res *transfer.DescribedServer
 			return fmt.Errorf("No Transfer Server ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		describe, err := conn.DescribeServer(&transfer.DescribeServerInput{
 			ServerId: aws.String(rs.Primary.ID),
@@ -324,7 +328,7 @@ func testAccCheckAWSTransferServerExists(n string, res *transfer.DescribedServer
 
 func testAccCheckAWSTransferServerDisappears(conf *transfer.DescribedServer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		params := &transfer.DeleteServerInput{
 			ServerId: conf.ServerId,
@@ -340,7 +344,7 @@ func testAccCheckAWSTransferServerDisappears(conf *transfer.DescribedServer) res
 }
 
 func testAccCheckAWSTransferServerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).transferconn
+	conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_transfer_server" {
@@ -365,7 +369,7 @@ func testAccCheckAWSTransferServerDestroy(s *terraform.State) error {
 
 func testAccCheckAWSTransferCreateUser(describedServer *transfer.DescribedServer, getRoleOutput *iam.GetRoleOutput, userName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		input := &transfer.CreateUserInput{
 			ServerId: describedServer.ServerId,
@@ -383,7 +387,7 @@ func testAccCheckAWSTransferCreateUser(describedServer *transfer.DescribedServer
 
 func testAccCheckAWSTransferCreateSshKey(describedServer *transfer.DescribedServer, userName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		input := &transfer.ImportSshPublicKeyInput{
 			ServerId:         describedServer.ServerId,
@@ -400,7 +404,7 @@ func testAccCheckAWSTransferCreateSshKey(describedServer *transfer.DescribedServ
 }
 
 func testAccPreCheckAWSTransfer(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).transferconn
+	conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 	input := &transfer.ListServersInput{}
 
@@ -49,7 +49,7 @@ func resourceAwsTransferSshKey() *schema.Resource {
 }
 
 func resourceAwsTransferSshKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	userName := d.Get("user_name").(string)
 	serverID := d.Get("server_id").(string)
 
@@ -72,7 +72,7 @@ func resourceAwsTransferSshKeyCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsTransferSshKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	serverID, userName, sshKeyID, err := decodeTransferSshKeyId(d.Id())
 	if err != nil {
 		return fmt.Errorf("error parsing Transfer SSH Public Key ID: %s", err)
@@ -115,7 +115,7 @@ func resourceAwsTransferSshKeyRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsTransferSshKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 	serverID, userName, sshKeyID, err := decodeTransferSshKeyId(d.Id())
 	if err != nil {
 		return fmt.Errorf("error parsing Transfer SSH Public Key ID: %s", err)
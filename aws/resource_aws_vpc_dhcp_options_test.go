@@ -11,23 +11,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
-		Name: "aws_vpc_dhcp_options",
-		F:    testSweepVpcDhcpOptions,
+	sweep.AddTestSweepers("aws_vpc_dhcp_options", &sweep.Sweeper{
+		Name:    "aws_vpc_dhcp_options",
+		Service: "ec2",
+		List:    testSweepVpcDhcpOptions,
 	})
 }
 
-func testSweepVpcDhcpOptions(region string) error {
+func testSweepVpcDhcpOptions(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
-
+	conn := client.(*AWSClient).ec2conn()
 	input := &ec2.DescribeDhcpOptionsInput{}
+	var sweepables []sweep.Sweepable
 
 	err = conn.DescribeDhcpOptionsPages(input, func(page *ec2.DescribeDhcpOptionsOutput, lastPage bool) bool {
 		for _, dhcpOption := range page.DhcpOptions {
@@ -62,25 +65,26 @@ func testSweepVpcDhcpOptions(region string) error {
 				DhcpOptionsId: dhcpOption.DhcpOptionsId,
 			}
 
-			_, err := conn.DeleteDhcpOptions(input)
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(dhcpOption.DhcpOptionsId), func() error {
+				_, err := conn.DeleteDhcpOptions(input)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting EC2 DHCP Option (%s): %s", aws.StringValue(dhcpOption.DhcpOptionsId), err)
-			}
+				return err
+			}).WithTags(keyvaluetags.Ec2KeyValueTags(dhcpOption.Tags).IgnoreAws().Map()))
 		}
+
 		return !lastPage
 	})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 DHCP Option sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		return fmt.Errorf("error describing DHCP Options: %s", err)
+		return sweepables, fmt.Errorf("error describing DHCP Options: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSDHCPOptions_basic(t *testing.T) {
@@ -206,7 +210,7 @@ func TestAccAWSDHCPOptions_disappears(t *testing.T) {
 }
 
 func testAccCheckDHCPOptionsDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_dhcp_options" {
@@ -249,7 +253,7 @@ func testAccCheckDHCPOptionsExists(n string, d *ec2.DhcpOptions) resource.TestCh
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		resp, err := conn.DescribeDhcpOptions(&ec2.DescribeDhcpOptionsInput{
 			DhcpOptionsIds: []*string{
 				aws.String(rs.Primary.ID),
@@ -279,7 +283,7 @@ func testAccCheckDHCPOptionsDelete(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		_, err := conn.DeleteDhcpOptions(&ec2.DeleteDhcpOptionsInput{
 			DhcpOptionsId: aws.String(rs.Primary.ID),
 		})
@@ -32,7 +32,7 @@ func resourceAwsVpcEndpointServiceAllowedPrincipal() *schema.Resource {
 }
 
 func resourceAwsVpcEndpointServiceAllowedPrincipalCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	svcId := d.Get("vpc_endpoint_service_id").(string)
 	arn := d.Get("principal_arn").(string)
@@ -56,7 +56,7 @@ func resourceAwsVpcEndpointServiceAllowedPrincipalCreate(d *schema.ResourceData,
 }
 
 func resourceAwsVpcEndpointServiceAllowedPrincipalRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	svcId := d.Get("vpc_endpoint_service_id").(string)
 	arn := d.Get("principal_arn").(string)
@@ -89,7 +89,7 @@ func resourceAwsVpcEndpointServiceAllowedPrincipalRead(d *schema.ResourceData, m
 }
 
 func resourceAwsVpcEndpointServiceAllowedPrincipalDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	svcId := d.Get("vpc_endpoint_service_id").(string)
 	arn := d.Get("principal_arn").(string)
@@ -8,32 +8,31 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
-		Name: "aws_vpc_endpoint_service",
-		F:    testSweepEc2VpcEndpointServices,
+	sweep.AddTestSweepers("aws_vpc_endpoint_service", &sweep.Sweeper{
+		Name:    "aws_vpc_endpoint_service",
+		Service: "ec2",
+		List:    testSweepEc2VpcEndpointServices,
 		Dependencies: []string{
 			"aws_vpc_endpoint",
 		},
 	})
 }
 
-func testSweepEc2VpcEndpointServices(region string) error {
+func testSweepEc2VpcEndpointServices(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-
-	conn := client.(*AWSClient).ec2conn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).ec2conn()
+	var sweepables []sweep.Sweepable
 
 	input := &ec2.DescribeVpcEndpointServiceConfigurationsInput{}
 
@@ -51,22 +50,12 @@ func testSweepEc2VpcEndpointServices(region string) error {
 				continue
 			}
 
-			id := aws.StringValue(serviceConfiguration.ServiceId)
-
-			log.Printf("[INFO] Deleting EC2 VPC Endpoint Service: %s", id)
-
 			r := resourceAwsVpcEndpointService()
 			d := r.Data(nil)
-			d.SetId(id)
-
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(serviceConfiguration.ServiceId))
+			d.Set("tags", keyvaluetags.Ec2KeyValueTags(serviceConfiguration.Tags).IgnoreAws().Map())
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting EC2 VPC Endpoint Service (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !lastPage
@@ -74,14 +63,14 @@ func testSweepEc2VpcEndpointServices(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 VPC Endpoint Service sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EC2 VPC Endpoint Services: %w", err))
+		return sweepables, fmt.Errorf("error listing EC2 VPC Endpoint Services: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSVpcEndpointService_basic(t *testing.T) {
@@ -301,7 +290,7 @@ func TestAccAWSVpcEndpointService_private_dns_name(t *testing.T) {
 }
 
 func testAccCheckVpcEndpointServiceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_endpoint_service" {
@@ -339,7 +328,7 @@ func testAccCheckVpcEndpointServiceExists(n string, svcCfg *ec2.ServiceConfigura
 			return fmt.Errorf("No VPC Endpoint Service ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		resp, err := conn.DescribeVpcEndpointServiceConfigurations(&ec2.DescribeVpcEndpointServiceConfigurationsInput{
 			ServiceIds: []*string{aws.String(rs.Primary.ID)},
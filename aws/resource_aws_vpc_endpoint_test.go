@@ -11,32 +11,31 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
-		Name: "aws_vpc_endpoint",
-		F:    testSweepEc2VpcEndpoints,
+	sweep.AddTestSweepers("aws_vpc_endpoint", &sweep.Sweeper{
+		Name:    "aws_vpc_endpoint",
+		Service: "ec2",
+		List:    testSweepEc2VpcEndpoints,
 		Dependencies: []string{
 			"aws_route_table",
 		},
 	})
 }
 
-func testSweepEc2VpcEndpoints(region string) error {
+func testSweepEc2VpcEndpoints(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
-
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-
-	conn := client.(*AWSClient).ec2conn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).ec2conn()
+	var sweepables []sweep.Sweepable
 
 	input := &ec2.DescribeVpcEndpointsInput{}
 
@@ -54,22 +53,12 @@ func testSweepEc2VpcEndpoints(region string) error {
 				continue
 			}
 
-			id := aws.StringValue(vpcEndpoint.VpcEndpointId)
-
-			log.Printf("[INFO] Deleting EC2 VPC Endpoint: %s", id)
-
 			r := resourceAwsVpcEndpoint()
 			d := r.Data(nil)
-			d.SetId(id)
-
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(vpcEndpoint.VpcEndpointId))
+			d.Set("tags", keyvaluetags.Ec2KeyValueTags(vpcEndpoint.Tags).IgnoreAws().Map())
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting EC2 VPC Endpoint (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !lastPage
@@ -77,14 +66,14 @@ func testSweepEc2VpcEndpoints(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 VPC Endpoint sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EC2 VPC Endpoints: %w", err))
+		return sweepables, fmt.Errorf("error listing EC2 VPC Endpoints: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSVpcEndpoint_gatewayBasic(t *testing.T) {
@@ -538,7 +527,7 @@ func TestAccAWSVpcEndpoint_VpcEndpointType_GatewayLoadBalancer(t *testing.T) {
 }
 
 func testAccCheckVpcEndpointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_endpoint" {
@@ -578,7 +567,7 @@ func testAccCheckVpcEndpointExists(n string, endpoint *ec2.VpcEndpoint) resource
 			return fmt.Errorf("No VPC Endpoint ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		input := &ec2.DescribeVpcEndpointsInput{
 			VpcEndpointIds: []*string{aws.String(rs.Primary.ID)},
 		}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"reflect"
@@ -14,77 +15,75 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
-		Name: "aws_vpc_peering_connection",
-		F:    testSweepEc2VpcPeeringConnections,
+	sweep.AddTestSweepers("aws_vpc_peering_connection", &sweep.Sweeper{
+		Name:    "aws_vpc_peering_connection",
+		Service: "ec2",
+		List:    testSweepEc2VpcPeeringConnections,
 	})
 }
 
-func testSweepEc2VpcPeeringConnections(region string) error {
+func testSweepEc2VpcPeeringConnections(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 	input := &ec2.DescribeVpcPeeringConnectionsInput{}
-
-	err = conn.DescribeVpcPeeringConnectionsPages(input, func(page *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
+	var sweepables []sweep.Sweepable
+
+	err = lister.DescribeVpcPeeringConnectionsItems(context.Background(), conn, input, func(vpcPeeringConnection *ec2.VpcPeeringConnection) bool {
+		deletedStatuses := map[string]bool{
+			ec2.VpcPeeringConnectionStateReasonCodeDeleted:  true,
+			ec2.VpcPeeringConnectionStateReasonCodeExpired:  true,
+			ec2.VpcPeeringConnectionStateReasonCodeFailed:   true,
+			ec2.VpcPeeringConnectionStateReasonCodeRejected: true,
 		}
 
-		for _, vpcPeeringConnection := range page.VpcPeeringConnections {
-			deletedStatuses := map[string]bool{
-				ec2.VpcPeeringConnectionStateReasonCodeDeleted:  true,
-				ec2.VpcPeeringConnectionStateReasonCodeExpired:  true,
-				ec2.VpcPeeringConnectionStateReasonCodeFailed:   true,
-				ec2.VpcPeeringConnectionStateReasonCodeRejected: true,
-			}
-
-			if _, ok := deletedStatuses[aws.StringValue(vpcPeeringConnection.Status.Code)]; ok {
-				continue
-			}
-
-			id := aws.StringValue(vpcPeeringConnection.VpcPeeringConnectionId)
-			input := &ec2.DeleteVpcPeeringConnectionInput{
-				VpcPeeringConnectionId: vpcPeeringConnection.VpcPeeringConnectionId,
-			}
+		if _, ok := deletedStatuses[aws.StringValue(vpcPeeringConnection.Status.Code)]; ok {
+			return true
+		}
 
-			log.Printf("[INFO] Deleting EC2 VPC Peering Connection: %s", id)
+		id := aws.StringValue(vpcPeeringConnection.VpcPeeringConnectionId)
 
-			_, err := conn.DeleteVpcPeeringConnection(input)
+		sweepables = append(sweepables, sweep.NewResource(id, func() error {
+			_, err := conn.DeleteVpcPeeringConnection(&ec2.DeleteVpcPeeringConnectionInput{
+				VpcPeeringConnectionId: aws.String(id),
+			})
 
 			if isAWSErr(err, "InvalidVpcPeeringConnectionID.NotFound", "") {
-				continue
+				return nil
 			}
 
 			if err != nil {
-				log.Printf("[ERROR] Error deleting EC2 VPC Peering Connection (%s): %s", id, err)
-				continue
+				return err
 			}
 
 			if err := waitForEc2VpcPeeringConnectionDeletion(conn, id, 5*time.Minute); err != nil {
-				log.Printf("[ERROR] Error waiting for EC2 VPC Peering Connection (%s) to be deleted: %s", id, err)
+				return fmt.Errorf("error waiting for deletion: %w", err)
 			}
-		}
 
-		return !lastPage
+			return nil
+		}).WithTags(keyvaluetags.Ec2KeyValueTags(vpcPeeringConnection.Tags).IgnoreAws().Map()))
+
+		return true
 	})
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 VPC Peering Connection sweep for %s: %s", region, err)
-		return nil
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error describing EC2 VPC Peering Connections: %s", err)
+		return sweepables, fmt.Errorf("Error describing EC2 VPC Peering Connections: %s", err)
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSVPCPeeringConnection_basic(t *testing.T) {
@@ -126,7 +125,7 @@ func TestAccAWSVPCPeeringConnection_plan(t *testing.T) {
 
 	// reach out and DELETE the VPC Peering connection outside of Terraform
 	testDestroy := func(*terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		log.Printf("[DEBUG] Test deleting the VPC Peering Connection.")
 		_, err := conn.DeleteVpcPeeringConnection(
 			&ec2.DeleteVpcPeeringConnectionInput{
@@ -214,7 +213,7 @@ func TestAccAWSVPCPeeringConnection_options(t *testing.T) {
 	resourceName := "aws_vpc_peering_connection.test"
 
 	testAccepterChange := func(*terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		log.Printf("[DEBUG] Test change to the VPC Peering Connection Options.")
 
 		_, err := conn.ModifyVpcPeeringConnectionOptions(
@@ -403,7 +402,7 @@ func TestAccAWSVPCPeeringConnection_failedState(t *testing.T) {
 }
 
 func testAccCheckAWSVpcPeeringConnectionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_peering_connection" {
@@ -461,7 +460,7 @@ func testAccCheckAWSVpcPeeringConnectionExistsWithProvider(n string, connection
 			return fmt.Errorf("No VPC Peering Connection ID is set.")
 		}
 
-		conn := providerF().Meta().(*AWSClient).ec2conn
+		conn := providerF().Meta().(*AWSClient).ec2conn()
 		resp, err := conn.DescribeVpcPeeringConnections(
 			&ec2.DescribeVpcPeeringConnectionsInput{
 				VpcPeeringConnectionIds: []*string{aws.String(rs.Primary.ID)},
@@ -494,7 +493,7 @@ func testAccCheckAWSVpcPeeringConnectionOptionsWithProvider(n, block string, opt
 			return fmt.Errorf("No VPC Peering Connection ID is set.")
 		}
 
-		conn := providerF().Meta().(*AWSClient).ec2conn
+		conn := providerF().Meta().(*AWSClient).ec2conn()
 		resp, err := conn.DescribeVpcPeeringConnections(
 			&ec2.DescribeVpcPeeringConnectionsInput{
 				VpcPeeringConnectionIds: []*string{aws.String(rs.Primary.ID)},
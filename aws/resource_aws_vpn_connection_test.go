@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 type TunnelOptions struct {
@@ -35,18 +37,19 @@ type TunnelOptions struct {
 }
 
 func init() {
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
-		Name: "aws_vpn_connection",
-		F:    testSweepEc2VpnConnections,
+	sweep.AddTestSweepers("aws_vpn_connection", &sweep.Sweeper{
+		Name:    "aws_vpn_connection",
+		Service: "ec2",
+		List:    testSweepEc2VpnConnections,
 	})
 }
 
-func testSweepEc2VpnConnections(region string) error {
+func testSweepEc2VpnConnections(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 	input := &ec2.DescribeVpnConnectionsInput{}
 
 	// DescribeVpnConnections does not currently have any form of pagination
@@ -54,41 +57,44 @@ func testSweepEc2VpnConnections(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 VPN Connection sweep for %s: %s", region, err)
-		return nil
+		return nil, nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error retrieving EC2 VPN Connections: %s", err)
+		return nil, fmt.Errorf("error retrieving EC2 VPN Connections: %s", err)
 	}
 
+	var sweepables []sweep.Sweepable
+
 	for _, vpnConnection := range output.VpnConnections {
 		if aws.StringValue(vpnConnection.State) == ec2.VpnStateDeleted {
 			continue
 		}
 
 		id := aws.StringValue(vpnConnection.VpnConnectionId)
-		input := &ec2.DeleteVpnConnectionInput{
-			VpnConnectionId: vpnConnection.VpnConnectionId,
-		}
 
-		log.Printf("[INFO] Deleting EC2 VPN Connection: %s", id)
+		sweepables = append(sweepables, sweep.NewResource(id, func() error {
+			_, err := conn.DeleteVpnConnection(&ec2.DeleteVpnConnectionInput{
+				VpnConnectionId: aws.String(id),
+			})
 
-		_, err := conn.DeleteVpnConnection(input)
+			if isAWSErr(err, "InvalidVpnConnectionID.NotFound", "") {
+				return nil
+			}
 
-		if isAWSErr(err, "InvalidVpnConnectionID.NotFound", "") {
-			continue
-		}
+			if err != nil {
+				return err
+			}
 
-		if err != nil {
-			return fmt.Errorf("error deleting EC2 VPN Connection (%s): %s", id, err)
-		}
+			if err := waitForEc2VpnConnectionDeletion(conn, id); err != nil {
+				return fmt.Errorf("error waiting for deletion: %w", err)
+			}
 
-		if err := waitForEc2VpnConnectionDeletion(conn, id); err != nil {
-			return fmt.Errorf("error waiting for VPN connection (%s) to delete: %s", id, err)
-		}
+			return nil
+		}).WithTags(keyvaluetags.Ec2KeyValueTags(vpnConnection.Tags).IgnoreAws().Map()))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSVpnConnection_basic(t *testing.T) {
@@ -457,7 +463,7 @@ func TestAccAWSVpnConnection_disappears(t *testing.T) {
 }
 
 func testAccAwsVpnConnectionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpn_connection" {
 			continue
@@ -511,7 +517,7 @@ func testAccAwsVpnConnectionExists(vpnConnectionResource string, vpnConnection *
 			return fmt.Errorf("Not found: %s", vpnConnectionResource)
 		}
 
-		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		resp, err := ec2conn.DescribeVpnConnections(&ec2.DescribeVpnConnectionsInput{
 			VpnConnectionIds: []*string{aws.String(connection.Primary.ID)},
@@ -9,81 +9,77 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // add sweeper to delete known test VPN Gateways
 func init() {
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
-		Name: "aws_vpn_gateway",
-		F:    testSweepVPNGateways,
+	sweep.AddTestSweepers("aws_vpn_gateway", &sweep.Sweeper{
+		Name:    "aws_vpn_gateway",
+		Service: "ec2",
+		List:    testSweepVPNGateways,
 		Dependencies: []string{
 			"aws_dx_gateway_association",
 		},
 	})
 }
 
-func testSweepVPNGateways(region string) error {
+func testSweepVPNGateways(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).ec2conn
-	var sweeperErrs *multierror.Error
-
+	conn := client.(*AWSClient).ec2conn()
 	req := &ec2.DescribeVpnGatewaysInput{}
+
 	resp, err := conn.DescribeVpnGateways(req)
-	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping EC2 VPN Gateway sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error describing VPN Gateways: %s", err)
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 VPN Gateway sweep for %s: %s", region, err)
+		return nil, nil
 	}
 
-	if len(resp.VpnGateways) == 0 {
-		log.Print("[DEBUG] No VPN Gateways to sweep")
-		return nil
+	if err != nil {
+		return nil, fmt.Errorf("Error describing VPN Gateways: %s", err)
 	}
 
+	var sweepables []sweep.Sweepable
+
 	for _, vpng := range resp.VpnGateways {
 		if aws.StringValue(vpng.State) == ec2.VpnStateDeleted {
 			continue
 		}
 
-		for _, vpcAttachment := range vpng.VpcAttachments {
-			if aws.StringValue(vpcAttachment.State) == ec2.AttachmentStatusDetached {
-				continue
-			}
+		vpng := vpng
 
-			r := resourceAwsVpnGatewayAttachment()
-			d := r.Data(nil)
-			d.Set("vpc_id", vpcAttachment.VpcId)
-			d.Set("vpn_gateway_id", vpng.VpnGatewayId)
-			err := r.Delete(d, client)
-
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
+		sweepables = append(sweepables, sweep.NewResource(aws.StringValue(vpng.VpnGatewayId), func() error {
+			for _, vpcAttachment := range vpng.VpcAttachments {
+				if aws.StringValue(vpcAttachment.State) == ec2.AttachmentStatusDetached {
+					continue
+				}
+
+				r := resourceAwsVpnGatewayAttachment()
+				d := r.Data(nil)
+				d.Set("vpc_id", vpcAttachment.VpcId)
+				d.Set("vpn_gateway_id", vpng.VpnGatewayId)
+
+				if err := r.Delete(d, client); err != nil {
+					return fmt.Errorf("error detaching from VPC (%s): %w", aws.StringValue(vpcAttachment.VpcId), err)
+				}
 			}
-		}
 
-		r := resourceAwsVpnGateway()
-		d := r.Data(nil)
-		d.SetId(aws.StringValue(vpng.VpnGatewayId))
-		err := r.Delete(d, client)
+			r := resourceAwsVpnGateway()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(vpng.VpnGatewayId))
 
-		if err != nil {
-			log.Printf("[ERROR] %s", err)
-			sweeperErrs = multierror.Append(sweeperErrs, err)
-			continue
-		}
+			return r.Delete(d, client)
+		}).WithTags(keyvaluetags.Ec2KeyValueTags(vpng.Tags).IgnoreAws().Map()))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSVpnGateway_basic(t *testing.T) {
@@ -383,7 +379,7 @@ func TestAccAWSVpnGateway_tags(t *testing.T) {
 }
 
 func testAccCheckVpnGatewayDestroy(s *terraform.State) error {
-	ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpn_gateway" {
@@ -437,7 +433,7 @@ func testAccCheckVpnGatewayExists(n string, ig *ec2.VpnGateway) resource.TestChe
 			return fmt.Errorf("No ID is set")
 		}
 
-		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		resp, err := ec2conn.DescribeVpnGateways(&ec2.DescribeVpnGatewaysInput{
 			VpnGatewayIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -8,17 +8,18 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/waf"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/waf/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_byte_match_set", &resource.Sweeper{
-		Name: "aws_waf_byte_match_set",
-		F:    testSweepWafByteMatchSet,
+	sweep.AddTestSweepers("aws_waf_byte_match_set", &sweep.Sweeper{
+		Name:    "aws_waf_byte_match_set",
+		Service: "waf",
+		List:    testSweepWafByteMatchSet,
 		Dependencies: []string{
 			"aws_waf_rate_based_rule",
 			"aws_waf_rule",
@@ -27,14 +28,13 @@ func init() {
 	})
 }
 
-func testSweepWafByteMatchSet(region string) error {
+func testSweepWafByteMatchSet(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).wafconn()
+	var sweepables []sweep.Sweepable
 
 	input := &waf.ListByteMatchSetsInput{}
 
@@ -44,35 +44,23 @@ func testSweepWafByteMatchSet(region string) error {
 		}
 
 		for _, byteMatchSet := range page.ByteMatchSets {
-			id := aws.StringValue(byteMatchSet.ByteMatchSetId)
-
 			r := resourceAwsWafByteMatchSet()
 			d := r.Data(nil)
-			d.SetId(id)
-
-			// Need to Read first to fill in byte_match_tuples attribute
-			err := r.Read(d, client)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error reading WAF Byte Match Set (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			d.SetId(aws.StringValue(byteMatchSet.ByteMatchSetId))
 
-			// In case it was already deleted
-			if d.Id() == "" {
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(d.Id(), func() error {
+				// Need to Read first to fill in byte_match_tuples attribute
+				if err := r.Read(d, client); err != nil {
+					return fmt.Errorf("error reading: %w", err)
+				}
 
-			err = r.Delete(d, client)
+				// In case it was already deleted
+				if d.Id() == "" {
+					return nil
+				}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting WAF Byte Match Set (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return r.Delete(d, client)
+			}).WithName(aws.StringValue(byteMatchSet.Name)))
 		}
 
 		return !lastPage
@@ -80,14 +68,14 @@ func testSweepWafByteMatchSet(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping WAF Byte Match Set sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing WAF Byte Match Sets: %w", err))
+		return sweepables, fmt.Errorf("error describing WAF Byte Match Sets: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSWafByteMatchSet_basic(t *testing.T) {
@@ -287,7 +275,7 @@ func TestAccAWSWafByteMatchSet_disappears(t *testing.T) {
 
 func testAccCheckAWSWafByteMatchSetDisappears(v *waf.ByteMatchSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 
 		wr := newWafRetryer(conn)
 		_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -341,7 +329,7 @@ func testAccCheckAWSWafByteMatchSetExists(n string, v *waf.ByteMatchSet) resourc
 			return fmt.Errorf("No WAF ByteMatchSet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetByteMatchSet(&waf.GetByteMatchSetInput{
 			ByteMatchSetId: aws.String(rs.Primary.ID),
 		})
@@ -365,7 +353,7 @@ func testAccCheckAWSWafByteMatchSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetByteMatchSet(
 			&waf.GetByteMatchSetInput{
 				ByteMatchSetId: aws.String(rs.Primary.ID),
@@ -8,17 +8,18 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/waf/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_geo_match_set", &resource.Sweeper{
-		Name: "aws_waf_geo_match_set",
-		F:    testSweepWafGeoMatchSet,
+	sweep.AddTestSweepers("aws_waf_geo_match_set", &sweep.Sweeper{
+		Name:    "aws_waf_geo_match_set",
+		Service: "waf",
+		List:    testSweepWafGeoMatchSet,
 		Dependencies: []string{
 			"aws_waf_rate_based_rule",
 			"aws_waf_rule",
@@ -27,14 +28,13 @@ func init() {
 	})
 }
 
-func testSweepWafGeoMatchSet(region string) error {
+func testSweepWafGeoMatchSet(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).wafconn()
+	var sweepables []sweep.Sweepable
 
 	input := &waf.ListGeoMatchSetsInput{}
 
@@ -44,35 +44,23 @@ func testSweepWafGeoMatchSet(region string) error {
 		}
 
 		for _, geoMatchSet := range page.GeoMatchSets {
-			id := aws.StringValue(geoMatchSet.GeoMatchSetId)
-
 			r := resourceAwsWafGeoMatchSet()
 			d := r.Data(nil)
-			d.SetId(id)
-
-			// Need to Read first to fill in geo_match_constraint attribute
-			err := r.Read(d, client)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error reading WAF Geo Match Set (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			d.SetId(aws.StringValue(geoMatchSet.GeoMatchSetId))
 
-			// In case it was already deleted
-			if d.Id() == "" {
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(d.Id(), func() error {
+				// Need to Read first to fill in geo_match_constraint attribute
+				if err := r.Read(d, client); err != nil {
+					return fmt.Errorf("error reading: %w", err)
+				}
 
-			err = r.Delete(d, client)
+				// In case it was already deleted
+				if d.Id() == "" {
+					return nil
+				}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting WAF Geo Match Set (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return r.Delete(d, client)
+			}).WithName(aws.StringValue(geoMatchSet.Name)))
 		}
 
 		return !lastPage
@@ -80,14 +68,14 @@ func testSweepWafGeoMatchSet(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping WAF Geo Match Set sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing WAF Geo Match Sets: %w", err))
+		return sweepables, fmt.Errorf("error describing WAF Geo Match Sets: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSWafGeoMatchSet_basic(t *testing.T) {
@@ -264,7 +252,7 @@ func TestAccAWSWafGeoMatchSet_noConstraints(t *testing.T) {
 
 func testAccCheckAWSWafGeoMatchSetDisappears(v *waf.GeoMatchSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 
 		wr := newWafRetryer(conn)
 		_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -314,7 +302,7 @@ func testAccCheckAWSWafGeoMatchSetExists(n string, v *waf.GeoMatchSet) resource.
 			return fmt.Errorf("No WAF GeoMatchSet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetGeoMatchSet(&waf.GetGeoMatchSetInput{
 			GeoMatchSetId: aws.String(rs.Primary.ID),
 		})
@@ -338,7 +326,7 @@ func testAccCheckAWSWafGeoMatchSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetGeoMatchSet(
 			&waf.GetGeoMatchSetInput{
 				GeoMatchSetId: aws.String(rs.Primary.ID),
@@ -11,17 +11,18 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/waf/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_ipset", &resource.Sweeper{
-		Name: "aws_waf_ipset",
-		F:    testSweepWafIPSet,
+	sweep.AddTestSweepers("aws_waf_ipset", &sweep.Sweeper{
+		Name:    "aws_waf_ipset",
+		Service: "waf",
+		List:    testSweepWafIPSet,
 		Dependencies: []string{
 			"aws_waf_rate_based_rule",
 			"aws_waf_rule",
@@ -30,14 +31,13 @@ func init() {
 	})
 }
 
-func testSweepWafIPSet(region string) error {
+func testSweepWafIPSet(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).wafconn()
+	var sweepables []sweep.Sweepable
 
 	input := &waf.ListIPSetsInput{}
 
@@ -47,35 +47,23 @@ func testSweepWafIPSet(region string) error {
 		}
 
 		for _, ipSet := range page.IPSets {
-			id := aws.StringValue(ipSet.IPSetId)
-
 			r := resourceAwsWafIPSet()
 			d := r.Data(nil)
-			d.SetId(id)
-
-			// Need to Read first to fill in ip_set_descriptors attribute
-			err := r.Read(d, client)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error reading WAF IP Set (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			d.SetId(aws.StringValue(ipSet.IPSetId))
 
-			// In case it was already deleted
-			if d.Id() == "" {
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewResource(d.Id(), func() error {
+				// Need to Read first to fill in ip_set_descriptors attribute
+				if err := r.Read(d, client); err != nil {
+					return fmt.Errorf("error reading: %w", err)
+				}
 
-			err = r.Delete(d, client)
+				// In case it was already deleted
+				if d.Id() == "" {
+					return nil
+				}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting WAF IP Set (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return r.Delete(d, client)
+			}).WithName(aws.StringValue(ipSet.Name)))
 		}
 
 		return !lastPage
@@ -83,14 +71,14 @@ func testSweepWafIPSet(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping WAF IP Set sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing WAF IP Sets: %w", err))
+		return sweepables, fmt.Errorf("error describing WAF IP Sets: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSWafIPSet_basic(t *testing.T) {
@@ -435,7 +423,7 @@ func TestAccAWSWafIPSet_ipv6(t *testing.T) {
 
 func testAccCheckAWSWafIPSetDisappears(v *waf.IPSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 
 		wr := newWafRetryer(conn)
 		_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -481,7 +469,7 @@ func testAccCheckAWSWafIPSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetIPSet(
 			&waf.GetIPSetInput{
 				IPSetId: aws.String(rs.Primary.ID),
@@ -515,7 +503,7 @@ func testAccCheckAWSWafIPSetExists(n string, v *waf.IPSet) resource.TestCheckFun
 			return fmt.Errorf("No WAF IPSet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetIPSet(&waf.GetIPSetInput{
 			IPSetId: aws.String(rs.Primary.ID),
 		})
@@ -9,17 +9,18 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/waf"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/waf/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_rate_based_rule", &resource.Sweeper{
-		Name: "aws_waf_rate_based_rule",
-		F:    testSweepWafRateBasedRules,
+	sweep.AddTestSweepers("aws_waf_rate_based_rule", &sweep.Sweeper{
+		Name:    "aws_waf_rate_based_rule",
+		Service: "waf",
+		List:    testSweepWafRateBasedRules,
 		Dependencies: []string{
 			"aws_waf_rule_group",
 			"aws_waf_web_acl",
@@ -27,14 +28,13 @@ func init() {
 	})
 }
 
-func testSweepWafRateBasedRules(region string) error {
+func testSweepWafRateBasedRules(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).wafconn()
+	var sweepables []sweep.Sweepable
 
 	input := &waf.ListRateBasedRulesInput{}
 
@@ -44,35 +44,23 @@ func testSweepWafRateBasedRules(region string) error {
 		}
 
 		for _, rule := range page.Rules {
-			id := aws.StringValue(rule.RuleId)
-
 			r := resourceAwsWafRateBasedRule()
 			d := r.Data(nil)
-			d.SetId(id)
+			d.SetId(aws.StringValue(rule.RuleId))
 
-			// Need to Read first to fill in predicates attribute
-			err := r.Read(d, client)
+			sweepables = append(sweepables, sweep.NewResource(d.Id(), func() error {
+				// Need to Read first to fill in predicates attribute
+				if err := r.Read(d, client); err != nil {
+					return fmt.Errorf("error reading: %w", err)
+				}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error reading WAF Rate Based Rule (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				// In case it was already deleted
+				if d.Id() == "" {
+					return nil
+				}
 
-			// In case it was already deleted
-			if d.Id() == "" {
-				continue
-			}
-
-			err = r.Delete(d, client)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting WAF Rate Based Rule (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return r.Delete(d, client)
+			}).WithName(aws.StringValue(rule.Name)))
 		}
 
 		return !lastPage
@@ -80,14 +68,14 @@ func testSweepWafRateBasedRules(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping WAF Rate Based Rule sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing WAF Rate Based Rules: %w", err))
+		return sweepables, fmt.Errorf("error describing WAF Rate Based Rules: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAWSWafRateBasedRule_basic(t *testing.T) {
@@ -357,7 +345,7 @@ func testAccCheckAWSWafRateBasedRuleDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetRateBasedRule(
 			&waf.GetRateBasedRuleInput{
 				RuleId: aws.String(rs.Primary.ID),
@@ -393,7 +381,7 @@ func testAccCheckAWSWafRateBasedRuleExists(n string, v *waf.RateBasedRule) resou
 			return fmt.Errorf("No WAF Rule ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetRateBasedRule(&waf.GetRateBasedRuleInput{
 			RuleId: aws.String(rs.Primary.ID),
 		})
@@ -8,17 +8,18 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/waf/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_regex_match_set", &resource.Sweeper{
-		Name: "aws_waf_regex_match_set",
-		F:    testSweepWafRegexMatchSet,
+	sweep.AddTestSweepers("aws_waf_regex_match_set", &sweep.Sweeper{
+		Name:    "aws_waf_regex_match_set",
+		Service: "waf",
+		List:    testSweepWafRegexMatchSet,
 		Dependencies: []string{
 			"aws_waf_rate_based_rule",
 			"aws_waf_rule",
@@ -27,14 +28,13 @@ func init() {
 	})
 }
 
-func testSweepWafRegexMatchSet(region string) error {
+func testSweepWafRegexMatchSet(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafconn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).wafconn()
+	var sweepables []sweep.Sweepable
 
 	input := &waf.ListRegexMatchSetsInput{}
 
@@ -44,35 +44,23 @@ func testSweepWafRegexMatchSet(region string) error {
 		}
 
 		for _, regexMatchSet := range page.RegexMatchSets {
-			id := aws.StringValue(regexMatchSet.RegexMatchSetId)
-
 			r := resourceAwsWafRegexMatchSet()
 			d := r.Data(nil)
-			d.SetId(id)
+			d.SetId(aws.StringValue(regexMatchSet.RegexMatchSetId))
 
-			// Need to Read first to fill in regex_match_tuple attribute
-			err := r.Read(d, client)
+			sweepables = append(sweepables, sweep.NewResource(d.Id(), func() error {
+				// Need to Read first to fill in regex_match_tuple attribute
+				if err := r.Read(d, client); err != nil {
+					return fmt.Errorf("error reading: %w", err)
+				}
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error reading WAF Regex Match Set (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				// In case it was already deleted
+				if d.Id() == "" {
+					return nil
+				}
 
-			// In case it was already deleted
-			if d.Id() == "" {
-				continue
-			}
-
-			err = r.Delete(d, client)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting WAF Regex Match Set (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+				return r.Delete(d, client)
+			}).WithName(aws.StringValue(regexMatchSet.Name)))
 		}
 
 		return !lastPage
@@ -80,14 +68,14 @@ func testSweepWafRegexMatchSet(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping WAF Regex Match Set sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing WAF Regex Match Sets: %w", err))
+		return sweepables, fmt.Errorf("error describing WAF Regex Match Sets: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 // Serialized acceptance tests due to WAF account limits
@@ -274,7 +262,7 @@ func computeWafRegexMatchSetTuple(patternSet *waf.RegexPatternSet, fieldToMatch
 
 func testAccCheckAWSWafRegexMatchSetDisappears(set *waf.RegexMatchSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 
 		wr := newWafRetryer(conn)
 		_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -322,7 +310,7 @@ func testAccCheckAWSWafRegexMatchSetExists(n string, v *waf.RegexMatchSet) resou
 			return fmt.Errorf("No WAF Regex Match Set ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
 			RegexMatchSetId: aws.String(rs.Primary.ID),
 		})
@@ -346,7 +334,7 @@ func testAccCheckAWSWafRegexMatchSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
 			RegexMatchSetId: aws.String(rs.Primary.ID),
 		})
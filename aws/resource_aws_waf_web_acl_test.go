@@ -11,96 +11,96 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_web_acl", &resource.Sweeper{
-		Name: "aws_waf_web_acl",
-		F:    testSweepWafWebAcls,
+	sweep.AddTestSweepers("aws_waf_web_acl", &sweep.Sweeper{
+		Name:    "aws_waf_web_acl",
+		Service: "waf",
+		List:    testSweepWafWebAcls,
 	})
 }
 
-func testSweepWafWebAcls(region string) error {
+func testSweepWafWebAcls(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafconn
-
+	conn := client.(*AWSClient).wafconn()
 	input := &waf.ListWebACLsInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.ListWebACLs(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping WAF Regional Web ACL sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil // In case we have completed some pages
 		}
 
 		if err != nil {
-			return fmt.Errorf("error listing WAF Regional Web ACLs: %s", err)
+			return sweepables, fmt.Errorf("error listing WAF Regional Web ACLs: %s", err)
 		}
 
 		for _, webACL := range output.WebACLs {
-			deleteInput := &waf.DeleteWebACLInput{
-				WebACLId: webACL.WebACLId,
-			}
-			id := aws.StringValue(webACL.WebACLId)
-			wr := newWafRetryer(conn)
+			webACLID := webACL.WebACLId
 
-			_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
-				return conn.DeleteWebACL(deleteInput)
-			})
-
-			if isAWSErr(err, waf.ErrCodeNonEmptyEntityException, "") {
-				getWebACLInput := &waf.GetWebACLInput{
-					WebACLId: webACL.WebACLId,
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(webACLID), func() error {
+				deleteInput := &waf.DeleteWebACLInput{
+					WebACLId: webACLID,
 				}
+				wr := newWafRetryer(conn)
 
-				getWebACLOutput, getWebACLErr := conn.GetWebACL(getWebACLInput)
+				_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+					deleteInput.ChangeToken = token
+					return conn.DeleteWebACL(deleteInput)
+				})
 
-				if getWebACLErr != nil {
-					return fmt.Errorf("error getting WAF Regional Web ACL (%s): %s", id, getWebACLErr)
-				}
+				if isAWSErr(err, waf.ErrCodeNonEmptyEntityException, "") {
+					getWebACLInput := &waf.GetWebACLInput{
+						WebACLId: webACLID,
+					}
 
-				var updates []*waf.WebACLUpdate
-				updateWebACLInput := &waf.UpdateWebACLInput{
-					DefaultAction: getWebACLOutput.WebACL.DefaultAction,
-					Updates:       updates,
-					WebACLId:      webACL.WebACLId,
-				}
+					getWebACLOutput, getWebACLErr := conn.GetWebACL(getWebACLInput)
 
-				for _, rule := range getWebACLOutput.WebACL.Rules {
-					update := &waf.WebACLUpdate{
-						Action:        aws.String(waf.ChangeActionDelete),
-						ActivatedRule: rule,
+					if getWebACLErr != nil {
+						return fmt.Errorf("error getting: %w", getWebACLErr)
 					}
 
-					updateWebACLInput.Updates = append(updateWebACLInput.Updates, update)
-				}
+					var updates []*waf.WebACLUpdate
+					updateWebACLInput := &waf.UpdateWebACLInput{
+						DefaultAction: getWebACLOutput.WebACL.DefaultAction,
+						Updates:       updates,
+						WebACLId:      webACLID,
+					}
 
-				_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
-					updateWebACLInput.ChangeToken = token
-					log.Printf("[INFO] Removing Rules from WAF Regional Web ACL: %s", id)
-					return conn.UpdateWebACL(updateWebACLInput)
-				})
+					for _, rule := range getWebACLOutput.WebACL.Rules {
+						update := &waf.WebACLUpdate{
+							Action:        aws.String(waf.ChangeActionDelete),
+							ActivatedRule: rule,
+						}
 
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing rules from WAF Regional Web ACL (%s): %s", id, updateWebACLErr)
-				}
+						updateWebACLInput.Updates = append(updateWebACLInput.Updates, update)
+					}
 
-				_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
-					return conn.DeleteWebACL(deleteInput)
-				})
-			}
+					_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
+						updateWebACLInput.ChangeToken = token
+						return conn.UpdateWebACL(updateWebACLInput)
+					})
 
-			if err != nil {
-				return fmt.Errorf("error deleting WAF Regional Web ACL (%s): %s", id, err)
-			}
+					if updateWebACLErr != nil {
+						return fmt.Errorf("error removing rules: %w", updateWebACLErr)
+					}
+
+					_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
+						deleteInput.ChangeToken = token
+						return conn.DeleteWebACL(deleteInput)
+					})
+				}
+
+				return err
+			}).WithName(aws.StringValue(webACL.Name)))
 		}
 
 		if aws.StringValue(output.NextMarker) == "" {
@@ -110,7 +110,7 @@ func testSweepWafWebAcls(region string) error {
 		input.NextMarker = output.NextMarker
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSWafWebAcl_basic(t *testing.T) {
@@ -402,7 +402,7 @@ func TestAccAWSWafWebAcl_Tags(t *testing.T) {
 
 func testAccCheckAWSWafWebAclDisappears(v *waf.WebACL) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 
 		wr := newWafRetryer(conn)
 
@@ -426,7 +426,7 @@ func testAccCheckAWSWafWebAclDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetWebACL(
 			&waf.GetWebACLInput{
 				WebACLId: aws.String(rs.Primary.ID),
@@ -460,7 +460,7 @@ func testAccCheckAWSWafWebAclExists(n string, v *waf.WebACL) resource.TestCheckF
 			return fmt.Errorf("No WebACL ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		conn := testAccProvider.Meta().(*AWSClient).wafconn()
 		resp, err := conn.GetWebACL(&waf.GetWebACLInput{
 			WebACLId: aws.String(rs.Primary.ID),
 		})
@@ -12,99 +12,100 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
-		Name: "aws_wafregional_rate_based_rule",
-		F:    testSweepWafRegionalRateBasedRules,
+	sweep.AddTestSweepers("aws_wafregional_rate_based_rule", &sweep.Sweeper{
+		Name:    "aws_wafregional_rate_based_rule",
+		Service: "wafregional",
+		List:    testSweepWafRegionalRateBasedRules,
 		Dependencies: []string{
 			"aws_wafregional_web_acl",
 		},
 	})
 }
 
-func testSweepWafRegionalRateBasedRules(region string) error {
+func testSweepWafRegionalRateBasedRules(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafregionalconn
+	conn := client.(*AWSClient).wafregionalconn()
 
 	input := &waf.ListRateBasedRulesInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.ListRateBasedRules(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping WAF Regional Rate-Based Rule sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil // In case we have completed some pages
 		}
 
 		if err != nil {
-			return fmt.Errorf("error listing WAF Regional Rate-Based Rules: %s", err)
+			return sweepables, fmt.Errorf("error listing WAF Regional Rate-Based Rules: %s", err)
 		}
 
 		for _, rule := range output.Rules {
-			deleteInput := &waf.DeleteRateBasedRuleInput{
-				RuleId: rule.RuleId,
-			}
-			id := aws.StringValue(rule.RuleId)
-			wr := newWafRegionalRetryer(conn, region)
-
-			_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
-				return conn.DeleteRateBasedRule(deleteInput)
-			})
+			ruleID := rule.RuleId
 
-			if isAWSErr(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
-				getRateBasedRuleInput := &waf.GetRateBasedRuleInput{
-					RuleId: rule.RuleId,
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(ruleID), func() error {
+				deleteInput := &waf.DeleteRateBasedRuleInput{
+					RuleId: ruleID,
 				}
+				wr := newWafRegionalRetryer(conn, region)
 
-				getRateBasedRuleOutput, getRateBasedRuleErr := conn.GetRateBasedRule(getRateBasedRuleInput)
+				_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+					deleteInput.ChangeToken = token
+					return conn.DeleteRateBasedRule(deleteInput)
+				})
 
-				if getRateBasedRuleErr != nil {
-					return fmt.Errorf("error getting WAF Regional Rate-Based Rule (%s): %s", id, getRateBasedRuleErr)
-				}
+				if isAWSErr(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
+					getRateBasedRuleInput := &waf.GetRateBasedRuleInput{
+						RuleId: ruleID,
+					}
 
-				var updates []*waf.RuleUpdate
-				updateRateBasedRuleInput := &waf.UpdateRateBasedRuleInput{
-					RateLimit: getRateBasedRuleOutput.Rule.RateLimit,
-					RuleId:    rule.RuleId,
-					Updates:   updates,
-				}
+					getRateBasedRuleOutput, getRateBasedRuleErr := conn.GetRateBasedRule(getRateBasedRuleInput)
 
-				for _, predicate := range getRateBasedRuleOutput.Rule.MatchPredicates {
-					update := &waf.RuleUpdate{
-						Action:    aws.String(waf.ChangeActionDelete),
-						Predicate: predicate,
+					if getRateBasedRuleErr != nil {
+						return fmt.Errorf("error getting: %w", getRateBasedRuleErr)
 					}
 
-					updateRateBasedRuleInput.Updates = append(updateRateBasedRuleInput.Updates, update)
-				}
+					var updates []*waf.RuleUpdate
+					updateRateBasedRuleInput := &waf.UpdateRateBasedRuleInput{
+						RateLimit: getRateBasedRuleOutput.Rule.RateLimit,
+						RuleId:    ruleID,
+						Updates:   updates,
+					}
 
-				_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
-					updateRateBasedRuleInput.ChangeToken = token
-					log.Printf("[INFO] Removing Predicates from WAF Regional Rate-Based Rule: %s", id)
-					return conn.UpdateRateBasedRule(updateRateBasedRuleInput)
-				})
+					for _, predicate := range getRateBasedRuleOutput.Rule.MatchPredicates {
+						update := &waf.RuleUpdate{
+							Action:    aws.String(waf.ChangeActionDelete),
+							Predicate: predicate,
+						}
 
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing predicates from WAF Regional Rate-Based Rule (%s): %s", id, updateWebACLErr)
-				}
+						updateRateBasedRuleInput.Updates = append(updateRateBasedRuleInput.Updates, update)
+					}
 
-				_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
-					return conn.DeleteRateBasedRule(deleteInput)
-				})
-			}
+					_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
+						updateRateBasedRuleInput.ChangeToken = token
+						return conn.UpdateRateBasedRule(updateRateBasedRuleInput)
+					})
 
-			if err != nil {
-				return fmt.Errorf("error deleting WAF Regional Rate-Based Rule (%s): %s", id, err)
-			}
+					if updateWebACLErr != nil {
+						return fmt.Errorf("error removing predicates: %w", updateWebACLErr)
+					}
+
+					_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
+						deleteInput.ChangeToken = token
+						return conn.DeleteRateBasedRule(deleteInput)
+					})
+				}
+
+				return err
+			}).WithName(aws.StringValue(rule.Name)))
 		}
 
 		if aws.StringValue(output.NextMarker) == "" {
@@ -114,7 +115,7 @@ func testSweepWafRegionalRateBasedRules(region string) error {
 		input.NextMarker = output.NextMarker
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSWafRegionalRateBasedRule_basic(t *testing.T) {
@@ -375,7 +376,7 @@ func testAccCheckAWSWafRegionalRateBasedRuleDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetRateBasedRule(
 			&waf.GetRateBasedRuleInput{
 				RuleId: aws.String(rs.Primary.ID),
@@ -409,7 +410,7 @@ func testAccCheckAWSWafRegionalRateBasedRuleExists(n string, v *waf.RateBasedRul
 			return fmt.Errorf("No WAF Rule ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetRateBasedRule(&waf.GetRateBasedRuleInput{
 			RuleId: aws.String(rs.Primary.ID),
 		})
@@ -12,52 +12,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
-		Name: "aws_wafregional_rule_group",
-		F:    testSweepWafRegionalRuleGroups,
+	sweep.AddTestSweepers("aws_wafregional_rule_group", &sweep.Sweeper{
+		Name:    "aws_wafregional_rule_group",
+		Service: "wafregional",
+		List:    testSweepWafRegionalRuleGroups,
 	})
 }
 
-func testSweepWafRegionalRuleGroups(region string) error {
+func testSweepWafRegionalRuleGroups(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafregionalconn
+	conn := client.(*AWSClient).wafregionalconn()
 
 	req := &waf.ListRuleGroupsInput{}
 	resp, err := conn.ListRuleGroups(req)
-	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping WAF Regional Rule Group sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error describing WAF Regional Rule Groups: %s", err)
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping WAF Regional Rule Group sweep for %s: %s", region, err)
+		return nil, nil
 	}
 
-	if len(resp.RuleGroups) == 0 {
-		log.Print("[DEBUG] No AWS WAF Regional Rule Groups to sweep")
-		return nil
+	if err != nil {
+		return nil, fmt.Errorf("Error describing WAF Regional Rule Groups: %s", err)
 	}
 
+	var sweepables []sweep.Sweepable
+
 	for _, group := range resp.RuleGroups {
-		rResp, err := conn.ListActivatedRulesInRuleGroup(&waf.ListActivatedRulesInRuleGroupInput{
-			RuleGroupId: group.RuleGroupId,
-		})
-		if err != nil {
-			return err
-		}
-		oldRules := flattenWafActivatedRules(rResp.ActivatedRules)
-		err = deleteWafRegionalRuleGroup(*group.RuleGroupId, oldRules, conn, region)
-		if err != nil {
-			return err
-		}
+		id := aws.StringValue(group.RuleGroupId)
+
+		sweepables = append(sweepables, sweep.NewResource(id, func() error {
+			rResp, err := conn.ListActivatedRulesInRuleGroup(&waf.ListActivatedRulesInRuleGroupInput{
+				RuleGroupId: aws.String(id),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			oldRules := flattenWafActivatedRules(rResp.ActivatedRules)
+
+			return deleteWafRegionalRuleGroup(id, oldRules, conn, region)
+		}).WithName(aws.StringValue(group.Name)))
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSWafRegionalRuleGroup_basic(t *testing.T) {
@@ -309,7 +314,7 @@ func TestAccAWSWafRegionalRuleGroup_noActivatedRules(t *testing.T) {
 
 func testAccCheckAWSWafRegionalRuleGroupDisappears(group *waf.RuleGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		region := testAccProvider.Meta().(*AWSClient).region
 
 		rResp, err := conn.ListActivatedRulesInRuleGroup(&waf.ListActivatedRulesInRuleGroupInput{
@@ -360,7 +365,7 @@ func testAccCheckAWSWafRegionalRuleGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetRuleGroup(&waf.GetRuleGroupInput{
 			RuleGroupId: aws.String(rs.Primary.ID),
 		})
@@ -392,7 +397,7 @@ func testAccCheckAWSWafRegionalRuleGroupExists(n string, group *waf.RuleGroup) r
 			return fmt.Errorf("No WAF Regional Rule Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetRuleGroup(&waf.GetRuleGroupInput{
 			RuleGroupId: aws.String(rs.Primary.ID),
 		})
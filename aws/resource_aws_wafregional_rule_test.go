@@ -13,98 +13,99 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
-		Name: "aws_wafregional_rule",
-		F:    testSweepWafRegionalRules,
+	sweep.AddTestSweepers("aws_wafregional_rule", &sweep.Sweeper{
+		Name:    "aws_wafregional_rule",
+		Service: "wafregional",
+		List:    testSweepWafRegionalRules,
 		Dependencies: []string{
 			"aws_wafregional_web_acl",
 		},
 	})
 }
 
-func testSweepWafRegionalRules(region string) error {
+func testSweepWafRegionalRules(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafregionalconn
+	conn := client.(*AWSClient).wafregionalconn()
 
 	input := &waf.ListRulesInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.ListRules(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping WAF Regional Rule sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil // In case we have completed some pages
 		}
 
 		if err != nil {
-			return fmt.Errorf("error listing WAF Regional Rules: %s", err)
+			return sweepables, fmt.Errorf("error listing WAF Regional Rules: %s", err)
 		}
 
 		for _, rule := range output.Rules {
-			deleteInput := &waf.DeleteRuleInput{
-				RuleId: rule.RuleId,
-			}
-			id := aws.StringValue(rule.RuleId)
-			wr := newWafRegionalRetryer(conn, region)
-
-			_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
-				return conn.DeleteRule(deleteInput)
-			})
+			ruleID := rule.RuleId
 
-			if isAWSErr(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
-				getRuleInput := &waf.GetRuleInput{
-					RuleId: rule.RuleId,
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(ruleID), func() error {
+				deleteInput := &waf.DeleteRuleInput{
+					RuleId: ruleID,
 				}
+				wr := newWafRegionalRetryer(conn, region)
 
-				getRuleOutput, getRuleErr := conn.GetRule(getRuleInput)
+				_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+					deleteInput.ChangeToken = token
+					return conn.DeleteRule(deleteInput)
+				})
 
-				if getRuleErr != nil {
-					return fmt.Errorf("error getting WAF Regional Rule (%s): %s", id, getRuleErr)
-				}
+				if isAWSErr(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
+					getRuleInput := &waf.GetRuleInput{
+						RuleId: ruleID,
+					}
 
-				var updates []*waf.RuleUpdate
-				updateRuleInput := &waf.UpdateRuleInput{
-					RuleId:  rule.RuleId,
-					Updates: updates,
-				}
+					getRuleOutput, getRuleErr := conn.GetRule(getRuleInput)
 
-				for _, predicate := range getRuleOutput.Rule.Predicates {
-					update := &waf.RuleUpdate{
-						Action:    aws.String(waf.ChangeActionDelete),
-						Predicate: predicate,
+					if getRuleErr != nil {
+						return fmt.Errorf("error getting: %w", getRuleErr)
 					}
 
-					updateRuleInput.Updates = append(updateRuleInput.Updates, update)
-				}
+					var updates []*waf.RuleUpdate
+					updateRuleInput := &waf.UpdateRuleInput{
+						RuleId:  ruleID,
+						Updates: updates,
+					}
 
-				_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
-					updateRuleInput.ChangeToken = token
-					log.Printf("[INFO] Removing Predicates from WAF Regional Rule: %s", id)
-					return conn.UpdateRule(updateRuleInput)
-				})
+					for _, predicate := range getRuleOutput.Rule.Predicates {
+						update := &waf.RuleUpdate{
+							Action:    aws.String(waf.ChangeActionDelete),
+							Predicate: predicate,
+						}
 
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing predicates from WAF Regional Rule (%s): %s", id, updateWebACLErr)
-				}
+						updateRuleInput.Updates = append(updateRuleInput.Updates, update)
+					}
 
-				_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
-					return conn.DeleteRule(deleteInput)
-				})
-			}
+					_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
+						updateRuleInput.ChangeToken = token
+						return conn.UpdateRule(updateRuleInput)
+					})
 
-			if err != nil {
-				return fmt.Errorf("error deleting WAF Regional Rule (%s): %s", id, err)
-			}
+					if updateWebACLErr != nil {
+						return fmt.Errorf("error removing predicates: %w", updateWebACLErr)
+					}
+
+					_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
+						deleteInput.ChangeToken = token
+						return conn.DeleteRule(deleteInput)
+					})
+				}
+
+				return err
+			}).WithName(aws.StringValue(rule.Name)))
 		}
 
 		if aws.StringValue(output.NextMarker) == "" {
@@ -114,7 +115,7 @@ func testSweepWafRegionalRules(region string) error {
 		input.NextMarker = output.NextMarker
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSWafRegionalRule_basic(t *testing.T) {
@@ -352,7 +353,7 @@ func computeWafRegionalRulePredicate(dataId **string, negated bool, pType string
 
 func testAccCheckAWSWafRegionalRuleDisappears(v *waf.Rule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		region := testAccProvider.Meta().(*AWSClient).region
 
 		wr := newWafRegionalRetryer(conn, region)
@@ -400,7 +401,7 @@ func testAccCheckAWSWafRegionalRuleDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetRule(
 			&waf.GetRuleInput{
 				RuleId: aws.String(rs.Primary.ID),
@@ -434,7 +435,7 @@ func testAccCheckAWSWafRegionalRuleExists(n string, v *waf.Rule) resource.TestCh
 			return fmt.Errorf("No WAF Rule ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetRule(&waf.GetRuleInput{
 			RuleId: aws.String(rs.Primary.ID),
 		})
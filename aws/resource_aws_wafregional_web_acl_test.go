@@ -13,96 +13,96 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
-		Name: "aws_wafregional_web_acl",
-		F:    testSweepWafRegionalWebAcls,
+	sweep.AddTestSweepers("aws_wafregional_web_acl", &sweep.Sweeper{
+		Name:    "aws_wafregional_web_acl",
+		Service: "wafregional",
+		List:    testSweepWafRegionalWebAcls,
 	})
 }
 
-func testSweepWafRegionalWebAcls(region string) error {
+func testSweepWafRegionalWebAcls(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafregionalconn
-
+	conn := client.(*AWSClient).wafregionalconn()
 	input := &waf.ListWebACLsInput{}
+	var sweepables []sweep.Sweepable
 
 	for {
 		output, err := conn.ListWebACLs(input)
 
 		if testSweepSkipSweepError(err) {
 			log.Printf("[WARN] Skipping WAF Regional Web ACL sweep for %s: %s", region, err)
-			return nil
+			return sweepables, nil // In case we have completed some pages
 		}
 
 		if err != nil {
-			return fmt.Errorf("error listing WAF Regional Web ACLs: %s", err)
+			return sweepables, fmt.Errorf("error listing WAF Regional Web ACLs: %s", err)
 		}
 
 		for _, webACL := range output.WebACLs {
-			deleteInput := &waf.DeleteWebACLInput{
-				WebACLId: webACL.WebACLId,
-			}
-			id := aws.StringValue(webACL.WebACLId)
-			wr := newWafRegionalRetryer(conn, region)
+			webACLID := webACL.WebACLId
 
-			_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
-				return conn.DeleteWebACL(deleteInput)
-			})
-
-			if isAWSErr(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
-				getWebACLInput := &waf.GetWebACLInput{
-					WebACLId: webACL.WebACLId,
+			sweepables = append(sweepables, sweep.NewResource(aws.StringValue(webACLID), func() error {
+				deleteInput := &waf.DeleteWebACLInput{
+					WebACLId: webACLID,
 				}
+				wr := newWafRegionalRetryer(conn, region)
 
-				getWebACLOutput, getWebACLErr := conn.GetWebACL(getWebACLInput)
+				_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+					deleteInput.ChangeToken = token
+					return conn.DeleteWebACL(deleteInput)
+				})
 
-				if getWebACLErr != nil {
-					return fmt.Errorf("error getting WAF Regional Web ACL (%s): %s", id, getWebACLErr)
-				}
+				if isAWSErr(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
+					getWebACLInput := &waf.GetWebACLInput{
+						WebACLId: webACLID,
+					}
 
-				var updates []*waf.WebACLUpdate
-				updateWebACLInput := &waf.UpdateWebACLInput{
-					DefaultAction: getWebACLOutput.WebACL.DefaultAction,
-					Updates:       updates,
-					WebACLId:      webACL.WebACLId,
-				}
+					getWebACLOutput, getWebACLErr := conn.GetWebACL(getWebACLInput)
 
-				for _, rule := range getWebACLOutput.WebACL.Rules {
-					update := &waf.WebACLUpdate{
-						Action:        aws.String(waf.ChangeActionDelete),
-						ActivatedRule: rule,
+					if getWebACLErr != nil {
+						return fmt.Errorf("error getting: %w", getWebACLErr)
 					}
 
-					updateWebACLInput.Updates = append(updateWebACLInput.Updates, update)
-				}
+					var updates []*waf.WebACLUpdate
+					updateWebACLInput := &waf.UpdateWebACLInput{
+						DefaultAction: getWebACLOutput.WebACL.DefaultAction,
+						Updates:       updates,
+						WebACLId:      webACLID,
+					}
 
-				_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
-					updateWebACLInput.ChangeToken = token
-					log.Printf("[INFO] Removing Rules from WAF Regional Web ACL: %s", id)
-					return conn.UpdateWebACL(updateWebACLInput)
-				})
+					for _, rule := range getWebACLOutput.WebACL.Rules {
+						update := &waf.WebACLUpdate{
+							Action:        aws.String(waf.ChangeActionDelete),
+							ActivatedRule: rule,
+						}
 
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing rules from WAF Regional Web ACL (%s): %s", id, updateWebACLErr)
-				}
+						updateWebACLInput.Updates = append(updateWebACLInput.Updates, update)
+					}
 
-				_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
-					return conn.DeleteWebACL(deleteInput)
-				})
-			}
+					_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
+						updateWebACLInput.ChangeToken = token
+						return conn.UpdateWebACL(updateWebACLInput)
+					})
 
-			if err != nil {
-				return fmt.Errorf("error deleting WAF Regional Web ACL (%s): %s", id, err)
-			}
+					if updateWebACLErr != nil {
+						return fmt.Errorf("error removing rules: %w", updateWebACLErr)
+					}
+
+					_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
+						deleteInput.ChangeToken = token
+						return conn.DeleteWebACL(deleteInput)
+					})
+				}
+
+				return err
+			}).WithName(aws.StringValue(webACL.Name)))
 		}
 
 		if aws.StringValue(output.NextMarker) == "" {
@@ -112,7 +112,7 @@ func testSweepWafRegionalWebAcls(region string) error {
 		input.NextMarker = output.NextMarker
 	}
 
-	return nil
+	return sweepables, nil
 }
 
 func TestAccAWSWafRegionalWebAcl_basic(t *testing.T) {
@@ -501,7 +501,7 @@ func computeWafRegionalWebAclRuleIndex(ruleId **string, priority int, ruleType s
 
 func testAccCheckAWSWafRegionalWebAclDisappears(v *waf.WebACL) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		region := testAccProvider.Meta().(*AWSClient).region
 
 		wr := newWafRegionalRetryer(conn, region)
@@ -549,7 +549,7 @@ func testAccCheckAWSWafRegionalWebAclDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetWebACL(
 			&waf.GetWebACLInput{
 				WebACLId: aws.String(rs.Primary.ID),
@@ -583,7 +583,7 @@ func testAccCheckAWSWafRegionalWebAclExists(n string, v *waf.WebACL) resource.Te
 			return fmt.Errorf("No WebACL ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 		resp, err := conn.GetWebACL(&waf.GetWebACLInput{
 			WebACLId: aws.String(rs.Primary.ID),
 		})
@@ -8,17 +8,18 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/wafv2/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafv2_ip_set", &resource.Sweeper{
-		Name: "aws_wafv2_ip_set",
-		F:    testSweepWafv2IpSets,
+	sweep.AddTestSweepers("aws_wafv2_ip_set", &sweep.Sweeper{
+		Name:    "aws_wafv2_ip_set",
+		Service: "wafv2",
+		List:    testSweepWafv2IpSets,
 		Dependencies: []string{
 			"aws_wafv2_rule_group",
 			"aws_wafv2_web_acl",
@@ -26,14 +27,13 @@ func init() {
 	})
 }
 
-func testSweepWafv2IpSets(region string) error {
+func testSweepWafv2IpSets(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafv2conn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).wafv2conn()
+	var sweepables []sweep.Sweepable
 
 	input := &wafv2.ListIPSetsInput{
 		Scope: aws.String(wafv2.ScopeRegional),
@@ -45,22 +45,14 @@ func testSweepWafv2IpSets(region string) error {
 		}
 
 		for _, ipSet := range page.IPSets {
-			id := aws.StringValue(ipSet.Id)
-
 			r := resourceAwsWafv2IPSet()
 			d := r.Data(nil)
-			d.SetId(id)
+			d.SetId(aws.StringValue(ipSet.Id))
 			d.Set("lock_token", ipSet.LockToken)
 			d.Set("name", ipSet.Name)
 			d.Set("scope", input.Scope)
-			err := r.Delete(d, client)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting WAFv2 IP Set (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !lastPage
@@ -68,14 +60,14 @@ func testSweepWafv2IpSets(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping WAFv2 IP Set sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing WAFv2 IP Sets: %w", err))
+		return sweepables, fmt.Errorf("error describing WAFv2 IP Sets: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsWafv2IPSet_basic(t *testing.T) {
@@ -339,7 +331,7 @@ func testAccCheckAWSWafv2IPSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+		conn := testAccProvider.Meta().(*AWSClient).wafv2conn()
 		resp, err := conn.GetIPSet(
 			&wafv2.GetIPSetInput{
 				Id:    aws.String(rs.Primary.ID),
@@ -379,7 +371,7 @@ func testAccCheckAWSWafv2IPSetExists(n string, v *wafv2.IPSet) resource.TestChec
 			return fmt.Errorf("No WAFv2 IPSet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+		conn := testAccProvider.Meta().(*AWSClient).wafv2conn()
 		resp, err := conn.GetIPSet(&wafv2.GetIPSetInput{
 			Id:    aws.String(rs.Primary.ID),
 			Name:  aws.String(rs.Primary.Attributes["name"]),
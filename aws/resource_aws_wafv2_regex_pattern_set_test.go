@@ -8,17 +8,18 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/wafv2/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafv2_regex_pattern_set", &resource.Sweeper{
-		Name: "aws_wafv2_regex_pattern_set",
-		F:    testSweepWafv2RegexPatternSets,
+	sweep.AddTestSweepers("aws_wafv2_regex_pattern_set", &sweep.Sweeper{
+		Name:    "aws_wafv2_regex_pattern_set",
+		Service: "wafv2",
+		List:    testSweepWafv2RegexPatternSets,
 		Dependencies: []string{
 			"aws_wafv2_rule_group",
 			"aws_wafv2_web_acl",
@@ -26,14 +27,13 @@ func init() {
 	})
 }
 
-func testSweepWafv2RegexPatternSets(region string) error {
+func testSweepWafv2RegexPatternSets(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafv2conn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).wafv2conn()
+	var sweepables []sweep.Sweepable
 
 	input := &wafv2.ListRegexPatternSetsInput{
 		Scope: aws.String(wafv2.ScopeRegional),
@@ -45,22 +45,14 @@ func testSweepWafv2RegexPatternSets(region string) error {
 		}
 
 		for _, regexPatternSet := range page.RegexPatternSets {
-			id := aws.StringValue(regexPatternSet.Id)
-
 			r := resourceAwsWafv2RegexPatternSet()
 			d := r.Data(nil)
-			d.SetId(id)
+			d.SetId(aws.StringValue(regexPatternSet.Id))
 			d.Set("lock_token", regexPatternSet.LockToken)
 			d.Set("name", regexPatternSet.Name)
 			d.Set("scope", input.Scope)
-			err := r.Delete(d, client)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting WAFv2 Regex Pattern Set (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !lastPage
@@ -68,14 +60,14 @@ func testSweepWafv2RegexPatternSets(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping WAFv2 Regex Pattern Set sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing WAFv2 Regex Pattern Sets: %w", err))
+		return sweepables, fmt.Errorf("error describing WAFv2 Regex Pattern Sets: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsWafv2RegexPatternSet_basic(t *testing.T) {
@@ -275,7 +267,7 @@ func testAccCheckAWSWafv2RegexPatternSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+		conn := testAccProvider.Meta().(*AWSClient).wafv2conn()
 		resp, err := conn.GetRegexPatternSet(
 			&wafv2.GetRegexPatternSetInput{
 				Id:    aws.String(rs.Primary.ID),
@@ -312,7 +304,7 @@ func testAccCheckAWSWafv2RegexPatternSetExists(n string, v *wafv2.RegexPatternSe
 			return fmt.Errorf("No WAFv2 RegexPatternSet ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+		conn := testAccProvider.Meta().(*AWSClient).wafv2conn()
 		resp, err := conn.GetRegexPatternSet(&wafv2.GetRegexPatternSetInput{
 			Id:    aws.String(rs.Primary.ID),
 			Name:  aws.String(rs.Primary.Attributes["name"]),
@@ -8,28 +8,28 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/wafv2/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafv2_web_acl", &resource.Sweeper{
-		Name: "aws_wafv2_web_acl",
-		F:    testSweepWafv2WebAcls,
+	sweep.AddTestSweepers("aws_wafv2_web_acl", &sweep.Sweeper{
+		Name:    "aws_wafv2_web_acl",
+		Service: "wafv2",
+		List:    testSweepWafv2WebAcls,
 	})
 }
 
-func testSweepWafv2WebAcls(region string) error {
+func testSweepWafv2WebAcls(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).wafv2conn
-
-	var sweeperErrs *multierror.Error
+	conn := client.(*AWSClient).wafv2conn()
+	var sweepables []sweep.Sweepable
 
 	input := &wafv2.ListWebACLsInput{
 		Scope: aws.String(wafv2.ScopeRegional),
@@ -41,22 +41,14 @@ func testSweepWafv2WebAcls(region string) error {
 		}
 
 		for _, webAcl := range page.WebACLs {
-			id := aws.StringValue(webAcl.Id)
-
 			r := resourceAwsWafv2WebACL()
 			d := r.Data(nil)
-			d.SetId(id)
+			d.SetId(aws.StringValue(webAcl.Id))
 			d.Set("lock_token", webAcl.LockToken)
 			d.Set("name", webAcl.Name)
 			d.Set("scope", input.Scope)
-			err := r.Delete(d, client)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting WAFv2 Web ACL (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepables = append(sweepables, sweep.NewSchemaResource(r, d, client))
 		}
 
 		return !lastPage
@@ -64,14 +56,14 @@ func testSweepWafv2WebAcls(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping WAFv2 Web ACL sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing WAFv2 Web ACLs: %w", err))
+		return sweepables, fmt.Errorf("error describing WAFv2 Web ACLs: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return sweepables, nil
 }
 
 func TestAccAwsWafv2WebACL_basic(t *testing.T) {
@@ -755,10 +747,10 @@ func TestAccAwsWafv2WebACL_GeoMatchStatement(t *testing.T) {
 						"statement.0.geo_match_statement.0.country_codes.0":       "US",
 						"statement.0.geo_match_statement.0.country_codes.1":       "CA",
 						"statement.0.geo_match_statement.0.forwarded_ip_config.#": "0",
-						"visibility_config.#":                                     "1",
-						"visibility_config.0.cloudwatch_metrics_enabled":          "false",
-						"visibility_config.0.metric_name":                         "friendly-rule-metric-name",
-						"visibility_config.0.sampled_requests_enabled":            "false",
+						"visibility_config.#":                            "1",
+						"visibility_config.0.cloudwatch_metrics_enabled": "false",
+						"visibility_config.0.metric_name":                "friendly-rule-metric-name",
+						"visibility_config.0.sampled_requests_enabled":   "false",
 					}),
 					resource.TestCheckResourceAttr(resourceName, "visibility_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "visibility_config.0.cloudwatch_metrics_enabled", "false"),
@@ -1259,7 +1251,7 @@ func testAccCheckAwsWafv2WebACLDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+		conn := testAccProvider.Meta().(*AWSClient).wafv2conn()
 		resp, err := conn.GetWebACL(
 			&wafv2.GetWebACLInput{
 				Id:    aws.String(rs.Primary.ID),
@@ -1298,7 +1290,7 @@ func testAccCheckAwsWafv2WebACLExists(n string, v *wafv2.WebACL) resource.TestCh
 			return fmt.Errorf("No WAFv2 WebACL ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).wafv2conn
+		conn := testAccProvider.Meta().(*AWSClient).wafv2conn()
 		resp, err := conn.GetWebACL(&wafv2.GetWebACLInput{
 			Id:    aws.String(rs.Primary.ID),
 			Name:  aws.String(rs.Primary.Attributes["name"]),
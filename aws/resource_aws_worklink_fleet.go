@@ -120,7 +120,7 @@ func resourceAwsWorkLinkFleet() *schema.Resource {
 }
 
 func resourceAwsWorkLinkFleetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).worklinkconn
+	conn := meta.(*AWSClient).worklinkconn()
 
 	input := &worklink.CreateFleetInput{
 		FleetName:                  aws.String(d.Get("name").(string)),
@@ -158,7 +158,7 @@ func resourceAwsWorkLinkFleetCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsWorkLinkFleetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).worklinkconn
+	conn := meta.(*AWSClient).worklinkconn()
 
 	resp, err := conn.DescribeFleetMetadata(&worklink.DescribeFleetMetadataInput{
 		FleetArn: aws.String(d.Id()),
@@ -222,7 +222,7 @@ func resourceAwsWorkLinkFleetRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsWorkLinkFleetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).worklinkconn
+	conn := meta.(*AWSClient).worklinkconn()
 
 	input := &worklink.UpdateFleetMetadataInput{
 		FleetArn:                   aws.String(d.Id()),
@@ -273,7 +273,7 @@ func resourceAwsWorkLinkFleetUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsWorkLinkFleetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).worklinkconn
+	conn := meta.(*AWSClient).worklinkconn()
 
 	input := &worklink.DeleteFleetInput{
 		FleetArn: aws.String(d.Id()),
@@ -9,47 +9,51 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/workspaces"
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_workspaces_directory", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_directory", &sweep.Sweeper{
 		Name:         "aws_workspaces_directory",
-		F:            testSweepWorkspacesDirectories,
+		Service:      "workspaces",
+		List:         testSweepWorkspacesDirectories,
 		Dependencies: []string{"aws_workspaces_workspace"},
 	})
 }
 
-func testSweepWorkspacesDirectories(region string) error {
+func testSweepWorkspacesDirectories(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).workspacesconn
+	conn := client.(*AWSClient).workspacesconn()
+	var sweepables []sweep.Sweepable
 
-	var errors error
 	input := &workspaces.DescribeWorkspaceDirectoriesInput{}
 	err = conn.DescribeWorkspaceDirectoriesPages(input, func(resp *workspaces.DescribeWorkspaceDirectoriesOutput, _ bool) bool {
 		for _, directory := range resp.Directories {
-			err := workspacesDirectoryDelete(aws.StringValue(directory.DirectoryId), conn)
-			if err != nil {
-				errors = multierror.Append(errors, err)
-			}
+			id := aws.StringValue(directory.DirectoryId)
+
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				return workspacesDirectoryDelete(id, conn)
+			}).WithName(aws.StringValue(directory.DirectoryName)))
 		}
 		return true
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping Workspace Directory sweep for %s: %s", region, err)
-		return errors // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		errors = multierror.Append(errors, fmt.Errorf("error listing WorkSpaces Directories: %w", err))
+		return sweepables, fmt.Errorf("error listing WorkSpaces Directories: %w", err)
 	}
 
-	return errors
+	return sweepables, nil
 }
 
 func TestAccAwsWorkspacesDirectory_basic(t *testing.T) {
@@ -679,7 +683,7 @@ func TestFlattenWorkspaceCreationProperties(t *testing.T) {
 }
 
 func testAccPreCheckHasIAMRole(t *testing.T, roleName string) {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	input := &iam.GetRoleInput{
 		RoleName: aws.String(roleName),
@@ -698,7 +702,7 @@ func testAccPreCheckHasIAMRole(t *testing.T, roleName string) {
 }
 
 func testAccCheckAwsWorkspacesDirectoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_workspaces_directory" {
@@ -727,7 +731,7 @@ func testAccCheckAwsWorkspacesDirectoryDestroy(s *terraform.State) error {
 
 func testAccCheckAwsWorkspacesDirectoryDisappears(v *workspaces.WorkspaceDirectory) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		return workspacesDirectoryDelete(aws.StringValue(v.DirectoryId), testAccProvider.Meta().(*AWSClient).workspacesconn)
+		return workspacesDirectoryDelete(aws.StringValue(v.DirectoryId), testAccProvider.Meta().(*AWSClient).workspacesconn())
 	}
 }
 
@@ -742,7 +746,7 @@ func testAccCheckAwsWorkspacesDirectoryExists(n string, v *workspaces.WorkspaceD
 			return fmt.Errorf("workspaces directory resource ID is not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn()
 		resp, err := conn.DescribeWorkspaceDirectories(&workspaces.DescribeWorkspaceDirectoriesInput{
 			DirectoryIds: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -760,7 +764,7 @@ func testAccCheckAwsWorkspacesDirectoryExists(n string, v *workspaces.WorkspaceD
 }
 
 func testAccPreCheckWorkspacesDirectory(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn()
 
 	input := &workspaces.DescribeWorkspaceDirectoriesInput{}
 
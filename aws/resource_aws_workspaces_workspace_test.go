@@ -9,48 +9,51 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/workspaces"
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/workspaces/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_workspaces_workspace", &resource.Sweeper{
-		Name: "aws_workspaces_workspace",
-		F:    testSweepWorkspacesWorkspace,
+	sweep.AddTestSweepers("aws_workspaces_workspace", &sweep.Sweeper{
+		Name:    "aws_workspaces_workspace",
+		Service: "workspaces",
+		List:    testSweepWorkspacesWorkspace,
 	})
 }
 
-func testSweepWorkspacesWorkspace(region string) error {
+func testSweepWorkspacesWorkspace(region string) ([]sweep.Sweepable, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).workspacesconn
+	conn := client.(*AWSClient).workspacesconn()
+	var sweepables []sweep.Sweepable
 
-	var errors error
 	input := &workspaces.DescribeWorkspacesInput{}
 	err = conn.DescribeWorkspacesPages(input, func(resp *workspaces.DescribeWorkspacesOutput, _ bool) bool {
 		for _, workspace := range resp.Workspaces {
-			err := workspaceDelete(conn, aws.StringValue(workspace.WorkspaceId), waiter.WorkspaceTerminatedTimeout)
-			if err != nil {
-				errors = multierror.Append(errors, err)
-			}
+			id := aws.StringValue(workspace.WorkspaceId)
 
+			sweepables = append(sweepables, sweep.NewResource(id, func() error {
+				return workspaceDelete(conn, id, waiter.WorkspaceTerminatedTimeout)
+			}))
 		}
 		return true
 	})
+
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping workspaces sweep for %s: %s", region, err)
-		return errors // In case we have completed some pages, but had errors
+		return sweepables, nil // In case we have completed some pages
 	}
+
 	if err != nil {
-		errors = multierror.Append(errors, fmt.Errorf("error listing workspaces: %s", err))
+		return sweepables, fmt.Errorf("error listing workspaces: %s", err)
 	}
 
-	return errors
+	return sweepables, nil
 }
 
 func TestAccAwsWorkspacesWorkspace_basic(t *testing.T) {
@@ -347,7 +350,7 @@ func TestAccAwsWorkspacesWorkspace_timeout(t *testing.T) {
 }
 
 func testAccCheckAwsWorkspacesWorkspaceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_workspaces_workspace" {
@@ -381,7 +384,7 @@ func testAccCheckAwsWorkspacesWorkspaceExists(n string, v *workspaces.Workspace)
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn()
 
 		output, err := conn.DescribeWorkspaces(&workspaces.DescribeWorkspacesInput{
 			WorkspaceIds: []*string{aws.String(rs.Primary.ID)},
@@ -0,0 +1,25 @@
+//go:generate go run -tags generate internal/keyvaluetags/generators/tagresources/main.go
+
+package aws
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tagResourceID returns the ID of a generated aws_{SERVICE}_tag resource.
+func tagResourceID(identifier string, key string) string {
+	return fmt.Sprintf("%s,%s", identifier, key)
+}
+
+// tagResourceParseID returns the resource identifier and tag key of a generated
+// aws_{SERVICE}_tag resource ID.
+func tagResourceParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ",", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%s), expected IDENTIFIER,KEY", id)
+	}
+
+	return parts[0], parts[1], nil
+}
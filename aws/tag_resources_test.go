@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestTagResourceParseID(t *testing.T) {
+	testCases := []struct {
+		ID                 string
+		ExpectedIdentifier string
+		ExpectedKey        string
+		ExpectError        bool
+	}{
+		{
+			ID:          "",
+			ExpectError: true,
+		},
+		{
+			ID:          "arn:aws:sns:us-west-2:123456789012:example",
+			ExpectError: true,
+		},
+		{
+			ID:          "arn:aws:sns:us-west-2:123456789012:example,",
+			ExpectError: true,
+		},
+		{
+			ID:                 "arn:aws:sns:us-west-2:123456789012:example,Name",
+			ExpectedIdentifier: "arn:aws:sns:us-west-2:123456789012:example",
+			ExpectedKey:        "Name",
+		},
+		{
+			ID:                 "https://sqs.us-west-2.amazonaws.com/123456789012/example,key,with,commas",
+			ExpectedIdentifier: "https://sqs.us-west-2.amazonaws.com/123456789012/example",
+			ExpectedKey:        "key,with,commas",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.ID, func(t *testing.T) {
+			identifier, key, err := tagResourceParseID(testCase.ID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if identifier != testCase.ExpectedIdentifier {
+				t.Errorf("got identifier %s, expected %s", identifier, testCase.ExpectedIdentifier)
+			}
+
+			if key != testCase.ExpectedKey {
+				t.Errorf("got key %s, expected %s", key, testCase.ExpectedKey)
+			}
+
+			if !testCase.ExpectError && tagResourceID(identifier, key) != testCase.ID {
+				t.Errorf("got ID %s, expected %s", tagResourceID(identifier, key), testCase.ID)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
 )
 
 const (
@@ -146,11 +147,8 @@ func validateNeptuneIdentifier(v interface{}, k string) (ws []string, errors []e
 }
 
 func validateRdsIdentifierPrefix(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = naming.RdsDbInstanceIdentifier.ValidatePrefix(v, k)
 	value := v.(string)
-	if !regexp.MustCompile(`^[0-9a-z-]+$`).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"only lowercase alphanumeric characters and hyphens allowed in %q", k))
-	}
 	if !regexp.MustCompile(`^[a-z]`).MatchString(value) {
 		errors = append(errors, fmt.Errorf(
 			"first character of %q must be a letter", k))
@@ -423,16 +421,8 @@ func validateElbName(v interface{}, k string) (ws []string, errors []error) {
 }
 
 func validateElbNamePrefix(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = naming.ElbLoadBalancerName.ValidatePrefix(v, k)
 	value := v.(string)
-	if !regexp.MustCompile(`^[0-9A-Za-z-]+$`).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"only alphanumeric characters and hyphens allowed in %q: %q",
-			k, value))
-	}
-	if len(value) > 6 {
-		errors = append(errors, fmt.Errorf(
-			"%q cannot be longer than 6 characters: %q", k, value))
-	}
 	if regexp.MustCompile(`^-`).MatchString(value) {
 		errors = append(errors, fmt.Errorf(
 			"%q cannot begin with a hyphen: %q", k, value))
@@ -2358,16 +2348,8 @@ func validateSecretManagerSecretName(v interface{}, k string) (ws []string, erro
 }
 
 func validateLbTargetGroupNamePrefix(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = naming.Elbv2TargetGroupName.ValidatePrefix(v, k)
 	value := v.(string)
-	prefixMaxLength := 32 - resource.UniqueIDSuffixLength
-	if len(value) > prefixMaxLength {
-		errors = append(errors, fmt.Errorf(
-			"%q cannot be longer than %d characters", k, prefixMaxLength))
-	}
-	if !regexp.MustCompile(`^[0-9A-Za-z-]+$`).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"only alphanumeric characters and hyphens allowed in %q", k))
-	}
 	if regexp.MustCompile(`^-`).MatchString(value) {
 		errors = append(errors, fmt.Errorf(
 			"%q cannot begin with a hyphen", k))
@@ -1648,6 +1648,8 @@ func TestValidateElbName(t *testing.T) {
 func TestValidateElbNamePrefix(t *testing.T) {
 	validNamePrefixes := []string{
 		"test-",
+		"tf-test",
+		"tf-test-load-balancer-12",
 	}
 
 	for _, s := range validNamePrefixes {
@@ -1659,7 +1661,7 @@ func TestValidateElbNamePrefix(t *testing.T) {
 
 	invalidNamePrefixes := []string{
 		"tf.test.elb.",
-		"tf-test",
+		"tf-test-load-balancer-123",
 		"-test",
 	}
 
@@ -0,0 +1,55 @@
+package analysisutils
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// FuncBody returns the body of the function referenced by an expression, such
+// as the value of the Read field of a schema.Resource, which is either a
+// function literal or the name of a function declared in the package.
+// It returns nil if the function is not declared in the package.
+func FuncBody(pass *analysis.Pass, e ast.Expr) *ast.BlockStmt {
+	switch e := e.(type) {
+	case *ast.FuncLit:
+		return e.Body
+	case *ast.Ident:
+		obj := pass.TypesInfo.ObjectOf(e)
+
+		if obj == nil {
+			return nil
+		}
+
+		for _, file := range pass.Files {
+			for _, decl := range file.Decls {
+				funcDecl, ok := decl.(*ast.FuncDecl)
+
+				if !ok || funcDecl.Recv != nil {
+					continue
+				}
+
+				if pass.TypesInfo.Defs[funcDecl.Name] == obj {
+					return funcDecl.Body
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// EnclosingFuncBody returns the body of the innermost function declaration or
+// literal in a stack of nodes, as passed by (*inspector.Inspector).WithStack().
+func EnclosingFuncBody(stack []ast.Node) *ast.BlockStmt {
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch n := stack[i].(type) {
+		case *ast.FuncDecl:
+			return n.Body
+		case *ast.FuncLit:
+			return n.Body
+		}
+	}
+
+	return nil
+}
@@ -1,5 +1,24 @@
 package keyvaluetags
 
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
 const (
 	FuncNameNew = `New`
+
+	FuncNameSuffixListTags = `ListTags`
 )
+
+// IsListTagsFunc returns if the function call is a generated service ListTags function in the package
+func IsListTagsFunc(e ast.Expr, info *types.Info) bool {
+	selectorExpr, ok := e.(*ast.SelectorExpr)
+
+	if !ok || !strings.HasSuffix(selectorExpr.Sel.Name, FuncNameSuffixListTags) {
+		return false
+	}
+
+	return IsFunc(e, info, selectorExpr.Sel.Name)
+}
@@ -0,0 +1,20 @@
+package tfresource
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+)
+
+const (
+	FuncNameTimedOut = `TimedOut`
+
+	PackageName = `tfresource`
+	PackagePath = `github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource`
+)
+
+// IsFunc returns if the function call is in the package
+func IsFunc(e ast.Expr, info *types.Info, funcName string) bool {
+	return astutils.IsPackageFunc(e, info, PackagePath, funcName)
+}
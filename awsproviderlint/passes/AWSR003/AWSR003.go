@@ -0,0 +1,136 @@
+package AWSR003
+
+import (
+	"go/ast"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinforesourceonly"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/helper/analysisutils"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for d.SetId("") in Read without d.IsNewResource() check
+
+The AWSR003 analyzer reports when a resource Read function removes the resource
+from the Terraform state, via a (schema.ResourceData).SetId("") call, outside of
+a conditional checking (schema.ResourceData).IsNewResource().
+
+Eventually consistent APIs can return not found errors just after creation, in
+which case the resource would be silently removed from the state instead of
+returning an error.
+`
+
+const analyzerName = "AWSR003"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinforesourceonly.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	resourceInfos := pass.ResultOf[resourceinforesourceonly.Analyzer].([]*schema.ResourceInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	for _, resourceInfo := range resourceInfos {
+		for _, fieldName := range []string{schema.ResourceFieldRead, schema.ResourceFieldReadContext} {
+			kvExpr := resourceInfo.Fields[fieldName]
+
+			if kvExpr == nil {
+				continue
+			}
+
+			body := analysisutils.FuncBody(pass, kvExpr.Value)
+
+			if body == nil {
+				continue
+			}
+
+			var stack []ast.Node
+
+			ast.Inspect(body, func(n ast.Node) bool {
+				if n == nil {
+					stack = stack[:len(stack)-1]
+					return true
+				}
+
+				stack = append(stack, n)
+
+				callExpr, ok := n.(*ast.CallExpr)
+
+				if !ok || !isSetIdEmptyString(pass, callExpr) {
+					return true
+				}
+
+				if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+					return true
+				}
+
+				if !hasIsNewResourceCondition(pass, stack) {
+					pass.Reportf(callExpr.Pos(), "%s: resource removed from state without (schema.ResourceData).IsNewResource() check", analyzerName)
+				}
+
+				return true
+			})
+		}
+	}
+
+	return nil, nil
+}
+
+// isSetIdEmptyString returns true if the call is d.SetId("").
+func isSetIdEmptyString(pass *analysis.Pass, callExpr *ast.CallExpr) bool {
+	if !schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "SetId") {
+		return false
+	}
+
+	if len(callExpr.Args) != 1 {
+		return false
+	}
+
+	value := astutils.ExprStringValue(callExpr.Args[0])
+
+	return value != nil && *value == ""
+}
+
+// hasIsNewResourceCondition returns true if any if statement enclosing the
+// innermost node of the stack has a condition calling d.IsNewResource().
+func hasIsNewResourceCondition(pass *analysis.Pass, stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		ifStmt, ok := stack[i].(*ast.IfStmt)
+
+		if !ok || !inBody(ifStmt, stack[i+1]) {
+			continue
+		}
+
+		var found bool
+
+		ast.Inspect(ifStmt.Cond, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if ok && schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "IsNewResource") {
+				found = true
+			}
+
+			return !found
+		})
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// inBody returns true if the node is the body or else branch of the if
+// statement, rather than its condition or initialization statement.
+func inBody(ifStmt *ast.IfStmt, n ast.Node) bool {
+	return n == ifStmt.Body || (ifStmt.Else != nil && n == ifStmt.Else)
+}
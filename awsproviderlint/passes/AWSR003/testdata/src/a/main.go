@@ -0,0 +1,107 @@
+package a
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var errNotFound = errors.New("not found")
+
+func find(id string) error {
+	return errNotFound
+}
+
+func f() {
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourcePassingRead,
+		Delete: resourceDelete,
+	}
+
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourceFailingRead,
+		Delete: resourceDelete,
+	}
+
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			if err := find(d.Id()); err != nil {
+				d.SetId("") // want "resource removed from state without"
+				return nil
+			}
+
+			return nil
+		},
+		Delete: resourceDelete,
+	}
+
+	// Data sources are not checked.
+	_ = &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId("")
+			return nil
+		},
+	}
+}
+
+func resourceCreate(d *schema.ResourceData, meta interface{}) error {
+	// Only Read functions are checked.
+	d.SetId("")
+	return nil
+}
+
+func resourcePassingRead(d *schema.ResourceData, meta interface{}) error {
+	err := find(d.Id())
+
+	/* Passing cases */
+
+	if !d.IsNewResource() && errors.Is(err, errNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() {
+		if errors.Is(err, errNotFound) {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	d.SetId("test")
+
+	/* Comment ignored cases */
+
+	if errors.Is(err, errNotFound) {
+		//lintignore:AWSR003
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceFailingRead(d *schema.ResourceData, meta interface{}) error {
+	err := find(d.Id())
+
+	/* Failing cases */
+
+	if errors.Is(err, errNotFound) {
+		d.SetId("") // want "resource removed from state without \\(schema.ResourceData\\).IsNewResource\\(\\) check"
+		return nil
+	}
+
+	if d.IsNewResource() {
+		return err
+	}
+
+	d.SetId("") // want "resource removed from state without"
+
+	return nil
+}
+
+func resourceDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
@@ -0,0 +1,137 @@
+package AWSR004
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/helper/analysisutils"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/helper/awsprovidertype/keyvaluetags"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for tags read with ListTags() missing IgnoreConfig()
+
+The AWSR004 analyzer reports when the tags returned by a keyvaluetags package
+ListTags() function, in a function that sets the Terraform state via
+(schema.ResourceData).Set(), are never passed through
+(keyvaluetags.KeyValueTags).IgnoreConfig(), which ensures any provider level
+ignore tags configuration is applied.
+`
+
+const analyzerName = "AWSR004"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+	}
+
+	inspect.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		assignStmt := n.(*ast.AssignStmt)
+
+		if len(assignStmt.Rhs) != 1 || len(assignStmt.Lhs) == 0 {
+			return true
+		}
+
+		callExpr, ok := assignStmt.Rhs[0].(*ast.CallExpr)
+
+		if !ok || !keyvaluetags.IsListTagsFunc(callExpr.Fun, pass.TypesInfo) {
+			return true
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+			return true
+		}
+
+		ident, ok := assignStmt.Lhs[0].(*ast.Ident)
+
+		if !ok {
+			return true
+		}
+
+		obj := pass.TypesInfo.ObjectOf(ident)
+
+		if obj == nil {
+			return true
+		}
+
+		body := analysisutils.EnclosingFuncBody(stack)
+
+		if body == nil || !callsResourceDataSet(pass, body) {
+			return true
+		}
+
+		if !callsIgnoreConfig(pass, body, obj) {
+			pass.Reportf(callExpr.Pos(), "%s: missing (keyvaluetags.KeyValueTags).IgnoreConfig() for tags read with %s()", analyzerName, callExpr.Fun.(*ast.SelectorExpr).Sel.Name)
+		}
+
+		return true
+	})
+
+	return nil, nil
+}
+
+// callsResourceDataSet returns true if the function body calls d.Set().
+func callsResourceDataSet(pass *analysis.Pass, body *ast.BlockStmt) bool {
+	var found bool
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		callExpr, ok := n.(*ast.CallExpr)
+
+		if ok && schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "Set") {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// callsIgnoreConfig returns true if the function body calls IgnoreConfig() on
+// a receiver derived from the object.
+func callsIgnoreConfig(pass *analysis.Pass, body *ast.BlockStmt, obj types.Object) bool {
+	var found bool
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		callExpr, ok := n.(*ast.CallExpr)
+
+		if !ok || !keyvaluetags.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, keyvaluetags.TypeNameKeyValueTags, keyvaluetags.KeyValueTagsMethodNameIgnoreConfig) {
+			return !found
+		}
+
+		ast.Inspect(callExpr.Fun.(*ast.SelectorExpr).X, func(n ast.Node) bool {
+			ident, ok := n.(*ast.Ident)
+
+			if ok && pass.TypesInfo.ObjectOf(ident) == obj {
+				found = true
+			}
+
+			return !found
+		})
+
+		return !found
+	})
+
+	return found
+}
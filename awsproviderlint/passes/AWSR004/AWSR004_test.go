@@ -0,0 +1,14 @@
+package AWSR004
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+// The testdata package is nested under the provider import path, so that it
+// can import a stand-in for the internal keyvaluetags package.
+func TestAWSR004(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "github.com/terraform-providers/terraform-provider-aws/aws/a")
+}
@@ -0,0 +1,88 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+var ignoreTagsConfig = &keyvaluetags.IgnoreConfig{}
+
+/* Passing cases */
+
+func passingInline(d *schema.ResourceData) error {
+	tags, err := keyvaluetags.ExampleListTags(nil, d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	return d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
+}
+
+func passingReassigned(d *schema.ResourceData) error {
+	tags, err := keyvaluetags.ExampleListTags(nil, d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return err
+	}
+
+	return d.Set("tags_all", tags.Map())
+}
+
+// Functions not setting state, such as acceptance test checks, are not checked.
+func passingNoSet(d *schema.ResourceData) error {
+	_, err := keyvaluetags.ExampleListTags(nil, d.Id())
+
+	return err
+}
+
+/* Comment ignored cases */
+
+func ignored(d *schema.ResourceData) error {
+	//lintignore:AWSR004
+	tags, err := keyvaluetags.ExampleListTags(nil, d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	return d.Set("tags", tags.IgnoreAws().Map())
+}
+
+/* Failing cases */
+
+func failing(d *schema.ResourceData) error {
+	tags, err := keyvaluetags.ExampleListTags(nil, d.Id()) // want "missing \\(keyvaluetags.KeyValueTags\\).IgnoreConfig\\(\\) for tags read with ExampleListTags\\(\\)"
+
+	if err != nil {
+		return err
+	}
+
+	return d.Set("tags", tags.IgnoreAws().Map())
+}
+
+func failingOtherTags(d *schema.ResourceData) error {
+	tags, err := keyvaluetags.ExampleListTags(nil, d.Id()) // want "missing \\(keyvaluetags.KeyValueTags\\).IgnoreConfig\\(\\)"
+
+	if err != nil {
+		return err
+	}
+
+	otherTags, err := keyvaluetags.ExampleListTags(nil, "other")
+
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("other_tags", otherTags.IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return err
+	}
+
+	return d.Set("tags", tags.Map())
+}
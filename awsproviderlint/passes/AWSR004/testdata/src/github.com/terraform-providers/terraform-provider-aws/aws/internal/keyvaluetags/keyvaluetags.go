@@ -0,0 +1,22 @@
+// Package keyvaluetags is a stand-in for the provider package of the same name.
+package keyvaluetags
+
+type IgnoreConfig struct{}
+
+type KeyValueTags map[string]*string
+
+func (tags KeyValueTags) IgnoreAws() KeyValueTags {
+	return tags
+}
+
+func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
+	return tags
+}
+
+func (tags KeyValueTags) Map() map[string]string {
+	return nil
+}
+
+func ExampleListTags(conn interface{}, identifier string) (KeyValueTags, error) {
+	return KeyValueTags{}, nil
+}
@@ -0,0 +1,81 @@
+package AWSR005
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for d.Set() of complex values without error check
+
+The AWSR005 analyzer reports when the error returned by a
+(schema.ResourceData).Set() call with a list, map or set value is ignored.
+Unlike simple values, these values can fail to be set, such as when they do not
+match the attribute schema, leaving the attribute silently unset.
+`
+
+const analyzerName = "AWSR005"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.ExprStmt)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		exprStmt := n.(*ast.ExprStmt)
+
+		callExpr, ok := exprStmt.X.(*ast.CallExpr)
+
+		if !ok || !schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "Set") {
+			return
+		}
+
+		if len(callExpr.Args) != 2 || !isComplexValue(pass.TypesInfo.TypeOf(callExpr.Args[1])) {
+			return
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+			return
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: ResourceData.Set() of list, map or set value should check error", analyzerName)
+	})
+
+	return nil, nil
+}
+
+// isComplexValue returns true if the type is a slice, map or *schema.Set.
+func isComplexValue(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	if schema.IsTypeSet(t) {
+		return true
+	}
+
+	switch t.Underlying().(type) {
+	case *types.Map, *types.Slice:
+		return true
+	}
+
+	return false
+}
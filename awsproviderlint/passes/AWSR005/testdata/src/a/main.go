@@ -0,0 +1,46 @@
+package a
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type tags map[string]string
+
+func flatten() []interface{} {
+	return nil
+}
+
+func f(d *schema.ResourceData) error {
+	/* Passing cases */
+
+	if err := d.Set("list", flatten()); err != nil {
+		return fmt.Errorf("error setting list: %w", err)
+	}
+
+	err := d.Set("map", map[string]interface{}{})
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("string", "test")
+	d.Set("int", 1)
+	d.Set("bool", true)
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR005
+	d.Set("list", flatten())
+
+	/* Failing cases */
+
+	d.Set("list", flatten())                            // want "ResourceData.Set\\(\\) of list, map or set value should check error"
+	d.Set("list", []string{"test"})                     // want "ResourceData.Set\\(\\) of list, map or set value should check error"
+	d.Set("map", map[string]interface{}{})              // want "ResourceData.Set\\(\\) of list, map or set value should check error"
+	d.Set("tags", tags{})                               // want "ResourceData.Set\\(\\) of list, map or set value should check error"
+	d.Set("set", schema.NewSet(schema.HashString, nil)) // want "ResourceData.Set\\(\\) of list, map or set value should check error"
+
+	return nil
+}
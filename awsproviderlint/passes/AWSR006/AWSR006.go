@@ -0,0 +1,115 @@
+package AWSR006
+
+import (
+	"go/ast"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinforesourceonly"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/helper/analysisutils"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for resources with ID-only Read missing Importer
+
+The AWSR006 analyzer reports when a resource without an Importer has a Read
+function that only uses (schema.ResourceData).Id() to refresh the resource.
+Since the Read function does not depend on any other configuration, the
+resource can support import via schema.ImportStatePassthrough.
+`
+
+const analyzerName = "AWSR006"
+
+// idOnlyMethodNames are the (schema.ResourceData) methods that do not read
+// configuration or state other than the resource ID.
+var idOnlyMethodNames = map[string]bool{
+	"Id":            true,
+	"IsNewResource": true,
+	"Set":           true,
+	"SetId":         true,
+	"Timeout":       true,
+}
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinforesourceonly.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	resourceInfos := pass.ResultOf[resourceinforesourceonly.Analyzer].([]*schema.ResourceInfo)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	for _, resourceInfo := range resourceInfos {
+		if resourceInfo.Fields[schema.ResourceFieldImporter] != nil {
+			continue
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, resourceInfo.AstCompositeLit) {
+			continue
+		}
+
+		for _, fieldName := range []string{schema.ResourceFieldRead, schema.ResourceFieldReadContext} {
+			kvExpr := resourceInfo.Fields[fieldName]
+
+			if kvExpr == nil {
+				continue
+			}
+
+			body := analysisutils.FuncBody(pass, kvExpr.Value)
+
+			if body == nil || !isIdOnlyRead(pass, body) {
+				continue
+			}
+
+			pass.Reportf(resourceInfo.AstCompositeLit.Pos(), "%s: resource with ID-only %s should implement Importer", analyzerName, fieldName)
+		}
+	}
+
+	return nil, nil
+}
+
+// isIdOnlyRead returns true if the function body calls (schema.ResourceData).Id()
+// and otherwise only uses the ResourceData via methods in idOnlyMethodNames.
+// Passing the ResourceData to another function is treated as reading other
+// attributes.
+func isIdOnlyRead(pass *analysis.Pass, body *ast.BlockStmt) bool {
+	var callsId bool
+	idOnly := true
+	idOnlyUses := make(map[*ast.Ident]bool)
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			ident, ok := n.X.(*ast.Ident)
+
+			if !ok || !schema.IsTypeResourceData(pass.TypesInfo.TypeOf(ident)) {
+				return true
+			}
+
+			if idOnlyMethodNames[n.Sel.Name] {
+				idOnlyUses[ident] = true
+			}
+
+			if n.Sel.Name == "Id" {
+				callsId = true
+			}
+		case *ast.Ident:
+			if !schema.IsTypeResourceData(pass.TypesInfo.TypeOf(n)) {
+				return true
+			}
+
+			if !idOnlyUses[n] {
+				idOnly = false
+			}
+		}
+
+		return idOnly
+	})
+
+	return callsId && idOnly
+}
@@ -0,0 +1,111 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func find(id string) (map[string]interface{}, error) {
+	return nil, nil
+}
+
+func f() {
+	/* Passing cases */
+
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourceIdOnlyRead,
+		Delete: resourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourceGetRead,
+		Delete: resourceDelete,
+	}
+
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return readWithResourceData(d)
+		},
+		Delete: resourceDelete,
+	}
+
+	// Data sources are not checked.
+	_ = &schema.Resource{
+		Read: resourceIdOnlyRead,
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR006
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourceIdOnlyRead,
+		Delete: resourceDelete,
+	}
+
+	/* Failing cases */
+
+	_ = &schema.Resource{ // want "resource with ID-only Read should implement Importer"
+		Create: resourceCreate,
+		Read:   resourceIdOnlyRead,
+		Delete: resourceDelete,
+	}
+
+	_ = &schema.Resource{ // want "resource with ID-only Read should implement Importer"
+		Create: resourceCreate,
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			output, err := find(d.Id())
+
+			if err != nil {
+				if !d.IsNewResource() {
+					d.SetId("")
+					return nil
+				}
+
+				return err
+			}
+
+			return d.Set("name", output["name"])
+		},
+		Delete: resourceDelete,
+	}
+}
+
+func resourceCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("test")
+	return nil
+}
+
+func resourceIdOnlyRead(d *schema.ResourceData, meta interface{}) error {
+	output, err := find(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	return d.Set("name", output["name"])
+}
+
+func resourceGetRead(d *schema.ResourceData, meta interface{}) error {
+	output, err := find(d.Get("name").(string))
+
+	if err != nil {
+		return err
+	}
+
+	return d.Set("description", output["description"])
+}
+
+func readWithResourceData(d *schema.ResourceData) error {
+	return nil
+}
+
+func resourceDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
@@ -0,0 +1,144 @@
+package AWSR007
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/resource"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/helper/analysisutils"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/helper/awsprovidertype/tfresource"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for resource.Retry() errors missing timeout handling
+
+The AWSR007 analyzer reports when the error returned by a resource.Retry() or
+resource.RetryContext() call is not later checked with tfresource.TimedOut()
+or isResourceTimeoutError() in the same function.
+
+When the retry timeout is reached, for example because of a slow API or a
+paused process, the function is not called one last time and the error may not
+be the expected error from the API. The tfresource.RetryWhen*() functions
+handle this automatically.
+`
+
+const (
+	analyzerName = "AWSR007"
+
+	funcNameIsResourceTimeoutError = `isResourceTimeoutError`
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+	}
+
+	inspect.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		assignStmt := n.(*ast.AssignStmt)
+
+		if len(assignStmt.Lhs) != 1 || len(assignStmt.Rhs) != 1 {
+			return true
+		}
+
+		callExpr, ok := assignStmt.Rhs[0].(*ast.CallExpr)
+
+		if !ok || !(resource.IsFunc(callExpr.Fun, pass.TypesInfo, "Retry") || resource.IsFunc(callExpr.Fun, pass.TypesInfo, "RetryContext")) {
+			return true
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+			return true
+		}
+
+		ident, ok := assignStmt.Lhs[0].(*ast.Ident)
+
+		if !ok {
+			return true
+		}
+
+		obj := pass.TypesInfo.ObjectOf(ident)
+
+		if obj == nil {
+			return true
+		}
+
+		body := analysisutils.EnclosingFuncBody(stack)
+
+		if body == nil || checksTimedOut(pass, body, obj, assignStmt.End()) {
+			return true
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: resource.%s() error should be checked with tfresource.TimedOut(), prefer tfresource.RetryWhen*() functions", analyzerName, callExpr.Fun.(*ast.SelectorExpr).Sel.Name)
+
+		return true
+	})
+
+	return nil, nil
+}
+
+// checksTimedOut returns true if the function body passes the object to
+// tfresource.TimedOut() or isResourceTimeoutError() after the position.
+func checksTimedOut(pass *analysis.Pass, body *ast.BlockStmt, obj types.Object, pos token.Pos) bool {
+	var found bool
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		callExpr, ok := n.(*ast.CallExpr)
+
+		if !ok || callExpr.Pos() < pos || len(callExpr.Args) != 1 {
+			return !found
+		}
+
+		if !isTimedOutFunc(pass, callExpr.Fun) {
+			return !found
+		}
+
+		if ident, ok := callExpr.Args[0].(*ast.Ident); ok && pass.TypesInfo.ObjectOf(ident) == obj {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// isTimedOutFunc returns true if the function is tfresource.TimedOut(), also
+// when called from within the tfresource package, or the provider package
+// isResourceTimeoutError().
+func isTimedOutFunc(pass *analysis.Pass, e ast.Expr) bool {
+	ident, ok := e.(*ast.Ident)
+
+	if !ok {
+		return tfresource.IsFunc(e, pass.TypesInfo, tfresource.FuncNameTimedOut)
+	}
+
+	switch ident.Name {
+	case funcNameIsResourceTimeoutError:
+		return true
+	case tfresource.FuncNameTimedOut:
+		return pass.Pkg.Path() == tfresource.PackagePath
+	}
+
+	return false
+}
@@ -0,0 +1,12 @@
+package AWSR007
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAWSR007(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "github.com/terraform-providers/terraform-provider-aws/aws/a")
+}
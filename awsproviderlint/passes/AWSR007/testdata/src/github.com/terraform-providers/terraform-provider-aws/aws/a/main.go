@@ -0,0 +1,94 @@
+package a
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func create() error {
+	return nil
+}
+
+func isResourceTimeoutError(err error) bool {
+	return false
+}
+
+func f() error {
+	/* Passing cases */
+
+	err := resource.Retry(time.Minute, func() *resource.RetryError {
+		if err := create(); err != nil {
+			return resource.RetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		err = create()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		err = create()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR007
+	err = resource.Retry(time.Minute, func() *resource.RetryError {
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	/* Failing cases */
+
+	err = resource.Retry(time.Minute, func() *resource.RetryError { // want "resource.Retry\\(\\) error should be checked with tfresource.TimedOut\\(\\), prefer tfresource.RetryWhen\\*\\(\\) functions"
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	err = resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError { // want "resource.RetryContext\\(\\) error should be checked"
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func failingOtherError() error {
+	err := create()
+
+	retryErr := resource.Retry(time.Minute, func() *resource.RetryError { // want "resource.Retry\\(\\) error should be checked"
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		return retryErr
+	}
+
+	return nil
+}
@@ -0,0 +1,6 @@
+// Package tfresource is a stand-in for the provider package of the same name.
+package tfresource
+
+func TimedOut(err error) bool {
+	return false
+}
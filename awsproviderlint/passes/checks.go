@@ -9,6 +9,11 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/passes/AWSAT006"
 	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/passes/AWSR001"
 	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/passes/AWSR002"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/passes/AWSR003"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/passes/AWSR004"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/passes/AWSR005"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/passes/AWSR006"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/passes/AWSR007"
 	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/passes/AWSV001"
 	"golang.org/x/tools/go/analysis"
 )
@@ -22,5 +27,10 @@ var AllChecks = []*analysis.Analyzer{
 	AWSAT006.Analyzer,
 	AWSR001.Analyzer,
 	AWSR002.Analyzer,
+	AWSR003.Analyzer,
+	AWSR004.Analyzer,
+	AWSR005.Analyzer,
+	AWSR006.Analyzer,
+	AWSR007.Analyzer,
 	AWSV001.Analyzer,
 }